@@ -0,0 +1,16 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// WaitTimeoutError is returned when the timeout is reached waiting for a
+// condition to be met.
+type WaitTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e WaitTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for condition", e.Timeout)
+}
@@ -2,6 +2,7 @@ package cfnetworkingaction
 
 import (
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/v3action"
 )
 
 type PolicyDoesNotExistError struct{}
@@ -10,24 +11,29 @@ func (e PolicyDoesNotExistError) Error() string {
 	return "Policy does not exist."
 }
 
+// Policy represents a network policy from a source app to a destination app.
+// DestinationSpaceName and DestinationOrgName are only set when the
+// destination app is in a different space than the source app.
 type Policy struct {
-	SourceName      string
-	DestinationName string
-	Protocol        string
-	StartPort       int
-	EndPort         int
+	SourceName           string
+	DestinationName      string
+	DestinationSpaceName string
+	DestinationOrgName   string
+	Protocol             string
+	StartPort            int
+	EndPort              int
 }
 
-func (actor Actor) AddNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+func (actor Actor) AddNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -71,13 +77,10 @@ func (actor Actor) NetworkPoliciesBySpace(spaceGUID string) ([]Policy, Warnings,
 		appNameByGuid[app.GUID] = app.Name
 	}
 
-	var policies []Policy
-	emptyPolicy := Policy{}
-	for _, v1Policy := range v1Policies {
-		policy := actor.transformPolicy(appNameByGuid, v1Policy)
-		if policy != emptyPolicy {
-			policies = append(policies, policy)
-		}
+	policies, transformWarnings, err := actor.transformPoliciesAcrossSpaces(appNameByGuid, v1Policies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
 	}
 
 	return policies, allWarnings, nil
@@ -112,30 +115,127 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 		return []Policy{}, allWarnings, err
 	}
 
-	var policies []Policy
-	emptyPolicy := Policy{}
+	var srcAppPolicies []cfnetv1.Policy
 	for _, v1Policy := range v1Policies {
 		if v1Policy.Source.ID == appGUID {
-			policy := actor.transformPolicy(appNameByGuid, v1Policy)
-			if policy != emptyPolicy {
-				policies = append(policies, policy)
-			}
+			srcAppPolicies = append(srcAppPolicies, v1Policy)
 		}
 	}
 
+	policies, transformWarnings, err := actor.transformPoliciesAcrossSpaces(appNameByGuid, srcAppPolicies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
 	return policies, allWarnings, nil
 }
 
-func (actor Actor) RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+// ApplyNetworkPolicies creates the given policies between apps in the space
+// that do not exist yet. When prune is true, it also removes the policies
+// between apps in the space that are not given. It returns the policies that
+// were added and removed.
+func (actor Actor) ApplyNetworkPolicies(spaceGUID string, policies []Policy, prune bool) ([]Policy, []Policy, Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	applications, warnings, err := actor.V3Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	appNameByGuid := map[string]string{}
+	appGUIDByName := map[string]string{}
+	for _, app := range applications {
+		appNameByGuid[app.GUID] = app.Name
+		appGUIDByName[app.Name] = app.GUID
+	}
+
+	desired := map[Policy]bool{}
+	for _, policy := range policies {
+		for _, appName := range []string{policy.SourceName, policy.DestinationName} {
+			if _, ok := appGUIDByName[appName]; !ok {
+				return nil, nil, allWarnings, v3action.ApplicationNotFoundError{Name: appName}
+			}
+		}
+		desired[policy] = true
+	}
+
+	v1Policies, err := actor.NetworkingClient.ListPolicies()
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	current := map[Policy]bool{}
+	var removed []Policy
+	var v1PoliciesToRemove []cfnetv1.Policy
+	emptyPolicy := Policy{}
+	for _, v1Policy := range v1Policies {
+		policy := actor.transformPolicy(appNameByGuid, v1Policy)
+		if policy == emptyPolicy {
+			continue
+		}
+		current[policy] = true
+
+		if prune && !desired[policy] {
+			removed = append(removed, policy)
+			v1PoliciesToRemove = append(v1PoliciesToRemove, v1Policy)
+		}
+	}
+
+	var added []Policy
+	for policy := range desired {
+		if !current[policy] {
+			added = append(added, policy)
+		}
+	}
+	sortPolicies(added)
+
+	var v1PoliciesToCreate []cfnetv1.Policy
+	for _, policy := range added {
+		v1PoliciesToCreate = append(v1PoliciesToCreate, cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{
+				ID: appGUIDByName[policy.SourceName],
+			},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       appGUIDByName[policy.DestinationName],
+				Protocol: cfnetv1.PolicyProtocol(policy.Protocol),
+				Ports: cfnetv1.Ports{
+					Start: policy.StartPort,
+					End:   policy.EndPort,
+				},
+			},
+		})
+	}
+
+	if len(v1PoliciesToCreate) > 0 {
+		err = actor.NetworkingClient.CreatePolicies(v1PoliciesToCreate)
+		if err != nil {
+			return nil, nil, allWarnings, err
+		}
+	}
+
+	if len(v1PoliciesToRemove) > 0 {
+		err = actor.NetworkingClient.RemovePolicies(v1PoliciesToRemove)
+		if err != nil {
+			return added, nil, allWarnings, err
+		}
+	}
+
+	sortPolicies(removed)
+	return added, removed, allWarnings, nil
+}
+
+func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+	var allWarnings Warnings
+
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -183,3 +283,102 @@ func (Actor) transformPolicy(appNameByGuid map[string]string, v1Policy cfnetv1.P
 	}
 	return Policy{}
 }
+
+// transformPoliciesAcrossSpaces transforms the policies whose source app is in
+// appNameByGuid. Destination apps that are not in appNameByGuid are looked up
+// along with their space and organization; policies to apps that cannot be
+// found are dropped.
+func (actor Actor) transformPoliciesAcrossSpaces(appNameByGuid map[string]string, v1Policies []cfnetv1.Policy) ([]Policy, Warnings, error) {
+	var allWarnings Warnings
+
+	var otherAppGUIDs []string
+	for _, v1Policy := range v1Policies {
+		_, srcOk := appNameByGuid[v1Policy.Source.ID]
+		_, dstOk := appNameByGuid[v1Policy.Destination.ID]
+		if srcOk && !dstOk {
+			otherAppGUIDs = appendUnique(otherAppGUIDs, v1Policy.Destination.ID)
+		}
+	}
+
+	otherAppsByGUID := map[string]v3action.Application{}
+	spacesByGUID := map[string]v3action.Space{}
+	orgNamesByGUID := map[string]string{}
+	if len(otherAppGUIDs) > 0 {
+		apps, warnings, err := actor.V3Actor.GetApplicationsByGUIDs(otherAppGUIDs)
+		allWarnings = append(allWarnings, Warnings(warnings)...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var spaceGUIDs []string
+		for _, app := range apps {
+			otherAppsByGUID[app.GUID] = app
+			spaceGUIDs = appendUnique(spaceGUIDs, app.SpaceGUID)
+		}
+
+		if len(spaceGUIDs) > 0 {
+			spaces, warnings, err := actor.V3Actor.GetSpacesByGUIDs(spaceGUIDs)
+			allWarnings = append(allWarnings, Warnings(warnings)...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+
+			var orgGUIDs []string
+			for _, space := range spaces {
+				spacesByGUID[space.GUID] = space
+				orgGUIDs = appendUnique(orgGUIDs, space.OrganizationGUID)
+			}
+
+			orgs, warnings, err := actor.V3Actor.GetOrganizationsByGUIDs(orgGUIDs)
+			allWarnings = append(allWarnings, Warnings(warnings)...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+
+			for _, org := range orgs {
+				orgNamesByGUID[org.GUID] = org.Name
+			}
+		}
+	}
+
+	var policies []Policy
+	emptyPolicy := Policy{}
+	for _, v1Policy := range v1Policies {
+		policy := actor.transformPolicy(appNameByGuid, v1Policy)
+		if policy != emptyPolicy {
+			policies = append(policies, policy)
+			continue
+		}
+
+		srcName, srcOk := appNameByGuid[v1Policy.Source.ID]
+		destApp, destOk := otherAppsByGUID[v1Policy.Destination.ID]
+		if !srcOk || !destOk {
+			continue
+		}
+		destSpace, spaceOk := spacesByGUID[destApp.SpaceGUID]
+		if !spaceOk {
+			continue
+		}
+
+		policies = append(policies, Policy{
+			SourceName:           srcName,
+			DestinationName:      destApp.Name,
+			DestinationSpaceName: destSpace.Name,
+			DestinationOrgName:   orgNamesByGUID[destSpace.OrganizationGUID],
+			Protocol:             string(v1Policy.Destination.Protocol),
+			StartPort:            v1Policy.Destination.Ports.Start,
+			EndPort:              v1Policy.Destination.Ports.End,
+		})
+	}
+
+	return policies, allWarnings, nil
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, existing := range list {
+		if existing == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
@@ -0,0 +1,176 @@
+package cfnetworkingaction
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	defaultPolicyFileProtocol = "tcp"
+	defaultPolicyFilePort     = 8080
+)
+
+// InvalidPolicyFileError is returned when a network policy file cannot be
+// parsed or contains an invalid policy.
+type InvalidPolicyFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidPolicyFileError) Error() string {
+	return fmt.Sprintf("Invalid network policy file %s: %s", e.Path, e.Message)
+}
+
+// policyFile is the YAML representation of a list of network policies, keyed
+// by source app name.
+type policyFile map[string][]policyFileEntry
+
+type policyFileEntry struct {
+	Destination string `yaml:"destination"`
+	Protocol    string `yaml:"protocol,omitempty"`
+	Ports       string `yaml:"ports,omitempty"`
+}
+
+// ReadNetworkPolicyFile reads network policies from a YAML file keyed by
+// source app name, for example:
+//
+//   frontend:
+//   - destination: backend
+//     protocol: tcp
+//     ports: 8080-8090
+//
+// The protocol defaults to tcp and the ports default to 8080.
+func (Actor) ReadNetworkPolicyFile(path string) ([]Policy, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file policyFile
+	err = yaml.Unmarshal(raw, &file)
+	if err != nil {
+		return nil, InvalidPolicyFileError{Path: path, Message: err.Error()}
+	}
+
+	policies := []Policy{}
+	for sourceName, entries := range file {
+		for _, entry := range entries {
+			policy, err := entry.toPolicy(sourceName)
+			if err != nil {
+				return nil, InvalidPolicyFileError{Path: path, Message: err.Error()}
+			}
+			policies = append(policies, policy)
+		}
+	}
+
+	sortPolicies(policies)
+	return policies, nil
+}
+
+// WriteNetworkPolicyFile writes network policies to a YAML file in the format
+// read by ReadNetworkPolicyFile.
+func (Actor) WriteNetworkPolicyFile(path string, policies []Policy) error {
+	sorted := make([]Policy, len(policies))
+	copy(sorted, policies)
+	sortPolicies(sorted)
+
+	file := policyFile{}
+	for _, policy := range sorted {
+		file[policy.SourceName] = append(file[policy.SourceName], policyFileEntry{
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			Ports:       formatPorts(policy.StartPort, policy.EndPort),
+		})
+	}
+
+	raw, err := yaml.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0644)
+}
+
+func (entry policyFileEntry) toPolicy(sourceName string) (Policy, error) {
+	if entry.Destination == "" {
+		return Policy{}, fmt.Errorf("policy for app %s is missing a destination", sourceName)
+	}
+
+	protocol := strings.ToLower(entry.Protocol)
+	switch protocol {
+	case "":
+		protocol = defaultPolicyFileProtocol
+	case "tcp", "udp":
+	default:
+		return Policy{}, fmt.Errorf("policy from app %s to app %s has invalid protocol %q", sourceName, entry.Destination, entry.Protocol)
+	}
+
+	startPort, endPort, err := parsePorts(entry.Ports)
+	if err != nil {
+		return Policy{}, fmt.Errorf("policy from app %s to app %s has invalid ports %q", sourceName, entry.Destination, entry.Ports)
+	}
+
+	return Policy{
+		SourceName:      sourceName,
+		DestinationName: entry.Destination,
+		Protocol:        protocol,
+		StartPort:       startPort,
+		EndPort:         endPort,
+	}, nil
+}
+
+func parsePorts(ports string) (int, int, error) {
+	if ports == "" {
+		return defaultPolicyFilePort, defaultPolicyFilePort, nil
+	}
+
+	portRange := strings.SplitN(ports, "-", 2)
+	startPort, err := strconv.Atoi(strings.TrimSpace(portRange[0]))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	endPort := startPort
+	if len(portRange) == 2 {
+		endPort, err = strconv.Atoi(strings.TrimSpace(portRange[1]))
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if startPort < 1 || endPort > 65535 || startPort > endPort {
+		return 0, 0, fmt.Errorf("port range %d-%d is out of bounds", startPort, endPort)
+	}
+
+	return startPort, endPort, nil
+}
+
+func formatPorts(startPort int, endPort int) string {
+	if startPort == endPort {
+		return strconv.Itoa(startPort)
+	}
+	return fmt.Sprintf("%d-%d", startPort, endPort)
+}
+
+func sortPolicies(policies []Policy) {
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].SourceName != policies[j].SourceName {
+			return policies[i].SourceName < policies[j].SourceName
+		}
+		if policies[i].DestinationName != policies[j].DestinationName {
+			return policies[i].DestinationName < policies[j].DestinationName
+		}
+		if policies[i].Protocol != policies[j].Protocol {
+			return policies[i].Protocol < policies[j].Protocol
+		}
+		if policies[i].StartPort != policies[j].StartPort {
+			return policies[i].StartPort < policies[j].StartPort
+		}
+		return policies[i].EndPort < policies[j].EndPort
+	})
+}
@@ -0,0 +1,176 @@
+package cfnetworkingaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy File", func() {
+	var (
+		actor   *Actor
+		tempDir string
+		path    string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "network-policies")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tempDir, "policies.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("ReadNetworkPolicyFile", func() {
+		var (
+			policies []Policy
+			readErr  error
+		)
+
+		JustBeforeEach(func() {
+			policies, readErr = actor.ReadNetworkPolicyFile(path)
+		})
+
+		Context("when the file is valid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte(`---
+frontend:
+- destination: backend
+  protocol: TCP
+  ports: 8080-8090
+- destination: dns
+  protocol: udp
+  ports: 53
+backend:
+- destination: database
+`), 0644)).To(Succeed())
+			})
+
+			It("returns the sorted policies with defaults applied", func() {
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(policies).To(Equal([]Policy{
+					{SourceName: "backend", DestinationName: "database", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+					{SourceName: "frontend", DestinationName: "dns", Protocol: "udp", StartPort: 53, EndPort: 53},
+				}))
+			})
+		})
+
+		Context("when the file is empty", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte(""), 0644)).To(Succeed())
+			})
+
+			It("returns no policies", func() {
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(policies).To(BeEmpty())
+			})
+		})
+
+		Context("when the file is not valid YAML", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("frontend: [destination"), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidPolicyFileError", func() {
+				Expect(readErr).To(BeAssignableToTypeOf(InvalidPolicyFileError{}))
+			})
+		})
+
+		Context("when a policy has no destination", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("frontend:\n- protocol: tcp\n"), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidPolicyFileError", func() {
+				Expect(readErr).To(MatchError(InvalidPolicyFileError{
+					Path:    path,
+					Message: "policy for app frontend is missing a destination",
+				}))
+			})
+		})
+
+		Context("when a policy has an invalid protocol", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("frontend:\n- destination: backend\n  protocol: icmp\n"), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidPolicyFileError", func() {
+				Expect(readErr).To(MatchError(InvalidPolicyFileError{
+					Path:    path,
+					Message: `policy from app frontend to app backend has invalid protocol "icmp"`,
+				}))
+			})
+		})
+
+		Context("when a policy has invalid ports", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("frontend:\n- destination: backend\n  ports: 9000-8000\n"), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidPolicyFileError", func() {
+				Expect(readErr).To(MatchError(InvalidPolicyFileError{
+					Path:    path,
+					Message: `policy from app frontend to app backend has invalid ports "9000-8000"`,
+				}))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			BeforeEach(func() {
+				path = filepath.Join(tempDir, "does-not-exist.yml")
+			})
+
+			It("returns the error", func() {
+				Expect(os.IsNotExist(readErr)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("WriteNetworkPolicyFile", func() {
+		It("writes the policies keyed by source app name", func() {
+			err := actor.WriteNetworkPolicyFile(path, []Policy{
+				{SourceName: "frontend", DestinationName: "dns", Protocol: "udp", StartPort: 53, EndPort: 53},
+				{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+				{SourceName: "backend", DestinationName: "database", Protocol: "tcp", StartPort: 5432, EndPort: 5432},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal(`backend:
+- destination: database
+  protocol: tcp
+  ports: "5432"
+frontend:
+- destination: backend
+  protocol: tcp
+  ports: 8080-8090
+- destination: dns
+  protocol: udp
+  ports: "53"
+`))
+		})
+
+		It("writes policies that can be read back", func() {
+			policies := []Policy{
+				{SourceName: "backend", DestinationName: "database", Protocol: "tcp", StartPort: 5432, EndPort: 5432},
+				{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+			}
+			Expect(actor.WriteNetworkPolicyFile(path, policies)).To(Succeed())
+
+			readPolicies, err := actor.ReadNetworkPolicyFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readPolicies).To(Equal(policies))
+		})
+	})
+})
@@ -41,11 +41,12 @@ var _ = Describe("Policy", func() {
 		JustBeforeEach(func() {
 			spaceGuid := "space"
 			srcApp := "appA"
+			destSpaceGuid := "destSpace"
 			destApp := "appB"
 			protocol := "tcp"
 			startPort := 8080
 			endPort := 8090
-			warnings, executeErr = actor.AddNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.AddNetworkPolicy(spaceGuid, srcApp, destSpaceGuid, destApp, protocol, startPort, endPort)
 		})
 
 		It("creates policies", func() {
@@ -59,7 +60,7 @@ var _ = Describe("Policy", func() {
 
 			destAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(1)
 			Expect(destAppName).To(Equal("appB"))
-			Expect(spaceGUID).To(Equal("space"))
+			Expect(spaceGUID).To(Equal("destSpace"))
 
 			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
 			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
@@ -341,6 +342,103 @@ var _ = Describe("Policy", func() {
 			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(BeNil())
 		})
 
+		Context("when a policy has a destination app in another space", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{{
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appDGUID",
+						Protocol: "udp",
+						Ports: cfnetv1.Ports{
+							Start: 53,
+							End:   53,
+						},
+					},
+				}, {
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appEGUID",
+						Protocol: "tcp",
+						Ports: cfnetv1.Ports{
+							Start: 8080,
+							End:   8080,
+						},
+					},
+				}}, nil)
+
+				fakeV3Actor.GetApplicationsByGUIDsReturns([]v3action.Application{
+					{
+						Name:      "appD",
+						GUID:      "appDGUID",
+						SpaceGUID: "otherSpaceGUID",
+					},
+				}, []string{"GetApplicationsByGUIDsWarning"}, nil)
+				fakeV3Actor.GetSpacesByGUIDsReturns([]v3action.Space{
+					{
+						Name:             "otherSpace",
+						GUID:             "otherSpaceGUID",
+						OrganizationGUID: "otherOrgGUID",
+					},
+				}, []string{"GetSpacesByGUIDsWarning"}, nil)
+				fakeV3Actor.GetOrganizationsByGUIDsReturns([]v3action.Organization{
+					{
+						Name: "otherOrg",
+						GUID: "otherOrgGUID",
+					},
+				}, []string{"GetOrganizationsByGUIDsWarning"}, nil)
+			})
+
+			It("lists the policy with the destination's space and org, dropping apps that cannot be found", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(policies).To(Equal([]Policy{{
+					SourceName:           "appA",
+					DestinationName:      "appD",
+					DestinationSpaceName: "otherSpace",
+					DestinationOrgName:   "otherOrg",
+					Protocol:             "udp",
+					StartPort:            53,
+					EndPort:              53,
+				}}))
+				Expect(warnings).To(Equal(Warnings([]string{
+					"GetApplicationsBySpaceWarning",
+					"GetApplicationsByGUIDsWarning",
+					"GetSpacesByGUIDsWarning",
+					"GetOrganizationsByGUIDsWarning",
+				})))
+
+				Expect(fakeV3Actor.GetApplicationsByGUIDsCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.GetApplicationsByGUIDsArgsForCall(0)).To(Equal([]string{"appDGUID", "appEGUID"}))
+				Expect(fakeV3Actor.GetSpacesByGUIDsCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.GetSpacesByGUIDsArgsForCall(0)).To(Equal([]string{"otherSpaceGUID"}))
+				Expect(fakeV3Actor.GetOrganizationsByGUIDsCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.GetOrganizationsByGUIDsArgsForCall(0)).To(Equal([]string{"otherOrgGUID"}))
+			})
+
+			Context("when getting the destination apps fails", func() {
+				BeforeEach(func() {
+					fakeV3Actor.GetApplicationsByGUIDsReturns(nil, []string{"GetApplicationsByGUIDsWarning"}, errors.New("cherry"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(policies).To(Equal([]Policy{}))
+					Expect(warnings).To(ContainElement("GetApplicationsByGUIDsWarning"))
+					Expect(executeErr).To(MatchError("cherry"))
+				})
+			})
+		})
+
+		Context("when all policies are within the space", func() {
+			It("does not look up apps in other spaces", func() {
+				Expect(fakeV3Actor.GetApplicationsByGUIDsCallCount()).To(Equal(0))
+				Expect(fakeV3Actor.GetSpacesByGUIDsCallCount()).To(Equal(0))
+				Expect(fakeV3Actor.GetOrganizationsByGUIDsCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when getting the applications fails", func() {
 			BeforeEach(func() {
 				fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{}, []string{"GetApplicationsBySpaceWarning"}, errors.New("banana"))
@@ -385,11 +483,12 @@ var _ = Describe("Policy", func() {
 		JustBeforeEach(func() {
 			spaceGuid := "space"
 			srcApp := "appA"
+			destSpaceGuid := "destSpace"
 			destApp := "appB"
 			protocol := "udp"
 			startPort := 123
 			endPort := 345
-			warnings, executeErr = actor.RemoveNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.RemoveNetworkPolicy(spaceGuid, srcApp, destSpaceGuid, destApp, protocol, startPort, endPort)
 		})
 		It("removes policies", func() {
 			Expect(warnings).To(Equal(Warnings([]string{"v3ActorWarningA", "v3ActorWarningB"})))
@@ -402,7 +501,7 @@ var _ = Describe("Policy", func() {
 
 			destAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(1)
 			Expect(destAppName).To(Equal("appB"))
-			Expect(spaceGUID).To(Equal("space"))
+			Expect(spaceGUID).To(Equal("destSpace"))
 
 			Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(1))
 
@@ -475,4 +574,190 @@ var _ = Describe("Policy", func() {
 			})
 		})
 	})
+
+	Describe("ApplyNetworkPolicies", func() {
+		var (
+			policies []Policy
+			prune    bool
+			added    []Policy
+			removed  []Policy
+		)
+
+		BeforeEach(func() {
+			fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{
+				{Name: "appA", GUID: "appAGUID"},
+				{Name: "appB", GUID: "appBGUID"},
+				{Name: "appC", GUID: "appCGUID"},
+			}, []string{"GetApplicationsBySpaceWarning"}, nil)
+
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+				{
+					Source: cfnetv1.PolicySource{ID: "appAGUID"},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appBGUID",
+						Protocol: "tcp",
+						Ports:    cfnetv1.Ports{Start: 8080, End: 8080},
+					},
+				},
+				{
+					Source: cfnetv1.PolicySource{ID: "appBGUID"},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appCGUID",
+						Protocol: "udp",
+						Ports:    cfnetv1.Ports{Start: 53, End: 53},
+					},
+				},
+				{
+					Source: cfnetv1.PolicySource{ID: "appAGUID"},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appInOtherSpaceGUID",
+						Protocol: "tcp",
+						Ports:    cfnetv1.Ports{Start: 8080, End: 8080},
+					},
+				},
+			}, nil)
+
+			policies = []Policy{
+				{SourceName: "appA", DestinationName: "appB", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{SourceName: "appC", DestinationName: "appA", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+				{SourceName: "appA", DestinationName: "appC", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			}
+			prune = false
+		})
+
+		JustBeforeEach(func() {
+			added, removed, warnings, executeErr = actor.ApplyNetworkPolicies("space", policies, prune)
+		})
+
+		It("creates the missing policies in bulk", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings([]string{"GetApplicationsBySpaceWarning"})))
+
+			Expect(fakeV3Actor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+			Expect(fakeV3Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("space"))
+
+			Expect(added).To(Equal([]Policy{
+				{SourceName: "appA", DestinationName: "appC", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{SourceName: "appC", DestinationName: "appA", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+			}))
+			Expect(removed).To(BeEmpty())
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				{
+					Source: cfnetv1.PolicySource{ID: "appAGUID"},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appCGUID",
+						Protocol: "tcp",
+						Ports:    cfnetv1.Ports{Start: 8080, End: 8080},
+					},
+				},
+				{
+					Source: cfnetv1.PolicySource{ID: "appCGUID"},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appAGUID",
+						Protocol: "tcp",
+						Ports:    cfnetv1.Ports{Start: 9000, End: 9010},
+					},
+				},
+			}))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+		})
+
+		Context("when pruning", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("removes the policies in the space that are not given", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(removed).To(Equal([]Policy{
+					{SourceName: "appB", DestinationName: "appC", Protocol: "udp", StartPort: 53, EndPort: 53},
+				}))
+
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+				Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+					{
+						Source: cfnetv1.PolicySource{ID: "appBGUID"},
+						Destination: cfnetv1.PolicyDestination{
+							ID:       "appCGUID",
+							Protocol: "udp",
+							Ports:    cfnetv1.Ports{Start: 53, End: 53},
+						},
+					},
+				}))
+			})
+
+			Context("when removing the policies fails", func() {
+				BeforeEach(func() {
+					fakeNetworkingClient.RemovePoliciesReturns(errors.New("apple"))
+				})
+
+				It("returns the error and the policies that were added", func() {
+					Expect(executeErr).To(MatchError("apple"))
+					Expect(added).To(HaveLen(2))
+				})
+			})
+		})
+
+		Context("when all policies already exist", func() {
+			BeforeEach(func() {
+				policies = policies[:1]
+			})
+
+			It("does not create or remove any policies", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(added).To(BeEmpty())
+				Expect(removed).To(BeEmpty())
+
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a policy refers to an app that is not in the space", func() {
+			BeforeEach(func() {
+				policies = append(policies, Policy{SourceName: "appA", DestinationName: "appD", Protocol: "tcp", StartPort: 8080, EndPort: 8080})
+			})
+
+			It("returns an ApplicationNotFoundError without changing policies", func() {
+				Expect(executeErr).To(MatchError(v3action.ApplicationNotFoundError{Name: "appD"}))
+
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetApplicationsBySpaceReturns(nil, []string{"GetApplicationsBySpaceWarning"}, errors.New("banana"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("banana"))
+				Expect(warnings).To(Equal(Warnings([]string{"GetApplicationsBySpaceWarning"})))
+			})
+		})
+
+		Context("when listing policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("apple"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("apple"))
+			})
+		})
+
+		Context("when creating policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.CreatePoliciesReturns(errors.New("apple"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("apple"))
+			})
+		})
+	})
 })
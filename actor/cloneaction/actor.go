@@ -0,0 +1,23 @@
+// Package cloneaction contains the business logic for cloning the apps,
+// user-provided services, routes and network policies of a space into a new
+// space.
+package cloneaction
+
+// Warnings is a list of warnings returned back from the cloud controller
+type Warnings []string
+
+// Actor handles all business logic for cloning spaces.
+type Actor struct {
+	V2Actor         V2Actor
+	V3Actor         V3Actor
+	NetworkingActor NetworkingActor
+}
+
+// NewActor returns a new actor.
+func NewActor(v2Actor V2Actor, v3Actor V3Actor, networkingActor NetworkingActor) *Actor {
+	return &Actor{
+		V2Actor:         v2Actor,
+		V3Actor:         v3Actor,
+		NetworkingActor: networkingActor,
+	}
+}
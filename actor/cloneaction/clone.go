@@ -0,0 +1,417 @@
+package cloneaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// ClonedApplication represents an application that was recreated in the
+// destination space.
+type ClonedApplication struct {
+	Name string
+
+	// DropletCopied is false when the source application had no current
+	// droplet.
+	DropletCopied bool
+
+	// Started is true when the source application was started and its droplet
+	// was copied.
+	Started bool
+
+	Routes   []string
+	Services []string
+}
+
+// SpaceClone is the result of cloning a space.
+type SpaceClone struct {
+	Applications []ClonedApplication
+
+	// UserProvidedServices are the names of the user-provided service instances
+	// recreated in the destination space.
+	UserProvidedServices []string
+
+	// SkippedServices are the names of the managed service instances, which
+	// are not cloned because they would provision new backing resources.
+	SkippedServices []string
+
+	NetworkPolicies int
+}
+
+// CloneFailedError is returned when cloning fails after the destination space
+// was created. The destination space, and everything cloned into it, is
+// deleted; when that also fails, RollbackErr is set and the partially cloned
+// space is left behind.
+type CloneFailedError struct {
+	SpaceName   string
+	Err         error
+	RollbackErr error
+}
+
+func (e CloneFailedError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("cloning to space %s failed: %s; deleting the space also failed: %s", e.SpaceName, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("cloning to space %s failed, the space was deleted: %s", e.SpaceName, e.Err)
+}
+
+// DefaultHostnameSuffix returns the suffix appended to route hostnames when
+// none is provided.
+func DefaultHostnameSuffix(destinationSpaceName string) string {
+	return "-" + strings.ToLower(destinationSpaceName)
+}
+
+// CloneSpace creates the destination space in the organization and recreates
+// the applications, user-provided service instances, service bindings, routes
+// and network policies of the source space in it. The user is given the space
+// manager and space developer roles in the new space. Route hostnames have
+// hostnameSuffix appended so that they do not collide with the source routes.
+// When cloning fails after the destination space was created, the space is
+// deleted and a CloneFailedError is returned.
+func (actor Actor) CloneSpace(orgGUID string, sourceSpaceName string, destinationSpaceName string, hostnameSuffix string, username string) (SpaceClone, Warnings, error) {
+	var allWarnings Warnings
+
+	sourceSpace, warnings, err := actor.V2Actor.GetSpaceByOrganizationAndName(orgGUID, sourceSpaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceClone{}, allWarnings, err
+	}
+
+	destinationSpace, warnings, err := actor.V2Actor.CreateSpace(orgGUID, destinationSpaceName, "")
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceClone{}, allWarnings, err
+	}
+
+	clone, cloneWarnings, err := actor.cloneIntoSpace(orgGUID, sourceSpace.GUID, destinationSpace.GUID, hostnameSuffix, username)
+	allWarnings = append(allWarnings, cloneWarnings...)
+	if err != nil {
+		warnings, rollbackErr := actor.V2Actor.DeleteSpace(destinationSpace.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		return SpaceClone{}, allWarnings, CloneFailedError{
+			SpaceName:   destinationSpaceName,
+			Err:         err,
+			RollbackErr: rollbackErr,
+		}
+	}
+
+	return clone, allWarnings, nil
+}
+
+// cloneIntoSpace recreates the contents of the source space in the
+// destination space.
+func (actor Actor) cloneIntoSpace(orgGUID string, sourceSpaceGUID string, destinationSpaceGUID string, hostnameSuffix string, username string) (SpaceClone, Warnings, error) {
+	var (
+		allWarnings Warnings
+		clone       SpaceClone
+	)
+
+	for _, role := range []constant.SpaceUserRole{constant.SpaceManagerRole, constant.SpaceDeveloperRole} {
+		warnings, err := actor.V2Actor.SetSpaceRoleByUsername(role, orgGUID, destinationSpaceGUID, username)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceClone{}, allWarnings, err
+		}
+	}
+
+	serviceInstanceGUIDs, serviceWarnings, err := actor.cloneUserProvidedServices(sourceSpaceGUID, destinationSpaceGUID, &clone)
+	allWarnings = append(allWarnings, serviceWarnings...)
+	if err != nil {
+		return SpaceClone{}, allWarnings, err
+	}
+
+	applications, v3Warnings, err := actor.V3Actor.GetApplicationsBySpace(sourceSpaceGUID)
+	allWarnings = append(allWarnings, v3Warnings...)
+	if err != nil {
+		return SpaceClone{}, allWarnings, err
+	}
+
+	for _, app := range applications {
+		clonedApp, appWarnings, err := actor.cloneApplication(app, sourceSpaceGUID, destinationSpaceGUID, serviceInstanceGUIDs, hostnameSuffix)
+		allWarnings = append(allWarnings, appWarnings...)
+		if err != nil {
+			return SpaceClone{}, allWarnings, err
+		}
+		clone.Applications = append(clone.Applications, clonedApp)
+	}
+
+	policies, networkingWarnings, err := actor.NetworkingActor.NetworkPoliciesBySpace(sourceSpaceGUID)
+	allWarnings = append(allWarnings, networkingWarnings...)
+	if err != nil {
+		return SpaceClone{}, allWarnings, err
+	}
+
+	var spacePolicies []cfnetworkingaction.Policy
+	for _, policy := range policies {
+		if policy.DestinationSpaceName == "" {
+			spacePolicies = append(spacePolicies, policy)
+		}
+	}
+
+	if len(spacePolicies) > 0 {
+		added, _, networkingWarnings, err := actor.NetworkingActor.ApplyNetworkPolicies(destinationSpaceGUID, spacePolicies, false)
+		allWarnings = append(allWarnings, networkingWarnings...)
+		if err != nil {
+			return SpaceClone{}, allWarnings, err
+		}
+		clone.NetworkPolicies = len(added)
+	}
+
+	return clone, allWarnings, nil
+}
+
+// cloneUserProvidedServices recreates the user-provided service instances of
+// the source space in the destination space and records the managed service
+// instances that were skipped. It returns the GUIDs of the new service
+// instances keyed by the GUIDs of the source service instances.
+func (actor Actor) cloneUserProvidedServices(sourceSpaceGUID string, destinationSpaceGUID string, clone *SpaceClone) (map[string]string, Warnings, error) {
+	var allWarnings Warnings
+
+	serviceInstances, warnings, err := actor.V2Actor.GetServiceInstancesBySpace(sourceSpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	serviceInstanceGUIDs := map[string]string{}
+	for _, serviceInstance := range serviceInstances {
+		if serviceInstance.Type != ccv2.UserProvidedService {
+			clone.SkippedServices = append(clone.SkippedServices, serviceInstance.Name)
+			continue
+		}
+
+		userProvided, warnings, err := actor.V2Actor.GetUserProvidedServiceInstance(serviceInstance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		userProvided.GUID = ""
+		userProvided.SpaceGUID = destinationSpaceGUID
+		created, warnings, err := actor.V2Actor.CreateUserProvidedServiceInstance(userProvided)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		serviceInstanceGUIDs[serviceInstance.GUID] = created.GUID
+		clone.UserProvidedServices = append(clone.UserProvidedServices, serviceInstance.Name)
+	}
+
+	return serviceInstanceGUIDs, allWarnings, nil
+}
+
+func (actor Actor) cloneApplication(app v3action.Application, sourceSpaceGUID string, destinationSpaceGUID string, serviceInstanceGUIDs map[string]string, hostnameSuffix string) (ClonedApplication, Warnings, error) {
+	var allWarnings Warnings
+	clonedApp := ClonedApplication{Name: app.Name}
+
+	newApp, warnings, err := actor.V3Actor.CreateApplicationInSpace(v3action.Application{
+		Name:      app.Name,
+		Lifecycle: app.Lifecycle,
+	}, destinationSpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ClonedApplication{}, allWarnings, err
+	}
+
+	envWarnings, err := actor.cloneEnvironmentVariables(app.Name, sourceSpaceGUID, destinationSpaceGUID)
+	allWarnings = append(allWarnings, envWarnings...)
+	if err != nil {
+		return ClonedApplication{}, allWarnings, err
+	}
+
+	droplet, warnings, err := actor.V3Actor.CopyApplicationDroplet(app.GUID, newApp.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		clonedApp.DropletCopied = true
+	case v3action.DropletNotFoundError:
+	default:
+		return ClonedApplication{}, allWarnings, err
+	}
+
+	if clonedApp.DropletCopied {
+		warnings, err = actor.V3Actor.SetApplicationDroplet(app.Name, destinationSpaceGUID, droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ClonedApplication{}, allWarnings, err
+		}
+	}
+
+	processes, warnings, err := actor.V3Actor.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ClonedApplication{}, allWarnings, err
+	}
+
+	for _, process := range processes {
+		warnings, err = actor.V3Actor.ScaleProcessByApplication(newApp.GUID, v3action.Process{
+			Type:       process.Type,
+			Instances:  process.Instances,
+			MemoryInMB: process.MemoryInMB,
+			DiskInMB:   process.DiskInMB,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(v3action.ProcessNotFoundError); ok {
+			// Processes other than web only exist once a droplet is assigned.
+			continue
+		}
+		if err != nil {
+			return ClonedApplication{}, allWarnings, err
+		}
+	}
+
+	bindingWarnings, err := actor.cloneServiceBindings(app.GUID, newApp.GUID, serviceInstanceGUIDs, &clonedApp)
+	allWarnings = append(allWarnings, bindingWarnings...)
+	if err != nil {
+		return ClonedApplication{}, allWarnings, err
+	}
+
+	routeWarnings, err := actor.cloneRoutes(app.GUID, newApp.GUID, destinationSpaceGUID, hostnameSuffix, &clonedApp)
+	allWarnings = append(allWarnings, routeWarnings...)
+	if err != nil {
+		return ClonedApplication{}, allWarnings, err
+	}
+
+	if app.State == "STARTED" && clonedApp.DropletCopied {
+		_, warnings, err = actor.V3Actor.StartApplication(newApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ClonedApplication{}, allWarnings, err
+		}
+		clonedApp.Started = true
+	}
+
+	return clonedApp, allWarnings, nil
+}
+
+func (actor Actor) cloneEnvironmentVariables(appName string, sourceSpaceGUID string, destinationSpaceGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	envGroups, warnings, err := actor.V3Actor.GetEnvironmentVariablesByApplicationNameAndSpace(appName, sourceSpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var keys []string
+	for key := range envGroups.UserProvided {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, err := environmentVariableValue(envGroups.UserProvided[key])
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.V3Actor.SetEnvironmentVariableByApplicationNameAndSpace(appName, destinationSpaceGUID, v3action.EnvironmentVariablePair{
+			Key:   key,
+			Value: value,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// environmentVariableValue returns a user-provided environment variable value
+// as it is set with set-env: strings as they are and everything else as JSON.
+func environmentVariableValue(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// cloneServiceBindings binds the new application to the clones of the
+// user-provided service instances the source application is bound to.
+func (actor Actor) cloneServiceBindings(sourceAppGUID string, destinationAppGUID string, serviceInstanceGUIDs map[string]string, clonedApp *ClonedApplication) (Warnings, error) {
+	var allWarnings Warnings
+
+	serviceInstances, warnings, err := actor.V2Actor.GetServiceInstancesByApplication(sourceAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, serviceInstance := range serviceInstances {
+		serviceInstanceGUID, ok := serviceInstanceGUIDs[serviceInstance.GUID]
+		if !ok {
+			continue
+		}
+
+		warnings, err = actor.V2Actor.BindServiceByApplicationAndServiceInstance(destinationAppGUID, serviceInstanceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		clonedApp.Services = append(clonedApp.Services, serviceInstance.Name)
+	}
+
+	return allWarnings, nil
+}
+
+// cloneRoutes creates a copy of each route of the source application in the
+// destination space and maps it to the new application. HTTP routes have the
+// suffix appended to their hostname and TCP routes are given a random port.
+// Routes without a hostname or port cannot be copied without conflicting
+// with the source route, so they are skipped.
+func (actor Actor) cloneRoutes(sourceAppGUID string, destinationAppGUID string, destinationSpaceGUID string, hostnameSuffix string, clonedApp *ClonedApplication) (Warnings, error) {
+	var allWarnings Warnings
+
+	routes, warnings, err := actor.V2Actor.GetApplicationRoutes(sourceAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, route := range routes {
+		newRoute := v2action.Route{
+			Domain:    route.Domain,
+			SpaceGUID: destinationSpaceGUID,
+			Path:      route.Path,
+		}
+
+		generatePort := false
+		switch {
+		case route.Port.IsSet:
+			generatePort = true
+		case route.Host != "":
+			newRoute.Host = route.Host + hostnameSuffix
+		default:
+			continue
+		}
+
+		createdRoute, warnings, err := actor.V2Actor.CreateRoute(newRoute, generatePort)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.V2Actor.MapRouteToApplication(createdRoute.GUID, destinationAppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		clonedApp.Routes = append(clonedApp.Routes, createdRoute.String())
+	}
+
+	return allWarnings, nil
+}
@@ -0,0 +1,324 @@
+package cloneaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	. "code.cloudfoundry.org/cli/actor/cloneaction"
+	"code.cloudfoundry.org/cli/actor/cloneaction/cloneactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clone Actions", func() {
+	var (
+		actor               *Actor
+		fakeV2Actor         *cloneactionfakes.FakeV2Actor
+		fakeV3Actor         *cloneactionfakes.FakeV3Actor
+		fakeNetworkingActor *cloneactionfakes.FakeNetworkingActor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(cloneactionfakes.FakeV2Actor)
+		fakeV3Actor = new(cloneactionfakes.FakeV3Actor)
+		fakeNetworkingActor = new(cloneactionfakes.FakeNetworkingActor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor, fakeNetworkingActor)
+	})
+
+	Describe("DefaultHostnameSuffix", func() {
+		It("returns the lowercased space name prefixed with a dash", func() {
+			Expect(DefaultHostnameSuffix("Staging")).To(Equal("-staging"))
+		})
+	})
+
+	Describe("CloneSpace", func() {
+		var (
+			clone      SpaceClone
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "source-space-guid"}, v2action.Warnings{"get-space-warning"}, nil)
+			fakeV2Actor.CreateSpaceReturns(v2action.Space{GUID: "destination-space-guid"}, v2action.Warnings{"create-space-warning"}, nil)
+
+			fakeV2Actor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{
+				{GUID: "ups-guid", Name: "some-ups", Type: ccv2.UserProvidedService},
+				{GUID: "managed-guid", Name: "some-db", Type: ccv2.ManagedService},
+			}, nil, nil)
+			fakeV2Actor.GetUserProvidedServiceInstanceReturns(v2action.UserProvidedServiceInstance{
+				GUID:        "ups-guid",
+				Name:        "some-ups",
+				SpaceGUID:   "source-space-guid",
+				Credentials: map[string]interface{}{"password": "secret"},
+			}, nil, nil)
+			fakeV2Actor.CreateUserProvidedServiceInstanceReturns(v2action.UserProvidedServiceInstance{GUID: "new-ups-guid"}, v2action.Warnings{"create-ups-warning"}, nil)
+
+			fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{
+				{GUID: "app-guid", Name: "some-app", State: "STARTED", Lifecycle: v3action.AppLifecycle{Type: "buildpack"}},
+			}, nil, nil)
+			fakeV3Actor.CreateApplicationInSpaceReturns(v3action.Application{GUID: "new-app-guid", Name: "some-app"}, v3action.Warnings{"create-app-warning"}, nil)
+			fakeV3Actor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v3action.EnvironmentVariableGroups{
+				UserProvided: map[string]interface{}{"B": "2", "A": "1"},
+			}, nil, nil)
+			fakeV3Actor.CopyApplicationDropletReturns(v3action.Droplet{GUID: "new-droplet-guid"}, v3action.Warnings{"copy-droplet-warning"}, nil)
+			fakeV3Actor.GetApplicationProcessesReturns([]v3action.Process{
+				{GUID: "web-guid", Type: "web", Instances: types.NullInt{Value: 3, IsSet: true}},
+			}, nil, nil)
+
+			fakeV2Actor.GetServiceInstancesByApplicationReturns([]v2action.ServiceInstance{
+				{GUID: "ups-guid", Name: "some-ups"},
+				{GUID: "managed-guid", Name: "some-db"},
+			}, nil, nil)
+
+			domain := v2action.Domain{GUID: "domain-guid", Name: "example.com"}
+			fakeV2Actor.GetApplicationRoutesReturns(v2action.Routes{
+				{GUID: "route-guid", Host: "some-app", Domain: domain, Path: "/api"},
+				{GUID: "bare-route-guid", Domain: domain},
+			}, nil, nil)
+			fakeV2Actor.CreateRouteReturns(v2action.Route{GUID: "new-route-guid", Host: "some-app-dev", Domain: domain, Path: "/api"}, nil, nil)
+
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+				{SourceName: "some-app", DestinationName: "some-app", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{SourceName: "some-app", DestinationName: "other-app", DestinationSpaceName: "other-space", DestinationOrgName: "other-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			}, cfnetworkingaction.Warnings{"get-policies-warning"}, nil)
+			fakeNetworkingActor.ApplyNetworkPoliciesReturns([]cfnetworkingaction.Policy{{SourceName: "some-app", DestinationName: "some-app"}}, nil, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			clone, warnings, executeErr = actor.CloneSpace("org-guid", "source", "dev", "-dev", "some-user")
+		})
+
+		It("clones the space and returns all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(clone).To(Equal(SpaceClone{
+				Applications: []ClonedApplication{{
+					Name:          "some-app",
+					DropletCopied: true,
+					Started:       true,
+					Routes:        []string{"some-app-dev.example.com/api"},
+					Services:      []string{"some-ups"},
+				}},
+				UserProvidedServices: []string{"some-ups"},
+				SkippedServices:      []string{"some-db"},
+				NetworkPolicies:      1,
+			}))
+			Expect(warnings).To(ConsistOf("get-space-warning", "create-space-warning", "create-ups-warning", "create-app-warning", "copy-droplet-warning", "get-policies-warning"))
+		})
+
+		It("creates the destination space and gives the user roles in it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			orgGUID, spaceName, quotaGUID := fakeV2Actor.CreateSpaceArgsForCall(0)
+			Expect(orgGUID).To(Equal("org-guid"))
+			Expect(spaceName).To(Equal("dev"))
+			Expect(quotaGUID).To(BeEmpty())
+
+			Expect(fakeV2Actor.SetSpaceRoleByUsernameCallCount()).To(Equal(2))
+			role, _, spaceGUID, username := fakeV2Actor.SetSpaceRoleByUsernameArgsForCall(0)
+			Expect(role).To(Equal(constant.SpaceManagerRole))
+			Expect(spaceGUID).To(Equal("destination-space-guid"))
+			Expect(username).To(Equal("some-user"))
+			role, _, _, _ = fakeV2Actor.SetSpaceRoleByUsernameArgsForCall(1)
+			Expect(role).To(Equal(constant.SpaceDeveloperRole))
+		})
+
+		It("recreates the user-provided services and their bindings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeV2Actor.GetUserProvidedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(v2action.UserProvidedServiceInstance{
+				Name:        "some-ups",
+				SpaceGUID:   "destination-space-guid",
+				Credentials: map[string]interface{}{"password": "secret"},
+			}))
+
+			Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+			Expect(appGUID).To(Equal("new-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("new-ups-guid"))
+		})
+
+		It("recreates the apps with their environment, droplet and scale", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			app, spaceGUID := fakeV3Actor.CreateApplicationInSpaceArgsForCall(0)
+			Expect(app).To(Equal(v3action.Application{Name: "some-app", Lifecycle: v3action.AppLifecycle{Type: "buildpack"}}))
+			Expect(spaceGUID).To(Equal("destination-space-guid"))
+
+			appName, spaceGUID := fakeV3Actor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("source-space-guid"))
+			Expect(fakeV3Actor.SetEnvironmentVariableByApplicationNameAndSpaceCallCount()).To(Equal(2))
+			_, spaceGUID, envPair := fakeV3Actor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("destination-space-guid"))
+			Expect(envPair).To(Equal(v3action.EnvironmentVariablePair{Key: "A", Value: "1"}))
+
+			sourceAppGUID, destinationAppGUID := fakeV3Actor.CopyApplicationDropletArgsForCall(0)
+			Expect(sourceAppGUID).To(Equal("app-guid"))
+			Expect(destinationAppGUID).To(Equal("new-app-guid"))
+			_, _, dropletGUID := fakeV3Actor.SetApplicationDropletArgsForCall(0)
+			Expect(dropletGUID).To(Equal("new-droplet-guid"))
+
+			appGUID, process := fakeV3Actor.ScaleProcessByApplicationArgsForCall(0)
+			Expect(appGUID).To(Equal("new-app-guid"))
+			Expect(process).To(Equal(v3action.Process{Type: "web", Instances: types.NullInt{Value: 3, IsSet: true}}))
+
+			Expect(fakeV3Actor.StartApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+		})
+
+		It("recreates the routes with the hostname suffix and skips routes without a hostname", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(1))
+			route, generatePort := fakeV2Actor.CreateRouteArgsForCall(0)
+			Expect(route).To(Equal(v2action.Route{
+				Domain:    v2action.Domain{GUID: "domain-guid", Name: "example.com"},
+				Host:      "some-app-dev",
+				Path:      "/api",
+				SpaceGUID: "destination-space-guid",
+			}))
+			Expect(generatePort).To(BeFalse())
+
+			routeGUID, appGUID := fakeV2Actor.MapRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("new-route-guid"))
+			Expect(appGUID).To(Equal("new-app-guid"))
+		})
+
+		It("replicates the network policies between apps in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			spaceGUID, policies, prune := fakeNetworkingActor.ApplyNetworkPoliciesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("destination-space-guid"))
+			Expect(policies).To(Equal([]cfnetworkingaction.Policy{
+				{SourceName: "some-app", DestinationName: "some-app", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			}))
+			Expect(prune).To(BeFalse())
+		})
+
+		Context("when an environment variable is not a string", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v3action.EnvironmentVariableGroups{
+					UserProvided: map[string]interface{}{
+						"COUNT":   float64(3),
+						"ENABLED": true,
+						"CONFIG":  map[string]interface{}{"url": "http://example.com", "ports": []interface{}{float64(80)}},
+					},
+				}, nil, nil)
+			})
+
+			It("sets it as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeV3Actor.SetEnvironmentVariableByApplicationNameAndSpaceCallCount()).To(Equal(3))
+				_, _, envPair := fakeV3Actor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(0)
+				Expect(envPair).To(Equal(v3action.EnvironmentVariablePair{Key: "CONFIG", Value: `{"ports":[80],"url":"http://example.com"}`}))
+				_, _, envPair = fakeV3Actor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(1)
+				Expect(envPair).To(Equal(v3action.EnvironmentVariablePair{Key: "COUNT", Value: "3"}))
+				_, _, envPair = fakeV3Actor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(2)
+				Expect(envPair).To(Equal(v3action.EnvironmentVariablePair{Key: "ENABLED", Value: "true"}))
+			})
+		})
+
+		Context("when a route has a port", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationRoutesReturns(v2action.Routes{
+					{GUID: "tcp-route-guid", Domain: v2action.Domain{Name: "tcp.example.com"}, Port: types.NullInt{Value: 1024, IsSet: true}},
+				}, nil, nil)
+			})
+
+			It("creates the route with a random port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				route, generatePort := fakeV2Actor.CreateRouteArgsForCall(0)
+				Expect(route.Port.IsSet).To(BeFalse())
+				Expect(generatePort).To(BeTrue())
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeV3Actor.CopyApplicationDropletReturns(v3action.Droplet{}, nil, v3action.DropletNotFoundError{AppGUID: "app-guid"})
+			})
+
+			It("clones the app without starting it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(clone.Applications[0].DropletCopied).To(BeFalse())
+				Expect(clone.Applications[0].Started).To(BeFalse())
+				Expect(fakeV3Actor.SetApplicationDropletCallCount()).To(Equal(0))
+				Expect(fakeV3Actor.StartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a process does not exist on the new app", func() {
+			BeforeEach(func() {
+				fakeV3Actor.ScaleProcessByApplicationReturns(nil, v3action.ProcessNotFoundError{ProcessType: "worker"})
+			})
+
+			It("skips the process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the source space does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, v2action.Warnings{"get-space-warning"}, v2action.SpaceNotFoundError{Name: "source"})
+			})
+
+			It("returns the error without creating the destination space", func() {
+				Expect(executeErr).To(MatchError(v2action.SpaceNotFoundError{Name: "source"}))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+				Expect(fakeV2Actor.CreateSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the app fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.CreateApplicationInSpaceReturns(v3action.Application{}, v3action.Warnings{"create-app-warning"}, errors.New("create-app-error"))
+				fakeV2Actor.DeleteSpaceReturns(v2action.Warnings{"delete-space-warning"}, nil)
+			})
+
+			It("deletes the destination space and returns a CloneFailedError and all warnings", func() {
+				Expect(executeErr).To(MatchError(CloneFailedError{
+					SpaceName: "dev",
+					Err:       errors.New("create-app-error"),
+				}))
+				Expect(warnings).To(ConsistOf("get-space-warning", "create-space-warning", "create-ups-warning", "create-app-warning", "delete-space-warning"))
+				Expect(fakeNetworkingActor.ApplyNetworkPoliciesCallCount()).To(Equal(0))
+
+				Expect(fakeV2Actor.DeleteSpaceCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteSpaceArgsForCall(0)).To(Equal("destination-space-guid"))
+			})
+
+			Context("when deleting the destination space fails", func() {
+				BeforeEach(func() {
+					fakeV2Actor.DeleteSpaceReturns(v2action.Warnings{"delete-space-warning"}, errors.New("delete-space-error"))
+				})
+
+				It("returns a CloneFailedError with the rollback error", func() {
+					Expect(executeErr).To(MatchError(CloneFailedError{
+						SpaceName:   "dev",
+						Err:         errors.New("create-app-error"),
+						RollbackErr: errors.New("delete-space-error"),
+					}))
+				})
+			})
+		})
+
+		Context("when creating the destination space fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateSpaceReturns(v2action.Space{}, v2action.Warnings{"create-space-warning"}, errors.New("create-space-error"))
+			})
+
+			It("returns the error without deleting anything", func() {
+				Expect(executeErr).To(MatchError("create-space-error"))
+				Expect(fakeV2Actor.DeleteSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package cloneaction_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCloneAction(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Clone Actions Suite")
+}
@@ -0,0 +1,10 @@
+package cloneaction
+
+import "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+
+//go:generate counterfeiter . NetworkingActor
+
+type NetworkingActor interface {
+	ApplyNetworkPolicies(spaceGUID string, policies []cfnetworkingaction.Policy, prune bool) ([]cfnetworkingaction.Policy, []cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
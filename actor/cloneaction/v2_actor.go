@@ -0,0 +1,23 @@
+package cloneaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+//go:generate counterfeiter . V2Actor
+
+type V2Actor interface {
+	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	CreateSpace(orgGUID string, spaceName string, spaceQuotaGUID string) (v2action.Space, v2action.Warnings, error)
+	CreateUserProvidedServiceInstance(serviceInstance v2action.UserProvidedServiceInstance) (v2action.UserProvidedServiceInstance, v2action.Warnings, error)
+	DeleteSpace(spaceGUID string) (v2action.Warnings, error)
+	GetApplicationRoutes(applicationGUID string) (v2action.Routes, v2action.Warnings, error)
+	GetServiceInstancesByApplication(appGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetUserProvidedServiceInstance(serviceInstanceGUID string) (v2action.UserProvidedServiceInstance, v2action.Warnings, error)
+	MapRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	SetSpaceRoleByUsername(role constant.SpaceUserRole, orgGUID string, spaceGUID string, username string) (v2action.Warnings, error)
+}
@@ -0,0 +1,17 @@
+package cloneaction
+
+import "code.cloudfoundry.org/cli/actor/v3action"
+
+//go:generate counterfeiter . V3Actor
+
+type V3Actor interface {
+	CopyApplicationDroplet(sourceAppGUID string, destinationAppGUID string) (v3action.Droplet, v3action.Warnings, error)
+	CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationProcesses(appGUID string) ([]v3action.Process, v3action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v3action.EnvironmentVariableGroups, v3action.Warnings, error)
+	ScaleProcessByApplication(appGUID string, process v3action.Process) (v3action.Warnings, error)
+	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
+	SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+}
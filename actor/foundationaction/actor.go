@@ -0,0 +1,21 @@
+// Package foundationaction contains the business logic for exporting the
+// organizations, spaces, quotas, isolation segments and roles of a foundation
+// and reconciling a foundation against them.
+package foundationaction
+
+// Warnings is a list of warnings returned back from the cloud controller
+type Warnings []string
+
+// Actor handles all business logic for foundation configuration.
+type Actor struct {
+	V2Actor V2Actor
+	V3Actor V3Actor
+}
+
+// NewActor returns a new actor.
+func NewActor(v2Actor V2Actor, v3Actor V3Actor) *Actor {
+	return &Actor{
+		V2Actor: v2Actor,
+		V3Actor: v3Actor,
+	}
+}
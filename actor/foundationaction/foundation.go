@@ -0,0 +1,318 @@
+package foundationaction
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// Foundation is the configuration of the organizations, spaces, quotas,
+// isolation segments and roles of a foundation.
+type Foundation struct {
+	OrganizationQuotas []Quota
+	IsolationSegments  []IsolationSegment
+	Organizations      []Organization
+}
+
+// Quota is the name and limits of an organization or space quota. Limits of
+// -1 are unlimited.
+type Quota struct {
+	Name                    string `yaml:"name"`
+	MemoryLimit             int    `yaml:"memory_limit"`
+	InstanceMemoryLimit     int    `yaml:"instance_memory_limit"`
+	AppInstanceLimit        int    `yaml:"app_instance_limit"`
+	AppTaskLimit            int    `yaml:"app_task_limit"`
+	TotalRoutes             int    `yaml:"total_routes"`
+	TotalReservedRoutePorts int    `yaml:"total_reserved_route_ports"`
+	TotalServices           int    `yaml:"total_services"`
+	NonBasicServicesAllowed bool   `yaml:"non_basic_services_allowed"`
+}
+
+// UnmarshalYAML defaults the limits that are not provided to unlimited.
+func (quota *Quota) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type rawQuota Quota
+	raw := rawQuota{
+		MemoryLimit:             -1,
+		InstanceMemoryLimit:     -1,
+		AppInstanceLimit:        -1,
+		AppTaskLimit:            -1,
+		TotalRoutes:             -1,
+		TotalReservedRoutePorts: -1,
+		TotalServices:           -1,
+		NonBasicServicesAllowed: true,
+	}
+	err := unmarshal(&raw)
+	if err != nil {
+		return err
+	}
+
+	*quota = Quota(raw)
+	return nil
+}
+
+// IsolationSegment is an isolation segment and the organizations entitled to
+// it.
+type IsolationSegment struct {
+	Name          string   `yaml:"name"`
+	Organizations []string `yaml:"organizations,omitempty"`
+}
+
+// Organization is the quota, roles, space quotas and spaces of an
+// organization. An empty Quota leaves the organization's quota unchanged.
+type Organization struct {
+	Name            string   `yaml:"name"`
+	Quota           string   `yaml:"quota,omitempty"`
+	Managers        []string `yaml:"managers,omitempty"`
+	BillingManagers []string `yaml:"billing_managers,omitempty"`
+	Auditors        []string `yaml:"auditors,omitempty"`
+	SpaceQuotas     []Quota  `yaml:"space_quotas,omitempty"`
+	Spaces          []Space  `yaml:"spaces,omitempty"`
+}
+
+// Space is the quota, isolation segment and roles of a space. An empty Quota
+// or IsolationSegment leaves the space's quota or isolation segment
+// unchanged.
+type Space struct {
+	Name             string   `yaml:"name"`
+	Quota            string   `yaml:"quota,omitempty"`
+	IsolationSegment string   `yaml:"isolation_segment,omitempty"`
+	Managers         []string `yaml:"managers,omitempty"`
+	Developers       []string `yaml:"developers,omitempty"`
+	Auditors         []string `yaml:"auditors,omitempty"`
+}
+
+// GetFoundation returns the current configuration of the foundation.
+func (actor Actor) GetFoundation() (Foundation, Warnings, error) {
+	var (
+		allWarnings Warnings
+		foundation  Foundation
+	)
+
+	orgQuotas, warnings, err := actor.V2Actor.GetOrganizationQuotas()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Foundation{}, allWarnings, err
+	}
+
+	orgQuotaNames := map[string]string{}
+	for _, orgQuota := range orgQuotas {
+		orgQuotaNames[orgQuota.GUID] = orgQuota.Name
+		foundation.OrganizationQuotas = append(foundation.OrganizationQuotas, quotaFromOrganizationQuota(orgQuota))
+	}
+	sort.Slice(foundation.OrganizationQuotas, func(i int, j int) bool {
+		return foundation.OrganizationQuotas[i].Name < foundation.OrganizationQuotas[j].Name
+	})
+
+	summaries, v3Warnings, err := actor.V3Actor.GetIsolationSegmentSummaries()
+	allWarnings = append(allWarnings, v3Warnings...)
+	if err != nil {
+		return Foundation{}, allWarnings, err
+	}
+
+	for _, summary := range summaries {
+		foundation.IsolationSegments = append(foundation.IsolationSegments, IsolationSegment{
+			Name:          summary.Name,
+			Organizations: sortedStrings(summary.EntitledOrgs),
+		})
+	}
+	sort.Slice(foundation.IsolationSegments, func(i int, j int) bool {
+		return foundation.IsolationSegments[i].Name < foundation.IsolationSegments[j].Name
+	})
+
+	orgs, warnings, err := actor.V2Actor.GetOrganizations()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Foundation{}, allWarnings, err
+	}
+
+	for _, org := range orgs {
+		organization, orgWarnings, err := actor.exportOrganization(org, orgQuotaNames)
+		allWarnings = append(allWarnings, orgWarnings...)
+		if err != nil {
+			return Foundation{}, allWarnings, err
+		}
+		foundation.Organizations = append(foundation.Organizations, organization)
+	}
+	sort.Slice(foundation.Organizations, func(i int, j int) bool {
+		return foundation.Organizations[i].Name < foundation.Organizations[j].Name
+	})
+
+	return foundation, allWarnings, nil
+}
+
+func (actor Actor) exportOrganization(org v2action.Organization, orgQuotaNames map[string]string) (Organization, Warnings, error) {
+	var allWarnings Warnings
+
+	organization := Organization{
+		Name:  org.Name,
+		Quota: orgQuotaNames[org.QuotaDefinitionGUID],
+	}
+
+	for _, role := range []struct {
+		role      constant.OrganizationUserRole
+		usernames *[]string
+	}{
+		{constant.OrgManagerRole, &organization.Managers},
+		{constant.OrgBillingManagerRole, &organization.BillingManagers},
+		{constant.OrgAuditorRole, &organization.Auditors},
+	} {
+		users, warnings, err := actor.V2Actor.GetOrganizationUsersByRole(role.role, org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Organization{}, allWarnings, err
+		}
+		*role.usernames = usernames(users)
+	}
+
+	spaceQuotas, warnings, err := actor.V2Actor.GetSpaceQuotasByOrganization(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, allWarnings, err
+	}
+
+	spaceQuotaNames := map[string]string{}
+	for _, spaceQuota := range spaceQuotas {
+		spaceQuotaNames[spaceQuota.GUID] = spaceQuota.Name
+		organization.SpaceQuotas = append(organization.SpaceQuotas, quotaFromSpaceQuota(spaceQuota))
+	}
+	sort.Slice(organization.SpaceQuotas, func(i int, j int) bool {
+		return organization.SpaceQuotas[i].Name < organization.SpaceQuotas[j].Name
+	})
+
+	spaces, warnings, err := actor.V2Actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		foundationSpace, spaceWarnings, err := actor.exportSpace(space, spaceQuotaNames)
+		allWarnings = append(allWarnings, spaceWarnings...)
+		if err != nil {
+			return Organization{}, allWarnings, err
+		}
+		organization.Spaces = append(organization.Spaces, foundationSpace)
+	}
+	sort.Slice(organization.Spaces, func(i int, j int) bool {
+		return organization.Spaces[i].Name < organization.Spaces[j].Name
+	})
+
+	return organization, allWarnings, nil
+}
+
+func (actor Actor) exportSpace(space v2action.Space, spaceQuotaNames map[string]string) (Space, Warnings, error) {
+	var allWarnings Warnings
+
+	foundationSpace := Space{
+		Name:  space.Name,
+		Quota: spaceQuotaNames[space.SpaceQuotaDefinitionGUID],
+	}
+
+	isolationSegment, v3Warnings, err := actor.V3Actor.GetEffectiveIsolationSegmentBySpace(space.GUID, "")
+	allWarnings = append(allWarnings, v3Warnings...)
+	switch err.(type) {
+	case nil:
+		foundationSpace.IsolationSegment = isolationSegment.Name
+	case v3action.NoRelationshipError:
+	default:
+		return Space{}, allWarnings, err
+	}
+
+	for _, role := range []struct {
+		role      constant.SpaceUserRole
+		usernames *[]string
+	}{
+		{constant.SpaceManagerRole, &foundationSpace.Managers},
+		{constant.SpaceDeveloperRole, &foundationSpace.Developers},
+		{constant.SpaceAuditorRole, &foundationSpace.Auditors},
+	} {
+		users, warnings, err := actor.V2Actor.GetSpaceUsersByRole(role.role, space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Space{}, allWarnings, err
+		}
+		*role.usernames = usernames(users)
+	}
+
+	return foundationSpace, allWarnings, nil
+}
+
+func quotaFromOrganizationQuota(quota v2action.OrganizationQuota) Quota {
+	return Quota{
+		Name:                    quota.Name,
+		MemoryLimit:             quota.MemoryLimit,
+		InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+		AppInstanceLimit:        quota.AppInstanceLimit,
+		AppTaskLimit:            quota.AppTaskLimit,
+		TotalRoutes:             quota.TotalRoutes,
+		TotalReservedRoutePorts: quota.TotalReservedRoutePorts,
+		TotalServices:           quota.TotalServices,
+		NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+	}
+}
+
+func quotaFromSpaceQuota(quota v2action.SpaceQuota) Quota {
+	return Quota{
+		Name:                    quota.Name,
+		MemoryLimit:             quota.MemoryLimit,
+		InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+		AppInstanceLimit:        quota.AppInstanceLimit,
+		AppTaskLimit:            quota.AppTaskLimit,
+		TotalRoutes:             quota.TotalRoutes,
+		TotalReservedRoutePorts: quota.TotalReservedRoutePorts,
+		TotalServices:           quota.TotalServices,
+		NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+	}
+}
+
+func (quota Quota) organizationQuota() v2action.OrganizationQuota {
+	return v2action.OrganizationQuota{
+		Name:                    quota.Name,
+		MemoryLimit:             quota.MemoryLimit,
+		InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+		AppInstanceLimit:        quota.AppInstanceLimit,
+		AppTaskLimit:            quota.AppTaskLimit,
+		TotalRoutes:             quota.TotalRoutes,
+		TotalReservedRoutePorts: quota.TotalReservedRoutePorts,
+		TotalServices:           quota.TotalServices,
+		NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+	}
+}
+
+func (quota Quota) spaceQuota() v2action.SpaceQuota {
+	return v2action.SpaceQuota{
+		Name:                    quota.Name,
+		MemoryLimit:             quota.MemoryLimit,
+		InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+		AppInstanceLimit:        quota.AppInstanceLimit,
+		AppTaskLimit:            quota.AppTaskLimit,
+		TotalRoutes:             quota.TotalRoutes,
+		TotalReservedRoutePorts: quota.TotalReservedRoutePorts,
+		TotalServices:           quota.TotalServices,
+		NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+	}
+}
+
+// usernames returns the sorted usernames of the users, skipping users without
+// a username such as UAA clients.
+func usernames(users []v2action.User) []string {
+	var names []string
+	for _, user := range users {
+		if user.Username != "" {
+			names = append(names, user.Username)
+		}
+	}
+	return sortedStrings(names)
+}
+
+func sortedStrings(strings []string) []string {
+	if len(strings) == 0 {
+		return nil
+	}
+	sorted := make([]string, len(strings))
+	copy(sorted, strings)
+	sort.Strings(sorted)
+	return sorted
+}
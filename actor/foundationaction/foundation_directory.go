@@ -0,0 +1,262 @@
+package foundationaction
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	organizationQuotasFileName = "organization_quotas.yml"
+	isolationSegmentsFileName  = "isolation_segments.yml"
+	organizationsDirectoryName = "orgs"
+	organizationFileExt        = ".yml"
+)
+
+// organizationFileNameEscaper escapes the characters of an org name that
+// cannot be used in a file name, and the escape character itself, the same
+// way security group file names are escaped.
+var organizationFileNameEscaper = strings.NewReplacer("%", "%25", "/", "%2F", `\`, "%5C")
+
+// InvalidFoundationDirectoryError is returned when a foundation directory
+// cannot be parsed or is inconsistent.
+type InvalidFoundationDirectoryError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidFoundationDirectoryError) Error() string {
+	return fmt.Sprintf("Invalid foundation directory %s: %s", e.Path, e.Message)
+}
+
+// ExportFoundation writes the current configuration of the foundation to
+// dir in the layout read by ReadFoundation. It returns the number of
+// organizations written.
+func (actor Actor) ExportFoundation(dir string) (int, Warnings, error) {
+	foundation, warnings, err := actor.GetFoundation()
+	if err != nil {
+		return 0, warnings, err
+	}
+
+	return len(foundation.Organizations), warnings, actor.WriteFoundation(dir, foundation)
+}
+
+// WriteFoundation writes the foundation to dir as:
+//
+//   organization_quotas.yml  a list of organization quotas
+//   isolation_segments.yml   a list of isolation segments and the
+//                            organizations entitled to them
+//   orgs/ORG.yml             the quota, roles, space quotas and spaces of
+//                            each organization
+//
+// Slashes, backslashes and percent signs in ORG are escaped as %2F, %5C and
+// %25.
+func (Actor) WriteFoundation(dir string, foundation Foundation) error {
+	err := os.MkdirAll(filepath.Join(dir, organizationsDirectoryName), 0755)
+	if err != nil {
+		return err
+	}
+
+	err = writeYAMLFile(filepath.Join(dir, organizationQuotasFileName), foundation.OrganizationQuotas)
+	if err != nil {
+		return err
+	}
+
+	err = writeYAMLFile(filepath.Join(dir, isolationSegmentsFileName), foundation.IsolationSegments)
+	if err != nil {
+		return err
+	}
+
+	for _, org := range foundation.Organizations {
+		err = writeYAMLFile(filepath.Join(dir, organizationsDirectoryName, organizationFileName(org.Name)), org)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadFoundation reads a foundation from dir in the layout written by
+// WriteFoundation. dir and its orgs directory must exist; the other files are
+// treated as empty when they are missing. Limits omitted from a quota are
+// unlimited.
+func (Actor) ReadFoundation(dir string) (Foundation, error) {
+	var foundation Foundation
+
+	err := requireDirectory(dir, "")
+	if err != nil {
+		return Foundation{}, err
+	}
+
+	err = requireDirectory(dir, organizationsDirectoryName)
+	if err != nil {
+		return Foundation{}, err
+	}
+
+	err = readYAMLFile(dir, organizationQuotasFileName, &foundation.OrganizationQuotas)
+	if err != nil {
+		return Foundation{}, err
+	}
+
+	err = readYAMLFile(dir, isolationSegmentsFileName, &foundation.IsolationSegments)
+	if err != nil {
+		return Foundation{}, err
+	}
+
+	orgFiles, err := filepath.Glob(filepath.Join(dir, organizationsDirectoryName, "*"+organizationFileExt))
+	if err != nil {
+		return Foundation{}, err
+	}
+	sort.Strings(orgFiles)
+
+	for _, orgFile := range orgFiles {
+		var org Organization
+		err = readYAMLFile(dir, filepath.Join(organizationsDirectoryName, filepath.Base(orgFile)), &org)
+		if err != nil {
+			return Foundation{}, err
+		}
+		foundation.Organizations = append(foundation.Organizations, org)
+	}
+
+	err = validateFoundation(foundation)
+	if err != nil {
+		return Foundation{}, InvalidFoundationDirectoryError{Path: dir, Message: err.Error()}
+	}
+
+	return foundation, nil
+}
+
+func validateFoundation(foundation Foundation) error {
+	orgQuotas := map[string]bool{}
+	for _, quota := range foundation.OrganizationQuotas {
+		if quota.Name == "" {
+			return fmt.Errorf("%s: every quota must have a name", organizationQuotasFileName)
+		}
+		if orgQuotas[quota.Name] {
+			return fmt.Errorf("%s: quota %s is defined more than once", organizationQuotasFileName, quota.Name)
+		}
+		orgQuotas[quota.Name] = true
+	}
+
+	entitlements := map[string]map[string]bool{}
+	for _, isolationSegment := range foundation.IsolationSegments {
+		if isolationSegment.Name == "" {
+			return fmt.Errorf("%s: every isolation segment must have a name", isolationSegmentsFileName)
+		}
+		if entitlements[isolationSegment.Name] != nil {
+			return fmt.Errorf("%s: isolation segment %s is defined more than once", isolationSegmentsFileName, isolationSegment.Name)
+		}
+		entitlements[isolationSegment.Name] = map[string]bool{}
+		for _, orgName := range isolationSegment.Organizations {
+			entitlements[isolationSegment.Name][orgName] = true
+		}
+	}
+
+	orgs := map[string]bool{}
+	for _, org := range foundation.Organizations {
+		if org.Name == "" {
+			return fmt.Errorf("%s: every org must have a name", organizationsDirectoryName)
+		}
+		if orgs[org.Name] {
+			return fmt.Errorf("%s: org %s is defined more than once", organizationsDirectoryName, org.Name)
+		}
+		orgs[org.Name] = true
+
+		if org.Quota != "" && !orgQuotas[org.Quota] {
+			return fmt.Errorf("org %s: quota %s is not in %s", org.Name, org.Quota, organizationQuotasFileName)
+		}
+
+		spaceQuotas := map[string]bool{}
+		for _, quota := range org.SpaceQuotas {
+			if quota.Name == "" {
+				return fmt.Errorf("org %s: every space quota must have a name", org.Name)
+			}
+			spaceQuotas[quota.Name] = true
+		}
+
+		spaces := map[string]bool{}
+		for _, space := range org.Spaces {
+			if space.Name == "" {
+				return fmt.Errorf("org %s: every space must have a name", org.Name)
+			}
+			if spaces[space.Name] {
+				return fmt.Errorf("org %s: space %s is defined more than once", org.Name, space.Name)
+			}
+			spaces[space.Name] = true
+
+			if space.Quota != "" && !spaceQuotas[space.Quota] {
+				return fmt.Errorf("org %s / space %s: space quota %s is not in the org's space_quotas", org.Name, space.Name, space.Quota)
+			}
+			if space.IsolationSegment != "" && !entitlements[space.IsolationSegment][org.Name] {
+				return fmt.Errorf("org %s / space %s: isolation segment %s is not entitled to the org in %s", org.Name, space.Name, space.IsolationSegment, isolationSegmentsFileName)
+			}
+		}
+	}
+
+	for _, isolationSegment := range foundation.IsolationSegments {
+		for _, orgName := range isolationSegment.Organizations {
+			if !orgs[orgName] {
+				return fmt.Errorf("%s: isolation segment %s is entitled to org %s, which has no file in %s", isolationSegmentsFileName, isolationSegment.Name, orgName, organizationsDirectoryName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// requireDirectory returns an InvalidFoundationDirectoryError when name is
+// not a directory in dir, or dir itself is not a directory when name is
+// empty.
+func requireDirectory(dir string, name string) error {
+	info, err := os.Stat(filepath.Join(dir, name))
+	if os.IsNotExist(err) || (err == nil && !info.IsDir()) {
+		message := "directory does not exist"
+		if name != "" {
+			message = fmt.Sprintf("%s directory does not exist", name)
+		}
+		return InvalidFoundationDirectoryError{Path: dir, Message: message}
+	}
+
+	return err
+}
+
+func readYAMLFile(dir string, name string, out interface{}) error {
+	raw, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = yaml.Unmarshal(raw, out)
+	if err != nil {
+		return InvalidFoundationDirectoryError{
+			Path:    dir,
+			Message: fmt.Sprintf("%s: %s", filepath.ToSlash(name), strings.TrimPrefix(err.Error(), "yaml: ")),
+		}
+	}
+
+	return nil
+}
+
+// organizationFileName returns the name of the file of an org in the orgs
+// directory.
+func organizationFileName(name string) string {
+	return organizationFileNameEscaper.Replace(name) + organizationFileExt
+}
+
+func writeYAMLFile(path string, in interface{}) error {
+	raw, err := yaml.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0644)
+}
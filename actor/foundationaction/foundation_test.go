@@ -0,0 +1,273 @@
+package foundationaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/foundationaction/foundationactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Foundation Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *foundationactionfakes.FakeV2Actor
+		fakeV3Actor *foundationactionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(foundationactionfakes.FakeV2Actor)
+		fakeV3Actor = new(foundationactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("GetFoundation", func() {
+		var (
+			foundation Foundation
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationQuotasReturns(
+				[]v2action.OrganizationQuota{
+					{GUID: "small-guid", Name: "small", MemoryLimit: 1024, TotalServices: -1},
+					{GUID: "default-guid", Name: "default", MemoryLimit: -1},
+				},
+				v2action.Warnings{"quotas-warning"},
+				nil)
+			fakeV3Actor.GetIsolationSegmentSummariesReturns(
+				[]v3action.IsolationSegmentSummary{{Name: "secure", EntitledOrgs: []string{"acme"}}},
+				v3action.Warnings{"isolation-segments-warning"},
+				nil)
+			fakeV2Actor.GetOrganizationsReturns(
+				[]v2action.Organization{{GUID: "acme-guid", Name: "acme", QuotaDefinitionGUID: "small-guid"}},
+				v2action.Warnings{"orgs-warning"},
+				nil)
+			fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrganizationUserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.OrgManagerRole {
+					return []v2action.User{{Username: "carol"}, {Username: ""}, {Username: "alice"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeV2Actor.GetSpaceQuotasByOrganizationReturns(
+				[]v2action.SpaceQuota{{GUID: "dev-quota-guid", Name: "dev-quota", MemoryLimit: 512}},
+				nil,
+				nil)
+			fakeV2Actor.GetOrganizationSpacesReturns(
+				[]v2action.Space{
+					{GUID: "prod-guid", Name: "prod"},
+					{GUID: "dev-guid", Name: "dev", SpaceQuotaDefinitionGUID: "dev-quota-guid"},
+				},
+				nil,
+				nil)
+			fakeV3Actor.GetEffectiveIsolationSegmentBySpaceStub = func(spaceGUID string, _ string) (v3action.IsolationSegment, v3action.Warnings, error) {
+				if spaceGUID == "prod-guid" {
+					return v3action.IsolationSegment{Name: "secure"}, nil, nil
+				}
+				return v3action.IsolationSegment{}, nil, v3action.NoRelationshipError{}
+			}
+			fakeV2Actor.GetSpaceUsersByRoleStub = func(role constant.SpaceUserRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.SpaceDeveloperRole && spaceGUID == "dev-guid" {
+					return []v2action.User{{Username: "bob"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			foundation, warnings, executeErr = actor.GetFoundation()
+		})
+
+		It("returns the sorted quotas, isolation segments, orgs and spaces", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("quotas-warning", "isolation-segments-warning", "orgs-warning"))
+			Expect(foundation).To(Equal(Foundation{
+				OrganizationQuotas: []Quota{
+					{Name: "default", MemoryLimit: -1},
+					{Name: "small", MemoryLimit: 1024, TotalServices: -1},
+				},
+				IsolationSegments: []IsolationSegment{{Name: "secure", Organizations: []string{"acme"}}},
+				Organizations: []Organization{
+					{
+						Name:        "acme",
+						Quota:       "small",
+						Managers:    []string{"alice", "carol"},
+						SpaceQuotas: []Quota{{Name: "dev-quota", MemoryLimit: 512}},
+						Spaces: []Space{
+							{Name: "dev", Quota: "dev-quota", Developers: []string{"bob"}},
+							{Name: "prod", IsolationSegment: "secure"},
+						},
+					},
+				},
+			}))
+
+			Expect(fakeV2Actor.GetOrganizationSpacesArgsForCall(0)).To(Equal("acme-guid"))
+			Expect(fakeV2Actor.GetSpaceQuotasByOrganizationArgsForCall(0)).To(Equal("acme-guid"))
+		})
+
+		Context("when getting the space's isolation segment fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetEffectiveIsolationSegmentBySpaceReturns(v3action.IsolationSegment{}, v3action.Warnings{"segment-warning"}, errors.New("segment-error"))
+				fakeV3Actor.GetEffectiveIsolationSegmentBySpaceStub = nil
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("segment-error"))
+				Expect(warnings).To(ConsistOf("quotas-warning", "isolation-segments-warning", "orgs-warning", "segment-warning"))
+			})
+		})
+
+		Context("when getting the organizations fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationsReturns(nil, v2action.Warnings{"orgs-warning"}, errors.New("orgs-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("orgs-error"))
+				Expect(warnings).To(ConsistOf("quotas-warning", "isolation-segments-warning", "orgs-warning"))
+			})
+		})
+	})
+
+	Describe("WriteFoundation and ReadFoundation", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "foundation")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("reads back the foundation that was written", func() {
+			foundation := Foundation{
+				OrganizationQuotas: []Quota{{Name: "small", MemoryLimit: 1024, TotalRoutes: -1}},
+				IsolationSegments:  []IsolationSegment{{Name: "secure", Organizations: []string{"acme"}}},
+				Organizations: []Organization{
+					{
+						Name:        "acme",
+						Quota:       "small",
+						Auditors:    []string{"alice"},
+						SpaceQuotas: []Quota{{Name: "dev-quota", MemoryLimit: 512}},
+						Spaces:      []Space{{Name: "dev", Quota: "dev-quota", IsolationSegment: "secure", Developers: []string{"bob"}}},
+					},
+				},
+			}
+
+			Expect(actor.WriteFoundation(dir, foundation)).To(Succeed())
+			Expect(filepath.Join(dir, "orgs", "acme.yml")).To(BeAnExistingFile())
+
+			readFoundation, err := actor.ReadFoundation(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readFoundation).To(Equal(foundation))
+		})
+
+		It("escapes the org file names", func() {
+			Expect(actor.WriteFoundation(dir, Foundation{
+				Organizations: []Organization{{Name: "acme/dev%1"}},
+			})).To(Succeed())
+			Expect(filepath.Join(dir, "orgs", "acme%2Fdev%251.yml")).To(BeAnExistingFile())
+
+			foundation, err := actor.ReadFoundation(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundation.Organizations).To(Equal([]Organization{{Name: "acme/dev%1"}}))
+		})
+
+		It("defaults the omitted quota limits to unlimited", func() {
+			Expect(os.MkdirAll(filepath.Join(dir, "orgs"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "organization_quotas.yml"), []byte("- name: small\n  memory_limit: 1024\n"), 0644)).To(Succeed())
+
+			foundation, err := actor.ReadFoundation(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundation.OrganizationQuotas).To(Equal([]Quota{{
+				Name:                    "small",
+				MemoryLimit:             1024,
+				InstanceMemoryLimit:     -1,
+				AppInstanceLimit:        -1,
+				AppTaskLimit:            -1,
+				TotalRoutes:             -1,
+				TotalReservedRoutePorts: -1,
+				TotalServices:           -1,
+				NonBasicServicesAllowed: true,
+			}}))
+		})
+
+		Context("when the directory does not exist", func() {
+			It("returns an InvalidFoundationDirectoryError", func() {
+				missingDir := filepath.Join(dir, "missing")
+				_, err := actor.ReadFoundation(missingDir)
+				Expect(err).To(MatchError(InvalidFoundationDirectoryError{
+					Path:    missingDir,
+					Message: "directory does not exist",
+				}))
+			})
+		})
+
+		Context("when the orgs directory does not exist", func() {
+			It("returns an InvalidFoundationDirectoryError", func() {
+				_, err := actor.ReadFoundation(dir)
+				Expect(err).To(MatchError(InvalidFoundationDirectoryError{
+					Path:    dir,
+					Message: "orgs directory does not exist",
+				}))
+			})
+		})
+
+		Context("when an org file cannot be parsed", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Join(dir, "orgs"), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "orgs", "acme.yml"), []byte("name: [acme"), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidFoundationDirectoryError", func() {
+				_, err := actor.ReadFoundation(dir)
+				Expect(err).To(BeAssignableToTypeOf(InvalidFoundationDirectoryError{}))
+				Expect(err.Error()).To(HavePrefix("Invalid foundation directory " + dir + ": orgs/acme.yml: "))
+			})
+		})
+
+		Context("when a space refers to an isolation segment that is not entitled to its org", func() {
+			BeforeEach(func() {
+				Expect(actor.WriteFoundation(dir, Foundation{
+					IsolationSegments: []IsolationSegment{{Name: "secure"}},
+					Organizations:     []Organization{{Name: "acme", Spaces: []Space{{Name: "dev", IsolationSegment: "secure"}}}},
+				})).To(Succeed())
+			})
+
+			It("returns an InvalidFoundationDirectoryError", func() {
+				_, err := actor.ReadFoundation(dir)
+				Expect(err).To(MatchError(InvalidFoundationDirectoryError{
+					Path:    dir,
+					Message: "org acme / space dev: isolation segment secure is not entitled to the org in isolation_segments.yml",
+				}))
+			})
+		})
+
+		Context("when an org refers to a quota that is not defined", func() {
+			BeforeEach(func() {
+				Expect(actor.WriteFoundation(dir, Foundation{
+					Organizations: []Organization{{Name: "acme", Quota: "missing"}},
+				})).To(Succeed())
+			})
+
+			It("returns an InvalidFoundationDirectoryError", func() {
+				_, err := actor.ReadFoundation(dir)
+				Expect(err).To(MatchError(InvalidFoundationDirectoryError{
+					Path:    dir,
+					Message: "org acme: quota missing is not in organization_quotas.yml",
+				}))
+			})
+		})
+	})
+})
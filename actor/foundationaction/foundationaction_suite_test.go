@@ -0,0 +1,13 @@
+package foundationaction_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestFoundationAction(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Foundation Actions Suite")
+}
@@ -0,0 +1,587 @@
+package foundationaction
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// ChangeType is the kind of change made to a resource.
+type ChangeType string
+
+const (
+	ChangeCreate ChangeType = "create"
+	ChangeUpdate ChangeType = "update"
+	ChangeDelete ChangeType = "delete"
+)
+
+// ResourceType is the kind of resource a change is made to.
+type ResourceType string
+
+const (
+	OrganizationQuotaResource ResourceType = "org quota"
+	IsolationSegmentResource  ResourceType = "isolation segment"
+	EntitlementResource       ResourceType = "entitlement"
+	OrganizationResource      ResourceType = "org"
+	SpaceQuotaResource        ResourceType = "space quota"
+	SpaceResource             ResourceType = "space"
+	OrganizationRoleResource  ResourceType = "org role"
+	SpaceRoleResource         ResourceType = "space role"
+)
+
+// QuotaNotFoundError is returned when a change refers to a quota that does
+// not exist.
+type QuotaNotFoundError struct {
+	Name string
+}
+
+func (e QuotaNotFoundError) Error() string {
+	return fmt.Sprintf("Quota '%s' not found.", e.Name)
+}
+
+// Change is a single change that reconciles the foundation with its desired
+// configuration. Which fields are set depends on the Resource:
+//
+//   org quota          Name and Quota
+//   isolation segment  Name
+//   entitlement        Name of the isolation segment and Organization
+//   org                Organization and QuotaName
+//   space quota        Organization, Name and Quota
+//   space              Organization, Space, QuotaName and IsolationSegment
+//   org role           Organization, Role and Name of the user
+//   space role         Organization, Space, Role and Name of the user
+//   user               Name and Origin of the user
+//
+// An empty QuotaName or IsolationSegment leaves it unchanged.
+type Change struct {
+	Type             ChangeType
+	Resource         ResourceType
+	Organization     string
+	Space            string
+	Name             string
+	Role             string
+	Quota            Quota
+	QuotaName        string
+	IsolationSegment string
+	Origin           string
+}
+
+// Deletions returns the changes that delete a resource.
+func Deletions(changes []Change) []Change {
+	var deletions []Change
+	for _, change := range changes {
+		if change.Type == ChangeDelete {
+			deletions = append(deletions, change)
+		}
+	}
+	return deletions
+}
+
+// PlanFoundation returns the changes that reconcile the foundation with the
+// configuration in dir, in the order they must be applied. When prune is
+// true, orgs, spaces, entitlements and roles that are not in dir are
+// deleted; quotas and isolation segments are never deleted. Pruning is
+// refused when dir has no orgs, since it would delete every org.
+func (actor Actor) PlanFoundation(dir string, prune bool) ([]Change, Warnings, error) {
+	desired, err := actor.ReadFoundation(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if prune && len(desired.Organizations) == 0 {
+		return nil, nil, InvalidFoundationDirectoryError{
+			Path:    dir,
+			Message: fmt.Sprintf("no org files in %s, refusing to prune every org", organizationsDirectoryName),
+		}
+	}
+
+	current, warnings, err := actor.GetFoundation()
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return planChanges(current, desired, prune), warnings, nil
+}
+
+// ApplyChange makes a change returned by PlanFoundation or PlanRoles. Resources are looked
+// up by name so that changes can refer to resources created by earlier
+// changes.
+func (actor Actor) ApplyChange(change Change) (Warnings, error) {
+	switch change.Resource {
+	case OrganizationQuotaResource:
+		return actor.applyOrganizationQuotaChange(change)
+	case IsolationSegmentResource:
+		warnings, err := actor.V3Actor.CreateIsolationSegmentByName(v3action.IsolationSegment{Name: change.Name})
+		return Warnings(warnings), err
+	case EntitlementResource:
+		if change.Type == ChangeDelete {
+			warnings, err := actor.V3Actor.RevokeIsolationSegmentFromOrganizationByName(change.Name, change.Organization)
+			return Warnings(warnings), err
+		}
+		warnings, err := actor.V3Actor.EntitleIsolationSegmentToOrganizationByName(change.Name, change.Organization)
+		return Warnings(warnings), err
+	case OrganizationResource:
+		return actor.applyOrganizationChange(change)
+	case SpaceQuotaResource:
+		return actor.applySpaceQuotaChange(change)
+	case SpaceResource:
+		return actor.applySpaceChange(change)
+	case OrganizationRoleResource:
+		return actor.applyOrganizationRoleChange(change)
+	case SpaceRoleResource:
+		return actor.applySpaceRoleChange(change)
+	case UserResource:
+		return actor.applyUserChange(change)
+	}
+
+	return nil, fmt.Errorf("unknown resource %s", change.Resource)
+}
+
+func (actor Actor) applyOrganizationQuotaChange(change Change) (Warnings, error) {
+	quota := change.Quota.organizationQuota()
+	if change.Type == ChangeCreate {
+		_, warnings, err := actor.V2Actor.CreateOrganizationQuota(quota)
+		return Warnings(warnings), err
+	}
+
+	guid, allWarnings, err := actor.getOrganizationQuotaGUID(change.Name)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	quota.GUID = guid
+	_, warnings, err := actor.V2Actor.UpdateOrganizationQuota(quota)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) applyOrganizationChange(change Change) (Warnings, error) {
+	if change.Type == ChangeDelete {
+		warnings, err := actor.V2Actor.DeleteOrganization(change.Organization)
+		return Warnings(warnings), err
+	}
+
+	var (
+		allWarnings Warnings
+		quotaGUID   string
+		err         error
+	)
+	if change.QuotaName != "" {
+		quotaGUID, allWarnings, err = actor.getOrganizationQuotaGUID(change.QuotaName)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if change.Type == ChangeCreate {
+		_, warnings, err := actor.V2Actor.CreateOrganization(change.Organization, quotaGUID)
+		allWarnings = append(allWarnings, warnings...)
+		return allWarnings, err
+	}
+
+	org, warnings, err := actor.V2Actor.GetOrganizationByName(change.Organization)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.V2Actor.SetOrganizationQuota(org.GUID, quotaGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) applySpaceQuotaChange(change Change) (Warnings, error) {
+	org, allWarnings, err := actor.getOrganization(change.Organization)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	quota := change.Quota.spaceQuota()
+	quota.OrganizationGUID = org.GUID
+	if change.Type == ChangeCreate {
+		_, warnings, err := actor.V2Actor.CreateSpaceQuota(quota)
+		allWarnings = append(allWarnings, warnings...)
+		return allWarnings, err
+	}
+
+	guid, warnings, err := actor.getSpaceQuotaGUID(org.GUID, change.Name)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	quota.GUID = guid
+	_, v2Warnings, err := actor.V2Actor.UpdateSpaceQuota(quota)
+	allWarnings = append(allWarnings, v2Warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) applySpaceChange(change Change) (Warnings, error) {
+	if change.Type == ChangeDelete {
+		warnings, err := actor.V2Actor.DeleteSpaceByNameAndOrganizationName(change.Space, change.Organization)
+		return Warnings(warnings), err
+	}
+
+	org, allWarnings, err := actor.getOrganization(change.Organization)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var quotaGUID string
+	if change.QuotaName != "" {
+		var warnings Warnings
+		quotaGUID, warnings, err = actor.getSpaceQuotaGUID(org.GUID, change.QuotaName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	var space v2action.Space
+	if change.Type == ChangeCreate {
+		var warnings v2action.Warnings
+		space, warnings, err = actor.V2Actor.CreateSpace(org.GUID, change.Space, quotaGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	} else {
+		var warnings v2action.Warnings
+		space, warnings, err = actor.V2Actor.GetSpaceByOrganizationAndName(org.GUID, change.Space)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if quotaGUID != "" {
+			warnings, err = actor.V2Actor.SetSpaceQuota(space.GUID, quotaGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+	}
+
+	if change.IsolationSegment != "" {
+		warnings, err := actor.V3Actor.AssignIsolationSegmentToSpaceByNameAndSpace(change.IsolationSegment, space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) applyOrganizationRoleChange(change Change) (Warnings, error) {
+	org, allWarnings, err := actor.getOrganization(change.Organization)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var warnings v2action.Warnings
+	role := constant.OrganizationUserRole(change.Role)
+	if change.Type == ChangeDelete {
+		warnings, err = actor.V2Actor.UnsetOrganizationRoleByUsername(role, org.GUID, change.Name)
+	} else {
+		warnings, err = actor.V2Actor.SetOrganizationRoleByUsername(role, org.GUID, change.Name)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) applySpaceRoleChange(change Change) (Warnings, error) {
+	org, allWarnings, err := actor.getOrganization(change.Organization)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	space, warnings, err := actor.V2Actor.GetSpaceByOrganizationAndName(org.GUID, change.Space)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	role := constant.SpaceUserRole(change.Role)
+	if change.Type == ChangeDelete {
+		warnings, err = actor.V2Actor.UnsetSpaceRoleByUsername(role, space.GUID, change.Name)
+	} else {
+		warnings, err = actor.V2Actor.SetSpaceRoleByUsername(role, org.GUID, space.GUID, change.Name)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) getOrganization(orgName string) (v2action.Organization, Warnings, error) {
+	org, warnings, err := actor.V2Actor.GetOrganizationByName(orgName)
+	return org, Warnings(warnings), err
+}
+
+func (actor Actor) getOrganizationQuotaGUID(quotaName string) (string, Warnings, error) {
+	quotas, warnings, err := actor.V2Actor.GetOrganizationQuotas()
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	for _, quota := range quotas {
+		if quota.Name == quotaName {
+			return quota.GUID, Warnings(warnings), nil
+		}
+	}
+
+	return "", Warnings(warnings), QuotaNotFoundError{Name: quotaName}
+}
+
+func (actor Actor) getSpaceQuotaGUID(orgGUID string, quotaName string) (string, Warnings, error) {
+	quotas, warnings, err := actor.V2Actor.GetSpaceQuotasByOrganization(orgGUID)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	for _, quota := range quotas {
+		if quota.Name == quotaName {
+			return quota.GUID, Warnings(warnings), nil
+		}
+	}
+
+	return "", Warnings(warnings), QuotaNotFoundError{Name: quotaName}
+}
+
+// planChanges returns the changes that turn current into desired. Creates and
+// updates come first, ordered so that every resource exists before it is
+// referred to, followed by the deletes of a prune.
+func planChanges(current Foundation, desired Foundation, prune bool) []Change {
+	var changes, deletes []Change
+
+	currentOrgQuotas := map[string]Quota{}
+	for _, quota := range current.OrganizationQuotas {
+		currentOrgQuotas[quota.Name] = quota
+	}
+	for _, quota := range desired.OrganizationQuotas {
+		if existing, ok := currentOrgQuotas[quota.Name]; !ok {
+			changes = append(changes, Change{Type: ChangeCreate, Resource: OrganizationQuotaResource, Name: quota.Name, Quota: quota})
+		} else if existing != quota {
+			changes = append(changes, Change{Type: ChangeUpdate, Resource: OrganizationQuotaResource, Name: quota.Name, Quota: quota})
+		}
+	}
+
+	currentOrgs := map[string]Organization{}
+	for _, org := range current.Organizations {
+		currentOrgs[org.Name] = org
+	}
+	desiredOrgs := map[string]bool{}
+	for _, org := range desired.Organizations {
+		desiredOrgs[org.Name] = true
+	}
+
+	currentEntitlements := map[string]map[string]bool{}
+	for _, isolationSegment := range current.IsolationSegments {
+		currentEntitlements[isolationSegment.Name] = stringSet(isolationSegment.Organizations)
+	}
+	for _, isolationSegment := range desired.IsolationSegments {
+		if _, ok := currentEntitlements[isolationSegment.Name]; !ok {
+			changes = append(changes, Change{Type: ChangeCreate, Resource: IsolationSegmentResource, Name: isolationSegment.Name})
+		}
+	}
+
+	var entitlementChanges []Change
+	desiredSegments := map[string]bool{}
+	for _, isolationSegment := range desired.IsolationSegments {
+		desiredSegments[isolationSegment.Name] = true
+		desiredEntitlements := stringSet(isolationSegment.Organizations)
+		for _, orgName := range isolationSegment.Organizations {
+			if !currentEntitlements[isolationSegment.Name][orgName] {
+				entitlementChanges = append(entitlementChanges, Change{Type: ChangeCreate, Resource: EntitlementResource, Name: isolationSegment.Name, Organization: orgName})
+			}
+		}
+		if prune {
+			for _, orgName := range sortedKeys(currentEntitlements[isolationSegment.Name]) {
+				if !desiredEntitlements[orgName] && desiredOrgs[orgName] {
+					deletes = append(deletes, Change{Type: ChangeDelete, Resource: EntitlementResource, Name: isolationSegment.Name, Organization: orgName})
+				}
+			}
+		}
+	}
+	if prune {
+		for _, isolationSegment := range current.IsolationSegments {
+			if desiredSegments[isolationSegment.Name] {
+				continue
+			}
+			for _, orgName := range isolationSegment.Organizations {
+				if desiredOrgs[orgName] {
+					deletes = append(deletes, Change{Type: ChangeDelete, Resource: EntitlementResource, Name: isolationSegment.Name, Organization: orgName})
+				}
+			}
+		}
+	}
+
+	var orgChanges, spaceQuotaChanges, spaceChanges, roleChanges, roleDeletes, spaceDeletes []Change
+	for _, org := range desired.Organizations {
+		existing, exists := currentOrgs[org.Name]
+		if !exists {
+			orgChanges = append(orgChanges, Change{Type: ChangeCreate, Resource: OrganizationResource, Organization: org.Name, QuotaName: org.Quota})
+		} else if org.Quota != "" && org.Quota != existing.Quota {
+			orgChanges = append(orgChanges, Change{Type: ChangeUpdate, Resource: OrganizationResource, Organization: org.Name, QuotaName: org.Quota})
+		}
+
+		currentSpaceQuotas := map[string]Quota{}
+		for _, quota := range existing.SpaceQuotas {
+			currentSpaceQuotas[quota.Name] = quota
+		}
+		for _, quota := range org.SpaceQuotas {
+			if existingQuota, ok := currentSpaceQuotas[quota.Name]; !ok {
+				spaceQuotaChanges = append(spaceQuotaChanges, Change{Type: ChangeCreate, Resource: SpaceQuotaResource, Organization: org.Name, Name: quota.Name, Quota: quota})
+			} else if existingQuota != quota {
+				spaceQuotaChanges = append(spaceQuotaChanges, Change{Type: ChangeUpdate, Resource: SpaceQuotaResource, Organization: org.Name, Name: quota.Name, Quota: quota})
+			}
+		}
+
+		for _, role := range organizationRoles(org, existing) {
+			added, removed := diffUsernames(role.current, role.desired)
+			for _, username := range added {
+				roleChanges = append(roleChanges, Change{Type: ChangeCreate, Resource: OrganizationRoleResource, Organization: org.Name, Role: string(role.role), Name: username})
+			}
+			if prune {
+				for _, username := range removed {
+					roleDeletes = append(roleDeletes, Change{Type: ChangeDelete, Resource: OrganizationRoleResource, Organization: org.Name, Role: string(role.role), Name: username})
+				}
+			}
+		}
+
+		currentSpaces := map[string]Space{}
+		for _, space := range existing.Spaces {
+			currentSpaces[space.Name] = space
+		}
+		desiredSpaces := map[string]bool{}
+		for _, space := range org.Spaces {
+			desiredSpaces[space.Name] = true
+			existingSpace, spaceExists := currentSpaces[space.Name]
+			if !spaceExists {
+				spaceChanges = append(spaceChanges, Change{Type: ChangeCreate, Resource: SpaceResource, Organization: org.Name, Space: space.Name, QuotaName: space.Quota, IsolationSegment: space.IsolationSegment})
+			} else {
+				change := Change{Type: ChangeUpdate, Resource: SpaceResource, Organization: org.Name, Space: space.Name}
+				if space.Quota != "" && space.Quota != existingSpace.Quota {
+					change.QuotaName = space.Quota
+				}
+				if space.IsolationSegment != "" && space.IsolationSegment != existingSpace.IsolationSegment {
+					change.IsolationSegment = space.IsolationSegment
+				}
+				if change.QuotaName != "" || change.IsolationSegment != "" {
+					spaceChanges = append(spaceChanges, change)
+				}
+			}
+
+			for _, role := range spaceRoles(space, existingSpace) {
+				added, removed := diffUsernames(role.current, role.desired)
+				for _, username := range added {
+					roleChanges = append(roleChanges, Change{Type: ChangeCreate, Resource: SpaceRoleResource, Organization: org.Name, Space: space.Name, Role: string(role.role), Name: username})
+				}
+				if prune {
+					for _, username := range removed {
+						roleDeletes = append(roleDeletes, Change{Type: ChangeDelete, Resource: SpaceRoleResource, Organization: org.Name, Space: space.Name, Role: string(role.role), Name: username})
+					}
+				}
+			}
+		}
+
+		if prune {
+			for _, space := range existing.Spaces {
+				if !desiredSpaces[space.Name] {
+					spaceDeletes = append(spaceDeletes, Change{Type: ChangeDelete, Resource: SpaceResource, Organization: org.Name, Space: space.Name})
+				}
+			}
+		}
+	}
+
+	var orgDeletes []Change
+	if prune {
+		for _, org := range current.Organizations {
+			if !desiredOrgs[org.Name] {
+				orgDeletes = append(orgDeletes, Change{Type: ChangeDelete, Resource: OrganizationResource, Organization: org.Name})
+			}
+		}
+	}
+
+	changes = append(changes, orgChanges...)
+	changes = append(changes, entitlementChanges...)
+	changes = append(changes, spaceQuotaChanges...)
+	changes = append(changes, spaceChanges...)
+	changes = append(changes, roleChanges...)
+	changes = append(changes, roleDeletes...)
+	changes = append(changes, spaceDeletes...)
+	changes = append(changes, deletes...)
+	changes = append(changes, orgDeletes...)
+	return changes
+}
+
+type organizationRole struct {
+	role             constant.OrganizationUserRole
+	current, desired []string
+}
+
+func organizationRoles(desired Organization, current Organization) []organizationRole {
+	return []organizationRole{
+		{constant.OrgManagerRole, current.Managers, desired.Managers},
+		{constant.OrgBillingManagerRole, current.BillingManagers, desired.BillingManagers},
+		{constant.OrgAuditorRole, current.Auditors, desired.Auditors},
+	}
+}
+
+type spaceRole struct {
+	role             constant.SpaceUserRole
+	current, desired []string
+}
+
+func spaceRoles(desired Space, current Space) []spaceRole {
+	return []spaceRole{
+		{constant.SpaceManagerRole, current.Managers, desired.Managers},
+		{constant.SpaceDeveloperRole, current.Developers, desired.Developers},
+		{constant.SpaceAuditorRole, current.Auditors, desired.Auditors},
+	}
+}
+
+// diffUsernames returns the sorted usernames that are only in desired and
+// only in current. Usernames are compared case-insensitively, the way the UAA
+// looks them up.
+func diffUsernames(current []string, desired []string) ([]string, []string) {
+	var added, removed []string
+	for _, username := range sortedKeys(stringSet(desired)) {
+		if !containsUsername(current, username) {
+			added = append(added, username)
+		}
+	}
+	for _, username := range sortedKeys(stringSet(current)) {
+		if !containsUsername(desired, username) {
+			removed = append(removed, username)
+		}
+	}
+	return added, removed
+}
+
+func containsUsername(usernames []string, username string) bool {
+	for _, candidate := range usernames {
+		if strings.EqualFold(candidate, username) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringSet(values []string) map[string]bool {
+	set := map[string]bool{}
+	for _, s := range values {
+		set[s] = true
+	}
+	return set
+}
+
+func sortedKeys(set map[string]bool) []string {
+	var keys []string
+	for key := range set {
+		keys = append(keys, key)
+	}
+	return sortedStrings(keys)
+}
@@ -0,0 +1,326 @@
+package foundationaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/foundationaction/foundationactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *foundationactionfakes.FakeV2Actor
+		fakeV3Actor *foundationactionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(foundationactionfakes.FakeV2Actor)
+		fakeV3Actor = new(foundationactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("Deletions", func() {
+		It("returns only the changes that delete a resource", func() {
+			changes := []Change{
+				{Type: ChangeCreate, Resource: OrganizationResource, Organization: "research"},
+				{Type: ChangeDelete, Resource: SpaceResource, Organization: "acme", Space: "old"},
+				{Type: ChangeUpdate, Resource: SpaceResource, Organization: "acme", Space: "dev"},
+				{Type: ChangeDelete, Resource: OrganizationResource, Organization: "legacy"},
+			}
+
+			Expect(Deletions(changes)).To(Equal([]Change{
+				{Type: ChangeDelete, Resource: SpaceResource, Organization: "acme", Space: "old"},
+				{Type: ChangeDelete, Resource: OrganizationResource, Organization: "legacy"},
+			}))
+		})
+	})
+
+	Describe("PlanFoundation", func() {
+		var (
+			dir        string
+			prune      bool
+			changes    []Change
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "foundation")
+			Expect(err).ToNot(HaveOccurred())
+			prune = false
+
+			fakeV2Actor.GetOrganizationQuotasReturns(
+				[]v2action.OrganizationQuota{{GUID: "small-guid", Name: "small", MemoryLimit: 1024}},
+				v2action.Warnings{"quotas-warning"},
+				nil)
+			fakeV3Actor.GetIsolationSegmentSummariesReturns(
+				[]v3action.IsolationSegmentSummary{{Name: "secure", EntitledOrgs: []string{"acme", "legacy"}}},
+				nil,
+				nil)
+			fakeV2Actor.GetOrganizationsReturns(
+				[]v2action.Organization{
+					{GUID: "acme-guid", Name: "acme", QuotaDefinitionGUID: "small-guid"},
+					{GUID: "legacy-guid", Name: "legacy"},
+				},
+				nil,
+				nil)
+			fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrganizationUserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.OrgManagerRole && orgGUID == "acme-guid" {
+					return []v2action.User{{Username: "alice"}, {Username: "mallory"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeV2Actor.GetOrganizationSpacesStub = func(orgGUID string) ([]v2action.Space, v2action.Warnings, error) {
+				if orgGUID == "acme-guid" {
+					return []v2action.Space{{GUID: "dev-guid", Name: "dev"}, {GUID: "old-guid", Name: "old"}}, nil, nil
+				}
+				return []v2action.Space{{GUID: "legacy-space-guid", Name: "legacy-space"}}, nil, nil
+			}
+			fakeV3Actor.GetEffectiveIsolationSegmentBySpaceReturns(v3action.IsolationSegment{}, nil, v3action.NoRelationshipError{})
+
+			Expect(actor.WriteFoundation(dir, Foundation{
+				OrganizationQuotas: []Quota{
+					{Name: "small", MemoryLimit: 2048},
+					{Name: "large", MemoryLimit: 10240},
+				},
+				IsolationSegments: []IsolationSegment{
+					{Name: "secure", Organizations: []string{"acme"}},
+					{Name: "gpu", Organizations: []string{"acme", "research"}},
+				},
+				Organizations: []Organization{
+					{
+						Name:        "acme",
+						Quota:       "large",
+						Managers:    []string{"alice", "bob"},
+						SpaceQuotas: []Quota{{Name: "dev-quota", MemoryLimit: 512}},
+						Spaces: []Space{
+							{Name: "dev", Quota: "dev-quota", IsolationSegment: "secure", Developers: []string{"bob"}},
+						},
+					},
+					{
+						Name:   "research",
+						Spaces: []Space{{Name: "lab", IsolationSegment: "gpu"}},
+					},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.PlanFoundation(dir, prune)
+		})
+
+		It("returns the creates and updates in the order they must be applied", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("quotas-warning"))
+			Expect(changes).To(Equal([]Change{
+				{Type: ChangeUpdate, Resource: OrganizationQuotaResource, Name: "small", Quota: Quota{Name: "small", MemoryLimit: 2048}},
+				{Type: ChangeCreate, Resource: OrganizationQuotaResource, Name: "large", Quota: Quota{Name: "large", MemoryLimit: 10240}},
+				{Type: ChangeCreate, Resource: IsolationSegmentResource, Name: "gpu"},
+				{Type: ChangeUpdate, Resource: OrganizationResource, Organization: "acme", QuotaName: "large"},
+				{Type: ChangeCreate, Resource: OrganizationResource, Organization: "research"},
+				{Type: ChangeCreate, Resource: EntitlementResource, Name: "gpu", Organization: "acme"},
+				{Type: ChangeCreate, Resource: EntitlementResource, Name: "gpu", Organization: "research"},
+				{Type: ChangeCreate, Resource: SpaceQuotaResource, Organization: "acme", Name: "dev-quota", Quota: Quota{Name: "dev-quota", MemoryLimit: 512}},
+				{Type: ChangeUpdate, Resource: SpaceResource, Organization: "acme", Space: "dev", QuotaName: "dev-quota", IsolationSegment: "secure"},
+				{Type: ChangeCreate, Resource: SpaceResource, Organization: "research", Space: "lab", IsolationSegment: "gpu"},
+				{Type: ChangeCreate, Resource: OrganizationRoleResource, Organization: "acme", Role: "managers", Name: "bob"},
+				{Type: ChangeCreate, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "bob"},
+			}))
+		})
+
+		Context("when pruning", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("also returns the deletes of the orgs, spaces, entitlements and roles that are not in the directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes[len(changes)-3:]).To(Equal([]Change{
+					{Type: ChangeDelete, Resource: OrganizationRoleResource, Organization: "acme", Role: "managers", Name: "mallory"},
+					{Type: ChangeDelete, Resource: SpaceResource, Organization: "acme", Space: "old"},
+					{Type: ChangeDelete, Resource: OrganizationResource, Organization: "legacy"},
+				}))
+			})
+
+			Context("when the directory has no orgs", func() {
+				BeforeEach(func() {
+					Expect(os.RemoveAll(filepath.Join(dir, "orgs"))).To(Succeed())
+					Expect(os.Mkdir(filepath.Join(dir, "orgs"), 0755)).To(Succeed())
+					Expect(ioutil.WriteFile(filepath.Join(dir, "isolation_segments.yml"), []byte("[]\n"), 0644)).To(Succeed())
+				})
+
+				It("refuses to prune without getting the foundation", func() {
+					Expect(executeErr).To(MatchError(InvalidFoundationDirectoryError{
+						Path:    dir,
+						Message: "no org files in orgs, refusing to prune every org",
+					}))
+					Expect(fakeV2Actor.GetOrganizationsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the directory is invalid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "organization_quotas.yml"), []byte("- name: small\n"), 0644)).To(Succeed())
+			})
+
+			It("returns the error without getting the foundation", func() {
+				Expect(executeErr).To(MatchError(InvalidFoundationDirectoryError{
+					Path:    dir,
+					Message: "org acme: quota large is not in organization_quotas.yml",
+				}))
+				Expect(fakeV2Actor.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ApplyChange", func() {
+		var (
+			change     Change
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "acme-guid", Name: "acme"}, v2action.Warnings{"org-warning"}, nil)
+			fakeV2Actor.GetOrganizationQuotasReturns([]v2action.OrganizationQuota{{GUID: "large-guid", Name: "large"}}, v2action.Warnings{"quotas-warning"}, nil)
+			fakeV2Actor.GetSpaceQuotasByOrganizationReturns([]v2action.SpaceQuota{{GUID: "dev-quota-guid", Name: "dev-quota"}}, nil, nil)
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "dev-guid", Name: "dev"}, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyChange(change)
+		})
+
+		Context("when updating an org quota", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeUpdate, Resource: OrganizationQuotaResource, Name: "large", Quota: Quota{Name: "large", MemoryLimit: 10240}}
+				fakeV2Actor.UpdateOrganizationQuotaReturns(v2action.OrganizationQuota{}, v2action.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates the quota with the same name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("quotas-warning", "update-warning"))
+
+				quota := fakeV2Actor.UpdateOrganizationQuotaArgsForCall(0)
+				Expect(quota.GUID).To(Equal("large-guid"))
+				Expect(quota.MemoryLimit).To(Equal(10240))
+			})
+		})
+
+		Context("when creating an org with a quota", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeCreate, Resource: OrganizationResource, Organization: "research", QuotaName: "large"}
+			})
+
+			It("creates the org with the quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				orgName, quotaGUID := fakeV2Actor.CreateOrganizationArgsForCall(0)
+				Expect(orgName).To(Equal("research"))
+				Expect(quotaGUID).To(Equal("large-guid"))
+			})
+		})
+
+		Context("when the quota does not exist", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeUpdate, Resource: OrganizationResource, Organization: "acme", QuotaName: "missing"}
+			})
+
+			It("returns a QuotaNotFoundError", func() {
+				Expect(executeErr).To(MatchError(QuotaNotFoundError{Name: "missing"}))
+				Expect(fakeV2Actor.SetOrganizationQuotaCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when entitling an isolation segment", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeCreate, Resource: EntitlementResource, Name: "gpu", Organization: "acme"}
+				fakeV3Actor.EntitleIsolationSegmentToOrganizationByNameReturns(v3action.Warnings{"entitle-warning"}, nil)
+			})
+
+			It("entitles the isolation segment to the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("entitle-warning"))
+
+				segmentName, orgName := fakeV3Actor.EntitleIsolationSegmentToOrganizationByNameArgsForCall(0)
+				Expect(segmentName).To(Equal("gpu"))
+				Expect(orgName).To(Equal("acme"))
+			})
+		})
+
+		Context("when creating a space with a quota and an isolation segment", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeCreate, Resource: SpaceResource, Organization: "acme", Space: "lab", QuotaName: "dev-quota", IsolationSegment: "gpu"}
+				fakeV2Actor.CreateSpaceReturns(v2action.Space{GUID: "lab-guid"}, v2action.Warnings{"space-warning"}, nil)
+			})
+
+			It("creates the space and assigns it the isolation segment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-warning", "space-warning"))
+
+				orgGUID, spaceName, quotaGUID := fakeV2Actor.CreateSpaceArgsForCall(0)
+				Expect(orgGUID).To(Equal("acme-guid"))
+				Expect(spaceName).To(Equal("lab"))
+				Expect(quotaGUID).To(Equal("dev-quota-guid"))
+
+				segmentName, spaceGUID := fakeV3Actor.AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(0)
+				Expect(segmentName).To(Equal("gpu"))
+				Expect(spaceGUID).To(Equal("lab-guid"))
+			})
+		})
+
+		Context("when creating the space fails", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeCreate, Resource: SpaceResource, Organization: "acme", Space: "lab", IsolationSegment: "gpu"}
+				fakeV2Actor.CreateSpaceReturns(v2action.Space{}, v2action.Warnings{"space-warning"}, errors.New("create-error"))
+			})
+
+			It("returns the error without assigning the isolation segment", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("org-warning", "space-warning"))
+				Expect(fakeV3Actor.AssignIsolationSegmentToSpaceByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting a space role", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeDelete, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "mallory"}
+			})
+
+			It("removes the role from the user", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				role, spaceGUID, username := fakeV2Actor.UnsetSpaceRoleByUsernameArgsForCall(0)
+				Expect(role).To(Equal(constant.SpaceDeveloperRole))
+				Expect(spaceGUID).To(Equal("dev-guid"))
+				Expect(username).To(Equal("mallory"))
+			})
+		})
+
+		Context("when deleting an org", func() {
+			BeforeEach(func() {
+				change = Change{Type: ChangeDelete, Resource: OrganizationResource, Organization: "legacy"}
+			})
+
+			It("deletes the org by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV2Actor.DeleteOrganizationArgsForCall(0)).To(Equal("legacy"))
+			})
+		})
+	})
+})
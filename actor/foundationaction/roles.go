@@ -0,0 +1,434 @@
+package foundationaction
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/uaa"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// UserResource is the kind of resource of a change that creates a user in
+// UAA before it is given a role.
+const UserResource ResourceType = "user"
+
+// The role names used in roles CSV files, which match the role names of
+// set-org-role and set-space-role.
+const (
+	csvOrgManagerRole     = "OrgManager"
+	csvBillingManagerRole = "BillingManager"
+	csvOrgAuditorRole     = "OrgAuditor"
+	csvSpaceManagerRole   = "SpaceManager"
+	csvSpaceDeveloperRole = "SpaceDeveloper"
+	csvSpaceAuditorRole   = "SpaceAuditor"
+)
+
+var rolesCSVHeader = []string{"org", "space", "role", "username"}
+
+// InvalidRolesFileError is returned when a roles file cannot be parsed.
+type InvalidRolesFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidRolesFileError) Error() string {
+	return fmt.Sprintf("Invalid roles file %s: %s", e.Path, e.Message)
+}
+
+// OrganizationRoles is the users with each role in an organization and its
+// spaces.
+type OrganizationRoles struct {
+	Name            string       `yaml:"name"`
+	Managers        []string     `yaml:"managers,omitempty"`
+	BillingManagers []string     `yaml:"billing_managers,omitempty"`
+	Auditors        []string     `yaml:"auditors,omitempty"`
+	Spaces          []SpaceRoles `yaml:"spaces,omitempty"`
+}
+
+// SpaceRoles is the users with each role in a space.
+type SpaceRoles struct {
+	Name       string   `yaml:"name"`
+	Managers   []string `yaml:"managers,omitempty"`
+	Developers []string `yaml:"developers,omitempty"`
+	Auditors   []string `yaml:"auditors,omitempty"`
+}
+
+// GetOrganizationRoles returns the users with each role in the organization
+// and its spaces.
+func (actor Actor) GetOrganizationRoles(orgName string) (OrganizationRoles, Warnings, error) {
+	org, allWarnings, err := actor.getOrganization(orgName)
+	if err != nil {
+		return OrganizationRoles{}, allWarnings, err
+	}
+
+	roles, warnings, err := actor.getOrganizationRoles(org)
+	allWarnings = append(allWarnings, warnings...)
+	return roles, allWarnings, err
+}
+
+// ExportRoles writes the roles of the organization to path in the format
+// read by ReadRoles.
+func (actor Actor) ExportRoles(orgName string, path string) (Warnings, error) {
+	roles, warnings, err := actor.GetOrganizationRoles(orgName)
+	if err != nil {
+		return warnings, err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return warnings, err
+	}
+	defer file.Close()
+
+	return warnings, actor.WriteRoles(file, []OrganizationRoles{roles}, isCSVFile(path))
+}
+
+// WriteRoles writes the roles to writer as CSV when csvFormat is true and as
+// YAML otherwise.
+func (Actor) WriteRoles(writer io.Writer, roles []OrganizationRoles, csvFormat bool) error {
+	if !csvFormat {
+		raw, err := yaml.Marshal(roles)
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(raw)
+		return err
+	}
+
+	records := [][]string{rolesCSVHeader}
+	for _, org := range roles {
+		for _, role := range []struct {
+			name      string
+			usernames []string
+		}{
+			{csvOrgManagerRole, org.Managers},
+			{csvBillingManagerRole, org.BillingManagers},
+			{csvOrgAuditorRole, org.Auditors},
+		} {
+			for _, username := range role.usernames {
+				records = append(records, []string{org.Name, "", role.name, username})
+			}
+		}
+
+		for _, space := range org.Spaces {
+			for _, role := range []struct {
+				name      string
+				usernames []string
+			}{
+				{csvSpaceManagerRole, space.Managers},
+				{csvSpaceDeveloperRole, space.Developers},
+				{csvSpaceAuditorRole, space.Auditors},
+			} {
+				for _, username := range role.usernames {
+					records = append(records, []string{org.Name, space.Name, role.name, username})
+				}
+			}
+		}
+	}
+
+	return csv.NewWriter(writer).WriteAll(records)
+}
+
+// ReadRoles reads the roles of organizations from path. A file with a .csv
+// extension has rows of org, space, role and username, with an empty space
+// for org roles; any other file is YAML in the format written by
+// ExportRoles.
+func (Actor) ReadRoles(path string) ([]OrganizationRoles, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []OrganizationRoles
+	if isCSVFile(path) {
+		roles, err = parseRolesCSV(raw)
+	} else {
+		err = yaml.Unmarshal(raw, &roles)
+		if err != nil {
+			err = errors.New(strings.TrimPrefix(err.Error(), "yaml: "))
+		}
+	}
+	if err == nil {
+		err = validateRoles(roles)
+	}
+	if err != nil {
+		return nil, InvalidRolesFileError{Path: path, Message: err.Error()}
+	}
+
+	return roles, nil
+}
+
+// PlanRoles returns the role changes that give the users in the roles file
+// at path their roles. The organizations and spaces in the file must exist.
+// When prune is true, roles in those organizations and spaces that are not
+// in the file are removed. When origin is not empty, every user that is
+// given a role is first created in UAA with that origin.
+func (actor Actor) PlanRoles(path string, prune bool, origin string) ([]Change, Warnings, error) {
+	desired, err := actor.ReadRoles(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		allWarnings          Warnings
+		changes, roleDeletes []Change
+		users                []string
+	)
+	seenUsers := map[string]bool{}
+	addChange := func(change Change) {
+		changes = append(changes, change)
+		if !seenUsers[change.Name] {
+			seenUsers[change.Name] = true
+			users = append(users, change.Name)
+		}
+	}
+
+	for _, org := range desired {
+		v2Org, warnings, err := actor.getOrganization(org.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		current, warnings, err := actor.getOrganizationRoles(v2Org)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, role := range organizationRoles(org.organization(), current.organization()) {
+			added, removed := diffUsernames(role.current, role.desired)
+			for _, username := range added {
+				addChange(Change{Type: ChangeCreate, Resource: OrganizationRoleResource, Organization: org.Name, Role: string(role.role), Name: username})
+			}
+			if prune {
+				for _, username := range removed {
+					roleDeletes = append(roleDeletes, Change{Type: ChangeDelete, Resource: OrganizationRoleResource, Organization: org.Name, Role: string(role.role), Name: username})
+				}
+			}
+		}
+
+		currentSpaces := map[string]SpaceRoles{}
+		for _, space := range current.Spaces {
+			currentSpaces[space.Name] = space
+		}
+		for _, space := range org.Spaces {
+			currentSpace, ok := currentSpaces[space.Name]
+			if !ok {
+				return nil, allWarnings, v2action.SpaceNotFoundError{Name: space.Name}
+			}
+
+			for _, role := range spaceRoles(space.space(), currentSpace.space()) {
+				added, removed := diffUsernames(role.current, role.desired)
+				for _, username := range added {
+					addChange(Change{Type: ChangeCreate, Resource: SpaceRoleResource, Organization: org.Name, Space: space.Name, Role: string(role.role), Name: username})
+				}
+				if prune {
+					for _, username := range removed {
+						roleDeletes = append(roleDeletes, Change{Type: ChangeDelete, Resource: SpaceRoleResource, Organization: org.Name, Space: space.Name, Role: string(role.role), Name: username})
+					}
+				}
+			}
+		}
+	}
+
+	var userChanges []Change
+	if origin != "" {
+		for _, username := range users {
+			userChanges = append(userChanges, Change{Type: ChangeCreate, Resource: UserResource, Name: username, Origin: origin})
+		}
+	}
+
+	return append(append(userChanges, changes...), roleDeletes...), allWarnings, nil
+}
+
+// applyUserChange creates the user in UAA with the change's origin. A user
+// that already exists is left as it is.
+func (actor Actor) applyUserChange(change Change) (Warnings, error) {
+	_, warnings, err := actor.V2Actor.CreateUser(change.Name, "", change.Origin)
+	if _, ok := err.(uaa.ConflictError); ok {
+		return Warnings(warnings), nil
+	}
+	return Warnings(warnings), err
+}
+
+func (actor Actor) getOrganizationRoles(org v2action.Organization) (OrganizationRoles, Warnings, error) {
+	var allWarnings Warnings
+
+	roles := OrganizationRoles{Name: org.Name}
+	for _, role := range []struct {
+		role      constant.OrganizationUserRole
+		usernames *[]string
+	}{
+		{constant.OrgManagerRole, &roles.Managers},
+		{constant.OrgBillingManagerRole, &roles.BillingManagers},
+		{constant.OrgAuditorRole, &roles.Auditors},
+	} {
+		users, warnings, err := actor.V2Actor.GetOrganizationUsersByRole(role.role, org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationRoles{}, allWarnings, err
+		}
+		*role.usernames = usernames(users)
+	}
+
+	spaces, warnings, err := actor.V2Actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationRoles{}, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		spaceRoles := SpaceRoles{Name: space.Name}
+		for _, role := range []struct {
+			role      constant.SpaceUserRole
+			usernames *[]string
+		}{
+			{constant.SpaceManagerRole, &spaceRoles.Managers},
+			{constant.SpaceDeveloperRole, &spaceRoles.Developers},
+			{constant.SpaceAuditorRole, &spaceRoles.Auditors},
+		} {
+			users, warnings, err := actor.V2Actor.GetSpaceUsersByRole(role.role, space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return OrganizationRoles{}, allWarnings, err
+			}
+			*role.usernames = usernames(users)
+		}
+		roles.Spaces = append(roles.Spaces, spaceRoles)
+	}
+
+	return roles, allWarnings, nil
+}
+
+func (roles OrganizationRoles) organization() Organization {
+	return Organization{
+		Name:            roles.Name,
+		Managers:        roles.Managers,
+		BillingManagers: roles.BillingManagers,
+		Auditors:        roles.Auditors,
+	}
+}
+
+func (roles SpaceRoles) space() Space {
+	return Space{
+		Name:       roles.Name,
+		Managers:   roles.Managers,
+		Developers: roles.Developers,
+		Auditors:   roles.Auditors,
+	}
+}
+
+func parseRolesCSV(raw []byte) ([]OrganizationRoles, error) {
+	reader := csv.NewReader(bytes.NewReader(raw))
+	reader.FieldsPerRecord = len(rolesCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	var (
+		roles []OrganizationRoles
+		line  int
+	)
+	orgIndexes := map[string]int{}
+	spaceIndexes := map[string]map[string]int{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line++
+		if line == 1 && strings.EqualFold(record[0], rolesCSVHeader[0]) {
+			continue
+		}
+
+		orgName, spaceName, roleName, username := record[0], record[1], record[2], record[3]
+		if orgName == "" || username == "" {
+			return nil, fmt.Errorf("line %d: every row must have an org and a username", line)
+		}
+
+		orgIndex, ok := orgIndexes[orgName]
+		if !ok {
+			orgIndex = len(roles)
+			orgIndexes[orgName] = orgIndex
+			spaceIndexes[orgName] = map[string]int{}
+			roles = append(roles, OrganizationRoles{Name: orgName})
+		}
+		org := &roles[orgIndex]
+
+		if spaceName == "" {
+			switch roleName {
+			case csvOrgManagerRole:
+				org.Managers = append(org.Managers, username)
+			case csvBillingManagerRole:
+				org.BillingManagers = append(org.BillingManagers, username)
+			case csvOrgAuditorRole:
+				org.Auditors = append(org.Auditors, username)
+			default:
+				return nil, fmt.Errorf("line %d: role %s is not one of %s, %s or %s", line, roleName, csvOrgManagerRole, csvBillingManagerRole, csvOrgAuditorRole)
+			}
+			continue
+		}
+
+		spaceIndex, ok := spaceIndexes[orgName][spaceName]
+		if !ok {
+			spaceIndex = len(org.Spaces)
+			spaceIndexes[orgName][spaceName] = spaceIndex
+			org.Spaces = append(org.Spaces, SpaceRoles{Name: spaceName})
+		}
+		space := &org.Spaces[spaceIndex]
+
+		switch roleName {
+		case csvSpaceManagerRole:
+			space.Managers = append(space.Managers, username)
+		case csvSpaceDeveloperRole:
+			space.Developers = append(space.Developers, username)
+		case csvSpaceAuditorRole:
+			space.Auditors = append(space.Auditors, username)
+		default:
+			return nil, fmt.Errorf("line %d: role %s is not one of %s, %s or %s", line, roleName, csvSpaceManagerRole, csvSpaceDeveloperRole, csvSpaceAuditorRole)
+		}
+	}
+
+	return roles, nil
+}
+
+func validateRoles(roles []OrganizationRoles) error {
+	orgs := map[string]bool{}
+	for _, org := range roles {
+		if org.Name == "" {
+			return errors.New("every org must have a name")
+		}
+		if orgs[org.Name] {
+			return fmt.Errorf("org %s is defined more than once", org.Name)
+		}
+		orgs[org.Name] = true
+
+		spaces := map[string]bool{}
+		for _, space := range org.Spaces {
+			if space.Name == "" {
+				return fmt.Errorf("org %s: every space must have a name", org.Name)
+			}
+			if spaces[space.Name] {
+				return fmt.Errorf("org %s: space %s is defined more than once", org.Name, space.Name)
+			}
+			spaces[space.Name] = true
+		}
+	}
+
+	return nil
+}
+
+func isCSVFile(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".csv")
+}
@@ -0,0 +1,261 @@
+package foundationaction_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/foundationaction/foundationactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Roles Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *foundationactionfakes.FakeV2Actor
+		dir         string
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(foundationactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, new(foundationactionfakes.FakeV3Actor))
+
+		var err error
+		dir, err = ioutil.TempDir("", "roles")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("ReadRoles", func() {
+		It("reads the org and space roles from a CSV file", func() {
+			path := filepath.Join(dir, "roles.csv")
+			Expect(ioutil.WriteFile(path, []byte("org,space,role,username\nacme,,OrgManager,alice\nacme,dev,SpaceDeveloper,bob\nacme,dev,SpaceDeveloper,carol\n"), 0644)).To(Succeed())
+
+			roles, err := actor.ReadRoles(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(roles).To(Equal([]OrganizationRoles{
+				{
+					Name:     "acme",
+					Managers: []string{"alice"},
+					Spaces:   []SpaceRoles{{Name: "dev", Developers: []string{"bob", "carol"}}},
+				},
+			}))
+		})
+
+		It("reads back the roles written by WriteRoles", func() {
+			roles := []OrganizationRoles{
+				{
+					Name:     "acme",
+					Auditors: []string{"alice"},
+					Spaces:   []SpaceRoles{{Name: "dev", Managers: []string{"bob"}}},
+				},
+			}
+
+			for _, name := range []string{"roles.yml", "roles.csv"} {
+				buffer := new(bytes.Buffer)
+				Expect(actor.WriteRoles(buffer, roles, filepath.Ext(name) == ".csv")).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, name), buffer.Bytes(), 0644)).To(Succeed())
+
+				readRoles, err := actor.ReadRoles(filepath.Join(dir, name))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readRoles).To(Equal(roles))
+			}
+		})
+
+		Context("when a CSV row has an unknown role", func() {
+			It("returns an InvalidRolesFileError", func() {
+				path := filepath.Join(dir, "roles.csv")
+				Expect(ioutil.WriteFile(path, []byte("acme,dev,OrgManager,alice\n"), 0644)).To(Succeed())
+
+				_, err := actor.ReadRoles(path)
+				Expect(err).To(MatchError(InvalidRolesFileError{
+					Path:    path,
+					Message: "line 1: role OrgManager is not one of SpaceManager, SpaceDeveloper or SpaceAuditor",
+				}))
+			})
+		})
+
+		Context("when an org is defined more than once", func() {
+			It("returns an InvalidRolesFileError", func() {
+				path := filepath.Join(dir, "roles.yml")
+				Expect(ioutil.WriteFile(path, []byte("- name: acme\n- name: acme\n"), 0644)).To(Succeed())
+
+				_, err := actor.ReadRoles(path)
+				Expect(err).To(MatchError(InvalidRolesFileError{Path: path, Message: "org acme is defined more than once"}))
+			})
+		})
+	})
+
+	Describe("PlanRoles", func() {
+		var (
+			path       string
+			prune      bool
+			origin     string
+			changes    []Change
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			prune = false
+			origin = ""
+			path = filepath.Join(dir, "roles.yml")
+			Expect(ioutil.WriteFile(path, []byte(`
+- name: acme
+  managers: [alice, bob]
+  spaces:
+  - name: dev
+    developers: [carol]
+`), 0644)).To(Succeed())
+
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "acme-guid", Name: "acme"}, v2action.Warnings{"org-warning"}, nil)
+			fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrganizationUserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.OrgManagerRole {
+					return []v2action.User{{Username: "alice"}, {Username: "dave"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeV2Actor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "dev-guid", Name: "dev"}, {GUID: "prod-guid", Name: "prod"}}, v2action.Warnings{"spaces-warning"}, nil)
+			fakeV2Actor.GetSpaceUsersByRoleStub = func(role constant.SpaceUserRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.SpaceAuditorRole {
+					return []v2action.User{{Username: "erin"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.PlanRoles(path, prune, origin)
+		})
+
+		It("adds the missing roles", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "spaces-warning"))
+			Expect(changes).To(Equal([]Change{
+				{Type: ChangeCreate, Resource: OrganizationRoleResource, Organization: "acme", Role: "managers", Name: "bob"},
+				{Type: ChangeCreate, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "carol"},
+			}))
+		})
+
+		Context("when prune is true", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("removes the roles of the listed orgs and spaces that are not in the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal([]Change{
+					{Type: ChangeCreate, Resource: OrganizationRoleResource, Organization: "acme", Role: "managers", Name: "bob"},
+					{Type: ChangeCreate, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "carol"},
+					{Type: ChangeDelete, Resource: OrganizationRoleResource, Organization: "acme", Role: "managers", Name: "dave"},
+					{Type: ChangeDelete, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "auditors", Name: "erin"},
+				}))
+			})
+
+			Context("when a username differs only in case", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrganizationUserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error) {
+						if role == constant.OrgManagerRole {
+							return []v2action.User{{Username: "Alice"}, {Username: "BOB"}}, nil, nil
+						}
+						return nil, nil, nil
+					}
+				})
+
+				It("treats it as the same user", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(changes).To(Equal([]Change{
+						{Type: ChangeCreate, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "carol"},
+						{Type: ChangeDelete, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "auditors", Name: "erin"},
+					}))
+				})
+			})
+		})
+
+		Context("when an origin is provided", func() {
+			BeforeEach(func() {
+				origin = "ldap"
+			})
+
+			It("creates the users that are given roles first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal([]Change{
+					{Type: ChangeCreate, Resource: UserResource, Name: "bob", Origin: "ldap"},
+					{Type: ChangeCreate, Resource: UserResource, Name: "carol", Origin: "ldap"},
+					{Type: ChangeCreate, Resource: OrganizationRoleResource, Organization: "acme", Role: "managers", Name: "bob"},
+					{Type: ChangeCreate, Resource: SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "carol"},
+				}))
+			})
+		})
+
+		Context("when a space in the file does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "prod-guid", Name: "prod"}}, nil, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(v2action.SpaceNotFoundError{Name: "dev"}))
+			})
+		})
+
+		Context("when an org in the file does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"org-warning"}, v2action.OrganizationNotFoundError{Name: "acme"})
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(v2action.OrganizationNotFoundError{Name: "acme"}))
+				Expect(warnings).To(ConsistOf("org-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyChange for a user", func() {
+		var change Change
+
+		BeforeEach(func() {
+			change = Change{Type: ChangeCreate, Resource: UserResource, Name: "bob", Origin: "ldap"}
+		})
+
+		It("creates the user with the origin", func() {
+			fakeV2Actor.CreateUserReturns(v2action.User{}, v2action.Warnings{"user-warning"}, nil)
+
+			warnings, err := actor.ApplyChange(change)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("user-warning"))
+
+			username, password, origin := fakeV2Actor.CreateUserArgsForCall(0)
+			Expect(username).To(Equal("bob"))
+			Expect(password).To(BeEmpty())
+			Expect(origin).To(Equal("ldap"))
+		})
+
+		Context("when the user already exists", func() {
+			It("succeeds", func() {
+				fakeV2Actor.CreateUserReturns(v2action.User{}, nil, uaa.ConflictError{Message: "Username already in use"})
+
+				_, err := actor.ApplyChange(change)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when creating the user fails", func() {
+			It("returns the error", func() {
+				fakeV2Actor.CreateUserReturns(v2action.User{}, nil, errors.New("user-error"))
+
+				_, err := actor.ApplyChange(change)
+				Expect(err).To(MatchError("user-error"))
+			})
+		})
+	})
+})
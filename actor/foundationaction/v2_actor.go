@@ -0,0 +1,34 @@
+package foundationaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+//go:generate counterfeiter . V2Actor
+
+type V2Actor interface {
+	CreateOrganization(orgName string, quotaGUID string) (v2action.Organization, v2action.Warnings, error)
+	CreateOrganizationQuota(quota v2action.OrganizationQuota) (v2action.OrganizationQuota, v2action.Warnings, error)
+	CreateSpace(orgGUID string, spaceName string, spaceQuotaGUID string) (v2action.Space, v2action.Warnings, error)
+	CreateSpaceQuota(quota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+	CreateUser(username string, password string, origin string) (v2action.User, v2action.Warnings, error)
+	DeleteOrganization(orgName string) (v2action.Warnings, error)
+	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetOrganizationQuotas() ([]v2action.OrganizationQuota, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetOrganizationUsersByRole(role constant.OrganizationUserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetSpaceQuotasByOrganization(orgGUID string) ([]v2action.SpaceQuota, v2action.Warnings, error)
+	GetSpaceUsersByRole(role constant.SpaceUserRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error)
+	SetOrganizationQuota(orgGUID string, quotaGUID string) (v2action.Warnings, error)
+	SetOrganizationRoleByUsername(role constant.OrganizationUserRole, orgGUID string, username string) (v2action.Warnings, error)
+	SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error)
+	SetSpaceRoleByUsername(role constant.SpaceUserRole, orgGUID string, spaceGUID string, username string) (v2action.Warnings, error)
+	UnsetOrganizationRoleByUsername(role constant.OrganizationUserRole, orgGUID string, username string) (v2action.Warnings, error)
+	UnsetSpaceRoleByUsername(role constant.SpaceUserRole, spaceGUID string, username string) (v2action.Warnings, error)
+	UpdateOrganizationQuota(quota v2action.OrganizationQuota) (v2action.OrganizationQuota, v2action.Warnings, error)
+	UpdateSpaceQuota(quota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+}
@@ -0,0 +1,250 @@
+package pluginaction
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Alias is a user defined command alias.
+type Alias struct {
+	Name    string
+	Command string
+}
+
+// AliasNameConflictError is returned when an alias name is the name or alias
+// of a core or installed plugin command.
+type AliasNameConflictError struct {
+	Name string
+}
+
+func (AliasNameConflictError) Error() string {
+	return ""
+}
+
+// AliasNotFoundError is returned when an alias does not exist.
+type AliasNotFoundError struct {
+	Name string
+}
+
+func (AliasNotFoundError) Error() string {
+	return ""
+}
+
+// AliasInvalidError is returned when an alias command is empty, has an
+// unterminated quote, or does not start with a valid command name.
+type AliasInvalidError struct {
+	Name string
+}
+
+func (AliasInvalidError) Error() string {
+	return ""
+}
+
+// AliasNameInvalidError is returned when an alias name is empty, starts with
+// a dash or contains whitespace.
+type AliasNameInvalidError struct {
+	Name string
+}
+
+func (AliasNameInvalidError) Error() string {
+	return ""
+}
+
+// AliasArgumentsError is returned when an alias is called with fewer
+// arguments than its command refers to.
+type AliasArgumentsError struct {
+	Name     string
+	Expected int
+}
+
+func (AliasArgumentsError) Error() string {
+	return ""
+}
+
+var aliasArgumentReference = regexp.MustCompile(`\$(\d+|@)`)
+
+// GetAliases returns the user defined aliases sorted by name.
+func (actor Actor) GetAliases() []Alias {
+	aliases := []Alias{}
+	for name, command := range actor.config.Aliases() {
+		aliases = append(aliases, Alias{Name: name, Command: command})
+	}
+
+	sort.Slice(aliases, func(i, j int) bool {
+		return strings.ToLower(aliases[i].Name) < strings.ToLower(aliases[j].Name)
+	})
+
+	return aliases
+}
+
+// SetAlias adds or replaces an alias. The alias name cannot be the name or
+// alias of a core or installed plugin command. Neither the alias name nor the
+// command name the alias starts with can be empty, start with a dash or
+// contain whitespace.
+//
+// The command can refer to the arguments the alias is called with as $1, $2,
+// etc., or to all of them as $@. Arguments that are not referred to are
+// appended to the end of the command.
+func (actor Actor) SetAlias(commandList CommandList, name string, command string) error {
+	if !validAliasCommandName(name) {
+		return AliasNameInvalidError{Name: name}
+	}
+
+	if commandNameTaken(commandList, actor.config.Plugins(), name, "") {
+		return AliasNameConflictError{Name: name}
+	}
+
+	words, ok := splitAliasCommand(command)
+	if !ok || len(words) == 0 || !validAliasCommandName(words[0]) {
+		return AliasInvalidError{Name: name}
+	}
+
+	actor.config.SetAlias(name, command)
+	return nil
+}
+
+// RemoveAlias removes an alias.
+func (actor Actor) RemoveAlias(name string) error {
+	if _, found := actor.config.GetAlias(name); !found {
+		return AliasNotFoundError{Name: name}
+	}
+
+	actor.config.RemoveAlias(name)
+	return nil
+}
+
+// ExpandAlias replaces a leading alias in args with the alias command,
+// substituting the remaining args into it. args is returned unchanged when
+// the first arg is not an alias, or when it has since become the name of a
+// core or installed plugin command.
+func (actor Actor) ExpandAlias(commandList CommandList, args []string) ([]string, error) {
+	if len(args) == 0 {
+		return args, nil
+	}
+
+	name := args[0]
+	command, found := actor.config.GetAlias(name)
+	if !found || commandNameTaken(commandList, actor.config.Plugins(), name, "") {
+		return args, nil
+	}
+
+	words, ok := splitAliasCommand(command)
+	if !ok || len(words) == 0 {
+		return nil, AliasInvalidError{Name: name}
+	}
+
+	aliasArgs := args[1:]
+	used := make([]bool, len(aliasArgs))
+	expected := 0
+	usesAll := false
+
+	for _, word := range words {
+		for _, match := range aliasArgumentReference.FindAllStringSubmatch(word, -1) {
+			if match[1] == "@" {
+				usesAll = true
+				continue
+			}
+			index, _ := strconv.Atoi(match[1])
+			if index > expected {
+				expected = index
+			}
+		}
+	}
+
+	if expected > len(aliasArgs) {
+		return nil, AliasArgumentsError{Name: name, Expected: expected}
+	}
+
+	expanded := []string{}
+	for _, word := range words {
+		if word == "$@" {
+			expanded = append(expanded, aliasArgs...)
+			continue
+		}
+
+		expanded = append(expanded, aliasArgumentReference.ReplaceAllStringFunc(word, func(reference string) string {
+			if reference == "$@" {
+				return strings.Join(aliasArgs, " ")
+			}
+			index, _ := strconv.Atoi(reference[1:])
+			if index == 0 {
+				return reference
+			}
+			used[index-1] = true
+			return aliasArgs[index-1]
+		}))
+	}
+
+	if !usesAll {
+		for i, arg := range aliasArgs {
+			if !used[i] {
+				expanded = append(expanded, arg)
+			}
+		}
+	}
+
+	return expanded, nil
+}
+
+// validAliasCommandName returns true if name can be the name of an alias or
+// the command it runs: it is not empty, does not start with a dash, and does
+// not contain whitespace.
+func validAliasCommandName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, "-") && strings.IndexFunc(name, unicode.IsSpace) == -1
+}
+
+// splitAliasCommand splits command into words on whitespace. Single and
+// double quotes group words together, and a backslash escapes the next
+// character outside of single quotes. It returns false if a quote is not
+// terminated.
+func splitAliasCommand(command string) ([]string, bool) {
+	var (
+		words   []string
+		word    []rune
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, char := range command {
+		switch {
+		case escaped:
+			word = append(word, char)
+			escaped = false
+		case char == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			} else {
+				word = append(word, char)
+			}
+		case char == '\'' || char == '"':
+			quote = char
+			inWord = true
+		case unicode.IsSpace(char):
+			if inWord {
+				words = append(words, string(word))
+				word = nil
+				inWord = false
+			}
+		default:
+			word = append(word, char)
+			inWord = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, false
+	}
+
+	if inWord {
+		words = append(words, string(word))
+	}
+
+	return words, true
+}
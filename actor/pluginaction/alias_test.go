@@ -0,0 +1,307 @@
+package pluginaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Alias Actions", func() {
+	var (
+		actor           *Actor
+		fakeConfig      *pluginactionfakes.FakeConfig
+		fakeCommandList *pluginactionfakes.FakeCommandList
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakeCommandList = new(pluginactionfakes.FakeCommandList)
+		actor = NewActor(fakeConfig, nil)
+	})
+
+	Describe("GetAliases", func() {
+		BeforeEach(func() {
+			fakeConfig.AliasesReturns(map[string]string{
+				"sw":    "v3-scale $1 --process worker",
+				"Apps2": "v3-apps",
+				"b":     "buildpacks",
+			})
+		})
+
+		It("returns the aliases sorted by name", func() {
+			Expect(actor.GetAliases()).To(Equal([]Alias{
+				{Name: "Apps2", Command: "v3-apps"},
+				{Name: "b", Command: "buildpacks"},
+				{Name: "sw", Command: "v3-scale $1 --process worker"},
+			}))
+		})
+	})
+
+	Describe("SetAlias", func() {
+		var (
+			name    string
+			command string
+			err     error
+		)
+
+		BeforeEach(func() {
+			name = "sw"
+			command = "v3-scale $1 --process worker"
+		})
+
+		JustBeforeEach(func() {
+			err = actor.SetAlias(fakeCommandList, name, command)
+		})
+
+		It("sets the alias in the config", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SetAliasCallCount()).To(Equal(1))
+			setName, setCommand := fakeConfig.SetAliasArgsForCall(0)
+			Expect(setName).To(Equal("sw"))
+			Expect(setCommand).To(Equal("v3-scale $1 --process worker"))
+		})
+
+		Context("when the name is a core command name", func() {
+			BeforeEach(func() {
+				fakeCommandList.HasCommandStub = func(commandName string) bool {
+					return commandName == "sw"
+				}
+			})
+
+			It("returns an AliasNameConflictError", func() {
+				Expect(err).To(MatchError(AliasNameConflictError{Name: "sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the name is a core command alias", func() {
+			BeforeEach(func() {
+				fakeCommandList.HasAliasStub = func(commandAlias string) bool {
+					return commandAlias == "sw"
+				}
+			})
+
+			It("returns an AliasNameConflictError", func() {
+				Expect(err).To(MatchError(AliasNameConflictError{Name: "sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the name is a plugin command name or alias", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginsReturns([]configv3.Plugin{{
+					Name:     "some-plugin",
+					Commands: []configv3.PluginCommand{{Name: "switch", Alias: "sw"}},
+				}})
+			})
+
+			It("returns an AliasNameConflictError", func() {
+				Expect(err).To(MatchError(AliasNameConflictError{Name: "sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the command has an unterminated quote", func() {
+			BeforeEach(func() {
+				command = "set-env $1 FOO 'bar"
+			})
+
+			It("returns an AliasInvalidError", func() {
+				Expect(err).To(MatchError(AliasInvalidError{Name: "sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the command is blank", func() {
+			BeforeEach(func() {
+				command = "  "
+			})
+
+			It("returns an AliasInvalidError", func() {
+				Expect(err).To(MatchError(AliasInvalidError{Name: "sw"}))
+			})
+		})
+
+		Context("when the name is empty", func() {
+			BeforeEach(func() {
+				name = ""
+			})
+
+			It("returns an AliasNameInvalidError", func() {
+				Expect(err).To(MatchError(AliasNameInvalidError{Name: ""}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the name starts with a dash", func() {
+			BeforeEach(func() {
+				name = "-sw"
+			})
+
+			It("returns an AliasNameInvalidError", func() {
+				Expect(err).To(MatchError(AliasNameInvalidError{Name: "-sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the name contains whitespace", func() {
+			BeforeEach(func() {
+				name = "s w"
+			})
+
+			It("returns an AliasNameInvalidError", func() {
+				Expect(err).To(MatchError(AliasNameInvalidError{Name: "s w"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the command name is empty", func() {
+			BeforeEach(func() {
+				command = `"" $1`
+			})
+
+			It("returns an AliasInvalidError", func() {
+				Expect(err).To(MatchError(AliasInvalidError{Name: "sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the command name starts with a dash", func() {
+			BeforeEach(func() {
+				command = "--version"
+			})
+
+			It("returns an AliasInvalidError", func() {
+				Expect(err).To(MatchError(AliasInvalidError{Name: "sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the command name contains whitespace", func() {
+			BeforeEach(func() {
+				command = `"v3-scale $1" --process worker`
+			})
+
+			It("returns an AliasInvalidError", func() {
+				Expect(err).To(MatchError(AliasInvalidError{Name: "sw"}))
+				Expect(fakeConfig.SetAliasCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RemoveAlias", func() {
+		Context("when the alias exists", func() {
+			BeforeEach(func() {
+				fakeConfig.GetAliasReturns("v3-apps", true)
+			})
+
+			It("removes the alias from the config", func() {
+				Expect(actor.RemoveAlias("a3")).To(Succeed())
+
+				Expect(fakeConfig.GetAliasArgsForCall(0)).To(Equal("a3"))
+				Expect(fakeConfig.RemoveAliasCallCount()).To(Equal(1))
+				Expect(fakeConfig.RemoveAliasArgsForCall(0)).To(Equal("a3"))
+			})
+		})
+
+		Context("when the alias does not exist", func() {
+			It("returns an AliasNotFoundError", func() {
+				Expect(actor.RemoveAlias("a3")).To(MatchError(AliasNotFoundError{Name: "a3"}))
+				Expect(fakeConfig.RemoveAliasCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ExpandAlias", func() {
+		Context("when the first argument is not an alias", func() {
+			It("returns the arguments unchanged", func() {
+				args, err := actor.ExpandAlias(fakeCommandList, []string{"apps", "--guid"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(args).To(Equal([]string{"apps", "--guid"}))
+			})
+		})
+
+		Context("when there are no arguments", func() {
+			It("returns no arguments", func() {
+				args, err := actor.ExpandAlias(fakeCommandList, []string{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(args).To(BeEmpty())
+				Expect(fakeConfig.GetAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the alias has since become a plugin command", func() {
+			BeforeEach(func() {
+				fakeConfig.GetAliasReturns("v3-apps", true)
+				fakeConfig.PluginsReturns([]configv3.Plugin{{
+					Name:     "some-plugin",
+					Commands: []configv3.PluginCommand{{Name: "a3"}},
+				}})
+			})
+
+			It("does not expand the alias", func() {
+				args, err := actor.ExpandAlias(fakeCommandList, []string{"a3"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(args).To(Equal([]string{"a3"}))
+			})
+		})
+
+		DescribeTable("expanding aliases",
+			func(command string, args []string, expectedArgs []string) {
+				fakeConfig.GetAliasReturns(command, true)
+
+				expandedArgs, err := actor.ExpandAlias(fakeCommandList, append([]string{"some-alias"}, args...))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expandedArgs).To(Equal(expectedArgs))
+			},
+
+			Entry("substitutes positional arguments",
+				"v3-scale $1 --process worker -i $2", []string{"my-app", "4"},
+				[]string{"v3-scale", "my-app", "--process", "worker", "-i", "4"}),
+			Entry("substitutes positional arguments in any order",
+				"map-route $2 $1", []string{"example.com", "my-app"},
+				[]string{"map-route", "my-app", "example.com"}),
+			Entry("substitutes positional arguments inside words",
+				"v3-app $1-$2", []string{"my-app", "blue"},
+				[]string{"v3-app", "my-app-blue"}),
+			Entry("appends arguments that are not referred to",
+				"v3-scale $1 --process worker", []string{"my-app", "-i", "4"},
+				[]string{"v3-scale", "my-app", "--process", "worker", "-i", "4"}),
+			Entry("substitutes all arguments for $@",
+				"v3-scale $@ --process worker", []string{"my-app", "-i", "4"},
+				[]string{"v3-scale", "my-app", "-i", "4", "--process", "worker"}),
+			Entry("keeps quoted words together",
+				`set-env $1 GREETING "hello world" 'it''s'`, []string{"my-app"},
+				[]string{"set-env", "my-app", "GREETING", "hello world", "its"}),
+			Entry("keeps escaped characters",
+				`curl /v2/apps\?q=name:$1`, []string{"my-app"},
+				[]string{"curl", "/v2/apps?q=name:my-app"}),
+		)
+
+		Context("when fewer arguments are passed than the alias refers to", func() {
+			BeforeEach(func() {
+				fakeConfig.GetAliasReturns("v3-scale $1 --process worker -i $2", true)
+			})
+
+			It("returns an AliasArgumentsError", func() {
+				_, err := actor.ExpandAlias(fakeCommandList, []string{"sw", "my-app"})
+				Expect(err).To(MatchError(AliasArgumentsError{Name: "sw", Expected: 2}))
+			})
+		})
+
+		Context("when the alias command has an unterminated quote", func() {
+			BeforeEach(func() {
+				fakeConfig.GetAliasReturns(`set-env $1 FOO "bar`, true)
+			})
+
+			It("returns an AliasInvalidError", func() {
+				_, err := actor.ExpandAlias(fakeCommandList, []string{"se", "my-app"})
+				Expect(err).To(MatchError(AliasInvalidError{Name: "se"}))
+			})
+		})
+	})
+})
@@ -8,10 +8,14 @@ import "code.cloudfoundry.org/cli/util/configv3"
 type Config interface {
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(repoName string, repoURL string)
+	Aliases() map[string]string
+	GetAlias(name string) (string, bool)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
+	RemoveAlias(name string)
 	RemovePlugin(string)
+	SetAlias(name string, command string)
 	WritePluginConfig() error
 }
@@ -115,32 +115,15 @@ func (actor Actor) GetAndValidatePlugin(pluginMetadata PluginMetadata, commandLi
 	conflictingAliases := []string{}
 
 	for _, command := range plugin.Commands {
-		if commandList.HasCommand(command.Name) || commandList.HasAlias(command.Name) {
+		// we do not error if a plugins commands conflict with previous
+		// versions of the same plugin
+		if commandNameTaken(commandList, installedPlugins, command.Name, plugin.Name) {
 			conflictingNames = append(conflictingNames, command.Name)
 		}
 
-		if commandList.HasAlias(command.Alias) || commandList.HasCommand(command.Alias) {
+		if command.Alias != "" && commandNameTaken(commandList, installedPlugins, command.Alias, plugin.Name) {
 			conflictingAliases = append(conflictingAliases, command.Alias)
 		}
-
-		for _, installedPlugin := range installedPlugins {
-			// we do not error if a plugins commands conflict with previous
-			// versions of the same plugin
-			if plugin.Name == installedPlugin.Name {
-				continue
-			}
-
-			for _, installedCommand := range installedPlugin.Commands {
-				if command.Name == installedCommand.Name || command.Name == installedCommand.Alias {
-					conflictingNames = append(conflictingNames, command.Name)
-				}
-
-				if command.Alias != "" &&
-					(command.Alias == installedCommand.Alias || command.Alias == installedCommand.Name) {
-					conflictingAliases = append(conflictingAliases, command.Alias)
-				}
-			}
-		}
 	}
 
 	if len(conflictingNames) > 0 || len(conflictingAliases) > 0 {
@@ -163,6 +146,28 @@ func (actor Actor) GetAndValidatePlugin(pluginMetadata PluginMetadata, commandLi
 	return plugin, nil
 }
 
+// commandNameTaken returns true if name is the name or alias of a core
+// command, or of a command of an installed plugin other than ignoredPlugin.
+func commandNameTaken(commandList CommandList, installedPlugins []configv3.Plugin, name string, ignoredPlugin string) bool {
+	if commandList.HasCommand(name) || commandList.HasAlias(name) {
+		return true
+	}
+
+	for _, installedPlugin := range installedPlugins {
+		if installedPlugin.Name == ignoredPlugin {
+			continue
+		}
+
+		for _, installedCommand := range installedPlugin.Commands {
+			if name == installedCommand.Name || name == installedCommand.Alias {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (actor Actor) InstallPluginFromPath(path string, plugin configv3.Plugin) error {
 	installPath := generic.ExecutableFilename(filepath.Join(actor.config.PluginHome(), plugin.Name))
 	err := fileutils.CopyPathToPath(path, installPath)
@@ -20,6 +20,28 @@ type FakeConfig struct {
 		repoName string
 		repoURL  string
 	}
+	AliasesStub        func() map[string]string
+	aliasesMutex       sync.RWMutex
+	aliasesArgsForCall []struct{}
+	aliasesReturns     struct {
+		result1 map[string]string
+	}
+	aliasesReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	GetAliasStub        func(name string) (string, bool)
+	getAliasMutex       sync.RWMutex
+	getAliasArgsForCall []struct {
+		name string
+	}
+	getAliasReturns struct {
+		result1 string
+		result2 bool
+	}
+	getAliasReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
 	GetPluginStub        func(pluginName string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -60,11 +82,22 @@ type FakeConfig struct {
 	pluginsReturnsOnCall map[int]struct {
 		result1 []configv3.Plugin
 	}
+	RemoveAliasStub        func(name string)
+	removeAliasMutex       sync.RWMutex
+	removeAliasArgsForCall []struct {
+		name string
+	}
 	RemovePluginStub        func(string)
 	removePluginMutex       sync.RWMutex
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	SetAliasStub        func(name string, command string)
+	setAliasMutex       sync.RWMutex
+	setAliasArgsForCall []struct {
+		name    string
+		command string
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct{}
@@ -127,6 +160,97 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return fake.addPluginRepositoryArgsForCall[i].repoName, fake.addPluginRepositoryArgsForCall[i].repoURL
 }
 
+func (fake *FakeConfig) Aliases() map[string]string {
+	fake.aliasesMutex.Lock()
+	ret, specificReturn := fake.aliasesReturnsOnCall[len(fake.aliasesArgsForCall)]
+	fake.aliasesArgsForCall = append(fake.aliasesArgsForCall, struct{}{})
+	fake.recordInvocation("Aliases", []interface{}{})
+	fake.aliasesMutex.Unlock()
+	if fake.AliasesStub != nil {
+		return fake.AliasesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.aliasesReturns.result1
+}
+
+func (fake *FakeConfig) AliasesCallCount() int {
+	fake.aliasesMutex.RLock()
+	defer fake.aliasesMutex.RUnlock()
+	return len(fake.aliasesArgsForCall)
+}
+
+func (fake *FakeConfig) AliasesReturns(result1 map[string]string) {
+	fake.AliasesStub = nil
+	fake.aliasesReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeConfig) AliasesReturnsOnCall(i int, result1 map[string]string) {
+	fake.AliasesStub = nil
+	if fake.aliasesReturnsOnCall == nil {
+		fake.aliasesReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.aliasesReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeConfig) GetAlias(name string) (string, bool) {
+	fake.getAliasMutex.Lock()
+	ret, specificReturn := fake.getAliasReturnsOnCall[len(fake.getAliasArgsForCall)]
+	fake.getAliasArgsForCall = append(fake.getAliasArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetAlias", []interface{}{name})
+	fake.getAliasMutex.Unlock()
+	if fake.GetAliasStub != nil {
+		return fake.GetAliasStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAliasReturns.result1, fake.getAliasReturns.result2
+}
+
+func (fake *FakeConfig) GetAliasCallCount() int {
+	fake.getAliasMutex.RLock()
+	defer fake.getAliasMutex.RUnlock()
+	return len(fake.getAliasArgsForCall)
+}
+
+func (fake *FakeConfig) GetAliasArgsForCall(i int) string {
+	fake.getAliasMutex.RLock()
+	defer fake.getAliasMutex.RUnlock()
+	return fake.getAliasArgsForCall[i].name
+}
+
+func (fake *FakeConfig) GetAliasReturns(result1 string, result2 bool) {
+	fake.GetAliasStub = nil
+	fake.getAliasReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetAliasReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.GetAliasStub = nil
+	if fake.getAliasReturnsOnCall == nil {
+		fake.getAliasReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.getAliasReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) GetPlugin(pluginName string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -298,6 +422,30 @@ func (fake *FakeConfig) PluginsReturnsOnCall(i int, result1 []configv3.Plugin) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RemoveAlias(name string) {
+	fake.removeAliasMutex.Lock()
+	fake.removeAliasArgsForCall = append(fake.removeAliasArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemoveAlias", []interface{}{name})
+	fake.removeAliasMutex.Unlock()
+	if fake.RemoveAliasStub != nil {
+		fake.RemoveAliasStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemoveAliasCallCount() int {
+	fake.removeAliasMutex.RLock()
+	defer fake.removeAliasMutex.RUnlock()
+	return len(fake.removeAliasArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveAliasArgsForCall(i int) string {
+	fake.removeAliasMutex.RLock()
+	defer fake.removeAliasMutex.RUnlock()
+	return fake.removeAliasArgsForCall[i].name
+}
+
 func (fake *FakeConfig) RemovePlugin(arg1 string) {
 	fake.removePluginMutex.Lock()
 	fake.removePluginArgsForCall = append(fake.removePluginArgsForCall, struct {
@@ -322,6 +470,31 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) SetAlias(name string, command string) {
+	fake.setAliasMutex.Lock()
+	fake.setAliasArgsForCall = append(fake.setAliasArgsForCall, struct {
+		name    string
+		command string
+	}{name, command})
+	fake.recordInvocation("SetAlias", []interface{}{name, command})
+	fake.setAliasMutex.Unlock()
+	if fake.SetAliasStub != nil {
+		fake.SetAliasStub(name, command)
+	}
+}
+
+func (fake *FakeConfig) SetAliasCallCount() int {
+	fake.setAliasMutex.RLock()
+	defer fake.setAliasMutex.RUnlock()
+	return len(fake.setAliasArgsForCall)
+}
+
+func (fake *FakeConfig) SetAliasArgsForCall(i int) (string, string) {
+	fake.setAliasMutex.RLock()
+	defer fake.setAliasMutex.RUnlock()
+	return fake.setAliasArgsForCall[i].name, fake.setAliasArgsForCall[i].command
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
@@ -369,6 +542,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.aliasesMutex.RLock()
+	defer fake.aliasesMutex.RUnlock()
+	fake.getAliasMutex.RLock()
+	defer fake.getAliasMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
@@ -377,8 +554,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginRepositoriesMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
+	fake.removeAliasMutex.RLock()
+	defer fake.removeAliasMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.setAliasMutex.RLock()
+	defer fake.setAliasMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
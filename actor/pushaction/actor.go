@@ -2,19 +2,25 @@
 // push.
 package pushaction
 
+import "code.cloudfoundry.org/cli/util/words/generator"
+
 // Warnings is a list of warnings returned back from the cloud controller
 type Warnings []string
 
 // Actor handles all business logic for Cloud Controller v2 operations.
 type Actor struct {
-	V2Actor     V2Actor
-	SharedActor SharedActor
+	V2Actor       V2Actor
+	V3Actor       V3Actor
+	SharedActor   SharedActor
+	WordGenerator generator.WordGenerator
 }
 
 // NewActor returns a new actor.
-func NewActor(v2Actor V2Actor, sharedActor SharedActor) *Actor {
+func NewActor(v2Actor V2Actor, v3Actor V3Actor, sharedActor SharedActor) *Actor {
 	return &Actor{
-		V2Actor:     v2Actor,
-		SharedActor: sharedActor,
+		V2Actor:       v2Actor,
+		V3Actor:       v3Actor,
+		SharedActor:   sharedActor,
+		WordGenerator: generator.NewWordGenerator(),
 	}
 }
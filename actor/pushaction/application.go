@@ -2,19 +2,46 @@ package pushaction
 
 import (
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	log "github.com/sirupsen/logrus"
 )
 
 type Application struct {
 	v2action.Application
-	Stack v2action.Stack
+	// Buildpacks are the buildpacks the application is staged with when more
+	// than one is requested. They are set through the V3 API, since V2
+	// applications only have a single buildpack.
+	Buildpacks []string
+	Stack      v2action.Stack
 }
 
 func (app Application) String() string {
-	return fmt.Sprintf("%s, Stack Name: '%s'", app.Application, app.Stack.Name)
+	return fmt.Sprintf("%s, Buildpacks: [%s], Stack Name: '%s'", app.Application, strings.Join(app.Buildpacks, ", "), app.Stack.Name)
+}
+
+// CalculatedBuildpacks returns the buildpacks that will be used.
+func (app Application) CalculatedBuildpacks() []string {
+	if len(app.Buildpacks) > 0 {
+		return app.Buildpacks
+	}
+
+	if buildpack := app.CalculatedBuildpack(); buildpack != "" {
+		return []string{buildpack}
+	}
+	return nil
+}
+
+// MultipleBuildpacksNotSupportedError is returned when an application
+// requests more than one buildpack and the targeted Cloud Controller does not
+// provide the V3 API.
+type MultipleBuildpacksNotSupportedError struct{}
+
+func (MultipleBuildpacksNotSupportedError) Error() string {
+	return "multiple buildpacks require the V3 API"
 }
 
 func (app *Application) SetStack(stack v2action.Stack) {
@@ -40,8 +67,15 @@ func (actor Actor) CreateOrUpdateApp(config ApplicationConfig) (ApplicationConfi
 		}
 
 		config.DesiredApplication.Application = app
+
+		buildpackWarnings, err := actor.updateBuildpacks(config.DesiredApplication)
+		allWarnings := append(Warnings(warnings), buildpackWarnings...)
+		if err != nil {
+			return ApplicationConfig{}, "", allWarnings, err
+		}
+
 		config.CurrentApplication = config.DesiredApplication
-		return config, UpdatedApplication, Warnings(warnings), err
+		return config, UpdatedApplication, allWarnings, err
 	} else {
 		log.Debugf("creating application: %#v", config.DesiredApplication)
 		app, warnings, err := actor.V2Actor.CreateApplication(config.DesiredApplication.Application)
@@ -51,9 +85,42 @@ func (actor Actor) CreateOrUpdateApp(config ApplicationConfig) (ApplicationConfi
 		}
 
 		config.DesiredApplication.Application = app
+
+		buildpackWarnings, err := actor.updateBuildpacks(config.DesiredApplication)
+		allWarnings := append(Warnings(warnings), buildpackWarnings...)
+		if err != nil {
+			return ApplicationConfig{}, "", allWarnings, err
+		}
+
 		config.CurrentApplication = config.DesiredApplication
-		return config, CreatedApplication, Warnings(warnings), err
+		return config, CreatedApplication, allWarnings, err
+	}
+}
+
+// updateBuildpacks sets the buildpacks of the application through the V3 API
+// when more than one buildpack is requested.
+func (actor Actor) updateBuildpacks(app Application) (Warnings, error) {
+	if len(app.Buildpacks) < 2 {
+		return nil, nil
+	}
+
+	if actor.V3Actor == nil {
+		log.Error("multiple buildpacks requested without the V3 API")
+		return nil, MultipleBuildpacksNotSupportedError{}
+	}
+
+	log.WithField("buildpacks", app.Buildpacks).Debug("updating application buildpacks")
+	_, warnings, err := actor.V3Actor.UpdateApplication(v3action.Application{
+		GUID: app.GUID,
+		Lifecycle: v3action.AppLifecycle{
+			Type: v3action.BuildpackAppLifecycleType,
+			Data: v3action.AppLifecycleData{Buildpacks: app.Buildpacks},
+		},
+	})
+	if err != nil {
+		log.Errorln("updating application buildpacks:", err)
 	}
+	return Warnings(warnings), err
 }
 
 func (actor Actor) FindOrReturnPartialApp(appName string, spaceGUID string) (bool, Application, v2action.Warnings, error) {
@@ -127,14 +127,20 @@ func (actor Actor) configureRoutes(manifestApp manifest.Application, orgGUID str
 		return config, warnings, err
 	}
 
-	desiredRoute, warnings, err := actor.GetGeneratedRoute(manifestApp, orgGUID, spaceGUID, config.CurrentRoutes)
+	if manifestApp.RandomRoute && len(config.CurrentRoutes) > 0 {
+		log.Debug("app already has routes, skipping random route")
+		config.DesiredRoutes = config.CurrentRoutes
+		return config, nil, nil
+	}
+
+	generatedRoutes, warnings, err := actor.GetGeneratedRoutes(manifestApp, orgGUID, spaceGUID, config.CurrentRoutes)
 	if err != nil {
-		log.Errorln("getting default route:", err)
+		log.Errorln("getting generated routes:", err)
 		return config, warnings, err
 	}
 
 	// TODO: when working with all of routes, append to current route
-	config.DesiredRoutes = append(config.CurrentRoutes, desiredRoute)
+	config.DesiredRoutes = append(config.CurrentRoutes, generatedRoutes...)
 	return config, warnings, nil
 }
 
@@ -164,11 +170,26 @@ func (actor Actor) getDesiredServices(currentServices map[string]v2action.Servic
 
 func (actor Actor) configureExistingApp(config ApplicationConfig, app manifest.Application, foundApp Application) (ApplicationConfig, v2action.Warnings, error) {
 	log.Debugln("found app:", foundApp)
+	var warnings v2action.Warnings
+	if actor.V3Actor != nil {
+		log.Info("looking up application buildpacks")
+		v3App, v3Warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(foundApp.Name, foundApp.SpaceGUID)
+		warnings = append(warnings, v3Warnings...)
+		if err != nil {
+			log.Errorln("existing buildpacks lookup:", err)
+			return config, warnings, err
+		}
+		if len(v3App.Lifecycle.Data.Buildpacks) > 1 {
+			foundApp.Buildpacks = v3App.Lifecycle.Data.Buildpacks
+		}
+	}
+
 	config.CurrentApplication = foundApp
 	config.DesiredApplication = foundApp
 
 	log.Info("looking up application routes")
-	routes, warnings, err := actor.V2Actor.GetApplicationRoutes(foundApp.GUID)
+	routes, routeWarnings, err := actor.V2Actor.GetApplicationRoutes(foundApp.GUID)
+	warnings = append(warnings, routeWarnings...)
 	if err != nil {
 		log.Errorln("existing routes lookup:", err)
 		return config, warnings, err
@@ -221,6 +242,13 @@ func (actor Actor) configureResources(config ApplicationConfig, dockerImagePath
 func (Actor) overrideApplicationProperties(application Application, manifest manifest.Application, noStart bool) Application {
 	if manifest.Buildpack.IsSet {
 		application.Buildpack = manifest.Buildpack
+		application.Buildpacks = nil
+	}
+	if len(manifest.Buildpacks) == 1 {
+		application.Buildpack.ParseValue(manifest.Buildpacks[0])
+		application.Buildpacks = nil
+	} else if len(manifest.Buildpacks) > 1 {
+		application.Buildpacks = manifest.Buildpacks
 	}
 	if manifest.Command.IsSet {
 		application.Command = manifest.Command
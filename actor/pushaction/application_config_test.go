@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/manifest"
@@ -29,7 +30,7 @@ var _ = Describe("Application Config", func() {
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
 		fakeSharedActor = new(pushactionfakes.FakeSharedActor)
-		actor = NewActor(fakeV2Actor, fakeSharedActor)
+		actor = NewActor(fakeV2Actor, nil, fakeSharedActor)
 	})
 
 	Describe("ApplicationConfig", func() {
@@ -250,6 +251,83 @@ var _ = Describe("Application Config", func() {
 				})
 			})
 
+			Context("when the V3 API is available", func() {
+				var fakeV3Actor *pushactionfakes.FakeV3Actor
+
+				BeforeEach(func() {
+					fakeV3Actor = new(pushactionfakes.FakeV3Actor)
+					actor.V3Actor = fakeV3Actor
+				})
+
+				Context("when the application is staged with multiple buildpacks", func() {
+					BeforeEach(func() {
+						fakeV3Actor.GetApplicationByNameAndSpaceReturns(
+							v3action.Application{
+								Lifecycle: v3action.AppLifecycle{
+									Type: v3action.BuildpackAppLifecycleType,
+									Data: v3action.AppLifecycleData{Buildpacks: []string{"some-buildpack-1", "some-buildpack-2"}},
+								},
+							},
+							v3action.Warnings{"v3-app-warning"},
+							nil,
+						)
+					})
+
+					It("sets the buildpacks of the current application", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ContainElement("v3-app-warning"))
+						Expect(firstConfig.CurrentApplication.Buildpacks).To(Equal([]string{"some-buildpack-1", "some-buildpack-2"}))
+						Expect(firstConfig.DesiredApplication.Buildpacks).To(Equal([]string{"some-buildpack-1", "some-buildpack-2"}))
+
+						Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						passedName, passedSpaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+						Expect(passedName).To(Equal(app.Name))
+						Expect(passedSpaceGUID).To(Equal(spaceGUID))
+					})
+
+					Context("when the manifest sets a single buildpack", func() {
+						BeforeEach(func() {
+							manifestApps[0].Buildpack = types.FilteredString{IsSet: true, Value: "some-buildpack"}
+						})
+
+						It("only clears the desired buildpacks", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(firstConfig.CurrentApplication.Buildpacks).To(Equal([]string{"some-buildpack-1", "some-buildpack-2"}))
+							Expect(firstConfig.DesiredApplication.Buildpacks).To(BeEmpty())
+						})
+					})
+				})
+
+				Context("when the application is staged with a single buildpack", func() {
+					BeforeEach(func() {
+						fakeV3Actor.GetApplicationByNameAndSpaceReturns(
+							v3action.Application{Lifecycle: v3action.AppLifecycle{Data: v3action.AppLifecycleData{Buildpacks: []string{"some-buildpack"}}}},
+							nil,
+							nil,
+						)
+					})
+
+					It("leaves the buildpack to the V2 application", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(firstConfig.CurrentApplication.Buildpacks).To(BeEmpty())
+					})
+				})
+
+				Context("when looking up the V3 application errors", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("dios mio")
+						fakeV3Actor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"v3-app-warning"}, expectedErr)
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(warnings).To(ConsistOf("some-app-warning-1", "some-app-warning-2", "v3-app-warning"))
+					})
+				})
+			})
+
 			Context("when retrieving the application's routes errors", func() {
 				var expectedErr error
 
@@ -353,6 +431,31 @@ var _ = Describe("Application Config", func() {
 				})
 			})
 
+			Context("when the manifest contains buildpacks", func() {
+				Context("when there is a single buildpack", func() {
+					BeforeEach(func() {
+						manifestApps[0].Buildpacks = []string{"some-buildpack"}
+					})
+
+					It("sets it as the application buildpack", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(firstConfig.DesiredApplication.Buildpack).To(Equal(types.FilteredString{IsSet: true, Value: "some-buildpack"}))
+						Expect(firstConfig.DesiredApplication.Buildpacks).To(BeEmpty())
+					})
+				})
+
+				Context("when there are multiple buildpacks", func() {
+					BeforeEach(func() {
+						manifestApps[0].Buildpacks = []string{"some-buildpack-1", "some-buildpack-2"}
+					})
+
+					It("sets them as the application buildpacks", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(firstConfig.DesiredApplication.Buildpacks).To(Equal([]string{"some-buildpack-1", "some-buildpack-2"}))
+					})
+				})
+			})
+
 			Context("when the manifest does not contain any properties", func() {
 				BeforeEach(func() {
 					stack = v2action.Stack{
@@ -374,7 +477,7 @@ var _ = Describe("Application Config", func() {
 							"env2": "2",
 							"env3": "9",
 						},
-						GUID: "some-app-guid",
+						GUID:                    "some-app-guid",
 						HealthCheckHTTPEndpoint: "/some-endpoint",
 						HealthCheckTimeout:      5,
 						HealthCheckType:         "port",
@@ -606,7 +709,7 @@ var _ = Describe("Application Config", func() {
 
 			Context("when only the -d flag is provided", func() {
 				BeforeEach(func() {
-					manifestApps[0].Domain = "some-private-domain"
+					manifestApps[0].Domains = []string{"private-domain.com"}
 				})
 
 				Context("when the provided domain exists", func() {
@@ -633,7 +736,7 @@ var _ = Describe("Application Config", func() {
 						)
 						Expect(fakeV2Actor.GetDomainsByNameAndOrganizationCallCount()).To(Equal(1))
 						domainNamesArg, orgGUIDArg := fakeV2Actor.GetDomainsByNameAndOrganizationArgsForCall(0)
-						Expect(domainNamesArg).To(Equal([]string{"some-private-domain"}))
+						Expect(domainNamesArg).To(Equal([]string{"private-domain.com"}))
 						Expect(orgGUIDArg).To(Equal(orgGUID))
 					})
 				})
@@ -648,17 +751,30 @@ var _ = Describe("Application Config", func() {
 					})
 
 					It("returns an DomainNotFoundError", func() {
-						Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "some-private-domain"}))
+						Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "private-domain.com"}))
 						Expect(warnings).To(ConsistOf("some-organization-domain-warning", "app-route-warnings"))
 
 						Expect(fakeV2Actor.GetDomainsByNameAndOrganizationCallCount()).To(Equal(1))
 						domainNamesArg, orgGUIDArg := fakeV2Actor.GetDomainsByNameAndOrganizationArgsForCall(0)
-						Expect(domainNamesArg).To(Equal([]string{"some-private-domain"}))
+						Expect(domainNamesArg).To(Equal([]string{"private-domain.com"}))
 						Expect(orgGUIDArg).To(Equal(orgGUID))
 					})
 				})
 			})
 
+			Context("when random-route is provided", func() {
+				BeforeEach(func() {
+					manifestApps[0].RandomRoute = true
+				})
+
+				It("keeps the existing routes of the app instead of generating a random route", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("app-route-warnings"))
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(existingRoute))
+					Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when no route flags are provided", func() {
 				Context("when retrieving the default route is successful", func() {
 					BeforeEach(func() {
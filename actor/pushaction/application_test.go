@@ -7,6 +7,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
@@ -17,11 +18,13 @@ var _ = Describe("Applications", func() {
 	var (
 		actor       *Actor
 		fakeV2Actor *pushactionfakes.FakeV2Actor
+		fakeV3Actor *pushactionfakes.FakeV3Actor
 	)
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor, nil)
+		fakeV3Actor = new(pushactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor, nil)
 	})
 
 	Describe("CreateOrUpdateApp", func() {
@@ -123,6 +126,62 @@ var _ = Describe("Applications", func() {
 				})
 			})
 
+			Context("when multiple buildpacks are requested", func() {
+				BeforeEach(func() {
+					config.DesiredApplication.Buildpacks = []string{"some-buildpack-1", "some-buildpack-2"}
+					fakeV2Actor.UpdateApplicationReturns(v2action.Application{
+						Name: "some-app-name",
+						GUID: "some-app-guid",
+					}, v2action.Warnings{"update-warning"}, nil)
+				})
+
+				Context("when updating the buildpacks is successful", func() {
+					BeforeEach(func() {
+						fakeV3Actor.UpdateApplicationReturns(v3action.Application{}, v3action.Warnings{"buildpacks-warning"}, nil)
+					})
+
+					It("sets the buildpacks through the V3 API", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("update-warning", "buildpacks-warning"))
+						Expect(returnedConfig.DesiredApplication.Buildpacks).To(Equal([]string{"some-buildpack-1", "some-buildpack-2"}))
+
+						Expect(fakeV3Actor.UpdateApplicationCallCount()).To(Equal(1))
+						Expect(fakeV3Actor.UpdateApplicationArgsForCall(0)).To(Equal(v3action.Application{
+							GUID: "some-app-guid",
+							Lifecycle: v3action.AppLifecycle{
+								Type: v3action.BuildpackAppLifecycleType,
+								Data: v3action.AppLifecycleData{Buildpacks: []string{"some-buildpack-1", "some-buildpack-2"}},
+							},
+						}))
+					})
+				})
+
+				Context("when updating the buildpacks errors", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("no buildpacks for you")
+						fakeV3Actor.UpdateApplicationReturns(v3action.Application{}, v3action.Warnings{"buildpacks-warning"}, expectedErr)
+					})
+
+					It("returns warnings and error", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(warnings).To(ConsistOf("update-warning", "buildpacks-warning"))
+					})
+				})
+
+				Context("when the V3 API is not available", func() {
+					BeforeEach(func() {
+						actor.V3Actor = nil
+					})
+
+					It("returns a MultipleBuildpacksNotSupportedError", func() {
+						Expect(executeErr).To(MatchError(MultipleBuildpacksNotSupportedError{}))
+						Expect(warnings).To(ConsistOf("update-warning"))
+					})
+				})
+			})
+
 			Context("when the update errors", func() {
 				var expectedErr error
 				BeforeEach(func() {
@@ -148,6 +207,10 @@ var _ = Describe("Applications", func() {
 					}, v2action.Warnings{"create-warning"}, nil)
 				})
 
+				It("does not update the buildpacks", func() {
+					Expect(fakeV3Actor.UpdateApplicationCallCount()).To(Equal(0))
+				})
+
 				It("creates the application", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("create-warning"))
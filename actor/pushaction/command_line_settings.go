@@ -18,11 +18,15 @@ type CommandLineSettings struct {
 	DockerUsername     string
 	HealthCheckTimeout int
 	HealthCheckType    string
+	Hostname           string
 	Instances          types.NullInt
 	Memory             uint64
 	Name               string
+	NoHostname         bool
 	NoRoute            bool
 	ProvidedAppPath    string
+	RandomRoute        bool
+	RoutePath          string
 	StackName          string
 	Domain             string
 }
@@ -30,6 +34,7 @@ type CommandLineSettings struct {
 func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Application) manifest.Application {
 	if settings.Buildpack.IsSet {
 		app.Buildpack = settings.Buildpack
+		app.Buildpacks = nil
 	}
 
 	if settings.Command.IsSet {
@@ -60,6 +65,11 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 		app.HealthCheckType = settings.HealthCheckType
 	}
 
+	if settings.Hostname != "" {
+		app.Hosts = []string{settings.Hostname}
+		app.NoHostname = false
+	}
+
 	if settings.Instances.IsSet {
 		app.Instances = settings.Instances
 	}
@@ -72,6 +82,10 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 		app.Name = settings.Name
 	}
 
+	if settings.NoHostname {
+		app.NoHostname = true
+	}
+
 	if settings.NoRoute {
 		app.NoRoute = true
 	}
@@ -83,12 +97,20 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 		app.Path = settings.CurrentDirectory
 	}
 
+	if settings.RandomRoute {
+		app.RandomRoute = true
+	}
+
+	if settings.RoutePath != "" {
+		app.RoutePath = settings.RoutePath
+	}
+
 	if settings.StackName != "" {
 		app.StackName = settings.StackName
 	}
 
 	if settings.Domain != "" {
-		app.Domain = settings.Domain
+		app.Domains = []string{settings.Domain}
 	}
 
 	return app
@@ -96,7 +118,7 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 
 func (settings CommandLineSettings) String() string {
 	return fmt.Sprintf(
-		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Command IsSet: %t, Command: '%s', CurrentDirectory: '%s', Disk Quota: '%d', Docker Image: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%d', Provided App Path: '%s', Stack: '%s', Domain: '%s'",
+		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Command IsSet: %t, Command: '%s', CurrentDirectory: '%s', Disk Quota: '%d', Docker Image: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Hostname: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%d', No Hostname: %t, Provided App Path: '%s', Random Route: %t, Route Path: '%s', Stack: '%s', Domain: '%s'",
 		settings.Name,
 		settings.Buildpack.IsSet,
 		settings.Buildpack.Value,
@@ -107,10 +129,14 @@ func (settings CommandLineSettings) String() string {
 		settings.DockerImage,
 		settings.HealthCheckTimeout,
 		settings.HealthCheckType,
+		settings.Hostname,
 		settings.Instances.IsSet,
 		settings.Instances.Value,
 		settings.Memory,
+		settings.NoHostname,
 		settings.ProvidedAppPath,
+		settings.RandomRoute,
+		settings.RoutePath,
 		settings.StackName,
 		settings.Domain,
 	)
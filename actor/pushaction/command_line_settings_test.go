@@ -33,6 +33,16 @@ var _ = Describe("CommandLineSettings", func() {
 			manifest.Application{Buildpack: types.FilteredString{IsSet: true, Value: "not-sixpack"}},
 			manifest.Application{Buildpack: types.FilteredString{IsSet: true, Value: "not-sixpack"}},
 		),
+		Entry("overrides buildpacks with the buildpack name",
+			CommandLineSettings{Buildpack: types.FilteredString{IsSet: true, Value: "sixpack"}},
+			manifest.Application{Buildpacks: []string{"apm", "not-sixpack"}},
+			manifest.Application{Buildpack: types.FilteredString{IsSet: true, Value: "sixpack"}},
+		),
+		Entry("passes through buildpacks",
+			CommandLineSettings{},
+			manifest.Application{Buildpacks: []string{"apm", "not-sixpack"}},
+			manifest.Application{Buildpacks: []string{"apm", "not-sixpack"}},
+		),
 		Entry("overrides command",
 			CommandLineSettings{Command: types.FilteredString{IsSet: true, Value: "not-steve"}},
 			manifest.Application{Command: types.FilteredString{IsSet: true, Value: "steve"}},
@@ -148,15 +158,45 @@ var _ = Describe("CommandLineSettings", func() {
 			manifest.Application{StackName: "steve"},
 			manifest.Application{StackName: "steve"},
 		),
-		Entry("overrides domain",
+		Entry("overrides domains",
 			CommandLineSettings{Domain: "not-steve"},
-			manifest.Application{Domain: "steve"},
-			manifest.Application{Domain: "not-steve"},
+			manifest.Application{Domains: []string{"steve", "steve-2"}},
+			manifest.Application{Domains: []string{"not-steve"}},
+		),
+		Entry("passes through domains",
+			CommandLineSettings{},
+			manifest.Application{Domains: []string{"steve"}},
+			manifest.Application{Domains: []string{"steve"}},
+		),
+		Entry("overrides hosts and no hostname",
+			CommandLineSettings{Hostname: "not-steve"},
+			manifest.Application{Hosts: []string{"steve", "steve-2"}, NoHostname: true},
+			manifest.Application{Hosts: []string{"not-steve"}},
 		),
-		Entry("passes through domain",
+		Entry("passes through hosts",
 			CommandLineSettings{},
-			manifest.Application{Domain: "steve"},
-			manifest.Application{Domain: "steve"},
+			manifest.Application{Hosts: []string{"steve"}},
+			manifest.Application{Hosts: []string{"steve"}},
+		),
+		Entry("overrides no hostname",
+			CommandLineSettings{NoHostname: true},
+			manifest.Application{},
+			manifest.Application{NoHostname: true},
+		),
+		Entry("overrides random route",
+			CommandLineSettings{RandomRoute: true},
+			manifest.Application{},
+			manifest.Application{RandomRoute: true},
+		),
+		Entry("passes through random route",
+			CommandLineSettings{},
+			manifest.Application{RandomRoute: true},
+			manifest.Application{RandomRoute: true},
+		),
+		Entry("overrides route path",
+			CommandLineSettings{RoutePath: "/some-path"},
+			manifest.Application{},
+			manifest.Application{RoutePath: "/some-path"},
 		),
 	)
 
@@ -102,9 +102,13 @@ func (Actor) validatePremergedSettings(settings CommandLineSettings, apps []mani
 			settings.DockerImage != "",
 			settings.HealthCheckTimeout != 0,
 			settings.HealthCheckType != "",
+			settings.Hostname != "",
 			settings.Instances.IsSet,
 			settings.Memory != 0,
+			settings.NoHostname,
 			settings.ProvidedAppPath != "",
+			settings.RandomRoute,
+			settings.RoutePath != "",
 			settings.StackName != "":
 			log.Error("cannot use some parameters with multiple apps")
 			return CommandLineOptionsWithMultipleAppsError{}
@@ -114,6 +118,9 @@ func (Actor) validatePremergedSettings(settings CommandLineSettings, apps []mani
 		if app.NoRoute && len(app.Routes) > 0 {
 			return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"no-route", "routes"}}
 		}
+		if app.Buildpack.IsSet && len(app.Buildpacks) > 0 {
+			return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"buildpack", "buildpacks"}}
+		}
 	}
 
 	return nil
@@ -126,6 +133,12 @@ func (Actor) validateMergedSettings(apps []manifest.Application) error {
 			return MissingNameError{}
 		}
 
+		if len(app.Routes) > 0 {
+			if property := routeGenerationProperty(app); property != "" {
+				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"routes", property}}
+			}
+		}
+
 		if app.DockerImage == "" {
 			_, err := os.Stat(app.Path)
 			if os.IsNotExist(err) {
@@ -140,6 +153,9 @@ func (Actor) validateMergedSettings(apps []manifest.Application) error {
 			if app.Buildpack.IsSet {
 				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "buildpack"}}
 			}
+			if len(app.Buildpacks) > 0 {
+				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "buildpacks"}}
+			}
 			if app.Path != "" {
 				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "path"}}
 			}
@@ -147,3 +163,21 @@ func (Actor) validateMergedSettings(apps []manifest.Application) error {
 	}
 	return nil
 }
+
+// routeGenerationProperty returns the name of the first property set on the
+// application that is used to generate its routes, or "" if none are set.
+func routeGenerationProperty(app manifest.Application) string {
+	switch {
+	case len(app.Domains) > 0:
+		return "domains"
+	case len(app.Hosts) > 0:
+		return "hosts"
+	case app.NoHostname:
+		return "no-hostname"
+	case app.RandomRoute:
+		return "random-route"
+	case app.RoutePath != "":
+		return "route-path"
+	}
+	return ""
+}
@@ -20,7 +20,7 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 	)
 
 	BeforeEach(func() {
-		actor = NewActor(nil, nil)
+		actor = NewActor(nil, nil, nil)
 		currentDirectory = getCurrentDir()
 	})
 
@@ -206,9 +206,17 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{Memory: 4}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{ProvidedAppPath: "some-path"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{StackName: "some-stackname"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{Hostname: "some-hostname"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{NoHostname: true}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{RandomRoute: true}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{RoutePath: "/some-path"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
 		Entry("DockerPasswordNotSetError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", DockerImage: "some-image", DockerUsername: "some-username"}}, actionerror.DockerPasswordNotSetError{}),
 		Entry("PropertyCombinationError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", DockerImage: "some-image", Buildpack: types.FilteredString{IsSet: true}}}, actionerror.PropertyCombinationError{AppName: "some-name-1", Properties: []string{"docker", "buildpack"}}),
 		Entry("PropertyCombinationError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", DockerImage: "some-image", Path: "some-path"}}, actionerror.PropertyCombinationError{AppName: "some-name-1", Properties: []string{"docker", "path"}}),
 		Entry("PropertyCombinationError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", Routes: []string{"some-route"}, NoRoute: true, Path: "some-path"}}, actionerror.PropertyCombinationError{AppName: "some-name-1", Properties: []string{"no-route", "routes"}}),
+		Entry("PropertyCombinationError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", Routes: []string{"some-route"}, Hosts: []string{"some-host"}}}, actionerror.PropertyCombinationError{AppName: "some-name-1", Properties: []string{"routes", "hosts"}}),
+		Entry("PropertyCombinationError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", Buildpack: types.FilteredString{IsSet: true}, Buildpacks: []string{"some-buildpack"}}}, actionerror.PropertyCombinationError{AppName: "some-name-1", Properties: []string{"buildpack", "buildpacks"}}),
+		Entry("PropertyCombinationError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", DockerImage: "some-image", Buildpacks: []string{"some-buildpack"}}}, actionerror.PropertyCombinationError{AppName: "some-name-1", Properties: []string{"docker", "buildpacks"}}),
+		Entry("PropertyCombinationError", CommandLineSettings{RandomRoute: true}, []manifest.Application{{Name: "some-name-1", Routes: []string{"some-route"}}}, actionerror.PropertyCombinationError{AppName: "some-name-1", Properties: []string{"routes", "random-route"}}),
 	)
 })
@@ -35,6 +35,36 @@ type FakeSharedActor struct {
 		result1 []sharedaction.Resource
 		result2 error
 	}
+	ListArchiveResourcesStub        func(archivePath string) ([]sharedaction.Resource, []sharedaction.IgnoredResource, error)
+	listArchiveResourcesMutex       sync.RWMutex
+	listArchiveResourcesArgsForCall []struct {
+		archivePath string
+	}
+	listArchiveResourcesReturns struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}
+	listArchiveResourcesReturnsOnCall map[int]struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}
+	ListDirectoryResourcesStub        func(sourceDir string) ([]sharedaction.Resource, []sharedaction.IgnoredResource, error)
+	listDirectoryResourcesMutex       sync.RWMutex
+	listDirectoryResourcesArgsForCall []struct {
+		sourceDir string
+	}
+	listDirectoryResourcesReturns struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}
+	listDirectoryResourcesReturnsOnCall map[int]struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}
 	ZipArchiveResourcesStub        func(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
 	zipArchiveResourcesMutex       sync.RWMutex
 	zipArchiveResourcesArgsForCall []struct {
@@ -169,6 +199,114 @@ func (fake *FakeSharedActor) GatherDirectoryResourcesReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeSharedActor) ListArchiveResources(archivePath string) ([]sharedaction.Resource, []sharedaction.IgnoredResource, error) {
+	fake.listArchiveResourcesMutex.Lock()
+	ret, specificReturn := fake.listArchiveResourcesReturnsOnCall[len(fake.listArchiveResourcesArgsForCall)]
+	fake.listArchiveResourcesArgsForCall = append(fake.listArchiveResourcesArgsForCall, struct {
+		archivePath string
+	}{archivePath})
+	fake.recordInvocation("ListArchiveResources", []interface{}{archivePath})
+	fake.listArchiveResourcesMutex.Unlock()
+	if fake.ListArchiveResourcesStub != nil {
+		return fake.ListArchiveResourcesStub(archivePath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.listArchiveResourcesReturns.result1, fake.listArchiveResourcesReturns.result2, fake.listArchiveResourcesReturns.result3
+}
+
+func (fake *FakeSharedActor) ListArchiveResourcesCallCount() int {
+	fake.listArchiveResourcesMutex.RLock()
+	defer fake.listArchiveResourcesMutex.RUnlock()
+	return len(fake.listArchiveResourcesArgsForCall)
+}
+
+func (fake *FakeSharedActor) ListArchiveResourcesArgsForCall(i int) string {
+	fake.listArchiveResourcesMutex.RLock()
+	defer fake.listArchiveResourcesMutex.RUnlock()
+	return fake.listArchiveResourcesArgsForCall[i].archivePath
+}
+
+func (fake *FakeSharedActor) ListArchiveResourcesReturns(result1 []sharedaction.Resource, result2 []sharedaction.IgnoredResource, result3 error) {
+	fake.ListArchiveResourcesStub = nil
+	fake.listArchiveResourcesReturns = struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSharedActor) ListArchiveResourcesReturnsOnCall(i int, result1 []sharedaction.Resource, result2 []sharedaction.IgnoredResource, result3 error) {
+	fake.ListArchiveResourcesStub = nil
+	if fake.listArchiveResourcesReturnsOnCall == nil {
+		fake.listArchiveResourcesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.Resource
+			result2 []sharedaction.IgnoredResource
+			result3 error
+		})
+	}
+	fake.listArchiveResourcesReturnsOnCall[i] = struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSharedActor) ListDirectoryResources(sourceDir string) ([]sharedaction.Resource, []sharedaction.IgnoredResource, error) {
+	fake.listDirectoryResourcesMutex.Lock()
+	ret, specificReturn := fake.listDirectoryResourcesReturnsOnCall[len(fake.listDirectoryResourcesArgsForCall)]
+	fake.listDirectoryResourcesArgsForCall = append(fake.listDirectoryResourcesArgsForCall, struct {
+		sourceDir string
+	}{sourceDir})
+	fake.recordInvocation("ListDirectoryResources", []interface{}{sourceDir})
+	fake.listDirectoryResourcesMutex.Unlock()
+	if fake.ListDirectoryResourcesStub != nil {
+		return fake.ListDirectoryResourcesStub(sourceDir)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.listDirectoryResourcesReturns.result1, fake.listDirectoryResourcesReturns.result2, fake.listDirectoryResourcesReturns.result3
+}
+
+func (fake *FakeSharedActor) ListDirectoryResourcesCallCount() int {
+	fake.listDirectoryResourcesMutex.RLock()
+	defer fake.listDirectoryResourcesMutex.RUnlock()
+	return len(fake.listDirectoryResourcesArgsForCall)
+}
+
+func (fake *FakeSharedActor) ListDirectoryResourcesArgsForCall(i int) string {
+	fake.listDirectoryResourcesMutex.RLock()
+	defer fake.listDirectoryResourcesMutex.RUnlock()
+	return fake.listDirectoryResourcesArgsForCall[i].sourceDir
+}
+
+func (fake *FakeSharedActor) ListDirectoryResourcesReturns(result1 []sharedaction.Resource, result2 []sharedaction.IgnoredResource, result3 error) {
+	fake.ListDirectoryResourcesStub = nil
+	fake.listDirectoryResourcesReturns = struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSharedActor) ListDirectoryResourcesReturnsOnCall(i int, result1 []sharedaction.Resource, result2 []sharedaction.IgnoredResource, result3 error) {
+	fake.ListDirectoryResourcesStub = nil
+	if fake.listDirectoryResourcesReturnsOnCall == nil {
+		fake.listDirectoryResourcesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.Resource
+			result2 []sharedaction.IgnoredResource
+			result3 error
+		})
+	}
+	fake.listDirectoryResourcesReturnsOnCall[i] = struct {
+		result1 []sharedaction.Resource
+		result2 []sharedaction.IgnoredResource
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSharedActor) ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error) {
 	var filesToIncludeCopy []sharedaction.Resource
 	if filesToInclude != nil {
@@ -290,6 +428,10 @@ func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
 	defer fake.gatherArchiveResourcesMutex.RUnlock()
 	fake.gatherDirectoryResourcesMutex.RLock()
 	defer fake.gatherDirectoryResourcesMutex.RUnlock()
+	fake.listArchiveResourcesMutex.RLock()
+	defer fake.listArchiveResourcesMutex.RUnlock()
+	fake.listDirectoryResourcesMutex.RLock()
+	defer fake.listDirectoryResourcesMutex.RUnlock()
 	fake.zipArchiveResourcesMutex.RLock()
 	defer fake.zipArchiveResourcesMutex.RUnlock()
 	fake.zipDirectoryResourcesMutex.RLock()
@@ -189,35 +189,46 @@ func (actor Actor) CreateRoutes(config ApplicationConfig) (ApplicationConfig, bo
 	return config, createdRoutes, allWarnings, nil
 }
 
-// GetGeneratedRoute returns a route with the host and the default org domain.
-// This may be a partial route (ie no GUID) if the route does not exist.
-func (actor Actor) GetGeneratedRoute(manifestApp manifest.Application, orgGUID string, spaceGUID string, knownRoutes []v2action.Route) (v2action.Route, Warnings, error) {
-	desiredDomain, warnings, err := actor.calculateDomain(manifestApp, orgGUID)
+// GetGeneratedRoutes returns the routes for every combination of the hosts
+// and domains of the application. When no domains are provided the default
+// org domain is used, and when no hosts are provided the application name is
+// used, with random words appended when random-route is set. Routes on TCP
+// domains have no host and get a random port. These may be partial routes
+// (ie no GUID) if the routes do not exist.
+func (actor Actor) GetGeneratedRoutes(manifestApp manifest.Application, orgGUID string, spaceGUID string, knownRoutes []v2action.Route) ([]v2action.Route, Warnings, error) {
+	desiredDomains, warnings, err := actor.calculateDomains(manifestApp, orgGUID)
 	if err != nil {
-		return v2action.Route{}, warnings, err
+		return nil, warnings, err
 	}
 
-	var host string
+	hosts := actor.calculateHosts(manifestApp)
 
-	if desiredDomain.IsHTTP() {
-		host = manifestApp.Name
-	}
+	var routes []v2action.Route
+	for _, desiredDomain := range desiredDomains {
+		for _, host := range hosts {
+			generatedRoute := v2action.Route{
+				Domain:    desiredDomain,
+				SpaceGUID: spaceGUID,
+			}
+			if desiredDomain.IsHTTP() {
+				generatedRoute.Host = strings.ToLower(host)
+				generatedRoute.Path = manifestApp.RoutePath
+			}
 
-	defaultRoute := v2action.Route{
-		Domain:    desiredDomain,
-		Host:      strings.ToLower(host),
-		SpaceGUID: spaceGUID,
-	}
+			if _, found := actor.routeInListBySettings(generatedRoute, routes); found {
+				continue
+			}
 
-	cachedRoute, found := actor.routeInListBySettings(defaultRoute, knownRoutes)
-	if !found {
-		route, routeWarnings, err := actor.V2Actor.FindRouteBoundToSpaceWithSettings(defaultRoute)
-		if _, ok := err.(v2action.RouteNotFoundError); ok {
-			return defaultRoute, append(warnings, routeWarnings...), nil
+			route, routeWarnings, err := actor.findOrReturnPartialRoute(generatedRoute, knownRoutes)
+			warnings = append(warnings, routeWarnings...)
+			if err != nil {
+				return nil, warnings, err
+			}
+			routes = append(routes, route)
 		}
-		return route, append(warnings, routeWarnings...), err
 	}
-	return cachedRoute, warnings, nil
+
+	return routes, warnings, nil
 }
 
 func (actor Actor) mapRouteToApp(route v2action.Route, appGUID string) (v2action.Warnings, error) {
@@ -228,35 +239,48 @@ func (actor Actor) mapRouteToApp(route v2action.Route, appGUID string) (v2action
 	return warnings, err
 }
 
-func (actor Actor) calculateDomain(manifestApp manifest.Application, orgGUID string) (v2action.Domain, Warnings, error) {
-	var (
-		desiredDomain v2action.Domain
-		warnings      Warnings
-		err           error
-	)
-
-	if manifestApp.Domain == "" {
-		desiredDomain, warnings, err = actor.DefaultDomain(orgGUID)
+func (actor Actor) calculateDomains(manifestApp manifest.Application, orgGUID string) ([]v2action.Domain, Warnings, error) {
+	if len(manifestApp.Domains) == 0 {
+		defaultDomain, warnings, err := actor.DefaultDomain(orgGUID)
 		if err != nil {
 			log.Errorln("could not find default domains:", err.Error())
-			return v2action.Domain{}, warnings, err
-		}
-	} else {
-		desiredDomains, getDomainWarnings, getDomainsErr := actor.V2Actor.GetDomainsByNameAndOrganization([]string{manifestApp.Domain}, orgGUID)
-		warnings = append(warnings, getDomainWarnings...)
-		if getDomainsErr != nil {
-			log.Errorln("could not find provided domains '%s':", manifestApp.Domain, getDomainsErr.Error())
-			return v2action.Domain{}, warnings, getDomainsErr
+			return nil, warnings, err
 		}
-		if len(desiredDomains) == 0 {
-			log.Errorln("could not find provided domains '%s':", manifestApp.Domain)
-			return v2action.Domain{}, warnings, v2action.DomainNotFoundError{Name: manifestApp.Domain}
+		return []v2action.Domain{defaultDomain}, warnings, nil
+	}
+
+	foundDomains, warnings, err := actor.V2Actor.GetDomainsByNameAndOrganization(manifestApp.Domains, orgGUID)
+	if err != nil {
+		log.Errorf("could not find provided domains '%s': %s", strings.Join(manifestApp.Domains, ", "), err)
+		return nil, Warnings(warnings), err
+	}
+
+	// CC does not allow one to have shared/owned domains with the same domain
+	// name, so there is at most one domain per name.
+	var desiredDomains []v2action.Domain
+	for _, name := range manifestApp.Domains {
+		domain, found := actor.domainInListByName(name, foundDomains)
+		if !found {
+			log.Errorf("could not find provided domain '%s'", name)
+			return nil, Warnings(warnings), v2action.DomainNotFoundError{Name: name}
 		}
-		// CC does not allow one to have shared/owned domains with the same domain name. so it's ok to take the first one
-		desiredDomain = desiredDomains[0]
+		desiredDomains = append(desiredDomains, domain)
 	}
 
-	return desiredDomain, warnings, nil
+	return desiredDomains, Warnings(warnings), nil
+}
+
+func (actor Actor) calculateHosts(manifestApp manifest.Application) []string {
+	switch {
+	case manifestApp.NoHostname:
+		return []string{""}
+	case len(manifestApp.Hosts) > 0:
+		return manifestApp.Hosts
+	case manifestApp.RandomRoute:
+		return []string{fmt.Sprintf("%s-%s", manifestApp.Name, actor.WordGenerator.Babble())}
+	default:
+		return []string{manifestApp.Name}
+	}
 }
 
 func (actor Actor) calculateRoute(route string, domainCache map[string]v2action.Domain) ([]string, v2action.Domain, error) {
@@ -275,6 +299,13 @@ func (actor Actor) calculateRoute(route string, domainCache map[string]v2action.
 	return hosts, foundDomain, err
 }
 
+func (actor Actor) findOrReturnPartialRoute(route v2action.Route, knownRoutes []v2action.Route) (v2action.Route, Warnings, error) {
+	if cachedRoute, found := actor.routeInListBySettings(route, knownRoutes); found {
+		return cachedRoute, nil, nil
+	}
+	return actor.findOrReturnPartialRouteWithSettings(route)
+}
+
 func (actor Actor) findOrReturnPartialRouteWithSettings(route v2action.Route) (v2action.Route, Warnings, error) {
 	cachedRoute, warnings, err := actor.V2Actor.FindRouteBoundToSpaceWithSettings(route)
 	if _, ok := err.(v2action.RouteNotFoundError); ok {
@@ -345,6 +376,16 @@ func (Actor) parseURL(route string) (string, types.NullInt, string, error) {
 	return parsedURL.Hostname(), port, path, err
 }
 
+func (Actor) domainInListByName(name string, domains []v2action.Domain) (v2action.Domain, bool) {
+	for _, domain := range domains {
+		if strings.EqualFold(domain.Name, name) {
+			return domain, true
+		}
+	}
+
+	return v2action.Domain{}, false
+}
+
 func (Actor) routeInListByGUID(route v2action.Route, routes []v2action.Route) bool {
 	for _, r := range routes {
 		if r.GUID == route.GUID {
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/words/generator/generatorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -17,13 +18,16 @@ import (
 
 var _ = Describe("Routes", func() {
 	var (
-		actor       *Actor
-		fakeV2Actor *pushactionfakes.FakeV2Actor
+		actor             *Actor
+		fakeV2Actor       *pushactionfakes.FakeV2Actor
+		fakeWordGenerator *generatorfakes.FakeWordGenerator
 	)
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor, nil)
+		fakeWordGenerator = new(generatorfakes.FakeWordGenerator)
+		actor = NewActor(fakeV2Actor, nil, nil)
+		actor.WordGenerator = fakeWordGenerator
 	})
 
 	Describe("UnmapRoutes", func() {
@@ -815,16 +819,16 @@ var _ = Describe("Routes", func() {
 		})
 	})
 
-	Describe("GetGeneratedRoute", func() {
+	Describe("GetGeneratedRoutes", func() {
 		var (
 			providedManifest manifest.Application
 			orgGUID          string
 			spaceGUID        string
 			knownRoutes      []v2action.Route
 
-			defaultRoute v2action.Route
-			warnings     Warnings
-			executeErr   error
+			routes     []v2action.Route
+			warnings   Warnings
+			executeErr error
 
 			domain v2action.Domain
 		)
@@ -844,12 +848,12 @@ var _ = Describe("Routes", func() {
 		})
 
 		JustBeforeEach(func() {
-			defaultRoute, warnings, executeErr = actor.GetGeneratedRoute(providedManifest, orgGUID, spaceGUID, knownRoutes)
+			routes, warnings, executeErr = actor.GetGeneratedRoutes(providedManifest, orgGUID, spaceGUID, knownRoutes)
 		})
 
 		Context("the domain is provided", func() {
 			BeforeEach(func() {
-				providedManifest.Domain = "some-private-domain"
+				providedManifest.Domains = []string{"private-domain.com"}
 			})
 
 			Context("when the provided domain exists", func() {
@@ -870,7 +874,7 @@ var _ = Describe("Routes", func() {
 						It("it uses the provided domain instead of the first shared domain", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 							Expect(warnings).To(ConsistOf("some-organization-domain-warning", "get-route-warnings"))
-							Expect(defaultRoute).To(Equal(v2action.Route{
+							Expect(routes).To(ConsistOf(v2action.Route{
 								Domain:    domain,
 								Host:      strings.ToLower(providedManifest.Name),
 								SpaceGUID: spaceGUID,
@@ -878,7 +882,7 @@ var _ = Describe("Routes", func() {
 
 							Expect(fakeV2Actor.GetDomainsByNameAndOrganizationCallCount()).To(Equal(1))
 							domainNamesArg, orgGUIDArg := fakeV2Actor.GetDomainsByNameAndOrganizationArgsForCall(0)
-							Expect(domainNamesArg).To(Equal([]string{"some-private-domain"}))
+							Expect(domainNamesArg).To(Equal([]string{"private-domain.com"}))
 							Expect(orgGUIDArg).To(Equal(orgGUID))
 						})
 					})
@@ -899,7 +903,7 @@ var _ = Describe("Routes", func() {
 						It("returns the route and warnings", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 							Expect(warnings).To(ConsistOf("some-organization-domain-warning", "get-route-warnings"))
-							Expect(defaultRoute).To(Equal(route))
+							Expect(routes).To(ConsistOf(route))
 						})
 					})
 
@@ -935,14 +939,14 @@ var _ = Describe("Routes", func() {
 					It("it uses the provided domain instead of the first shared domain and has no host", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 						Expect(warnings).To(ConsistOf("some-organization-domain-warning", "get-route-warnings"))
-						Expect(defaultRoute).To(Equal(v2action.Route{
+						Expect(routes).To(ConsistOf(v2action.Route{
 							Domain:    domain,
 							SpaceGUID: spaceGUID,
 						}))
 
 						Expect(fakeV2Actor.GetDomainsByNameAndOrganizationCallCount()).To(Equal(1))
 						domainNamesArg, orgGUIDArg := fakeV2Actor.GetDomainsByNameAndOrganizationArgsForCall(0)
-						Expect(domainNamesArg).To(Equal([]string{"some-private-domain"}))
+						Expect(domainNamesArg).To(Equal([]string{"private-domain.com"}))
 						Expect(orgGUIDArg).To(Equal(orgGUID))
 					})
 				})
@@ -958,7 +962,7 @@ var _ = Describe("Routes", func() {
 				})
 
 				It("returns an DomainNotFoundError", func() {
-					Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "some-private-domain"}))
+					Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "private-domain.com"}))
 					Expect(warnings).To(ConsistOf("some-organization-domain-warning"))
 				})
 			})
@@ -989,7 +993,7 @@ var _ = Describe("Routes", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 						Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
 
-						Expect(defaultRoute).To(Equal(v2action.Route{
+						Expect(routes).To(ConsistOf(v2action.Route{
 							Domain:    domain,
 							GUID:      "some-route-guid",
 							Host:      strings.ToLower(providedManifest.Name),
@@ -1017,7 +1021,7 @@ var _ = Describe("Routes", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 							Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings"))
 
-							Expect(defaultRoute).To(Equal(v2action.Route{
+							Expect(routes).To(ConsistOf(v2action.Route{
 								Domain:    domain,
 								GUID:      "some-route-guid",
 								Host:      strings.ToLower(providedManifest.Name),
@@ -1038,7 +1042,7 @@ var _ = Describe("Routes", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 						Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
 
-						Expect(defaultRoute).To(Equal(v2action.Route{Domain: domain, Host: strings.ToLower(providedManifest.Name), SpaceGUID: spaceGUID}))
+						Expect(routes).To(ConsistOf(v2action.Route{Domain: domain, Host: strings.ToLower(providedManifest.Name), SpaceGUID: spaceGUID}))
 					})
 				})
 
@@ -1071,5 +1075,111 @@ var _ = Describe("Routes", func() {
 				})
 			})
 		})
+
+		Context("when hosts and domains are provided", func() {
+			var otherDomain v2action.Domain
+
+			BeforeEach(func() {
+				providedManifest.Hosts = []string{"Host-1", "host-2"}
+				providedManifest.Domains = []string{"private-domain.com", "other-domain.com"}
+
+				otherDomain = v2action.Domain{
+					Name: "other-domain.com",
+					GUID: "some-other-domain-guid",
+				}
+				fakeV2Actor.GetDomainsByNameAndOrganizationReturns(
+					[]v2action.Domain{otherDomain, domain},
+					v2action.Warnings{"some-organization-domain-warning"},
+					nil,
+				)
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
+			})
+
+			It("returns a route for every host on every domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(Equal([]v2action.Route{
+					{Domain: domain, Host: "host-1", SpaceGUID: spaceGUID},
+					{Domain: domain, Host: "host-2", SpaceGUID: spaceGUID},
+					{Domain: otherDomain, Host: "host-1", SpaceGUID: spaceGUID},
+					{Domain: otherDomain, Host: "host-2", SpaceGUID: spaceGUID},
+				}))
+				Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(4))
+
+				domainNamesArg, _ := fakeV2Actor.GetDomainsByNameAndOrganizationArgsForCall(0)
+				Expect(domainNamesArg).To(Equal([]string{"private-domain.com", "other-domain.com"}))
+			})
+
+			Context("when one of the domains is a TCP domain", func() {
+				BeforeEach(func() {
+					otherDomain.RouterGroupType = constant.TCPRouterGroup
+					fakeV2Actor.GetDomainsByNameAndOrganizationReturns([]v2action.Domain{otherDomain, domain}, nil, nil)
+				})
+
+				It("returns a single route with a random port on the TCP domain", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(routes).To(Equal([]v2action.Route{
+						{Domain: domain, Host: "host-1", SpaceGUID: spaceGUID},
+						{Domain: domain, Host: "host-2", SpaceGUID: spaceGUID},
+						{Domain: otherDomain, SpaceGUID: spaceGUID},
+					}))
+					Expect(routes[2].RandomTCPPort()).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when no-hostname is provided", func() {
+			BeforeEach(func() {
+				providedManifest.NoHostname = true
+				providedManifest.Hosts = []string{"some-host"}
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{domain}, nil, nil)
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, nil, v2action.RouteNotFoundError{})
+			})
+
+			It("returns a route on the domain without a host", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(ConsistOf(v2action.Route{Domain: domain, SpaceGUID: spaceGUID}))
+			})
+		})
+
+		Context("when random-route is provided", func() {
+			BeforeEach(func() {
+				providedManifest.RandomRoute = true
+				fakeWordGenerator.BabbleReturns("awesome-banana")
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{domain}, nil, nil)
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, nil, v2action.RouteNotFoundError{})
+			})
+
+			It("appends random words to the app name for the host", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(ConsistOf(v2action.Route{Domain: domain, Host: "some-app-awesome-banana", SpaceGUID: spaceGUID}))
+				Expect(fakeWordGenerator.BabbleCallCount()).To(Equal(1))
+			})
+
+			Context("when hosts are also provided", func() {
+				BeforeEach(func() {
+					providedManifest.Hosts = []string{"some-host"}
+				})
+
+				It("uses the hosts", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(routes).To(ConsistOf(v2action.Route{Domain: domain, Host: "some-host", SpaceGUID: spaceGUID}))
+					Expect(fakeWordGenerator.BabbleCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a route path is provided", func() {
+			BeforeEach(func() {
+				providedManifest.RoutePath = "/some-path"
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{domain}, nil, nil)
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, nil, v2action.RouteNotFoundError{})
+			})
+
+			It("sets the path on the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(ConsistOf(v2action.Route{Domain: domain, Host: "some-app", Path: "/some-path", SpaceGUID: spaceGUID}))
+				Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsArgsForCall(0).Path).To(Equal("/some-path"))
+			})
+		})
 	})
 })
@@ -7,6 +7,8 @@ import "code.cloudfoundry.org/cli/actor/sharedaction"
 type SharedActor interface {
 	GatherArchiveResources(archivePath string) ([]sharedaction.Resource, error)
 	GatherDirectoryResources(sourceDir string) ([]sharedaction.Resource, error)
+	ListArchiveResources(archivePath string) ([]sharedaction.Resource, []sharedaction.IgnoredResource, error)
+	ListDirectoryResources(sourceDir string) ([]sharedaction.Resource, []sharedaction.IgnoredResource, error)
 	ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
 	ZipDirectoryResources(sourceDir string, filesToInclude []sharedaction.Resource) (string, error)
 }
@@ -0,0 +1,57 @@
+package pushaction
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	log "github.com/sirupsen/logrus"
+)
+
+// UploadPreview lists what push would do with each path of an application's
+// files.
+type UploadPreview struct {
+	// Resources are the files and directories that are sent to the Cloud
+	// Controller.
+	Resources []sharedaction.Resource
+	// Ignored are the paths excluded by the default ignore rules or
+	// .cfignore.
+	Ignored []sharedaction.IgnoredResource
+	// Matched are the resources the Cloud Controller already has, so they are
+	// not uploaded. It is only set when resource matching was requested.
+	Matched []sharedaction.Resource
+}
+
+// PreviewUpload gathers the resources at the provided path the same way push
+// does, without uploading them. When matchResources is true, the Cloud
+// Controller is asked which of them it already has.
+func (actor Actor) PreviewUpload(path string, matchResources bool) (UploadPreview, Warnings, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return UploadPreview{}, nil, err
+	}
+
+	var preview UploadPreview
+	if info.IsDir() {
+		log.WithField("path", path).Info("listing directory resources")
+		preview.Resources, preview.Ignored, err = actor.SharedActor.ListDirectoryResources(path)
+	} else {
+		log.WithField("path", path).Info("listing archive resources")
+		preview.Resources, preview.Ignored, err = actor.SharedActor.ListArchiveResources(path)
+	}
+	if err != nil {
+		return UploadPreview{}, nil, err
+	}
+
+	if !matchResources {
+		return preview, nil, nil
+	}
+
+	matched, _, warnings, err := actor.V2Actor.ResourceMatch(actor.ConvertSharedResourcesToV2Resources(preview.Resources))
+	if err != nil {
+		log.Errorln("matching resources:", err)
+		return UploadPreview{}, Warnings(warnings), err
+	}
+	preview.Matched = actor.ConvertV2ResourcesToSharedResources(matched)
+
+	return preview, Warnings(warnings), nil
+}
@@ -0,0 +1,158 @@
+package pushaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Upload Preview", func() {
+	var (
+		actor           *Actor
+		fakeV2Actor     *pushactionfakes.FakeV2Actor
+		fakeSharedActor *pushactionfakes.FakeSharedActor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		fakeSharedActor = new(pushactionfakes.FakeSharedActor)
+		actor = NewActor(fakeV2Actor, nil, fakeSharedActor)
+	})
+
+	Describe("PreviewUpload", func() {
+		var (
+			tempDir        string
+			path           string
+			matchResources bool
+
+			preview    UploadPreview
+			warnings   Warnings
+			executeErr error
+
+			resources []sharedaction.Resource
+			ignored   []sharedaction.IgnoredResource
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "upload-preview-test")
+			Expect(err).ToNot(HaveOccurred())
+			path = tempDir
+			matchResources = false
+
+			resources = []sharedaction.Resource{
+				{Filename: "some-dir", Mode: 0755},
+				{Filename: "some-dir/some-file", SHA1: "some-sha", Size: 6, Mode: 0644},
+				{Filename: "some-other-file", SHA1: "some-other-sha", Size: 10, Mode: 0644},
+			}
+			ignored = []sharedaction.IgnoredResource{
+				{Filename: ".git", Rule: sharedaction.IgnoreRule{Pattern: ".git"}},
+			}
+			fakeSharedActor.ListDirectoryResourcesReturns(resources, ignored, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			preview, warnings, executeErr = actor.PreviewUpload(path, matchResources)
+		})
+
+		Context("when the path is a directory", func() {
+			It("lists the directory resources without matching them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(preview).To(Equal(UploadPreview{
+					Resources: resources,
+					Ignored:   ignored,
+				}))
+
+				Expect(fakeSharedActor.ListDirectoryResourcesCallCount()).To(Equal(1))
+				Expect(fakeSharedActor.ListDirectoryResourcesArgsForCall(0)).To(Equal(path))
+				Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the path is an archive", func() {
+			BeforeEach(func() {
+				archive, err := ioutil.TempFile(tempDir, "some-archive")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(archive.Close()).To(Succeed())
+				path = archive.Name()
+
+				fakeSharedActor.ListArchiveResourcesReturns(resources, nil, nil)
+			})
+
+			It("lists the archive resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(preview.Resources).To(Equal(resources))
+
+				Expect(fakeSharedActor.ListArchiveResourcesCallCount()).To(Equal(1))
+				Expect(fakeSharedActor.ListArchiveResourcesArgsForCall(0)).To(Equal(path))
+			})
+		})
+
+		Context("when listing the resources errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("oh no")
+				fakeSharedActor.ListDirectoryResourcesReturns(nil, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when resource matching is requested", func() {
+			BeforeEach(func() {
+				matchResources = true
+			})
+
+			Context("when the match is successful", func() {
+				BeforeEach(func() {
+					fakeV2Actor.ResourceMatchReturns(
+						[]v2action.Resource{{Filename: "some-other-file", SHA1: "some-other-sha", Size: 10, Mode: 0644}},
+						nil,
+						v2action.Warnings{"some-match-warning"},
+						nil,
+					)
+				})
+
+				It("returns the matched resources and warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-match-warning"))
+					Expect(preview.Matched).To(Equal([]sharedaction.Resource{
+						{Filename: "some-other-file", SHA1: "some-other-sha", Size: 10, Mode: 0644},
+					}))
+
+					Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.ResourceMatchArgsForCall(0)).To(Equal(actor.ConvertSharedResourcesToV2Resources(resources)))
+				})
+			})
+
+			Context("when the match errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("no match")
+					fakeV2Actor.ResourceMatchReturns(nil, nil, v2action.Warnings{"some-match-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("some-match-warning"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,10 @@
+package pushaction
+
+import "code.cloudfoundry.org/cli/actor/v3action"
+
+//go:generate counterfeiter . V3Actor
+
+type V3Actor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	UpdateApplication(app v3action.Application) (v3action.Application, v3action.Warnings, error)
+}
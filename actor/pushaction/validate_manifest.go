@@ -0,0 +1,13 @@
+package pushaction
+
+import "code.cloudfoundry.org/cli/util/manifest"
+
+func (*Actor) ValidateManifest(pathToManifest string) ([]manifest.Diagnostic, error) {
+	// Cover method to make testing easier
+	return manifest.ValidateManifest(pathToManifest)
+}
+
+func (*Actor) ManifestJSONSchema() ([]byte, error) {
+	// Cover method to make testing easier
+	return manifest.JSONSchema()
+}
@@ -0,0 +1,393 @@
+package sharedaction
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/ykk"
+	log "github.com/sirupsen/logrus"
+)
+
+type InvalidArchiveEntryError struct {
+	Name string
+}
+
+func (e InvalidArchiveEntryError) Error() string {
+	return fmt.Sprintf("archive entry %s is outside of the archive", e.Name)
+}
+
+// archiveEntry is a file, directory or symlink inside an application archive.
+type archiveEntry struct {
+	Name string
+	Info os.FileInfo
+	// Mode is the mode of the resource created from the entry.
+	Mode os.FileMode
+	open func() (io.ReadCloser, error)
+}
+
+// Open returns the contents of the entry. The contents of a symlink are its
+// target.
+func (entry archiveEntry) Open() (io.ReadCloser, error) {
+	return entry.open()
+}
+
+// archive is an opened zip, JAR, WAR, tar or gzipped tar archive. Tar archives
+// cannot be read out of order, so they are extracted to a temporary directory
+// that is removed on Close.
+type archive struct {
+	Entries []archiveEntry
+
+	closers []io.Closer
+	tempDir string
+}
+
+func (actor Actor) openArchive(archivePath string) (*archive, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	opened := &archive{closers: []io.Closer{file}}
+	isTar := isTarArchive(file)
+	if isTar {
+		log.WithField("archivePath", archivePath).Debug("reading tar archive")
+		err = opened.extractTar(file)
+	} else {
+		err = opened.readZip(file)
+	}
+
+	// Only tarballs and JAR or WAR files are unwrapped, plain zip archives are
+	// pushed with their layout unchanged.
+	unwrap := isTar || isJavaArchive(archivePath)
+	if err == nil && unwrap {
+		err = opened.unwrapJavaArchive()
+	}
+	if err != nil {
+		_ = opened.Close()
+		return nil, err
+	}
+
+	if unwrap {
+		opened.stripTopLevelDirectory()
+	}
+	return opened, nil
+}
+
+func (a *archive) Close() error {
+	var closeErr error
+	for _, closer := range a.closers {
+		if err := closer.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	a.closers = nil
+
+	if a.tempDir != "" {
+		if err := os.RemoveAll(a.tempDir); err != nil && closeErr == nil {
+			closeErr = err
+		}
+		a.tempDir = ""
+	}
+	return closeErr
+}
+
+func (a *archive) readZip(file *os.File) error {
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	// ykk looks for the first zip header from the current offset.
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	reader, err := ykk.NewReader(file, info.Size())
+	if err != nil {
+		return err
+	}
+
+	a.Entries = nil
+	for _, archivedFile := range reader.File {
+		entry := archiveEntry{
+			Name: archivedFile.Name,
+			Info: archivedFile.FileInfo(),
+			Mode: DefaultArchiveFilePermissions,
+			open: archivedFile.Open,
+		}
+		if entry.Info.IsDir() {
+			entry.Mode = DefaultFolderPermissions
+		}
+		a.Entries = append(a.Entries, entry)
+	}
+	return nil
+}
+
+// extractTar reads a tar or gzipped tar archive, keeping the modes of its
+// files and the targets of its symlinks. Hard links get the contents and mode
+// of the entry they link to. When a path appears more than once, the last
+// entry wins, as it does when the archive is extracted with tar.
+func (a *archive) extractTar(file *os.File) error {
+	var reader io.Reader = file
+	if isGzipped(file) {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	if err := a.makeTempDir(); err != nil {
+		return err
+	}
+
+	indexByName := map[string]int{}
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimPrefix(header.Name, "./")
+		if name == "" {
+			continue
+		}
+
+		extractedPath, err := a.extractedPath(name)
+		if err != nil {
+			return err
+		}
+
+		entry := archiveEntry{
+			Name: name,
+			Info: header.FileInfo(),
+			Mode: fixMode(header.FileInfo().Mode().Perm()),
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			entry.Mode = DefaultFolderPermissions
+			entry.open = func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(bytes.NewReader(nil)), nil
+			}
+		case tar.TypeSymlink:
+			target := header.Linkname
+			entry.open = func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(strings.NewReader(target)), nil
+			}
+		case tar.TypeLink:
+			index, ok := indexByName[strings.TrimPrefix(header.Linkname, "./")]
+			if !ok {
+				return InvalidArchiveEntryError{Name: header.Linkname}
+			}
+			linked := a.Entries[index]
+			entry.Info = linked.Info
+			entry.Mode = linked.Mode
+			entry.open = linked.open
+
+			// Copy the contents, so that a later entry replacing the
+			// linked path does not change the link.
+			if linked.Info.Mode().IsRegular() {
+				err = copyEntry(linked, extractedPath)
+				if err != nil {
+					return err
+				}
+				entry.open = func() (io.ReadCloser, error) {
+					return os.Open(extractedPath)
+				}
+			}
+		case tar.TypeReg:
+			err = extractFile(tarReader, extractedPath)
+			if err != nil {
+				return err
+			}
+			entry.open = func() (io.ReadCloser, error) {
+				return os.Open(extractedPath)
+			}
+		default:
+			log.WithField("name", header.Name).Debug("skipping unsupported tar entry")
+			continue
+		}
+
+		if index, ok := indexByName[name]; ok {
+			a.Entries[index] = entry
+			continue
+		}
+		indexByName[name] = len(a.Entries)
+		a.Entries = append(a.Entries, entry)
+	}
+}
+
+// unwrapJavaArchive replaces the entries of an archive that only contains a
+// single JAR or WAR file with the entries of that file, so that a tarball of a
+// Java artifact is pushed the same way as the artifact itself.
+func (a *archive) unwrapJavaArchive() error {
+	var nested *archiveEntry
+	for i, entry := range a.Entries {
+		if entry.Info.IsDir() {
+			continue
+		}
+		if nested != nil || !entry.Info.Mode().IsRegular() || !isJavaArchive(entry.Name) {
+			return nil
+		}
+		nested = &a.Entries[i]
+	}
+	if nested == nil {
+		return nil
+	}
+
+	log.WithField("name", nested.Name).Debug("reading nested java archive")
+	if err := a.makeTempDir(); err != nil {
+		return err
+	}
+
+	nestedFile, err := ioutil.TempFile(a.tempDir, "nested-")
+	if err != nil {
+		return err
+	}
+	a.closers = append(a.closers, nestedFile)
+
+	contents, err := nested.Open()
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	if _, err = io.Copy(nestedFile, contents); err != nil {
+		return err
+	}
+
+	return a.readZip(nestedFile)
+}
+
+// stripTopLevelDirectory removes a single top level directory that wraps an
+// exploded JAR or WAR, recognized by its META-INF or WEB-INF directory, from
+// the entry names.
+func (a *archive) stripTopLevelDirectory() {
+	var topLevel string
+	isJavaLayout := false
+	for _, entry := range a.Entries {
+		name := strings.TrimPrefix(entry.Name, "/")
+		if name == "" {
+			continue
+		}
+
+		parts := strings.SplitN(name, "/", 3)
+		if len(parts) == 1 || (topLevel != "" && parts[0] != topLevel) {
+			return
+		}
+		topLevel = parts[0]
+
+		if len(parts) == 3 && (parts[1] == "META-INF" || parts[1] == "WEB-INF") {
+			isJavaLayout = true
+		}
+	}
+	if !isJavaLayout {
+		return
+	}
+
+	log.WithField("directory", topLevel).Debug("removing top level directory from archive entries")
+	var entries []archiveEntry
+	for _, entry := range a.Entries {
+		entry.Name = strings.TrimPrefix(strings.TrimPrefix(entry.Name, "/"), topLevel+"/")
+		if entry.Name != "" {
+			entries = append(entries, entry)
+		}
+	}
+	a.Entries = entries
+}
+
+func (a *archive) makeTempDir() error {
+	if a.tempDir != "" {
+		return nil
+	}
+
+	tempDir, err := ioutil.TempDir("", "cf-cli-archive-")
+	if err != nil {
+		return err
+	}
+	a.tempDir = tempDir
+	return nil
+}
+
+// extractedPath returns where an entry is extracted to, making sure that it is
+// inside the temporary directory.
+func (a *archive) extractedPath(name string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(name, "/")))
+	if cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", InvalidArchiveEntryError{Name: name}
+	}
+	return filepath.Join(a.tempDir, cleaned), nil
+}
+
+// copyEntry writes the contents of the entry to path.
+func copyEntry(entry archiveEntry, path string) error {
+	contents, err := entry.open()
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	return extractFile(contents, path)
+}
+
+func extractFile(contents io.Reader, path string) error {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, contents)
+	return err
+}
+
+// isTarArchive returns true for tar archives, gzipped or not. Any other file
+// is read as a zip archive.
+func isTarArchive(file *os.File) bool {
+	header := make([]byte, 512)
+	if isGzipped(file) {
+		gzipReader, err := gzip.NewReader(io.NewSectionReader(file, 0, 1<<62))
+		if err != nil {
+			return false
+		}
+		defer gzipReader.Close()
+
+		if _, err = io.ReadFull(gzipReader, header); err != nil {
+			return false
+		}
+	} else if _, err := file.ReadAt(header, 0); err != nil {
+		return false
+	}
+
+	return string(header[257:262]) == "ustar"
+}
+
+func isGzipped(file *os.File) bool {
+	magic := make([]byte, 2)
+	_, err := file.ReadAt(magic, 0)
+	return err == nil && magic[0] == 0x1f && magic[1] == 0x8b
+}
+
+func isJavaArchive(name string) bool {
+	extension := strings.ToLower(filepath.Ext(name))
+	return extension == ".jar" || extension == ".war"
+}
@@ -0,0 +1,135 @@
+package sharedaction
+
+import (
+	"reflect"
+	"sort"
+)
+
+// CompletionValueType is the kind of resource whose names can be used to
+// complete an argument or flag value.
+type CompletionValueType string
+
+const (
+	CompletionValueNone     CompletionValueType = ""
+	CompletionValueApps     CompletionValueType = "apps"
+	CompletionValueOrgs     CompletionValueType = "orgs"
+	CompletionValueSpaces   CompletionValueType = "spaces"
+	CompletionValueServices CompletionValueType = "services"
+)
+
+var positionalArgCompletionValues = map[string]CompletionValueType{
+	"APP_NAME":         CompletionValueApps,
+	"SOURCE_APP":       CompletionValueApps,
+	"ORG":              CompletionValueOrgs,
+	"ORG_NAME":         CompletionValueOrgs,
+	"SPACE":            CompletionValueSpaces,
+	"SPACE_NAME":       CompletionValueSpaces,
+	"SERVICE_INSTANCE": CompletionValueServices,
+}
+
+// CommandCompletion contains the details needed by shell completion scripts
+// to complete a command
+type CommandCompletion struct {
+	// Name is the command name
+	Name string
+
+	// Description is the command description
+	Description string
+
+	// Alias is the command alias
+	Alias string
+
+	// Flags contains the list of flags for this command
+	Flags []CompletionFlag
+
+	// Args contains the value type of each positional argument, in order
+	Args []CompletionValueType
+}
+
+// CompletionFlag contains the completion details of a command's flag
+type CompletionFlag struct {
+	// Short is the short form of the flag
+	Short string
+
+	// Long is the long form of the flag
+	Long string
+
+	// Description is the description of the flag
+	Description string
+
+	// TakesValue is true when the flag is followed by a value
+	TakesValue bool
+
+	// ValueType is the kind of resource the flag value names, if any
+	ValueType CompletionValueType
+}
+
+// CommandCompletions returns the completion details of all commands in the
+// commandList, sorted by command name. Positional arguments are completed
+// based on their names, and flags are completed based on their completion
+// tag.
+func (Actor) CommandCompletions(commandList interface{}) []CommandCompletion {
+	handler := reflect.TypeOf(commandList)
+
+	completions := []CommandCompletion{}
+	for i := 0; i < handler.NumField(); i++ {
+		field := handler.Field(i)
+		commandName := field.Tag.Get("command")
+		if commandName == "" {
+			continue
+		}
+
+		completion := CommandCompletion{
+			Name:        commandName,
+			Description: field.Tag.Get("description"),
+			Alias:       field.Tag.Get("alias"),
+			Flags:       []CompletionFlag{},
+			Args:        []CompletionValueType{},
+		}
+
+		command := field.Type
+		for j := 0; j < command.NumField(); j++ {
+			commandField := command.Field(j)
+			fieldTag := commandField.Tag
+
+			if fieldTag.Get("positional-args") != "" {
+				completion.Args = positionalArgValueTypes(commandField.Type)
+				continue
+			}
+
+			if fieldTag.Get("hidden") != "" || (fieldTag.Get("short") == "" && fieldTag.Get("long") == "") {
+				continue
+			}
+
+			completion.Flags = append(completion.Flags, CompletionFlag{
+				Short:       fieldTag.Get("short"),
+				Long:        fieldTag.Get("long"),
+				Description: fieldTag.Get("description"),
+				TakesValue:  commandField.Type.Kind() != reflect.Bool,
+				ValueType:   CompletionValueType(fieldTag.Get("completion")),
+			})
+		}
+
+		completions = append(completions, completion)
+	}
+
+	sort.Slice(completions, func(i int, j int) bool {
+		return completions[i].Name < completions[j].Name
+	})
+
+	return completions
+}
+
+func positionalArgValueTypes(args reflect.Type) []CompletionValueType {
+	valueTypes := []CompletionValueType{}
+	if args.Kind() != reflect.Struct {
+		return valueTypes
+	}
+
+	for i := 0; i < args.NumField(); i++ {
+		argName := args.Field(i).Tag.Get("positional-arg-name")
+		valueTypes = append(valueTypes, positionalArgCompletionValues[argName])
+	}
+
+	return valueTypes
+}
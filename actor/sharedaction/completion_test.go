@@ -0,0 +1,84 @@
+package sharedaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type completionCommandList struct {
+	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+
+	Target           targetCompletionCommand           `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	AddNetworkPolicy addNetworkPolicyCompletionCommand `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
+	BindService      bindServiceCompletionCommand      `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
+}
+
+type targetCompletionCommand struct {
+	Organization string      `short:"o" description:"Organization" completion:"orgs"`
+	Space        string      `short:"s" description:"Space" completion:"spaces"`
+	usage        interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+}
+
+type addNetworkPolicyCompletionCommand struct {
+	RequiredArgs struct {
+		SourceApp string `positional-arg-name:"SOURCE_APP" required:"true"`
+	} `positional-args:"yes"`
+	DestinationApp string      `long:"destination-app" required:"true" description:"Name of app to connect to" completion:"apps"`
+	Secret         bool        `long:"secret" hidden:"true"`
+	usage          interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP"`
+}
+
+type bindServiceCompletionCommand struct {
+	RequiredArgs struct {
+		AppName         string `positional-arg-name:"APP_NAME" required:"true"`
+		ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true"`
+		BindingName     string `positional-arg-name:"BINDING_NAME"`
+	} `positional-args:"yes"`
+	ParametersAsJSON string `short:"c" description:"Valid JSON object containing service-specific configuration parameters"`
+}
+
+var _ = Describe("Completion Actions", func() {
+	var actor *Actor
+
+	BeforeEach(func() {
+		actor = NewActor(&sharedactionfakes.FakeConfig{}, nil)
+	})
+
+	Describe("CommandCompletions", func() {
+		It("returns the completions for every command sorted by name", func() {
+			completions := actor.CommandCompletions(completionCommandList{})
+			Expect(completions).To(Equal([]CommandCompletion{
+				{
+					Name:        "add-network-policy",
+					Description: "Create policy to allow direct network traffic from one app to another",
+					Flags: []CompletionFlag{
+						{Long: "destination-app", Description: "Name of app to connect to", TakesValue: true, ValueType: CompletionValueApps},
+					},
+					Args: []CompletionValueType{CompletionValueApps},
+				},
+				{
+					Name:        "bind-service",
+					Description: "Bind a service instance to an app",
+					Alias:       "bs",
+					Flags: []CompletionFlag{
+						{Short: "c", Description: "Valid JSON object containing service-specific configuration parameters", TakesValue: true},
+					},
+					Args: []CompletionValueType{CompletionValueApps, CompletionValueServices, CompletionValueNone},
+				},
+				{
+					Name:        "target",
+					Description: "Set or view the targeted org or space",
+					Alias:       "t",
+					Flags: []CompletionFlag{
+						{Short: "o", Description: "Organization", TakesValue: true, ValueType: CompletionValueOrgs},
+						{Short: "s", Description: "Space", TakesValue: true, ValueType: CompletionValueSpaces},
+					},
+					Args: []CompletionValueType{},
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,87 @@
+package sharedaction
+
+import (
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// IgnoreRule is a pattern that excludes files from being uploaded.
+type IgnoreRule struct {
+	// Source is the path of the ignore file the rule was read from, relative to
+	// the application directory or archive. It is empty for the
+	// DefaultIgnoreLines and the trace files the CLI writes.
+	Source string
+	// Line is the line number of the rule in Source.
+	Line    int
+	Pattern string
+}
+
+// IgnoredResource is a path that is not uploaded, along with the rule that
+// excluded it.
+type IgnoredResource struct {
+	Filename string
+	Rule     IgnoreRule
+}
+
+type ignoreRule struct {
+	IgnoreRule
+	negate bool
+	// matcher matches paths that the rule ignores, or for negated rules the
+	// paths that it includes again.
+	matcher *ignore.GitIgnore
+}
+
+// ignoreRules is an ordered set of .cfignore style rules that, unlike
+// ignore.GitIgnore, can report which rule decided that a path is ignored.
+type ignoreRules []ignoreRule
+
+func (rules ignoreRules) add(source string, lines []string) ignoreRules {
+	for i, line := range lines {
+		trimmed := strings.Trim(strings.TrimRight(line, "\r"), " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		rule := ignoreRule{
+			IgnoreRule: IgnoreRule{Source: source, Pattern: trimmed},
+			negate:     strings.HasPrefix(trimmed, "!"),
+		}
+		if source != "" {
+			rule.Line = i + 1
+		}
+
+		if rule.negate {
+			// A negated rule on its own never matches, so it is matched after a
+			// rule that ignores everything.
+			rule.matcher, _ = ignore.CompileIgnoreLines("*", line)
+		} else {
+			rule.matcher, _ = ignore.CompileIgnoreLines(line)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// match returns the rule that ignores the path, following the same last match
+// wins semantics as ignore.GitIgnore.MatchesPath.
+func (rules ignoreRules) match(path string) (IgnoreRule, bool) {
+	var (
+		matched IgnoreRule
+		ignored bool
+	)
+
+	for _, rule := range rules {
+		if rule.negate {
+			if ignored && !rule.matcher.MatchesPath(path) {
+				matched = IgnoreRule{}
+				ignored = false
+			}
+		} else if rule.matcher.MatchesPath(path) {
+			matched = rule.IgnoreRule
+			ignored = true
+		}
+	}
+
+	return matched, ignored
+}
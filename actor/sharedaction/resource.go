@@ -7,11 +7,10 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
-	"code.cloudfoundry.org/ykk"
-	ignore "github.com/sabhiram/go-gitignore"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -58,91 +57,113 @@ type Resource struct {
 
 // GatherArchiveResources returns a list of resources for an archive.
 func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error) {
-	var resources []Resource
+	resources, _, err := actor.ListArchiveResources(archivePath)
+	return resources, err
+}
 
-	archive, err := os.Open(archivePath)
-	if err != nil {
-		return nil, err
-	}
-	defer archive.Close()
+// ListArchiveResources returns the list of resources for an archive, along
+// with the files in the archive that are ignored and the rule that ignored
+// each of them. Zip, JAR, WAR, tar and gzipped tar archives are supported. An
+// archive that only contains a JAR or WAR file is read as that file.
+func (actor Actor) ListArchiveResources(archivePath string) ([]Resource, []IgnoredResource, error) {
+	var (
+		resources []Resource
+		ignored   []IgnoredResource
+	)
 
-	reader, err := actor.newArchiveReader(archive)
+	archive, err := actor.openArchive(archivePath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	defer archive.Close()
 
-	gitIgnore, err := actor.generateArchiveCFIgnoreMatcher(reader.File)
+	rules, err := actor.generateArchiveIgnoreRules(archive.Entries)
 	if err != nil {
 		log.Errorln("reading .cfignore file:", err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	for _, archivedFile := range reader.File {
+	ignoredDirs := map[string]IgnoreRule{}
+	for _, archivedFile := range archive.Entries {
 		filename := filepath.ToSlash(archivedFile.Name)
-		if gitIgnore.MatchesPath(filename) {
+		if rule, ok := rules.match(filename); ok {
+			ignored = recordIgnored(ignored, ignoredDirs, strings.TrimSuffix(filename, "/"), archivedFile.Info.IsDir(), rule)
 			continue
 		}
 
 		resource := Resource{Filename: filename}
-		if archivedFile.FileInfo().IsDir() {
+		if archivedFile.Info.IsDir() {
 			resource.Mode = DefaultFolderPermissions
 		} else {
 			fileReader, err := archivedFile.Open()
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			defer fileReader.Close()
 
 			hash := sha1.New()
 
-			_, err = io.Copy(hash, fileReader)
+			size, err := io.Copy(hash, fileReader)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
-			resource.Mode = DefaultArchiveFilePermissions
+			resource.Mode = archivedFile.Mode
 			resource.SHA1 = fmt.Sprintf("%x", hash.Sum(nil))
-			resource.Size = archivedFile.FileInfo().Size()
+			resource.Size = size
 		}
 		resources = append(resources, resource)
 	}
-	return resources, nil
+	return resources, ignored, nil
 }
 
 // GatherDirectoryResources returns a list of resources for a directory.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
+	resources, _, err := actor.ListDirectoryResources(sourceDir)
+	return resources, err
+}
+
+// ListDirectoryResources returns the list of resources for a directory, along
+// with the paths in the directory that are ignored and the rule that ignored
+// each of them. Paths inside an ignored directory that are ignored by the same
+// rule are not listed.
+func (actor Actor) ListDirectoryResources(sourceDir string) ([]Resource, []IgnoredResource, error) {
 	var (
 		resources []Resource
-		gitIgnore *ignore.GitIgnore
+		ignored   []IgnoredResource
 	)
 
-	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
+	rules, err := actor.generateDirectoryIgnoreRules(sourceDir)
 	if err != nil {
 		log.Errorln("reading .cfignore file:", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	evalDir, err := filepath.EvalSymlinks(sourceDir)
 	if err != nil {
 		log.Errorln("evaluating symlink:", err)
-		return nil, err
+		return nil, nil, err
 	}
 
+	ignoredDirs := map[string]IgnoreRule{}
 	walkErr := filepath.Walk(evalDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		// if file ignored contine to the next file
-		if gitIgnore.MatchesPath(path) {
-			return nil
-		}
-
 		relPath, err := filepath.Rel(evalDir, path)
 		if err != nil {
 			return err
 		}
 
+		// if file ignored contine to the next file
+		if rule, ok := rules.match(path); ok {
+			if relPath != "." {
+				ignored = recordIgnored(ignored, ignoredDirs, filepath.ToSlash(relPath), info.IsDir(), rule)
+			}
+			return nil
+		}
+
 		if relPath == "." {
 			return nil
 		}
@@ -175,10 +196,26 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 	})
 
 	if len(resources) == 0 {
-		return nil, EmptyDirectoryError{Path: sourceDir}
+		return nil, nil, EmptyDirectoryError{Path: sourceDir}
+	}
+
+	return resources, ignored, walkErr
+}
+
+// recordIgnored appends the ignored path unless its parent directory was
+// ignored by the same rule.
+func recordIgnored(ignored []IgnoredResource, ignoredDirs map[string]IgnoreRule, filename string, isDir bool, rule IgnoreRule) []IgnoredResource {
+	if isDir {
+		ignoredDirs[filename] = rule
 	}
 
-	return resources, walkErr
+	if parent := path.Dir(filename); parent != "." {
+		if parentRule, ok := ignoredDirs[parent]; ok && parentRule == rule {
+			return ignored
+		}
+	}
+
+	return append(ignored, IgnoredResource{Filename: filename, Rule: rule})
 }
 
 // ZipArchiveResources zips an archive and a sorted (based on full
@@ -195,18 +232,13 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 	writer := zip.NewWriter(zipFile)
 	defer writer.Close()
 
-	source, err := os.Open(sourceArchivePath)
+	source, err := actor.openArchive(sourceArchivePath)
 	if err != nil {
 		return "", err
 	}
 	defer source.Close()
 
-	reader, err := actor.newArchiveReader(source)
-	if err != nil {
-		return "", err
-	}
-
-	for _, archiveFile := range reader.File {
+	for _, archiveFile := range source.Entries {
 		resource, ok := actor.findInResources(archiveFile.Name, filesToInclude)
 		if !ok {
 			log.WithField("archiveFileName", archiveFile.Name).Debug("skipping file")
@@ -221,7 +253,7 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 		}
 
 		err = actor.addFileToZipFromFileSystem(
-			resource.Filename, reader, archiveFile.FileInfo(),
+			resource.Filename, reader, archiveFile.Info,
 			resource.Filename, resource.SHA1, resource.Mode, writer,
 		)
 		if err != nil {
@@ -304,6 +336,11 @@ func (Actor) addFileToZipFromFileSystem(
 	header.Name = destPath
 	header.Method = zip.Deflate
 
+	// Symlinks from archives stay symlinks instead of becoming files that
+	// contain their target.
+	if fileInfo.Mode()&os.ModeSymlink != 0 {
+		mode |= os.ModeSymlink
+	}
 	header.SetMode(mode)
 	log.WithFields(log.Fields{
 		"srcPath":  srcPath,
@@ -338,7 +375,8 @@ func (Actor) addFileToZipFromFileSystem(
 	return nil
 }
 
-func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnore, error) {
+func (Actor) generateArchiveIgnoreRules(files []archiveEntry) (ignoreRules, error) {
+	rules := ignoreRules{}.add("", DefaultIgnoreLines)
 	for _, item := range files {
 		if strings.HasSuffix(item.Name, ".cfignore") {
 			fileReader, err := item.Open()
@@ -351,14 +389,13 @@ func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnor
 			if err != nil {
 				return nil, err
 			}
-			s := append(DefaultIgnoreLines, strings.Split(string(raw), "\n")...)
-			return ignore.CompileIgnoreLines(s...)
+			return rules.add(item.Name, strings.Split(string(raw), "\n")), nil
 		}
 	}
-	return ignore.CompileIgnoreLines(DefaultIgnoreLines...)
+	return rules, nil
 }
 
-func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.GitIgnore, error) {
+func (actor Actor) generateDirectoryIgnoreRules(sourceDir string) (ignoreRules, error) {
 	pathToCFIgnore := filepath.Join(sourceDir, ".cfignore")
 
 	additionalIgnoreLines := DefaultIgnoreLines
@@ -371,11 +408,15 @@ func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.G
 		}
 	}
 
+	var rules ignoreRules
 	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
-		return ignore.CompileIgnoreFileAndLines(pathToCFIgnore, additionalIgnoreLines...)
-	} else {
-		return ignore.CompileIgnoreLines(additionalIgnoreLines...)
+		raw, err := ioutil.ReadFile(pathToCFIgnore)
+		if err != nil {
+			return nil, err
+		}
+		rules = rules.add(".cfignore", strings.Split(string(raw), "\n"))
 	}
+	return rules.add("", additionalIgnoreLines), nil
 }
 
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
@@ -389,12 +430,3 @@ func (Actor) findInResources(path string, filesToInclude []Resource) (Resource,
 	log.WithField("path", path).Debug("did not find resource in files to include")
 	return Resource{}, false
 }
-
-func (Actor) newArchiveReader(archive *os.File) (*zip.Reader, error) {
-	info, err := archive.Stat()
-	if err != nil {
-		return nil, err
-	}
-
-	return ykk.NewReader(archive, info.Size())
-}
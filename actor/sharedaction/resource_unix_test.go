@@ -3,6 +3,7 @@
 package sharedaction_test
 
 import (
+	"archive/tar"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -324,6 +325,307 @@ var _ = Describe("Resource Actions", func() {
 		})
 	})
 
+	Describe("ListArchiveResources", func() {
+		var (
+			archive string
+
+			resources  []Resource
+			ignored    []IgnoredResource
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("level2"), 0655)
+			Expect(err).ToNot(HaveOccurred())
+
+			tmpfile, err := ioutil.TempFile("", "example")
+			Expect(err).ToNot(HaveOccurred())
+			archive = tmpfile.Name()
+			Expect(tmpfile.Close()).ToNot(HaveOccurred())
+
+			Expect(zipit(srcDir, archive, "")).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			resources, ignored, executeErr = actor.ListArchiveResources(archive)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(archive)).ToNot(HaveOccurred())
+		})
+
+		It("returns the resources and the ignored files with the rule that ignored them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(resources).To(Equal(
+				[]Resource{
+					{Filename: "/", Mode: DefaultFolderPermissions},
+					{Filename: "/level1/", Mode: DefaultFolderPermissions},
+					{Filename: "/tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: DefaultArchiveFilePermissions},
+					{Filename: "/tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: DefaultArchiveFilePermissions},
+				}))
+			Expect(ignored).To(Equal(
+				[]IgnoredResource{
+					{Filename: "/.cfignore", Rule: IgnoreRule{Pattern: ".cfignore"}},
+					{Filename: "/level1/level2", Rule: IgnoreRule{Source: "/.cfignore", Line: 1, Pattern: "level2"}},
+				}))
+		})
+
+		Context("when the archive wraps Java artifacts", func() {
+			var (
+				javaSrcDir string
+				extension  string
+			)
+
+			BeforeEach(func() {
+				var err error
+				javaSrcDir, err = ioutil.TempDir("", "zip-with-java")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(os.MkdirAll(filepath.Join(javaSrcDir, "app", "WEB-INF"), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(javaSrcDir, "app", "WEB-INF", "web.xml"), []byte("why hello"), 0644)).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				Expect(os.RemoveAll(archive)).ToNot(HaveOccurred())
+				archive = filepath.Join(javaSrcDir, "..", filepath.Base(javaSrcDir)+extension)
+				Expect(zipit(javaSrcDir, archive, "")).To(Succeed())
+
+				resources, ignored, executeErr = actor.ListArchiveResources(archive)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(javaSrcDir)).ToNot(HaveOccurred())
+			})
+
+			Context("when the archive is a zip file", func() {
+				BeforeEach(func() {
+					extension = ".zip"
+				})
+
+				It("keeps the wrapping directory", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(resources).To(ContainElement(
+						Resource{Filename: "/app/WEB-INF/web.xml", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: DefaultArchiveFilePermissions},
+					))
+				})
+
+				Context("when it only contains a WAR file", func() {
+					BeforeEach(func() {
+						Expect(os.RemoveAll(filepath.Join(javaSrcDir, "app"))).To(Succeed())
+						Expect(zipit(srcDir, filepath.Join(javaSrcDir, "app.war"), "")).To(Succeed())
+					})
+
+					It("does not unwrap the WAR file", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(resources).To(HaveLen(2))
+						Expect(resources[1].Filename).To(Equal("/app.war"))
+					})
+				})
+			})
+
+			Context("when the archive is a WAR file", func() {
+				BeforeEach(func() {
+					extension = ".war"
+				})
+
+				It("removes the wrapping directory", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(resources).To(ContainElement(
+						Resource{Filename: "WEB-INF/web.xml", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: DefaultArchiveFilePermissions},
+					))
+				})
+			})
+		})
+	})
+
+	Describe("ListArchiveResources with tar archives", func() {
+		var (
+			archive   string
+			gzipped   bool
+			tarSrcDir string
+
+			resources  []Resource
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			gzipped = true
+			tarSrcDir = srcDir
+			Expect(os.Symlink("tmpFile2", filepath.Join(srcDir, "some-link"))).To(Succeed())
+
+			tmpfile, err := ioutil.TempFile("", "example")
+			Expect(err).ToNot(HaveOccurred())
+			archive = tmpfile.Name()
+			Expect(tmpfile.Close()).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			Expect(tarit(tarSrcDir, archive, gzipped)).To(Succeed())
+			resources, _, executeErr = actor.ListArchiveResources(archive)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(archive)).ToNot(HaveOccurred())
+		})
+
+		Context("when the archive is a gzipped tarball", func() {
+			It("keeps the file modes and symlinks", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(resources).To(Equal(
+					[]Resource{
+						{Filename: "level1/", Mode: DefaultFolderPermissions},
+						{Filename: "level1/level2/", Mode: DefaultFolderPermissions},
+						{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644},
+						{Filename: "some-link", SHA1: "e9620e21b7a71c8011a9728f9544fc1f178009c6", Size: 8, Mode: 0777},
+						{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+						{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+					}))
+			})
+		})
+
+		Context("when the archive is a tarball", func() {
+			BeforeEach(func() {
+				gzipped = false
+			})
+
+			It("gathers the same resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(HaveLen(6))
+				Expect(resources[4]).To(Equal(Resource{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751}))
+			})
+		})
+
+		Context("when the tarball has hard links and repeated paths", func() {
+			BeforeEach(func() {
+				var err error
+				tarSrcDir, err = ioutil.TempDir("", "tar-with-hard-links")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tarSrcDir)).ToNot(HaveOccurred())
+			})
+
+			// Replaces the archive written from tarSrcDir with one that
+			// tar.FileInfoHeader cannot produce.
+			JustBeforeEach(func() {
+				file, err := os.Create(archive)
+				Expect(err).ToNot(HaveOccurred())
+				writer := tar.NewWriter(file)
+				for _, entry := range []struct {
+					header   tar.Header
+					contents string
+				}{
+					{header: tar.Header{Name: "./some-file", Typeflag: tar.TypeReg, Mode: 0750}, contents: "first"},
+					{header: tar.Header{Name: "./some-hard-link", Typeflag: tar.TypeLink, Linkname: "./some-file"}},
+					{header: tar.Header{Name: "./some-file", Typeflag: tar.TypeReg, Mode: 0644}, contents: "second"},
+				} {
+					entry.header.Size = int64(len(entry.contents))
+					Expect(writer.WriteHeader(&entry.header)).To(Succeed())
+					_, err = writer.Write([]byte(entry.contents))
+					Expect(err).ToNot(HaveOccurred())
+				}
+				Expect(writer.Close()).To(Succeed())
+				Expect(file.Close()).To(Succeed())
+
+				resources, _, executeErr = actor.ListArchiveResources(archive)
+			})
+
+			It("keeps the last entry of each path and gives hard links the linked contents and mode", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(Equal([]Resource{
+					{Filename: "some-file", SHA1: "352f7829a2384b001cc12b0c2613c756454a1f6a", Size: 6, Mode: 0644},
+					{Filename: "some-hard-link", SHA1: "e0996a37c13d44c3b06074939d43fa3759bd32c1", Size: 5, Mode: 0750},
+				}))
+			})
+		})
+
+		Context("when the tarball only contains a WAR file", func() {
+			BeforeEach(func() {
+				var err error
+				tarSrcDir, err = ioutil.TempDir("", "tar-with-war")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(os.Mkdir(filepath.Join(tarSrcDir, "build"), 0755)).To(Succeed())
+				Expect(zipit(srcDir, filepath.Join(tarSrcDir, "build", "app.war"), "")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tarSrcDir)).ToNot(HaveOccurred())
+			})
+
+			It("gathers the resources of the WAR file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(ContainElement(
+					Resource{Filename: "/tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: DefaultArchiveFilePermissions},
+				))
+			})
+		})
+
+		Context("when the tarball wraps an exploded WAR in a directory", func() {
+			BeforeEach(func() {
+				var err error
+				tarSrcDir, err = ioutil.TempDir("", "tar-with-exploded-war")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(os.MkdirAll(filepath.Join(tarSrcDir, "app", "WEB-INF"), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(tarSrcDir, "app", "WEB-INF", "web.xml"), []byte("why hello"), 0644)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tarSrcDir)).ToNot(HaveOccurred())
+			})
+
+			It("removes the wrapping directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(Equal(
+					[]Resource{
+						{Filename: "WEB-INF/", Mode: DefaultFolderPermissions},
+						{Filename: "WEB-INF/web.xml", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644},
+					}))
+			})
+		})
+	})
+
+	Describe("ListDirectoryResources", func() {
+		var (
+			resources  []Resource
+			ignored    []IgnoredResource
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("# some comment\nlevel2\ntmpFile*\n!tmpFile3\n"), 0655)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(srcDir, "level1", "level2", "some-file"), nil, 0655)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			resources, ignored, executeErr = actor.ListDirectoryResources(srcDir)
+		})
+
+		It("returns the resources and the ignored paths with the rule that ignored them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(resources).To(Equal(
+				[]Resource{
+					{Filename: "level1", Mode: DefaultFolderPermissions},
+					{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+				}))
+			Expect(ignored).To(Equal(
+				[]IgnoredResource{
+					{Filename: ".cfignore", Rule: IgnoreRule{Pattern: ".cfignore"}},
+					{Filename: "level1/level2", Rule: IgnoreRule{Source: ".cfignore", Line: 2, Pattern: "level2"}},
+					{Filename: "level1/level2/tmpFile1", Rule: IgnoreRule{Source: ".cfignore", Line: 3, Pattern: "tmpFile*"}},
+					{Filename: "tmpFile2", Rule: IgnoreRule{Source: ".cfignore", Line: 3, Pattern: "tmpFile*"}},
+				}))
+		})
+	})
+
 	Describe("ZipDirectoryResources", func() {
 		var (
 			resultZip  string
@@ -375,4 +677,55 @@ var _ = Describe("Resource Actions", func() {
 			})
 		})
 	})
+
+	Describe("ZipArchiveResources with tar archives", func() {
+		var (
+			archive    string
+			resultZip  string
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			Expect(os.Symlink("tmpFile2", filepath.Join(srcDir, "some-link"))).To(Succeed())
+
+			tmpfile, err := ioutil.TempFile("", "example")
+			Expect(err).ToNot(HaveOccurred())
+			archive = tmpfile.Name()
+			Expect(tmpfile.Close()).ToNot(HaveOccurred())
+
+			Expect(tarit(srcDir, archive, true)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			resources, err := actor.GatherArchiveResources(archive)
+			Expect(err).ToNot(HaveOccurred())
+			resultZip, executeErr = actor.ZipArchiveResources(archive, resources)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(archive)).ToNot(HaveOccurred())
+			Expect(os.RemoveAll(resultZip)).ToNot(HaveOccurred())
+		})
+
+		It("zips the archive and keeps the file permissions and symlinks", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			zipFile, err := os.Open(resultZip)
+			Expect(err).ToNot(HaveOccurred())
+			defer zipFile.Close()
+
+			zipInfo, err := zipFile.Stat()
+			Expect(err).ToNot(HaveOccurred())
+
+			reader, err := ykk.NewReader(zipFile, zipInfo.Size())
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(reader.File).To(HaveLen(6))
+			Expect(reader.File[2].Name).To(Equal("level1/level2/tmpFile1"))
+			Expect(reader.File[2].Mode()).To(Equal(os.FileMode(0644)))
+			Expect(reader.File[3].Name).To(Equal("some-link"))
+			Expect(reader.File[3].Mode()).To(Equal(os.ModeSymlink | 0777))
+			Expect(reader.File[4].Mode()).To(Equal(os.FileMode(0751)))
+		})
+	})
 })
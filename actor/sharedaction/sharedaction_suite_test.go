@@ -1,7 +1,9 @@
 package sharedaction_test
 
 import (
+	"archive/tar"
 	"archive/zip"
+	"compress/gzip"
 	"io"
 	"os"
 	"path/filepath"
@@ -81,3 +83,64 @@ func zipit(source, target, prefix string) error {
 
 	return err
 }
+
+func tarit(source, target string, gzipped bool) error {
+	tarfile, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer tarfile.Close()
+
+	var writer io.Writer = tarfile
+	if gzipped {
+		gzipWriter := gzip.NewWriter(tarfile)
+		defer gzipWriter.Close()
+		writer = gzipWriter
+	}
+
+	archive := tar.NewWriter(writer)
+	defer archive.Close()
+
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(source, path)
+		if err != nil || relPath == "." {
+			return err
+		}
+
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+
+		header.Name = "./" + filepath.ToSlash(relPath)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		err = archive.WriteHeader(header)
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(archive, file)
+		return err
+	})
+}
@@ -1,6 +1,9 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
 
 //go:generate counterfeiter . CloudControllerClient
 
@@ -11,14 +14,21 @@ type CloudControllerClient interface {
 	UpdateRouteApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
 	CheckRoute(route ccv2.Route) (bool, ccv2.Warnings, error)
 	CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	CreateOrganization(org ccv2.Organization) (ccv2.Organization, ccv2.Warnings, error)
+	CreateOrganizationQuota(quota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	CreateServiceBinding(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateSpace(space ccv2.Space) (ccv2.Space, ccv2.Warnings, error)
+	CreateSpaceQuota(quota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
+	CreateUserProvidedServiceInstance(serviceInstance ccv2.UserProvidedServiceInstance) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteOrganizationUserByRole(role constant.OrganizationUserRole, orgGUID string, username string) (ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteRouteApplication(routeGUID string, appGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
 	DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteSpaceUserByRole(role constant.SpaceUserRole, spaceGUID string, username string) (ccv2.Warnings, error)
 	GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error)
 	GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
@@ -28,7 +38,10 @@ type CloudControllerClient interface {
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	GetOrganizationQuotas(queries ...ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
 	GetOrganizations(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
+	GetOrganizationSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	GetOrganizationUsersByRole(role constant.OrganizationUserRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetRouteApplications(routeGUID string, queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
 	GetRoutes(queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
@@ -45,9 +58,11 @@ type CloudControllerClient interface {
 	GetSpaces(queries ...ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
 	GetSpaceStagingSecurityGroupsBySpace(spaceGUID string, queries ...ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	GetSpaceUsersByRole(role constant.SpaceUserRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error)
 	GetStacks(queries ...ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
 	GetStagingSpacesBySecurityGroup(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
+	GetUserProvidedServiceInstance(serviceInstanceGUID string) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error)
 	PollJob(job ccv2.Job) (ccv2.Warnings, error)
 	RemoveSpaceFromRunningSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	RemoveSpaceFromStagingSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
@@ -55,6 +70,12 @@ type CloudControllerClient interface {
 	RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	UpdateOrganization(org ccv2.Organization) (ccv2.Organization, ccv2.Warnings, error)
+	UpdateOrganizationQuota(quota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	UpdateOrganizationUserByRole(role constant.OrganizationUserRole, orgGUID string, username string) (ccv2.Warnings, error)
+	UpdateSpace(space ccv2.Space) (ccv2.Space, ccv2.Warnings, error)
+	UpdateSpaceQuota(quota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	UpdateSpaceUserByRole(role constant.SpaceUserRole, spaceGUID string, username string) (ccv2.Warnings, error)
 	UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 
 	API() string
@@ -106,3 +106,23 @@ func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
 	}
 	return returnedOrgs, Warnings(warnings), err
 }
+
+// CreateOrganization creates an organization with the provided name. When
+// quotaGUID is empty, the organization gets the default quota.
+func (actor Actor) CreateOrganization(orgName string, quotaGUID string) (Organization, Warnings, error) {
+	org, warnings, err := actor.CloudControllerClient.CreateOrganization(ccv2.Organization{
+		Name:                orgName,
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	return Organization(org), Warnings(warnings), err
+}
+
+// SetOrganizationQuota assigns the organization quota with the provided GUID
+// to the organization.
+func (actor Actor) SetOrganizationQuota(orgGUID string, quotaGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateOrganization(ccv2.Organization{
+		GUID:                orgGUID,
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	return Warnings(warnings), err
+}
@@ -26,3 +26,32 @@ func (actor Actor) GetOrganizationQuota(guid string) (OrganizationQuota, Warning
 
 	return OrganizationQuota(orgQuota), Warnings(warnings), err
 }
+
+// GetOrganizationQuotas returns all the organization quotas.
+func (actor Actor) GetOrganizationQuotas() ([]OrganizationQuota, Warnings, error) {
+	ccv2Quotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	quotas := make([]OrganizationQuota, len(ccv2Quotas))
+	for i, quota := range ccv2Quotas {
+		quotas[i] = OrganizationQuota(quota)
+	}
+
+	return quotas, Warnings(warnings), nil
+}
+
+// CreateOrganizationQuota creates an organization quota with the name and
+// limits of the provided quota.
+func (actor Actor) CreateOrganizationQuota(quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	createdQuota, warnings, err := actor.CloudControllerClient.CreateOrganizationQuota(ccv2.OrganizationQuota(quota))
+	return OrganizationQuota(createdQuota), Warnings(warnings), err
+}
+
+// UpdateOrganizationQuota updates the name and limits of the organization
+// quota with the GUID of the provided quota.
+func (actor Actor) UpdateOrganizationQuota(quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	updatedQuota, warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(ccv2.OrganizationQuota(quota))
+	return OrganizationQuota(updatedQuota), Warnings(warnings), err
+}
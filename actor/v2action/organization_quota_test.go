@@ -75,4 +75,72 @@ var _ = Describe("OrganizationQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationQuotas", func() {
+		Context("when getting the quotas succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{{GUID: "quota-guid", Name: "default"}},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the quotas and warnings", func() {
+				quotas, warnings, err := actor.GetOrganizationQuotas()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quotas).To(ConsistOf(OrganizationQuota{GUID: "quota-guid", Name: "default"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when getting the quotas fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"warning-1"}, errors.New("get-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationQuotas()
+				Expect(err).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateOrganizationQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{GUID: "quota-guid", Name: "small", MemoryLimit: 1024},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("creates the quota", func() {
+			quota, warnings, err := actor.CreateOrganizationQuota(OrganizationQuota{Name: "small", MemoryLimit: 1024})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quota).To(Equal(OrganizationQuota{GUID: "quota-guid", Name: "small", MemoryLimit: 1024}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateOrganizationQuotaArgsForCall(0)).To(Equal(ccv2.OrganizationQuota{Name: "small", MemoryLimit: 1024}))
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{GUID: "quota-guid", Name: "small", MemoryLimit: 2048},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("updates the quota", func() {
+			quota, warnings, err := actor.UpdateOrganizationQuota(OrganizationQuota{GUID: "quota-guid", Name: "small", MemoryLimit: 2048})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quota.MemoryLimit).To(Equal(2048))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0).GUID).To(Equal("quota-guid"))
+		})
+	})
 })
@@ -377,4 +377,38 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateOrganizationReturns(
+				ccv2.Organization{GUID: "org-guid", Name: "some-org", QuotaDefinitionGUID: "quota-guid"},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("creates the organization with the quota", func() {
+			org, warnings, err := actor.CreateOrganization("some-org", "quota-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(org.GUID).To(Equal("org-guid"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.CreateOrganizationArgsForCall(0)).To(Equal(ccv2.Organization{Name: "some-org", QuotaDefinitionGUID: "quota-guid"}))
+		})
+	})
+
+	Describe("SetOrganizationQuota", func() {
+		Context("when updating the organization fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationReturns(ccv2.Organization{}, ccv2.Warnings{"warning-1"}, errors.New("update-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.SetOrganizationQuota("org-guid", "quota-guid")
+				Expect(err).To(MatchError("update-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationArgsForCall(0)).To(Equal(ccv2.Organization{GUID: "org-guid", QuotaDefinitionGUID: "quota-guid"}))
+			})
+		})
+	})
 })
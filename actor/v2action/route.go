@@ -34,9 +34,13 @@ func (RouteInDifferentSpaceError) Error() string {
 type RouteNotFoundError struct {
 	Host       string
 	DomainGUID string
+	Path       string
 }
 
 func (e RouteNotFoundError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("Route with host %s, domain guid %s and path %s not found", e.Host, e.DomainGUID, e.Path)
+	}
 	return fmt.Sprintf("Route with host %s and domain guid %s not found", e.Host, e.DomainGUID)
 }
 
@@ -239,7 +243,7 @@ func (actor Actor) FindRouteBoundToSpaceWithSettings(route Route) (Route, Warnin
 		return Route{}, nil, RouteNotFoundError{DomainGUID: route.Domain.GUID}
 	}
 
-	existingRoute, warnings, err := actor.GetRouteByHostAndDomain(route.Host, route.Domain.GUID)
+	existingRoute, warnings, err := actor.GetRouteByComponents(route)
 	if routeNotFoundErr, ok := err.(RouteNotFoundError); ok {
 		// This check only works for API versions 2.55 or higher. It will return
 		// false for anything below that.
@@ -308,6 +312,51 @@ func (actor Actor) GetRouteByHostAndDomain(host string, domainGUID string) (Rout
 	return routes[0], append(Warnings(warnings), domainWarnings...), err
 }
 
+// GetRouteByComponents returns the HTTP route with the matching host, domain
+// GUID and path.
+func (actor Actor) GetRouteByComponents(route Route) (Route, Warnings, error) {
+	queries := []ccv2.Query{
+		{
+			Filter:   ccv2.HostFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{route.Host},
+		},
+		{
+			Filter:   ccv2.DomainGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{route.Domain.GUID},
+		},
+	}
+	if route.Path != "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.PathFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{route.Path},
+		})
+	}
+
+	ccv2Routes, warnings, err := actor.CloudControllerClient.GetRoutes(queries...)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
+	}
+
+	// Routes without a path cannot be filtered for, so the routes with other
+	// paths on the same host and domain are skipped here.
+	for _, ccv2Route := range ccv2Routes {
+		if ccv2Route.Path != route.Path {
+			continue
+		}
+
+		routes, domainWarnings, err := actor.applyDomain([]ccv2.Route{ccv2Route})
+		if err != nil {
+			return Route{}, append(Warnings(warnings), domainWarnings...), err
+		}
+		return routes[0], append(Warnings(warnings), domainWarnings...), nil
+	}
+
+	return Route{}, Warnings(warnings), RouteNotFoundError{Host: route.Host, DomainGUID: route.Domain.GUID, Path: route.Path}
+}
+
 func ActorToCCRoute(route Route) ccv2.Route {
 	return ccv2.Route{
 		DomainGUID: route.Domain.GUID,
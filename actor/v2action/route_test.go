@@ -1152,6 +1152,99 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("GetRouteByComponents", func() {
+		var (
+			inputRoute Route
+
+			route      Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			inputRoute = Route{
+				Host:   "some-host",
+				Domain: Domain{GUID: "some-domain-guid"},
+			}
+			fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{Name: "domain.com"}, ccv2.Warnings{"get-domain-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.GetRouteByComponents(inputRoute)
+		})
+
+		Context("when the route has no path", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "route-with-path-guid", Host: "some-host", Path: "/path", DomainGUID: "some-domain-guid"},
+					{GUID: "route-guid", Host: "some-host", DomainGUID: "some-domain-guid"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns the route without a path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-domain-warning"))
+				Expect(route).To(Equal(Route{
+					Domain: Domain{Name: "domain.com"},
+					GUID:   "route-guid",
+					Host:   "some-host",
+				}))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Values: []string{"some-host"}},
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"some-domain-guid"}},
+				}))
+			})
+		})
+
+		Context("when the route has a path", func() {
+			BeforeEach(func() {
+				inputRoute.Path = "/path"
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "route-with-path-guid", Host: "some-host", Path: "/path", DomainGUID: "some-domain-guid"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("filters the routes by path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(route.GUID).To(Equal("route-with-path-guid"))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Values: []string{"some-host"}},
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"some-domain-guid"}},
+					{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Values: []string{"/path"}},
+				}))
+			})
+		})
+
+		Context("when no route matches the path", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "route-with-path-guid", Host: "some-host", Path: "/path", DomainGUID: "some-domain-guid"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns a RouteNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(RouteNotFoundError{Host: "some-host", DomainGUID: "some-domain-guid"}))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+
+		Context("when getting routes returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-routes-err")
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
 	Describe("CheckRoute", func() {
 		Context("when the API calls succeed", func() {
 			BeforeEach(func() {
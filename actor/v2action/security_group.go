@@ -142,6 +142,7 @@ func (actor Actor) GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeSta
 		securityGroup := SecurityGroup{
 			GUID:           s.GUID,
 			Name:           s.Name,
+			Rules:          s.Rules,
 			RunningDefault: s.RunningDefault,
 			StagingDefault: s.StagingDefault,
 		}
@@ -275,6 +276,51 @@ func (actor Actor) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]Sec
 	return processSecurityGroups(spaceGUID, ccv2SecurityGroups, Warnings(warnings), err)
 }
 
+// GetSecurityGroupRulesBySpace returns the rules of all security groups that
+// apply to apps in this space, for both the 'running' and 'staging' lifecycle
+// phases. This includes the groups bound to the space as well as the groups
+// applied globally by default.
+func (actor Actor) GetSecurityGroupRulesBySpace(spaceGUID string) ([]SecurityGroupRule, Warnings, error) {
+	var allWarnings Warnings
+
+	runningSecurityGroups, warnings, err := actor.GetSpaceRunningSecurityGroupsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	stagingSecurityGroups, warnings, err := actor.GetSpaceStagingSecurityGroupsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	allSecurityGroups, ccv2Warnings, err := actor.CloudControllerClient.GetSecurityGroups()
+	allWarnings = append(allWarnings, ccv2Warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	for _, securityGroup := range allSecurityGroups {
+		if securityGroup.RunningDefault && !containsSecurityGroup(runningSecurityGroups, securityGroup.GUID) {
+			runningSecurityGroups = append(runningSecurityGroups, SecurityGroup(securityGroup))
+		}
+		if securityGroup.StagingDefault && !containsSecurityGroup(stagingSecurityGroups, securityGroup.GUID) {
+			stagingSecurityGroups = append(stagingSecurityGroups, SecurityGroup(securityGroup))
+		}
+	}
+
+	var rules []SecurityGroupRule
+	for _, securityGroup := range runningSecurityGroups {
+		rules = append(rules, extractSecurityGroupRules(securityGroup, ccv2.SecurityGroupLifecycleRunning)...)
+	}
+	for _, securityGroup := range stagingSecurityGroups {
+		rules = append(rules, extractSecurityGroupRules(securityGroup, ccv2.SecurityGroupLifecycleStaging)...)
+	}
+
+	return rules, allWarnings, nil
+}
+
 func (actor Actor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (Warnings, error) {
 	if lifecycle != ccv2.SecurityGroupLifecycleRunning && lifecycle != ccv2.SecurityGroupLifecycleStaging {
 		return nil, InvalidLifecycleError{lifecycle: lifecycle}
@@ -403,6 +449,15 @@ func processSecurityGroups(spaceGUID string, ccv2SecurityGroups []ccv2.SecurityG
 	return securityGroups, warnings, nil
 }
 
+func containsSecurityGroup(securityGroups []SecurityGroup, securityGroupGUID string) bool {
+	for _, securityGroup := range securityGroups {
+		if securityGroup.GUID == securityGroupGUID {
+			return true
+		}
+	}
+	return false
+}
+
 func (actor Actor) isRunningSecurityGroupBoundToSpace(securityGroupName string, spaceGUID string) (bool, Warnings, error) {
 	ccv2SecurityGroups, warnings, err := actor.CloudControllerClient.GetSpaceRunningSecurityGroupsBySpace(spaceGUID, ccv2.Query{
 		Filter:   ccv2.NameFilter,
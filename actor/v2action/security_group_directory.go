@@ -0,0 +1,485 @@
+package v2action
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	securityGroupBindingsFileName = "bindings.yml"
+	securityGroupRulesFileExt     = ".json"
+)
+
+var (
+	// securityGroupFileNameEscaper escapes the characters of a security group
+	// name that cannot be used in a file name, and the escape character
+	// itself so that the mapping can be reversed.
+	securityGroupFileNameEscaper = strings.NewReplacer("%", "%25", "/", "%2F", `\`, "%5C")
+	// securityGroupFileNameUnescaper reverses securityGroupFileNameEscaper.
+	securityGroupFileNameUnescaper = strings.NewReplacer("%25", "%", "%2F", "/", "%5C", `\`)
+)
+
+// SecurityGroupBinding represents a security group bound to a space for a
+// lifecycle phase. A binding without an organization and space is a global
+// binding that applies to all spaces.
+type SecurityGroupBinding struct {
+	SecurityGroupName string
+	Organization      string
+	Space             string
+	Lifecycle         ccv2.SecurityGroupLifecycle
+}
+
+// SecurityGroupChangeType describes how a security group directory differs
+// from the security groups of the foundation.
+type SecurityGroupChangeType string
+
+const (
+	// SecurityGroupChangeAdded is something in the directory that is missing
+	// from the foundation.
+	SecurityGroupChangeAdded SecurityGroupChangeType = "added"
+	// SecurityGroupChangeRemoved is something on the foundation that is
+	// missing from the directory.
+	SecurityGroupChangeRemoved SecurityGroupChangeType = "removed"
+	// SecurityGroupChangeChanged is a rule whose description or logging
+	// differs between the directory and the foundation.
+	SecurityGroupChangeChanged SecurityGroupChangeType = "changed"
+)
+
+// SecurityGroupChange is a single difference between a security group
+// directory and the foundation. Rule is set for rule changes, Binding is set
+// for binding changes, and neither is set when a whole security group is
+// added or removed.
+type SecurityGroupChange struct {
+	Type              SecurityGroupChangeType
+	SecurityGroupName string
+	Rule              *ccv2.SecurityGroupRule
+	Binding           *SecurityGroupBinding
+}
+
+// InvalidSecurityGroupDirectoryError is returned when a security group
+// directory cannot be read or contains an invalid rule or binding.
+type InvalidSecurityGroupDirectoryError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidSecurityGroupDirectoryError) Error() string {
+	return fmt.Sprintf("Invalid security group directory %s: %s", e.Path, e.Message)
+}
+
+// securityGroupRuleFileEntry is a rule in the JSON format accepted by
+// create-security-group and update-security-group.
+type securityGroupRuleFileEntry struct {
+	Protocol    string `json:"protocol"`
+	Destination string `json:"destination"`
+	Ports       string `json:"ports,omitempty"`
+	Type        *int   `json:"type,omitempty"`
+	Code        *int   `json:"code,omitempty"`
+	Log         bool   `json:"log,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type securityGroupBindingsFile struct {
+	Running []securityGroupBindingFileEntry `yaml:"running,omitempty"`
+	Staging []securityGroupBindingFileEntry `yaml:"staging,omitempty"`
+}
+
+type securityGroupBindingFileEntry struct {
+	SecurityGroup string `yaml:"security_group"`
+	Org           string `yaml:"org,omitempty"`
+	Space         string `yaml:"space,omitempty"`
+}
+
+// ExportSecurityGroups writes the rules of every security group to
+// DIR/NAME.json, in the format accepted by create-security-group, and the
+// running and staging bindings of the security groups to DIR/bindings.yml.
+// It returns the number of security groups exported. Slashes, backslashes and
+// percent signs in NAME are escaped as %2F, %5C and %25. Rules files left in
+// DIR by an earlier export of a security group that no longer exists are
+// removed.
+func (actor Actor) ExportSecurityGroups(dir string, includeStaging bool) (int, Warnings, error) {
+	securityGroups, bindings, warnings, err := actor.getSecurityGroupsAndBindings(includeStaging)
+	if err != nil {
+		return 0, warnings, err
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return 0, warnings, err
+	}
+
+	written := map[string]bool{}
+	for _, securityGroup := range securityGroups {
+		entries := []securityGroupRuleFileEntry{}
+		for _, rule := range securityGroup.Rules {
+			entries = append(entries, securityGroupRuleFileEntry{
+				Protocol:    rule.Protocol,
+				Destination: rule.Destination,
+				Ports:       rule.Ports,
+				Type:        nullIntPointer(rule.Type),
+				Code:        nullIntPointer(rule.Code),
+				Log:         rule.Log,
+				Description: rule.Description,
+			})
+		}
+
+		raw, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return 0, warnings, err
+		}
+
+		fileName := securityGroupFileName(securityGroup.Name)
+		err = ioutil.WriteFile(filepath.Join(dir, fileName), append(raw, '\n'), 0644)
+		if err != nil {
+			return 0, warnings, err
+		}
+		written[fileName] = true
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, warnings, err
+	}
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != securityGroupRulesFileExt || written[file.Name()] {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return 0, warnings, err
+		}
+	}
+
+	var file securityGroupBindingsFile
+	for _, binding := range bindings {
+		entry := securityGroupBindingFileEntry{
+			SecurityGroup: binding.SecurityGroupName,
+			Org:           binding.Organization,
+			Space:         binding.Space,
+		}
+
+		if binding.Lifecycle == ccv2.SecurityGroupLifecycleStaging {
+			file.Staging = append(file.Staging, entry)
+		} else {
+			file.Running = append(file.Running, entry)
+		}
+	}
+
+	raw, err := yaml.Marshal(file)
+	if err != nil {
+		return 0, warnings, err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, securityGroupBindingsFileName), raw, 0644)
+	if err != nil {
+		return 0, warnings, err
+	}
+
+	return len(securityGroups), warnings, nil
+}
+
+// DiffSecurityGroups compares the security groups of the foundation with a
+// directory written by ExportSecurityGroups. It returns the security groups,
+// rules and bindings that were added, removed or changed in the directory,
+// sorted by security group name. When includeStaging is false, space bindings
+// for the staging lifecycle in the directory are ignored.
+func (actor Actor) DiffSecurityGroups(dir string, includeStaging bool) ([]SecurityGroupChange, Warnings, error) {
+	desiredGroups, desiredBindings, err := readSecurityGroupDirectory(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actualGroups, actualBindings, warnings, err := actor.getSecurityGroupsAndBindings(includeStaging)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	if !includeStaging {
+		var filtered []SecurityGroupBinding
+		for _, binding := range desiredBindings {
+			if binding.Lifecycle == ccv2.SecurityGroupLifecycleStaging && binding.Space != "" {
+				continue
+			}
+			filtered = append(filtered, binding)
+		}
+		desiredBindings = filtered
+	}
+
+	changes := diffSecurityGroupRules(actualGroups, desiredGroups)
+	changes = append(changes, diffSecurityGroupBindings(actualBindings, desiredBindings)...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].SecurityGroupName < changes[j].SecurityGroupName
+	})
+
+	return changes, warnings, nil
+}
+
+// getSecurityGroupsAndBindings returns every security group, including its
+// rules, and every binding of those security groups, sorted by name.
+func (actor Actor) getSecurityGroupsAndBindings(includeStaging bool) ([]SecurityGroup, []SecurityGroupBinding, Warnings, error) {
+	secGroupOrgSpaces, warnings, err := actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	var (
+		securityGroups []SecurityGroup
+		bindings       []SecurityGroupBinding
+	)
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		if !containsSecurityGroup(securityGroups, secGroupOrgSpace.SecurityGroup.GUID) {
+			securityGroups = append(securityGroups, *secGroupOrgSpace.SecurityGroup)
+		}
+
+		if secGroupOrgSpace.Lifecycle == "" {
+			continue
+		}
+
+		bindings = append(bindings, SecurityGroupBinding{
+			SecurityGroupName: secGroupOrgSpace.SecurityGroup.Name,
+			Organization:      secGroupOrgSpace.Organization.Name,
+			Space:             secGroupOrgSpace.Space.Name,
+			Lifecycle:         secGroupOrgSpace.Lifecycle,
+		})
+	}
+
+	sortSecurityGroupBindings(bindings)
+	return securityGroups, bindings, warnings, nil
+}
+
+func readSecurityGroupDirectory(dir string) ([]SecurityGroup, []SecurityGroupBinding, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var securityGroups []SecurityGroup
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != securityGroupRulesFileExt {
+			continue
+		}
+
+		raw, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var entries []securityGroupRuleFileEntry
+		err = json.Unmarshal(raw, &entries)
+		if err != nil {
+			return nil, nil, InvalidSecurityGroupDirectoryError{Path: dir, Message: fmt.Sprintf("%s: %s", file.Name(), err)}
+		}
+
+		securityGroup := SecurityGroup{Name: securityGroupNameFromFileName(file.Name())}
+		for _, entry := range entries {
+			if entry.Protocol == "" || entry.Destination == "" {
+				return nil, nil, InvalidSecurityGroupDirectoryError{Path: dir, Message: fmt.Sprintf("%s: every rule must have a protocol and a destination", file.Name())}
+			}
+
+			securityGroup.Rules = append(securityGroup.Rules, ccv2.SecurityGroupRule{
+				Protocol:    entry.Protocol,
+				Destination: entry.Destination,
+				Ports:       entry.Ports,
+				Type:        pointerNullInt(entry.Type),
+				Code:        pointerNullInt(entry.Code),
+				Log:         entry.Log,
+				Description: entry.Description,
+			})
+		}
+		securityGroups = append(securityGroups, securityGroup)
+	}
+
+	var bindings []SecurityGroupBinding
+	raw, err := ioutil.ReadFile(filepath.Join(dir, securityGroupBindingsFileName))
+	if os.IsNotExist(err) {
+		return securityGroups, bindings, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var file securityGroupBindingsFile
+	err = yaml.Unmarshal(raw, &file)
+	if err != nil {
+		return nil, nil, InvalidSecurityGroupDirectoryError{Path: dir, Message: fmt.Sprintf("%s: %s", securityGroupBindingsFileName, err)}
+	}
+
+	for lifecycle, entries := range map[ccv2.SecurityGroupLifecycle][]securityGroupBindingFileEntry{
+		ccv2.SecurityGroupLifecycleRunning: file.Running,
+		ccv2.SecurityGroupLifecycleStaging: file.Staging,
+	} {
+		for _, entry := range entries {
+			if !securityGroupNamed(securityGroups, entry.SecurityGroup) {
+				return nil, nil, InvalidSecurityGroupDirectoryError{Path: dir, Message: fmt.Sprintf("%s: security group %s has no rules file", securityGroupBindingsFileName, entry.SecurityGroup)}
+			}
+
+			if (entry.Org == "") != (entry.Space == "") {
+				return nil, nil, InvalidSecurityGroupDirectoryError{Path: dir, Message: fmt.Sprintf("%s: binding of security group %s must have both an org and a space, or neither", securityGroupBindingsFileName, entry.SecurityGroup)}
+			}
+
+			bindings = append(bindings, SecurityGroupBinding{
+				SecurityGroupName: entry.SecurityGroup,
+				Organization:      entry.Org,
+				Space:             entry.Space,
+				Lifecycle:         lifecycle,
+			})
+		}
+	}
+
+	sortSecurityGroupBindings(bindings)
+	return securityGroups, bindings, nil
+}
+
+func diffSecurityGroupRules(actualGroups []SecurityGroup, desiredGroups []SecurityGroup) []SecurityGroupChange {
+	actualByName := map[string]SecurityGroup{}
+	for _, securityGroup := range actualGroups {
+		actualByName[securityGroup.Name] = securityGroup
+	}
+
+	desiredByName := map[string]SecurityGroup{}
+	for _, securityGroup := range desiredGroups {
+		desiredByName[securityGroup.Name] = securityGroup
+	}
+
+	var changes []SecurityGroupChange
+	for _, desired := range desiredGroups {
+		actual, ok := actualByName[desired.Name]
+		if !ok {
+			changes = append(changes, SecurityGroupChange{Type: SecurityGroupChangeAdded, SecurityGroupName: desired.Name})
+			continue
+		}
+
+		actualRules := map[string]ccv2.SecurityGroupRule{}
+		for _, rule := range actual.Rules {
+			actualRules[securityGroupRuleKey(rule)] = rule
+		}
+
+		desiredRules := map[string]bool{}
+		for i, rule := range desired.Rules {
+			key := securityGroupRuleKey(rule)
+			desiredRules[key] = true
+
+			actualRule, found := actualRules[key]
+			switch {
+			case !found:
+				changes = append(changes, SecurityGroupChange{Type: SecurityGroupChangeAdded, SecurityGroupName: desired.Name, Rule: &desired.Rules[i]})
+			case actualRule.Description != rule.Description, actualRule.Log != rule.Log:
+				changes = append(changes, SecurityGroupChange{Type: SecurityGroupChangeChanged, SecurityGroupName: desired.Name, Rule: &desired.Rules[i]})
+			}
+		}
+
+		for i, rule := range actual.Rules {
+			if !desiredRules[securityGroupRuleKey(rule)] {
+				changes = append(changes, SecurityGroupChange{Type: SecurityGroupChangeRemoved, SecurityGroupName: desired.Name, Rule: &actual.Rules[i]})
+			}
+		}
+	}
+
+	for _, actual := range actualGroups {
+		if _, ok := desiredByName[actual.Name]; !ok {
+			changes = append(changes, SecurityGroupChange{Type: SecurityGroupChangeRemoved, SecurityGroupName: actual.Name})
+		}
+	}
+
+	return changes
+}
+
+func diffSecurityGroupBindings(actualBindings []SecurityGroupBinding, desiredBindings []SecurityGroupBinding) []SecurityGroupChange {
+	actual := map[SecurityGroupBinding]bool{}
+	for _, binding := range actualBindings {
+		actual[binding] = true
+	}
+
+	desired := map[SecurityGroupBinding]bool{}
+	for _, binding := range desiredBindings {
+		desired[binding] = true
+	}
+
+	var changes []SecurityGroupChange
+	for i, binding := range desiredBindings {
+		if !actual[binding] {
+			changes = append(changes, SecurityGroupChange{Type: SecurityGroupChangeAdded, SecurityGroupName: binding.SecurityGroupName, Binding: &desiredBindings[i]})
+		}
+	}
+
+	for i, binding := range actualBindings {
+		if !desired[binding] {
+			changes = append(changes, SecurityGroupChange{Type: SecurityGroupChangeRemoved, SecurityGroupName: binding.SecurityGroupName, Binding: &actualBindings[i]})
+		}
+	}
+
+	return changes
+}
+
+// securityGroupFileName returns the name of the rules file of a security
+// group.
+func securityGroupFileName(name string) string {
+	return securityGroupFileNameEscaper.Replace(name) + securityGroupRulesFileExt
+}
+
+// securityGroupNameFromFileName returns the name of the security group whose
+// rules are in the file.
+func securityGroupNameFromFileName(fileName string) string {
+	return securityGroupFileNameUnescaper.Replace(strings.TrimSuffix(fileName, securityGroupRulesFileExt))
+}
+
+// securityGroupRuleKey returns the fields that identify a rule: the traffic
+// it allows.
+func securityGroupRuleKey(rule ccv2.SecurityGroupRule) string {
+	return strings.Join([]string{strings.ToLower(rule.Protocol), rule.Destination, rule.Ports, nullIntKey(rule.Type), nullIntKey(rule.Code)}, " ")
+}
+
+func nullIntKey(value types.NullInt) string {
+	if !value.IsSet {
+		return ""
+	}
+	return strconv.Itoa(value.Value)
+}
+
+func nullIntPointer(value types.NullInt) *int {
+	if !value.IsSet {
+		return nil
+	}
+	return &value.Value
+}
+
+func pointerNullInt(value *int) types.NullInt {
+	var nullInt types.NullInt
+	nullInt.ParseIntValue(value)
+	return nullInt
+}
+
+func securityGroupNamed(securityGroups []SecurityGroup, name string) bool {
+	for _, securityGroup := range securityGroups {
+		if securityGroup.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func sortSecurityGroupBindings(bindings []SecurityGroupBinding) {
+	sort.Slice(bindings, func(i, j int) bool {
+		switch {
+		case bindings[i].SecurityGroupName != bindings[j].SecurityGroupName:
+			return bindings[i].SecurityGroupName < bindings[j].SecurityGroupName
+		case bindings[i].Lifecycle != bindings[j].Lifecycle:
+			return bindings[i].Lifecycle < bindings[j].Lifecycle
+		case bindings[i].Organization != bindings[j].Organization:
+			return bindings[i].Organization < bindings[j].Organization
+		}
+		return bindings[i].Space < bindings[j].Space
+	})
+}
@@ -0,0 +1,254 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Directory Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		dir                       string
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		var err error
+		dir, err = ioutil.TempDir("", "security-groups")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeCloudControllerClient.GetSecurityGroupsReturns(
+			[]ccv2.SecurityGroup{
+				{
+					GUID:           "public-guid",
+					Name:           "public",
+					RunningDefault: true,
+					StagingDefault: true,
+					Rules: []ccv2.SecurityGroupRule{
+						{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+						{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{Value: 0, IsSet: true}, Code: types.NullInt{Value: -1, IsSet: true}, Log: true},
+					},
+				},
+				{
+					GUID:  "db-guid",
+					Name:  "db",
+					Rules: []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.16.0/24", Ports: "5432", Description: "postgres"}},
+				},
+			},
+			ccv2.Warnings{"security-groups-warning"},
+			nil)
+		fakeCloudControllerClient.GetRunningSpacesBySecurityGroupStub = func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+			if securityGroupGUID == "db-guid" {
+				return []ccv2.Space{{GUID: "space-guid", Name: "prod", OrganizationGUID: "org-guid"}}, nil, nil
+			}
+			return nil, nil, nil
+		}
+		fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{GUID: "org-guid", Name: "acme"}, nil, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("ExportSecurityGroups", func() {
+		It("writes a rules file per security group and a bindings file", func() {
+			count, warnings, err := actor.ExportSecurityGroups(dir, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("security-groups-warning"))
+			Expect(count).To(Equal(2))
+
+			raw, err := ioutil.ReadFile(filepath.Join(dir, "db.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`[{"protocol": "tcp", "destination": "10.0.16.0/24", "ports": "5432", "description": "postgres"}]`))
+
+			raw, err = ioutil.ReadFile(filepath.Join(dir, "public.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`[
+				{"protocol": "all", "destination": "0.0.0.0-9.255.255.255"},
+				{"protocol": "icmp", "destination": "10.0.0.0/8", "type": 0, "code": -1, "log": true}
+			]`))
+
+			raw, err = ioutil.ReadFile(filepath.Join(dir, "bindings.yml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchYAML(`
+running:
+- security_group: db
+  org: acme
+  space: prod
+- security_group: public
+staging:
+- security_group: public
+`))
+		})
+
+		Context("when a security group name contains a slash", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID:  "team-guid",
+							Name:  `team/db\100%`,
+							Rules: []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.16.0/24", Ports: "5432"}},
+						},
+					},
+					nil,
+					nil)
+			})
+
+			It("escapes the name in the file name and reads it back unchanged", func() {
+				_, _, err := actor.ExportSecurityGroups(dir, true)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = os.Stat(filepath.Join(dir, "team%2Fdb%5C100%25.json"))
+				Expect(err).ToNot(HaveOccurred())
+
+				changes, _, err := actor.DiffSecurityGroups(dir, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changes).To(BeEmpty())
+			})
+		})
+
+		Context("when the directory has the rules file of a deleted security group", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "deleted.json"), []byte("[]\n"), 0644)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("security groups\n"), 0644)).To(Succeed())
+			})
+
+			It("removes the stale rules file and leaves other files alone", func() {
+				_, _, err := actor.ExportSecurityGroups(dir, true)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = os.Stat(filepath.Join(dir, "deleted.json"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+				_, err = os.Stat(filepath.Join(dir, "README.md"))
+				Expect(err).ToNot(HaveOccurred())
+
+				changes, _, err := actor.DiffSecurityGroups(dir, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changes).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"security-groups-warning"}, errors.New("get-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.ExportSecurityGroups(dir, true)
+				Expect(err).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("security-groups-warning"))
+			})
+		})
+	})
+
+	Describe("DiffSecurityGroups", func() {
+		var (
+			changes    []SecurityGroupChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			_, _, err := actor.ExportSecurityGroups(dir, true)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.DiffSecurityGroups(dir, true)
+		})
+
+		Context("when the directory matches the foundation", func() {
+			It("returns no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("security-groups-warning"))
+				Expect(changes).To(BeEmpty())
+			})
+		})
+
+		Context("when the directory has drifted from the foundation", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "db.json"), []byte(`[
+					{"protocol": "tcp", "destination": "10.0.16.0/24", "ports": "5432", "description": "primary"},
+					{"protocol": "tcp", "destination": "10.0.17.0/24", "ports": "5432"}
+				]`), 0644)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "dns.json"), []byte(`[{"protocol": "udp", "destination": "0.0.0.0/0", "ports": "53"}]`), 0644)).To(Succeed())
+				Expect(os.Remove(filepath.Join(dir, "public.json"))).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "bindings.yml"), []byte(`
+running:
+- security_group: db
+  org: acme
+  space: staging
+staging:
+- security_group: dns
+`), 0644)).To(Succeed())
+			})
+
+			It("returns the added, removed and changed security groups, rules and bindings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal([]SecurityGroupChange{
+					{Type: SecurityGroupChangeChanged, SecurityGroupName: "db", Rule: &ccv2.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.16.0/24", Ports: "5432", Description: "primary"}},
+					{Type: SecurityGroupChangeAdded, SecurityGroupName: "db", Rule: &ccv2.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.17.0/24", Ports: "5432"}},
+					{Type: SecurityGroupChangeAdded, SecurityGroupName: "db", Binding: &SecurityGroupBinding{SecurityGroupName: "db", Organization: "acme", Space: "staging", Lifecycle: ccv2.SecurityGroupLifecycleRunning}},
+					{Type: SecurityGroupChangeRemoved, SecurityGroupName: "db", Binding: &SecurityGroupBinding{SecurityGroupName: "db", Organization: "acme", Space: "prod", Lifecycle: ccv2.SecurityGroupLifecycleRunning}},
+					{Type: SecurityGroupChangeAdded, SecurityGroupName: "dns"},
+					{Type: SecurityGroupChangeAdded, SecurityGroupName: "dns", Binding: &SecurityGroupBinding{SecurityGroupName: "dns", Lifecycle: ccv2.SecurityGroupLifecycleStaging}},
+					{Type: SecurityGroupChangeRemoved, SecurityGroupName: "public"},
+					{Type: SecurityGroupChangeRemoved, SecurityGroupName: "public", Binding: &SecurityGroupBinding{SecurityGroupName: "public", Lifecycle: ccv2.SecurityGroupLifecycleRunning}},
+					{Type: SecurityGroupChangeRemoved, SecurityGroupName: "public", Binding: &SecurityGroupBinding{SecurityGroupName: "public", Lifecycle: ccv2.SecurityGroupLifecycleStaging}},
+				}))
+			})
+		})
+
+		Context("when an icmp rule has a different type or logging", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "public.json"), []byte(`[
+					{"protocol": "all", "destination": "0.0.0.0-9.255.255.255", "log": true},
+					{"protocol": "icmp", "destination": "10.0.0.0/8", "type": 8, "code": -1, "log": true}
+				]`), 0644)).To(Succeed())
+			})
+
+			It("returns the changed and the added and removed icmp rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal([]SecurityGroupChange{
+					{Type: SecurityGroupChangeChanged, SecurityGroupName: "public", Rule: &ccv2.SecurityGroupRule{Protocol: "all", Destination: "0.0.0.0-9.255.255.255", Log: true}},
+					{Type: SecurityGroupChangeAdded, SecurityGroupName: "public", Rule: &ccv2.SecurityGroupRule{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{Value: 8, IsSet: true}, Code: types.NullInt{Value: -1, IsSet: true}, Log: true}},
+					{Type: SecurityGroupChangeRemoved, SecurityGroupName: "public", Rule: &ccv2.SecurityGroupRule{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{Value: 0, IsSet: true}, Code: types.NullInt{Value: -1, IsSet: true}, Log: true}},
+				}))
+			})
+		})
+
+		Context("when a rules file is invalid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "db.json"), []byte(`[{"protocol": "tcp"}]`), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidSecurityGroupDirectoryError", func() {
+				Expect(executeErr).To(MatchError(InvalidSecurityGroupDirectoryError{Path: dir, Message: "db.json: every rule must have a protocol and a destination"}))
+				Expect(fakeCloudControllerClient.GetSecurityGroupsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a binding refers to a security group without a rules file", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "bindings.yml"), []byte("running:\n- security_group: missing\n"), 0644)).To(Succeed())
+			})
+
+			It("returns an InvalidSecurityGroupDirectoryError", func() {
+				Expect(executeErr).To(MatchError(InvalidSecurityGroupDirectoryError{Path: dir, Message: "bindings.yml: security group missing has no rules file"}))
+			})
+		})
+	})
+})
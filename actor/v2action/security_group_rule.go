@@ -0,0 +1,83 @@
+package v2action
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Allows returns true if the rule allows traffic to the given IP address and
+// port over the given protocol. Rule destinations may be single addresses,
+// CIDR blocks or address ranges, and rule ports may be single ports or port
+// ranges, each separated by commas.
+func (rule SecurityGroupRule) Allows(ip net.IP, port int, protocol string) bool {
+	switch strings.ToLower(rule.Protocol) {
+	case "all":
+	case strings.ToLower(protocol):
+		if !rulePortsContain(rule.Ports, port) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	return ruleDestinationContains(rule.Destination, ip)
+}
+
+func ruleDestinationContains(destination string, ip net.IP) bool {
+	for _, entry := range strings.Split(destination, ",") {
+		entry = strings.TrimSpace(entry)
+
+		switch {
+		case strings.Contains(entry, "/"):
+			_, network, err := net.ParseCIDR(entry)
+			if err == nil && network.Contains(ip) {
+				return true
+			}
+		case strings.Contains(entry, "-"):
+			bounds := strings.SplitN(entry, "-", 2)
+			start := net.ParseIP(strings.TrimSpace(bounds[0]))
+			end := net.ParseIP(strings.TrimSpace(bounds[1]))
+			if start != nil && end != nil && ipBetween(ip, start, end) {
+				return true
+			}
+		default:
+			if ruleIP := net.ParseIP(entry); ruleIP != nil && ruleIP.Equal(ip) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func rulePortsContain(ports string, port int) bool {
+	for _, entry := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(entry), "-", 2)
+
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			continue
+		}
+
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				continue
+			}
+		}
+
+		if start <= port && port <= end {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ipBetween(ip net.IP, start net.IP, end net.IP) bool {
+	ip16, start16, end16 := ip.To16(), start.To16(), end.To16()
+	return bytes.Compare(ip16, start16) >= 0 && bytes.Compare(ip16, end16) <= 0
+}
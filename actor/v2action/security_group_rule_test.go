@@ -0,0 +1,41 @@
+package v2action_test
+
+import (
+	"net"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Rule", func() {
+	Describe("Allows", func() {
+		DescribeTable("evaluates the rule against the traffic",
+			func(rule SecurityGroupRule, ip string, port int, protocol string, expected bool) {
+				Expect(rule.Allows(net.ParseIP(ip), port, protocol)).To(Equal(expected))
+			},
+
+			Entry("all protocols to a matching CIDR",
+				SecurityGroupRule{Protocol: "all", Destination: "10.0.0.0/8"}, "10.1.2.3", 443, "tcp", true),
+			Entry("all protocols to a CIDR that does not match",
+				SecurityGroupRule{Protocol: "all", Destination: "10.0.0.0/8"}, "11.1.2.3", 443, "tcp", false),
+			Entry("a matching protocol, address and port",
+				SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "443"}, "10.0.0.1", 443, "tcp", true),
+			Entry("a different protocol",
+				SecurityGroupRule{Protocol: "udp", Destination: "10.0.0.1", Ports: "443"}, "10.0.0.1", 443, "tcp", false),
+			Entry("a port outside the rule's ports",
+				SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "80,8080-8090"}, "10.0.0.1", 443, "tcp", false),
+			Entry("a port in a port range",
+				SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "80, 8080-8090"}, "10.0.0.1", 8085, "tcp", true),
+			Entry("an address in an address range",
+				SecurityGroupRule{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"}, "9.0.0.1", 80, "tcp", true),
+			Entry("an address outside an address range",
+				SecurityGroupRule{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"}, "10.0.0.1", 80, "tcp", false),
+			Entry("an address in a list of destinations",
+				SecurityGroupRule{Protocol: "udp", Destination: "192.168.0.1,10.0.0.0/24", Ports: "53"}, "10.0.0.53", 53, "UDP", true),
+			Entry("an unparseable destination",
+				SecurityGroupRule{Protocol: "all", Destination: "not-an-ip"}, "10.0.0.1", 80, "tcp", false),
+		)
+	})
+})
@@ -1272,6 +1272,107 @@ var _ = Describe("Security Group Actions", func() {
 		})
 	})
 
+	Describe("GetSecurityGroupRulesBySpace", func() {
+		var (
+			rules    []SecurityGroupRule
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			rules, warnings, err = actor.GetSecurityGroupRulesBySpace("some-space-guid")
+		})
+
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID:           "running-guid",
+							Name:           "running-group",
+							Rules:          []ccv2.SecurityGroupRule{{Destination: "10.0.0.0/8", Ports: "443", Protocol: "tcp"}},
+							RunningDefault: true,
+						},
+					},
+					ccv2.Warnings{"running-warning"},
+					nil)
+				fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID:  "staging-guid",
+							Name:  "staging-group",
+							Rules: []ccv2.SecurityGroupRule{{Destination: "0.0.0.0-255.255.255.255", Protocol: "all"}},
+						},
+					},
+					ccv2.Warnings{"staging-warning"},
+					nil)
+				fakeCloudControllerClient.GetSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID:           "running-guid",
+							Name:           "running-group",
+							Rules:          []ccv2.SecurityGroupRule{{Destination: "10.0.0.0/8", Ports: "443", Protocol: "tcp"}},
+							RunningDefault: true,
+						},
+						{
+							GUID:           "dns-guid",
+							Name:           "dns",
+							Rules:          []ccv2.SecurityGroupRule{{Destination: "0.0.0.0/0", Ports: "53", Protocol: "udp"}},
+							RunningDefault: true,
+							StagingDefault: true,
+						},
+						{
+							GUID:  "unbound-guid",
+							Name:  "unbound",
+							Rules: []ccv2.SecurityGroupRule{{Destination: "0.0.0.0/0", Protocol: "all"}},
+						},
+					},
+					ccv2.Warnings{"get-warning"},
+					nil)
+			})
+
+			It("returns the rules of the space and default groups for each lifecycle", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("running-warning", "staging-warning", "get-warning"))
+				Expect(rules).To(Equal([]SecurityGroupRule{
+					{Name: "running-group", Destination: "10.0.0.0/8", Ports: "443", Protocol: "tcp", Lifecycle: ccv2.SecurityGroupLifecycleRunning},
+					{Name: "dns", Destination: "0.0.0.0/0", Ports: "53", Protocol: "udp", Lifecycle: ccv2.SecurityGroupLifecycleRunning},
+					{Name: "staging-group", Destination: "0.0.0.0-255.255.255.255", Protocol: "all", Lifecycle: ccv2.SecurityGroupLifecycleStaging},
+					{Name: "dns", Destination: "0.0.0.0/0", Ports: "53", Protocol: "udp", Lifecycle: ccv2.SecurityGroupLifecycleStaging},
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceCallCount()).To(Equal(1))
+				spaceGUID, _ := fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when getting the space's running security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(nil, ccv2.Warnings{"running-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a SpaceNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("running-warning"))
+			})
+		})
+
+		Context("when getting all security groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get security groups error")
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
 	Describe("GetSpaceRunningSecurityGroupsBySpace", func() {
 		Context("when the space exists and there are no errors", func() {
 			BeforeEach(func() {
@@ -2,7 +2,9 @@ package v2action
 
 import (
 	"fmt"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
@@ -22,6 +24,18 @@ func (e ServiceInstanceNotFoundError) Error() string {
 	return fmt.Sprintf("Service instance '%s' not found.", e.Name)
 }
 
+// ServiceInstanceOperationFailedError is returned when the last operation
+// performed on a service instance has failed.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (e ServiceInstanceOperationFailedError) Error() string {
+	return fmt.Sprintf("Service instance %s %s failed: %s", e.Name, e.Operation, e.Description)
+}
+
 func (actor Actor) GetServiceInstance(guid string) (ServiceInstance, Warnings, error) {
 	instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(guid)
 	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
@@ -93,3 +107,38 @@ func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstan
 
 	return serviceInstances, Warnings(warnings), nil
 }
+
+// PollServiceInstanceReady polls the service instance until the last
+// operation performed on it is no longer in progress. A
+// ServiceInstanceOperationFailedError is returned if the operation failed and
+// a WaitTimeoutError if it is still in progress at the timeout. User provided
+// service instances are always ready.
+func (actor Actor) PollServiceInstanceReady(name string, spaceGUID string, timeout time.Duration) (ServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	deadline := time.Now().Add(timeout)
+	for {
+		serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstance{}, allWarnings, err
+		}
+
+		switch serviceInstance.LastOperation.State {
+		case ccv2.LastOperationFailed:
+			return serviceInstance, allWarnings, ServiceInstanceOperationFailedError{
+				Name:        name,
+				Operation:   serviceInstance.LastOperation.Type,
+				Description: serviceInstance.LastOperation.Description,
+			}
+		case ccv2.LastOperationInProgress:
+		default:
+			return serviceInstance, allWarnings, nil
+		}
+
+		if !time.Now().Before(deadline) {
+			return serviceInstance, allWarnings, actionerror.WaitTimeoutError{Timeout: timeout}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
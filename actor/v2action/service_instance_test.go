@@ -2,7 +2,9 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -305,4 +307,92 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("PollServiceInstanceReady", func() {
+		var (
+			fakeConfig      *v2actionfakes.FakeConfig
+			timeout         time.Duration
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			err             error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(0)
+			actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+			timeout = time.Second
+
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturnsOnCall(0,
+				[]ccv2.ServiceInstance{{
+					GUID:          "some-service-instance-guid",
+					LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress},
+				}},
+				ccv2.Warnings{"warning-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{
+					GUID:          "some-service-instance-guid",
+					LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationSucceeded},
+				}},
+				ccv2.Warnings{"warning-2"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, err = actor.PollServiceInstanceReady("some-service-instance", "some-space-guid", timeout)
+		})
+
+		It("polls until the last operation has succeeded", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serviceInstance.LastOperation.State).To(Equal(ccv2.LastOperationSucceeded))
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(2))
+		})
+
+		Context("when the last operation fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{
+						GUID:          "some-service-instance-guid",
+						LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationFailed, Description: "broker error"},
+					}},
+					ccv2.Warnings{"warning-2"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceOperationFailedError", func() {
+				Expect(err).To(MatchError(ServiceInstanceOperationFailedError{
+					Name:        "some-service-instance",
+					Operation:   "create",
+					Description: "broker error",
+				}))
+			})
+		})
+
+		Context("when the last operation is in progress at the timeout", func() {
+			BeforeEach(func() {
+				timeout = 0
+			})
+
+			It("returns a WaitTimeoutError", func() {
+				Expect(err).To(MatchError(actionerror.WaitTimeoutError{Timeout: 0}))
+				Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturnsOnCall(0, nil, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(err).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -54,13 +54,24 @@ func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgNam
 		return allWarnings, err
 	}
 
-	job, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(space.GUID)
+	warnings, err = actor.DeleteSpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+// DeleteSpace deletes the space and everything in it, and waits for the
+// deletion to finish.
+func (actor Actor) DeleteSpace(spaceGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	job, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(spaceGUID)
 	allWarnings = append(allWarnings, Warnings(deleteWarnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	warnings, err = actor.PollJob(Job(job))
+	warnings, err := actor.PollJob(Job(job))
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 
 	return allWarnings, err
@@ -113,3 +124,23 @@ func (actor Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName strin
 
 	return Space(ccv2Spaces[0]), Warnings(warnings), nil
 }
+
+// CreateSpace creates a space with the provided name in the organization.
+// When spaceQuotaGUID is empty, the space is not assigned a space quota.
+func (actor Actor) CreateSpace(orgGUID string, spaceName string, spaceQuotaGUID string) (Space, Warnings, error) {
+	space, warnings, err := actor.CloudControllerClient.CreateSpace(ccv2.Space{
+		Name:                     spaceName,
+		OrganizationGUID:         orgGUID,
+		SpaceQuotaDefinitionGUID: spaceQuotaGUID,
+	})
+	return Space(space), Warnings(warnings), err
+}
+
+// SetSpaceQuota assigns the space quota with the provided GUID to the space.
+func (actor Actor) SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateSpace(ccv2.Space{
+		GUID:                     spaceGUID,
+		SpaceQuotaDefinitionGUID: spaceQuotaGUID,
+	})
+	return Warnings(warnings), err
+}
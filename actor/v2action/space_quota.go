@@ -26,3 +26,32 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// GetSpaceQuotasByOrganization returns the space quotas of the organization.
+func (actor Actor) GetSpaceQuotasByOrganization(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	ccv2Quotas, warnings, err := actor.CloudControllerClient.GetOrganizationSpaceQuotas(orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	quotas := make([]SpaceQuota, len(ccv2Quotas))
+	for i, quota := range ccv2Quotas {
+		quotas[i] = SpaceQuota(quota)
+	}
+
+	return quotas, Warnings(warnings), nil
+}
+
+// CreateSpaceQuota creates a space quota with the name and limits of the
+// provided quota, in the quota's organization.
+func (actor Actor) CreateSpaceQuota(quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	createdQuota, warnings, err := actor.CloudControllerClient.CreateSpaceQuota(ccv2.SpaceQuota(quota))
+	return SpaceQuota(createdQuota), Warnings(warnings), err
+}
+
+// UpdateSpaceQuota updates the name and limits of the space quota with the
+// GUID of the provided quota.
+func (actor Actor) UpdateSpaceQuota(quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	updatedQuota, warnings, err := actor.CloudControllerClient.UpdateSpaceQuota(ccv2.SpaceQuota(quota))
+	return SpaceQuota(updatedQuota), Warnings(warnings), err
+}
@@ -76,4 +76,68 @@ var _ = Describe("SpaceQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotasByOrganization", func() {
+		Context("when getting the quotas succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{{GUID: "quota-guid", Name: "small", OrganizationGUID: "org-guid"}},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the quotas and warnings", func() {
+				quotas, warnings, err := actor.GetSpaceQuotasByOrganization("org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quotas).To(ConsistOf(SpaceQuota{GUID: "quota-guid", Name: "small", OrganizationGUID: "org-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationSpaceQuotasArgsForCall(0)).To(Equal("org-guid"))
+			})
+		})
+
+		Context("when getting the quotas fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationSpaceQuotasReturns(nil, ccv2.Warnings{"warning-1"}, errors.New("get-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotasByOrganization("org-guid")
+				Expect(err).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateSpaceQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateSpaceQuotaReturns(
+				ccv2.SpaceQuota{GUID: "quota-guid", Name: "small", OrganizationGUID: "org-guid"},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("creates the quota", func() {
+			quota, warnings, err := actor.CreateSpaceQuota(SpaceQuota{Name: "small", OrganizationGUID: "org-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quota.GUID).To(Equal("quota-guid"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.CreateSpaceQuotaArgsForCall(0)).To(Equal(ccv2.SpaceQuota{Name: "small", OrganizationGUID: "org-guid"}))
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateSpaceQuotaReturns(ccv2.SpaceQuota{GUID: "quota-guid"}, ccv2.Warnings{"warning-1"}, nil)
+		})
+
+		It("updates the quota", func() {
+			_, warnings, err := actor.UpdateSpaceQuota(SpaceQuota{GUID: "quota-guid", MemoryLimit: 512})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.UpdateSpaceQuotaArgsForCall(0)).To(Equal(ccv2.SpaceQuota{GUID: "quota-guid", MemoryLimit: 512}))
+		})
+	})
 })
@@ -192,6 +192,37 @@ var _ = Describe("Space", func() {
 			})
 		})
 
+		Describe("DeleteSpace", func() {
+			Context("when the delete and the job succeed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteSpaceReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"warning-1"}, nil)
+					fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"warning-2"}, nil)
+				})
+
+				It("deletes the space and waits for the job", func() {
+					warnings, err := actor.DeleteSpace("some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+					Expect(fakeCloudControllerClient.DeleteSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+				})
+			})
+
+			Context("when the delete fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteSpaceReturns(ccv2.Job{}, ccv2.Warnings{"warning-1"}, errors.New("delete-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					warnings, err := actor.DeleteSpace("some-space-guid")
+					Expect(err).To(MatchError("delete-error"))
+					Expect(warnings).To(ConsistOf("warning-1"))
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Describe("GetOrganizationSpaces", func() {
 			Context("when there are spaces in the org", func() {
 				BeforeEach(func() {
@@ -379,5 +410,41 @@ var _ = Describe("Space", func() {
 				})
 			})
 		})
+
+		Describe("CreateSpace", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSpaceReturns(
+					ccv2.Space{GUID: "space-guid", Name: "some-space"},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("creates the space in the organization", func() {
+				space, warnings, err := actor.CreateSpace("org-guid", "some-space", "space-quota-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(space.GUID).To(Equal("space-guid"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.CreateSpaceArgsForCall(0)).To(Equal(ccv2.Space{
+					Name:                     "some-space",
+					OrganizationGUID:         "org-guid",
+					SpaceQuotaDefinitionGUID: "space-quota-guid",
+				}))
+			})
+		})
+
+		Describe("SetSpaceQuota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSpaceReturns(ccv2.Space{}, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("updates the space quota of the space", func() {
+				warnings, err := actor.SetSpaceQuota("space-guid", "space-quota-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.UpdateSpaceArgsForCall(0)).To(Equal(ccv2.Space{GUID: "space-guid", SpaceQuotaDefinitionGUID: "space-quota-guid"}))
+			})
+		})
 	})
 })
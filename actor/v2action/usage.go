@@ -0,0 +1,129 @@
+package v2action
+
+// Usage is the amount of the quota-limited resources used in a space or
+// organization. Memory is in megabytes.
+type Usage struct {
+	Memory           int
+	Instances        int
+	Routes           int
+	ServiceInstances int
+}
+
+// add returns the sum of both usages.
+func (usage Usage) add(other Usage) Usage {
+	return Usage{
+		Memory:           usage.Memory + other.Memory,
+		Instances:        usage.Instances + other.Instances,
+		Routes:           usage.Routes + other.Routes,
+		ServiceInstances: usage.ServiceInstances + other.ServiceInstances,
+	}
+}
+
+// SpaceUsage is the usage of a space and the space quota it is limited by.
+// Quota has an empty GUID when the space has no space quota.
+type SpaceUsage struct {
+	Space Space
+	Quota SpaceQuota
+	Usage Usage
+}
+
+// OrganizationUsage is the usage of an organization, the organization quota
+// it is limited by and the usage of each of its spaces.
+type OrganizationUsage struct {
+	Organization Organization
+	Quota        OrganizationQuota
+	Usage        Usage
+	Spaces       []SpaceUsage
+}
+
+// GetOrganizationUsageByName returns the usage of the organization and its
+// spaces. Only the memory and instances of started apps and managed service
+// instances count against a quota, so only those are counted.
+func (actor Actor) GetOrganizationUsageByName(orgName string) (OrganizationUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationUsage{}, allWarnings, err
+	}
+
+	orgUsage := OrganizationUsage{Organization: org}
+
+	orgUsage.Quota, warnings, err = actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationUsage{}, allWarnings, err
+	}
+
+	spaceQuotas, warnings, err := actor.GetSpaceQuotasByOrganization(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationUsage{}, allWarnings, err
+	}
+
+	spaceQuotasByGUID := map[string]SpaceQuota{}
+	for _, spaceQuota := range spaceQuotas {
+		spaceQuotasByGUID[spaceQuota.GUID] = spaceQuota
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationUsage{}, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		usage, warnings, err := actor.getSpaceUsage(space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationUsage{}, allWarnings, err
+		}
+
+		orgUsage.Usage = orgUsage.Usage.add(usage)
+		orgUsage.Spaces = append(orgUsage.Spaces, SpaceUsage{
+			Space: space,
+			Quota: spaceQuotasByGUID[space.SpaceQuotaDefinitionGUID],
+			Usage: usage,
+		})
+	}
+
+	return orgUsage, allWarnings, nil
+}
+
+func (actor Actor) getSpaceUsage(spaceGUID string) (Usage, Warnings, error) {
+	var (
+		allWarnings Warnings
+		usage       Usage
+	)
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Usage{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		if !app.Started() || !app.Instances.IsSet {
+			continue
+		}
+		usage.Memory += int(app.Memory) * app.Instances.Value
+		usage.Instances += app.Instances.Value
+	}
+
+	routes, ccWarnings, err := actor.CloudControllerClient.GetSpaceRoutes(spaceGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return Usage{}, allWarnings, err
+	}
+	usage.Routes = len(routes)
+
+	serviceInstances, ccWarnings, err := actor.CloudControllerClient.GetSpaceServiceInstances(spaceGUID, false)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return Usage{}, allWarnings, err
+	}
+	usage.ServiceInstances = len(serviceInstances)
+
+	return usage, allWarnings, nil
+}
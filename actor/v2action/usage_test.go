@@ -0,0 +1,102 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrganizationUsageByName", func() {
+		var (
+			usage      OrganizationUsage
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid", Name: "acme", QuotaDefinitionGUID: "quota-guid"}},
+				ccv2.Warnings{"org-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{GUID: "quota-guid", Name: "default", MemoryLimit: 10240},
+				ccv2.Warnings{"quota-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationSpaceQuotasReturns(
+				[]ccv2.SpaceQuota{{GUID: "space-quota-guid", Name: "small", MemoryLimit: 1024}},
+				nil,
+				nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "dev-guid", Name: "dev", SpaceQuotaDefinitionGUID: "space-quota-guid"},
+					{GUID: "prod-guid", Name: "prod"},
+				},
+				nil,
+				nil)
+			fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				if queries[0].Values[0] == "dev-guid" {
+					return []ccv2.Application{
+						{Name: "web", State: ccv2.ApplicationStarted, Memory: 256, Instances: types.NullInt{Value: 2, IsSet: true}},
+						{Name: "worker", State: ccv2.ApplicationStopped, Memory: 1024, Instances: types.NullInt{Value: 1, IsSet: true}},
+					}, ccv2.Warnings{"apps-warning"}, nil
+				}
+				return []ccv2.Application{
+					{Name: "api", State: ccv2.ApplicationStarted, Memory: 512, Instances: types.NullInt{Value: 3, IsSet: true}},
+				}, nil, nil
+			}
+			fakeCloudControllerClient.GetSpaceRoutesReturns([]ccv2.Route{{GUID: "route-guid"}}, nil, nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns([]ccv2.ServiceInstance{{GUID: "db-guid"}, {GUID: "cache-guid"}}, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			usage, warnings, executeErr = actor.GetOrganizationUsageByName("acme")
+		})
+
+		It("returns the usage of the started apps, routes and managed service instances of each space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "quota-warning", "apps-warning"))
+
+			Expect(usage.Organization.Name).To(Equal("acme"))
+			Expect(usage.Quota.Name).To(Equal("default"))
+			Expect(usage.Usage).To(Equal(Usage{Memory: 2048, Instances: 5, Routes: 2, ServiceInstances: 4}))
+			Expect(usage.Spaces).To(HaveLen(2))
+			Expect(usage.Spaces[0].Space.Name).To(Equal("dev"))
+			Expect(usage.Spaces[0].Quota.Name).To(Equal("small"))
+			Expect(usage.Spaces[0].Usage).To(Equal(Usage{Memory: 512, Instances: 2, Routes: 1, ServiceInstances: 2}))
+			Expect(usage.Spaces[1].Space.Name).To(Equal("prod"))
+			Expect(usage.Spaces[1].Quota.GUID).To(BeEmpty())
+			Expect(usage.Spaces[1].Usage).To(Equal(Usage{Memory: 1536, Instances: 3, Routes: 1, ServiceInstances: 2}))
+
+			spaceGUID, includeUserProvidedServices, _ := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(includeUserProvidedServices).To(BeFalse())
+		})
+
+		Context("when getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"routes-warning"}, errors.New("routes-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(warnings).To(ConsistOf("org-warning", "quota-warning", "apps-warning", "routes-warning"))
+			})
+		})
+	})
+})
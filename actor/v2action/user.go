@@ -1,6 +1,9 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
 
 // User represents a CLI user.
 type User ccv2.User
@@ -16,3 +19,59 @@ func (actor Actor) CreateUser(username string, password string, origin string) (
 
 	return User(ccUser), Warnings(ccWarnings), err
 }
+
+// GetOrganizationUsersByRole returns the users with the provided role in the
+// organization.
+func (actor Actor) GetOrganizationUsersByRole(role constant.OrganizationUserRole, orgGUID string) ([]User, Warnings, error) {
+	ccv2Users, warnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(role, orgGUID)
+	return convertUsers(ccv2Users), Warnings(warnings), err
+}
+
+// GetSpaceUsersByRole returns the users with the provided role in the space.
+func (actor Actor) GetSpaceUsersByRole(role constant.SpaceUserRole, spaceGUID string) ([]User, Warnings, error) {
+	ccv2Users, warnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(role, spaceGUID)
+	return convertUsers(ccv2Users), Warnings(warnings), err
+}
+
+// SetOrganizationRoleByUsername gives the user the provided role in the
+// organization.
+func (actor Actor) SetOrganizationRoleByUsername(role constant.OrganizationUserRole, orgGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRole(role, orgGUID, username)
+	return Warnings(warnings), err
+}
+
+// UnsetOrganizationRoleByUsername removes the provided role in the
+// organization from the user.
+func (actor Actor) UnsetOrganizationRoleByUsername(role constant.OrganizationUserRole, orgGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteOrganizationUserByRole(role, orgGUID, username)
+	return Warnings(warnings), err
+}
+
+// SetSpaceRoleByUsername gives the user the provided role in the space. The
+// user is first made a member of the space's organization, which the Cloud
+// Controller requires before a space role can be given.
+func (actor Actor) SetSpaceRoleByUsername(role constant.SpaceUserRole, orgGUID string, spaceGUID string, username string) (Warnings, error) {
+	allWarnings, err := actor.SetOrganizationRoleByUsername(constant.OrgUserRole, orgGUID, username)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateSpaceUserByRole(role, spaceGUID, username)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UnsetSpaceRoleByUsername removes the provided role in the space from the
+// user.
+func (actor Actor) UnsetSpaceRoleByUsername(role constant.SpaceUserRole, spaceGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteSpaceUserByRole(role, spaceGUID, username)
+	return Warnings(warnings), err
+}
+
+func convertUsers(ccv2Users []ccv2.User) []User {
+	var users []User
+	for _, user := range ccv2Users {
+		users = append(users, User(user))
+	}
+	return users
+}
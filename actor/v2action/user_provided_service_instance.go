@@ -0,0 +1,27 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// UserProvidedServiceInstance represents an instance of a user provided
+// service, including its credentials.
+type UserProvidedServiceInstance ccv2.UserProvidedServiceInstance
+
+// CreateUserProvidedServiceInstance creates a user provided service instance
+// in the space of the service instance.
+func (actor Actor) CreateUserProvidedServiceInstance(serviceInstance UserProvidedServiceInstance) (UserProvidedServiceInstance, Warnings, error) {
+	createdServiceInstance, warnings, err := actor.CloudControllerClient.CreateUserProvidedServiceInstance(ccv2.UserProvidedServiceInstance(serviceInstance))
+	return UserProvidedServiceInstance(createdServiceInstance), Warnings(warnings), err
+}
+
+// GetUserProvidedServiceInstance returns the user provided service instance
+// with the given GUID.
+func (actor Actor) GetUserProvidedServiceInstance(guid string) (UserProvidedServiceInstance, Warnings, error) {
+	serviceInstance, warnings, err := actor.CloudControllerClient.GetUserProvidedServiceInstance(guid)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return UserProvidedServiceInstance{}, Warnings(warnings), ServiceInstanceNotFoundError{GUID: guid}
+	}
+	return UserProvidedServiceInstance(serviceInstance), Warnings(warnings), err
+}
@@ -0,0 +1,96 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Provided Service Instance Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		Context("when the create succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(
+					ccv2.UserProvidedServiceInstance{GUID: "some-guid", Name: "some-service-instance"},
+					ccv2.Warnings{"create-warning"},
+					nil)
+			})
+
+			It("returns the created service instance and all warnings", func() {
+				serviceInstance, warnings, err := actor.CreateUserProvidedServiceInstance(UserProvidedServiceInstance{
+					Name:        "some-service-instance",
+					SpaceGUID:   "some-space-guid",
+					Credentials: map[string]interface{}{"password": "some-password"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(UserProvidedServiceInstance{GUID: "some-guid", Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+
+				Expect(fakeCloudControllerClient.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(ccv2.UserProvidedServiceInstance{
+					Name:        "some-service-instance",
+					SpaceGUID:   "some-space-guid",
+					Credentials: map[string]interface{}{"password": "some-password"},
+				}))
+			})
+		})
+
+		Context("when the create fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(ccv2.UserProvidedServiceInstance{}, ccv2.Warnings{"create-warning"}, errors.New("create-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.CreateUserProvidedServiceInstance(UserProvidedServiceInstance{Name: "some-service-instance"})
+				Expect(err).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetUserProvidedServiceInstance", func() {
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserProvidedServiceInstanceReturns(
+					ccv2.UserProvidedServiceInstance{GUID: "some-guid", Name: "some-service-instance", SyslogDrainURL: "syslog://example.com"},
+					ccv2.Warnings{"get-warning"},
+					nil)
+			})
+
+			It("returns the service instance and all warnings", func() {
+				serviceInstance, warnings, err := actor.GetUserProvidedServiceInstance("some-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(UserProvidedServiceInstance{GUID: "some-guid", Name: "some-service-instance", SyslogDrainURL: "syslog://example.com"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.GetUserProvidedServiceInstanceArgsForCall(0)).To(Equal("some-guid"))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserProvidedServiceInstanceReturns(ccv2.UserProvidedServiceInstance{}, ccv2.Warnings{"get-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a ServiceInstanceNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetUserProvidedServiceInstance("some-guid")
+				Expect(err).To(MatchError(ServiceInstanceNotFoundError{GUID: "some-guid"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -121,4 +122,135 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(
+				[]ccv2.User{{GUID: "user-guid", Username: "alice"}},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("returns the users with the role", func() {
+			users, warnings, err := actor.GetOrganizationUsersByRole(constant.OrgManagerRole, "org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(ConsistOf(User{GUID: "user-guid", Username: "alice"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, orgGUID := fakeCloudControllerClient.GetOrganizationUsersByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.OrgManagerRole))
+			Expect(orgGUID).To(Equal("org-guid"))
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceUsersByRoleReturns(
+				[]ccv2.User{{GUID: "user-guid", Username: "alice"}},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("returns the users with the role", func() {
+			users, warnings, err := actor.GetSpaceUsersByRole(constant.SpaceDeveloperRole, "space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(ConsistOf(User{GUID: "user-guid", Username: "alice"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, spaceGUID := fakeCloudControllerClient.GetSpaceUsersByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.SpaceDeveloperRole))
+			Expect(spaceGUID).To(Equal("space-guid"))
+		})
+	})
+
+	Describe("SetOrganizationRoleByUsername", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"warning-1"}, nil)
+		})
+
+		It("gives the user the role", func() {
+			warnings, err := actor.SetOrganizationRoleByUsername(constant.OrgAuditorRole, "org-guid", "alice")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.OrgAuditorRole))
+			Expect(orgGUID).To(Equal("org-guid"))
+			Expect(username).To(Equal("alice"))
+		})
+	})
+
+	Describe("UnsetOrganizationRoleByUsername", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteOrganizationUserByRoleReturns(ccv2.Warnings{"warning-1"}, errors.New("delete-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			warnings, err := actor.UnsetOrganizationRoleByUsername(constant.OrgManagerRole, "org-guid", "alice")
+			Expect(err).To(MatchError("delete-error"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("SetSpaceRoleByUsername", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = actor.SetSpaceRoleByUsername(constant.SpaceDeveloperRole, "org-guid", "space-guid", "alice")
+		})
+
+		Context("when the user can be added to the organization", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"org-warning"}, nil)
+				fakeCloudControllerClient.UpdateSpaceUserByRoleReturns(ccv2.Warnings{"space-warning"}, nil)
+			})
+
+			It("adds the user to the organization and gives them the space role", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-warning", "space-warning"))
+
+				orgRole, orgGUID, orgUsername := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+				Expect(orgRole).To(Equal(constant.OrgUserRole))
+				Expect(orgGUID).To(Equal("org-guid"))
+				Expect(orgUsername).To(Equal("alice"))
+
+				spaceRole, spaceGUID, spaceUsername := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+				Expect(spaceRole).To(Equal(constant.SpaceDeveloperRole))
+				Expect(spaceGUID).To(Equal("space-guid"))
+				Expect(spaceUsername).To(Equal("alice"))
+			})
+		})
+
+		Context("when adding the user to the organization fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"org-warning"}, errors.New("org-error"))
+			})
+
+			It("returns the error and does not give the space role", func() {
+				Expect(err).To(MatchError("org-error"))
+				Expect(warnings).To(ConsistOf("org-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UnsetSpaceRoleByUsername", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteSpaceUserByRoleReturns(ccv2.Warnings{"warning-1"}, nil)
+		})
+
+		It("removes the role from the user", func() {
+			warnings, err := actor.UnsetSpaceRoleByUsername(constant.SpaceAuditorRole, "space-guid", "alice")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, spaceGUID, username := fakeCloudControllerClient.DeleteSpaceUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.SpaceAuditorRole))
+			Expect(spaceGUID).To(Equal("space-guid"))
+			Expect(username).To(Equal("alice"))
+		})
+	})
 })
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type FakeCloudControllerClient struct {
@@ -83,6 +84,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateOrganizationStub        func(org ccv2.Organization) (ccv2.Organization, ccv2.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		org ccv2.Organization
+	}
+	createOrganizationReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateOrganizationQuotaStub        func(quota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	createOrganizationQuotaMutex       sync.RWMutex
+	createOrganizationQuotaArgsForCall []struct {
+		quota ccv2.OrganizationQuota
+	}
+	createOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -116,6 +147,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSpaceStub        func(space ccv2.Space) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		space ccv2.Space
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceQuotaStub        func(quota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	createSpaceQuotaMutex       sync.RWMutex
+	createSpaceQuotaArgsForCall []struct {
+		quota ccv2.SpaceQuota
+	}
+	createSpaceQuotaReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -131,6 +192,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateUserProvidedServiceInstanceStub        func(serviceInstance ccv2.UserProvidedServiceInstance) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error)
+	createUserProvidedServiceInstanceMutex       sync.RWMutex
+	createUserProvidedServiceInstanceArgsForCall []struct {
+		serviceInstance ccv2.UserProvidedServiceInstance
+	}
+	createUserProvidedServiceInstanceReturns struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -146,6 +222,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteOrganizationUserByRoleStub        func(role constant.OrganizationUserRole, orgGUID string, username string) (ccv2.Warnings, error)
+	deleteOrganizationUserByRoleMutex       sync.RWMutex
+	deleteOrganizationUserByRoleArgsForCall []struct {
+		role     constant.OrganizationUserRole
+		orgGUID  string
+		username string
+	}
+	deleteOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteRouteStub        func(routeGUID string) (ccv2.Warnings, error)
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
@@ -201,6 +292,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteSpaceUserByRoleStub        func(role constant.SpaceUserRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	deleteSpaceUserByRoleMutex       sync.RWMutex
+	deleteSpaceUserByRoleArgsForCall []struct {
+		role      constant.SpaceUserRole
+		spaceGUID string
+		username  string
+	}
+	deleteSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetApplicationStub        func(guid string) (ccv2.Application, ccv2.Warnings, error)
 	getApplicationMutex       sync.RWMutex
 	getApplicationArgsForCall []struct {
@@ -338,6 +444,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationQuotasStub        func(queries ...ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -353,6 +474,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationSpaceQuotasStub        func(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	getOrganizationSpaceQuotasMutex       sync.RWMutex
+	getOrganizationSpaceQuotasArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationSpaceQuotasReturns struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationUsersByRoleStub        func(role constant.OrganizationUserRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role    constant.OrganizationUserRole
+		orgGUID string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetPrivateDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getPrivateDomainMutex       sync.RWMutex
 	getPrivateDomainArgsForCall []struct {
@@ -599,6 +751,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceUsersByRoleStub        func(role constant.SpaceUserRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role      constant.SpaceUserRole
+		spaceGUID string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetStackStub        func(guid string) (ccv2.Stack, ccv2.Warnings, error)
 	getStackMutex       sync.RWMutex
 	getStackArgsForCall []struct {
@@ -644,6 +812,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetUserProvidedServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error)
+	getUserProvidedServiceInstanceMutex       sync.RWMutex
+	getUserProvidedServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getUserProvidedServiceInstanceReturns struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
 	PollJobStub        func(job ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -743,6 +926,96 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateOrganizationStub        func(org ccv2.Organization) (ccv2.Organization, ccv2.Warnings, error)
+	updateOrganizationMutex       sync.RWMutex
+	updateOrganizationArgsForCall []struct {
+		org ccv2.Organization
+	}
+	updateOrganizationReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateOrganizationQuotaStub        func(quota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		quota ccv2.OrganizationQuota
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateOrganizationUserByRoleStub        func(role constant.OrganizationUserRole, orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserByRoleMutex       sync.RWMutex
+	updateOrganizationUserByRoleArgsForCall []struct {
+		role     constant.OrganizationUserRole
+		orgGUID  string
+		username string
+	}
+	updateOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceStub        func(space ccv2.Space) (ccv2.Space, ccv2.Warnings, error)
+	updateSpaceMutex       sync.RWMutex
+	updateSpaceArgsForCall []struct {
+		space ccv2.Space
+	}
+	updateSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateSpaceQuotaStub        func(quota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	updateSpaceQuotaMutex       sync.RWMutex
+	updateSpaceQuotaArgsForCall []struct {
+		quota ccv2.SpaceQuota
+	}
+	updateSpaceQuotaReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateSpaceUserByRoleStub        func(role constant.SpaceUserRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceUserByRoleMutex       sync.RWMutex
+	updateSpaceUserByRoleArgsForCall []struct {
+		role      constant.SpaceUserRole
+		spaceGUID string
+		username  string
+	}
+	updateSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 	uploadApplicationPackageMutex       sync.RWMutex
 	uploadApplicationPackageArgsForCall []struct {
@@ -1095,6 +1368,114 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateOrganization(org ccv2.Organization) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		org ccv2.Organization
+	}{org})
+	fake.recordInvocation("CreateOrganization", []interface{}{org})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(org)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationArgsForCall(i int) ccv2.Organization {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].org
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuota(quota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.createOrganizationQuotaReturnsOnCall[len(fake.createOrganizationQuotaArgsForCall)]
+	fake.createOrganizationQuotaArgsForCall = append(fake.createOrganizationQuotaArgsForCall, struct {
+		quota ccv2.OrganizationQuota
+	}{quota})
+	fake.recordInvocation("CreateOrganizationQuota", []interface{}{quota})
+	fake.createOrganizationQuotaMutex.Unlock()
+	if fake.CreateOrganizationQuotaStub != nil {
+		return fake.CreateOrganizationQuotaStub(quota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationQuotaReturns.result1, fake.createOrganizationQuotaReturns.result2, fake.createOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaCallCount() int {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return len(fake.createOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaArgsForCall(i int) ccv2.OrganizationQuota {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return fake.createOrganizationQuotaArgsForCall[i].quota
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationQuotaStub = nil
+	fake.createOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationQuotaStub = nil
+	if fake.createOrganizationQuotaReturnsOnCall == nil {
+		fake.createOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
@@ -1206,6 +1587,114 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSpace(space ccv2.Space) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		space ccv2.Space
+	}{space})
+	fake.recordInvocation("CreateSpace", []interface{}{space})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(space)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) ccv2.Space {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].space
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuota(quota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.createSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.createSpaceQuotaReturnsOnCall[len(fake.createSpaceQuotaArgsForCall)]
+	fake.createSpaceQuotaArgsForCall = append(fake.createSpaceQuotaArgsForCall, struct {
+		quota ccv2.SpaceQuota
+	}{quota})
+	fake.recordInvocation("CreateSpaceQuota", []interface{}{quota})
+	fake.createSpaceQuotaMutex.Unlock()
+	if fake.CreateSpaceQuotaStub != nil {
+		return fake.CreateSpaceQuotaStub(quota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceQuotaReturns.result1, fake.createSpaceQuotaReturns.result2, fake.createSpaceQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaCallCount() int {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	return len(fake.createSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaArgsForCall(i int) ccv2.SpaceQuota {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	return fake.createSpaceQuotaArgsForCall[i].quota
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceQuotaStub = nil
+	fake.createSpaceQuotaReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceQuotaStub = nil
+	if fake.createSpaceQuotaReturnsOnCall == nil {
+		fake.createSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -1260,6 +1749,60 @@ func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstance(serviceInstance ccv2.UserProvidedServiceInstance) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createUserProvidedServiceInstanceReturnsOnCall[len(fake.createUserProvidedServiceInstanceArgsForCall)]
+	fake.createUserProvidedServiceInstanceArgsForCall = append(fake.createUserProvidedServiceInstanceArgsForCall, struct {
+		serviceInstance ccv2.UserProvidedServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("CreateUserProvidedServiceInstance", []interface{}{serviceInstance})
+	fake.createUserProvidedServiceInstanceMutex.Unlock()
+	if fake.CreateUserProvidedServiceInstanceStub != nil {
+		return fake.CreateUserProvidedServiceInstanceStub(serviceInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createUserProvidedServiceInstanceReturns.result1, fake.createUserProvidedServiceInstanceReturns.result2, fake.createUserProvidedServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceCallCount() int {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.createUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceArgsForCall(i int) ccv2.UserProvidedServiceInstance {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.createUserProvidedServiceInstanceArgsForCall[i].serviceInstance
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceReturns(result1 ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	fake.createUserProvidedServiceInstanceReturns = struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceReturnsOnCall(i int, result1 ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	if fake.createUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.createUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.UserProvidedServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationMutex.Lock()
 	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
@@ -1314,6 +1857,59 @@ func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRole(role constant.OrganizationUserRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationUserByRoleReturnsOnCall[len(fake.deleteOrganizationUserByRoleArgsForCall)]
+	fake.deleteOrganizationUserByRoleArgsForCall = append(fake.deleteOrganizationUserByRoleArgsForCall, struct {
+		role     constant.OrganizationUserRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("DeleteOrganizationUserByRole", []interface{}{role, orgGUID, username})
+	fake.deleteOrganizationUserByRoleMutex.Unlock()
+	if fake.DeleteOrganizationUserByRoleStub != nil {
+		return fake.DeleteOrganizationUserByRoleStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteOrganizationUserByRoleReturns.result1, fake.deleteOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleCallCount() int {
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.deleteOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleArgsForCall(i int) (constant.OrganizationUserRole, string, string) {
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
+	return fake.deleteOrganizationUserByRoleArgsForCall[i].role, fake.deleteOrganizationUserByRoleArgsForCall[i].orgGUID, fake.deleteOrganizationUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserByRoleStub = nil
+	fake.deleteOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserByRoleStub = nil
+	if fake.deleteOrganizationUserByRoleReturnsOnCall == nil {
+		fake.deleteOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
 	fake.deleteRouteMutex.Lock()
 	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
@@ -1522,6 +2118,59 @@ func (fake *FakeCloudControllerClient) DeleteSpaceReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRole(role constant.SpaceUserRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.deleteSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceUserByRoleReturnsOnCall[len(fake.deleteSpaceUserByRoleArgsForCall)]
+	fake.deleteSpaceUserByRoleArgsForCall = append(fake.deleteSpaceUserByRoleArgsForCall, struct {
+		role      constant.SpaceUserRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("DeleteSpaceUserByRole", []interface{}{role, spaceGUID, username})
+	fake.deleteSpaceUserByRoleMutex.Unlock()
+	if fake.DeleteSpaceUserByRoleStub != nil {
+		return fake.DeleteSpaceUserByRoleStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteSpaceUserByRoleReturns.result1, fake.deleteSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleCallCount() int {
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
+	return len(fake.deleteSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleArgsForCall(i int) (constant.SpaceUserRole, string, string) {
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
+	return fake.deleteSpaceUserByRoleArgsForCall[i].role, fake.deleteSpaceUserByRoleArgsForCall[i].spaceGUID, fake.deleteSpaceUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserByRoleStub = nil
+	fake.deleteSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserByRoleStub = nil
+	if fake.deleteSpaceUserByRoleReturnsOnCall == nil {
+		fake.deleteSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
 	fake.getApplicationMutex.Lock()
 	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
@@ -2010,6 +2659,60 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(queries ...ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		queries []ccv2.Query
+	}{queries})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{queries})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotasReturns.result1, fake.getOrganizationQuotasReturns.result2, fake.getOrganizationQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv2.Query {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return fake.getOrganizationQuotasArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(queries ...ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -2064,6 +2767,115 @@ func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getOrganizationSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpaceQuotasReturnsOnCall[len(fake.getOrganizationSpaceQuotasArgsForCall)]
+	fake.getOrganizationSpaceQuotasArgsForCall = append(fake.getOrganizationSpaceQuotasArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationSpaceQuotas", []interface{}{orgGUID})
+	fake.getOrganizationSpaceQuotasMutex.Unlock()
+	if fake.GetOrganizationSpaceQuotasStub != nil {
+		return fake.GetOrganizationSpaceQuotasStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationSpaceQuotasReturns.result1, fake.getOrganizationSpaceQuotasReturns.result2, fake.getOrganizationSpaceQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotasCallCount() int {
+	fake.getOrganizationSpaceQuotasMutex.RLock()
+	defer fake.getOrganizationSpaceQuotasMutex.RUnlock()
+	return len(fake.getOrganizationSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotasArgsForCall(i int) string {
+	fake.getOrganizationSpaceQuotasMutex.RLock()
+	defer fake.getOrganizationSpaceQuotasMutex.RUnlock()
+	return fake.getOrganizationSpaceQuotasArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotasReturns(result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationSpaceQuotasStub = nil
+	fake.getOrganizationSpaceQuotasReturns = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotasReturnsOnCall(i int, result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationSpaceQuotasStub = nil
+	if fake.getOrganizationSpaceQuotasReturnsOnCall == nil {
+		fake.getOrganizationSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRole(role constant.OrganizationUserRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role    constant.OrganizationUserRole
+		orgGUID string
+	}{role, orgGUID})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, orgGUID})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleArgsForCall(i int) (constant.OrganizationUserRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
 	fake.getPrivateDomainMutex.Lock()
 	ret, specificReturn := fake.getPrivateDomainReturnsOnCall[len(fake.getPrivateDomainArgsForCall)]
@@ -2934,6 +3746,61 @@ func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceRetur
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRole(role constant.SpaceUserRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role      constant.SpaceUserRole
+		spaceGUID string
+	}{role, spaceGUID})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, spaceGUID})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleArgsForCall(i int) (constant.SpaceUserRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error) {
 	fake.getStackMutex.Lock()
 	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
@@ -3096,6 +3963,60 @@ func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupReturnsOnC
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstance(serviceInstanceGUID string) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error) {
+	fake.getUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getUserProvidedServiceInstanceReturnsOnCall[len(fake.getUserProvidedServiceInstanceArgsForCall)]
+	fake.getUserProvidedServiceInstanceArgsForCall = append(fake.getUserProvidedServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetUserProvidedServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.getUserProvidedServiceInstanceMutex.Unlock()
+	if fake.GetUserProvidedServiceInstanceStub != nil {
+		return fake.GetUserProvidedServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUserProvidedServiceInstanceReturns.result1, fake.getUserProvidedServiceInstanceReturns.result2, fake.getUserProvidedServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceCallCount() int {
+	fake.getUserProvidedServiceInstanceMutex.RLock()
+	defer fake.getUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.getUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceArgsForCall(i int) string {
+	fake.getUserProvidedServiceInstanceMutex.RLock()
+	defer fake.getUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.getUserProvidedServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceReturns(result1 ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserProvidedServiceInstanceStub = nil
+	fake.getUserProvidedServiceInstanceReturns = struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceReturnsOnCall(i int, result1 ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserProvidedServiceInstanceStub = nil
+	if fake.getUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.getUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.UserProvidedServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
 	fake.pollJobMutex.Lock()
 	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
@@ -3469,6 +4390,328 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganization(org ccv2.Organization) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.updateOrganizationMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationReturnsOnCall[len(fake.updateOrganizationArgsForCall)]
+	fake.updateOrganizationArgsForCall = append(fake.updateOrganizationArgsForCall, struct {
+		org ccv2.Organization
+	}{org})
+	fake.recordInvocation("UpdateOrganization", []interface{}{org})
+	fake.updateOrganizationMutex.Unlock()
+	if fake.UpdateOrganizationStub != nil {
+		return fake.UpdateOrganizationStub(org)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationReturns.result1, fake.updateOrganizationReturns.result2, fake.updateOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationCallCount() int {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return len(fake.updateOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationArgsForCall(i int) ccv2.Organization {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return fake.updateOrganizationArgsForCall[i].org
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationStub = nil
+	fake.updateOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationStub = nil
+	if fake.updateOrganizationReturnsOnCall == nil {
+		fake.updateOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(quota ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		quota ccv2.OrganizationQuota
+	}{quota})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{quota})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(quota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2, fake.updateOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) ccv2.OrganizationQuota {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].quota
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRole(role constant.OrganizationUserRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByRoleReturnsOnCall[len(fake.updateOrganizationUserByRoleArgsForCall)]
+	fake.updateOrganizationUserByRoleArgsForCall = append(fake.updateOrganizationUserByRoleArgsForCall, struct {
+		role     constant.OrganizationUserRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationUserByRole", []interface{}{role, orgGUID, username})
+	fake.updateOrganizationUserByRoleMutex.Unlock()
+	if fake.UpdateOrganizationUserByRoleStub != nil {
+		return fake.UpdateOrganizationUserByRoleStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserByRoleReturns.result1, fake.updateOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleCallCount() int {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.updateOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleArgsForCall(i int) (constant.OrganizationUserRole, string, string) {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return fake.updateOrganizationUserByRoleArgsForCall[i].role, fake.updateOrganizationUserByRoleArgsForCall[i].orgGUID, fake.updateOrganizationUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	fake.updateOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	if fake.updateOrganizationUserByRoleReturnsOnCall == nil {
+		fake.updateOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpace(space ccv2.Space) (ccv2.Space, ccv2.Warnings, error) {
+	fake.updateSpaceMutex.Lock()
+	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
+	fake.updateSpaceArgsForCall = append(fake.updateSpaceArgsForCall, struct {
+		space ccv2.Space
+	}{space})
+	fake.recordInvocation("UpdateSpace", []interface{}{space})
+	fake.updateSpaceMutex.Unlock()
+	if fake.UpdateSpaceStub != nil {
+		return fake.UpdateSpaceStub(space)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceReturns.result1, fake.updateSpaceReturns.result2, fake.updateSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceCallCount() int {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	return len(fake.updateSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceArgsForCall(i int) ccv2.Space {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	return fake.updateSpaceArgsForCall[i].space
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceStub = nil
+	fake.updateSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceStub = nil
+	if fake.updateSpaceReturnsOnCall == nil {
+		fake.updateSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuota(quota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.updateSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.updateSpaceQuotaReturnsOnCall[len(fake.updateSpaceQuotaArgsForCall)]
+	fake.updateSpaceQuotaArgsForCall = append(fake.updateSpaceQuotaArgsForCall, struct {
+		quota ccv2.SpaceQuota
+	}{quota})
+	fake.recordInvocation("UpdateSpaceQuota", []interface{}{quota})
+	fake.updateSpaceQuotaMutex.Unlock()
+	if fake.UpdateSpaceQuotaStub != nil {
+		return fake.UpdateSpaceQuotaStub(quota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceQuotaReturns.result1, fake.updateSpaceQuotaReturns.result2, fake.updateSpaceQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaCallCount() int {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	return len(fake.updateSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaArgsForCall(i int) ccv2.SpaceQuota {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	return fake.updateSpaceQuotaArgsForCall[i].quota
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaStub = nil
+	fake.updateSpaceQuotaReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaStub = nil
+	if fake.updateSpaceQuotaReturnsOnCall == nil {
+		fake.updateSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRole(role constant.SpaceUserRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserByRoleReturnsOnCall[len(fake.updateSpaceUserByRoleArgsForCall)]
+	fake.updateSpaceUserByRoleArgsForCall = append(fake.updateSpaceUserByRoleArgsForCall, struct {
+		role      constant.SpaceUserRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceUserByRole", []interface{}{role, spaceGUID, username})
+	fake.updateSpaceUserByRoleMutex.Unlock()
+	if fake.UpdateSpaceUserByRoleStub != nil {
+		return fake.UpdateSpaceUserByRoleStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserByRoleReturns.result1, fake.updateSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleCallCount() int {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return len(fake.updateSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleArgsForCall(i int) (constant.SpaceUserRole, string, string) {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return fake.updateSpaceUserByRoleArgsForCall[i].role, fake.updateSpaceUserByRoleArgsForCall[i].spaceGUID, fake.updateSpaceUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	fake.updateSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	if fake.updateSpaceUserByRoleReturnsOnCall == nil {
+		fake.updateSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error) {
 	var existingResourcesCopy []ccv2.Resource
 	if existingResources != nil {
@@ -3824,14 +5067,26 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.checkRouteMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteRouteApplicationMutex.RLock()
@@ -3840,6 +5095,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteServiceBindingMutex.RUnlock()
 	fake.deleteSpaceMutex.RLock()
 	defer fake.deleteSpaceMutex.RUnlock()
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationInstancesByApplicationMutex.RLock()
@@ -3858,8 +5115,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
 	fake.getOrganizationQuotaMutex.RLock()
 	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getOrganizationSpaceQuotasMutex.RLock()
+	defer fake.getOrganizationSpaceQuotasMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
 	defer fake.getPrivateDomainMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
@@ -3892,12 +5155,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceServiceInstancesMutex.RUnlock()
 	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
 	fake.getStacksMutex.RLock()
 	defer fake.getStacksMutex.RUnlock()
 	fake.getStagingSpacesBySecurityGroupMutex.RLock()
 	defer fake.getStagingSpacesBySecurityGroupMutex.RUnlock()
+	fake.getUserProvidedServiceInstanceMutex.RLock()
+	defer fake.getUserProvidedServiceInstanceMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
 	fake.removeSpaceFromRunningSecurityGroupMutex.RLock()
@@ -3912,6 +5179,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
 	fake.aPIMutex.RLock()
@@ -3,6 +3,7 @@ package v3action
 import (
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -15,6 +16,7 @@ type Application struct {
 	GUID      string
 	State     string
 	Lifecycle AppLifecycle
+	SpaceGUID string
 }
 
 type AppLifecycle struct {
@@ -125,6 +127,32 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetApplicationsByGUIDs returns the applications with the given GUIDs,
+// including the GUIDs of the spaces they are in.
+func (actor Actor) GetApplicationsByGUIDs(appGUIDs []string) ([]Application, Warnings, error) {
+	ccv3Apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		ccv3.GUIDFilter: []string{strings.Join(appGUIDs, ",")},
+	})
+	if err != nil {
+		return []Application{}, Warnings(warnings), err
+	}
+
+	apps := make([]Application, len(ccv3Apps))
+	for i, ccv3App := range ccv3Apps {
+		apps[i] = Application{
+			Name:  ccv3App.Name,
+			GUID:  ccv3App.GUID,
+			State: ccv3App.State,
+			Lifecycle: AppLifecycle{
+				Type: AppLifecycleType(ccv3App.Lifecycle.Type),
+				Data: AppLifecycleData(ccv3App.Lifecycle.Data),
+			},
+			SpaceGUID: ccv3App.Relationships[ccv3.SpaceRelationship].GUID,
+		}
+	}
+	return apps, Warnings(warnings), nil
+}
+
 // CreateApplicationInSpace creates and returns the application with the given
 // name in the given space.
 func (actor Actor) CreateApplicationInSpace(app Application, spaceGUID string) (Application, Warnings, error) {
@@ -240,6 +240,74 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsByGUIDs", func() {
+		Context("when the applications exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{
+							GUID: "some-app-guid-1",
+							Name: "some-app-1",
+							Relationships: ccv3.Relationships{
+								ccv3.SpaceRelationship: ccv3.Relationship{GUID: "some-space-guid-1"},
+							},
+						},
+						{
+							GUID: "some-app-guid-2",
+							Name: "some-app-2",
+							Relationships: ccv3.Relationships{
+								ccv3.SpaceRelationship: ccv3.Relationship{GUID: "some-space-guid-2"},
+							},
+						},
+					},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the applications with their space GUIDs and warnings", func() {
+				apps, warnings, err := actor.GetApplicationsByGUIDs([]string{"some-app-guid-1", "some-app-guid-2"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						GUID:      "some-app-guid-1",
+						Name:      "some-app-1",
+						SpaceGUID: "some-space-guid-1",
+					},
+					Application{
+						GUID:      "some-app-guid-2",
+						Name:      "some-app-2",
+						SpaceGUID: "some-space-guid-2",
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter: []string{"some-app-guid-1,some-app-guid-2"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{},
+					ccv3.Warnings{"some-warning"},
+					expectedError)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationsByGUIDs([]string{"some-app-guid-1"})
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(expectedError))
+			})
+		})
+	})
+
 	Describe("CreateApplicationInSpace", func() {
 		var (
 			application Application
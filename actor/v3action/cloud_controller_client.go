@@ -1,6 +1,7 @@
 package v3action
 
 import (
+	"io"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -12,16 +13,23 @@ import (
 type CloudControllerClient interface {
 	AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	CloudControllerAPIVersion() string
+	CopyDroplet(dropletGUID string, appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	CreateApplicationProcess(appGUID string, processType string) (string, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
+	CreateDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	CreateIsolationSegment(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	CreatePackage(pkg ccv3.Package) (ccv3.Package, ccv3.Warnings, error)
 	DeleteApplication(guid string) (string, ccv3.Warnings, error)
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
+	DeleteDroplet(dropletGUID string) (string, ccv3.Warnings, error)
 	DeleteIsolationSegment(guid string) (ccv3.Warnings, error)
+	DeletePackage(packageGUID string) (string, ccv3.Warnings, error)
+	DownloadDroplet(dropletGUID string) (io.ReadCloser, int64, ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	GetApplicationDropletCurrent(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
 	GetApplicationEnvironmentVariables(appGUID string) (ccv3.EnvironmentVariableGroups, ccv3.Warnings, error)
 	GetApplicationProcessByType(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error)
@@ -30,6 +38,7 @@ type CloudControllerClient interface {
 	GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
 	GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error)
 	GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error)
+	GetDropletSize(dropletGUID string) (int64, ccv3.Warnings, error)
 	GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	GetIsolationSegmentOrganizationsByIsolationSegment(isolationSegmentGUID string) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetIsolationSegments(query url.Values) ([]ccv3.IsolationSegment, ccv3.Warnings, error)
@@ -37,8 +46,12 @@ type CloudControllerClient interface {
 	GetOrganizations(query url.Values) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetPackages(query url.Values) ([]ccv3.Package, ccv3.Warnings, error)
 	GetPackage(guid string) (ccv3.Package, ccv3.Warnings, error)
+	GetPackageSize(packageGUID string) (int64, ccv3.Warnings, error)
 	GetProcessInstances(processGUID string) ([]ccv3.Instance, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	GetSpaces(query url.Values) ([]ccv3.Space, ccv3.Warnings, error)
+	GetTasks(query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
+	PatchApplicationProcessCommand(processGUID string, command string) (ccv3.Warnings, error)
 	PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (ccv3.Warnings, error)
 	PatchOrganizationDefaultIsolationSegment(orgGUID string, isolationSegmentGUID string) (ccv3.Warnings, error)
 	PollJob(jobURL string) (ccv3.Warnings, error)
@@ -49,5 +62,6 @@ type CloudControllerClient interface {
 	UpdateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	PatchApplicationUserProvidedEnvironmentVariables(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
+	UploadDropletBits(dropletGUID string, bits io.Reader, bitsLength int64) (string, ccv3.Warnings, error)
 	UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 }
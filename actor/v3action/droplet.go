@@ -1,7 +1,17 @@
 package v3action
 
 import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
 	"net/url"
+	"os"
+	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -28,6 +38,68 @@ type Droplet struct {
 
 type Buildpack ccv3.DropletBuildpack
 
+// DropletNotFoundError is returned when an application does not have a
+// current droplet, or does not have the droplet with DropletGUID.
+type DropletNotFoundError struct {
+	AppGUID     string
+	DropletGUID string
+}
+
+func (e DropletNotFoundError) Error() string {
+	if e.DropletGUID != "" {
+		return fmt.Sprintf("Droplet %s for application %s not found", e.DropletGUID, e.AppGUID)
+	}
+	return fmt.Sprintf("Droplet for application %s not found", e.AppGUID)
+}
+
+// DropletChecksumMismatchError is returned when the checksum of downloaded or
+// uploaded droplet bits does not match the checksum the Cloud Controller has
+// for the droplet.
+type DropletChecksumMismatchError struct {
+	DropletGUID string
+	Expected    string
+	Actual      string
+}
+
+func (e DropletChecksumMismatchError) Error() string {
+	return fmt.Sprintf("Checksum of droplet %s is %s, expected %s", e.DropletGUID, e.Actual, e.Expected)
+}
+
+// DropletUploadFailedError is returned when uploading the bits of a new
+// droplet fails and the droplet, left in the AWAITING_UPLOAD or FAILED state,
+// cannot be deleted.
+type DropletUploadFailedError struct {
+	DropletGUID string
+	Err         error
+	CleanupErr  error
+}
+
+func (e DropletUploadFailedError) Error() string {
+	return fmt.Sprintf("Upload of droplet %s failed: %s; deleting the droplet also failed: %s", e.DropletGUID, e.Err, e.CleanupErr)
+}
+
+// CopyDropletError is returned when a droplet copy ends in a state other than
+// STAGED.
+type CopyDropletError struct {
+	DropletGUID string
+	State       DropletState
+}
+
+func (e CopyDropletError) Error() string {
+	return fmt.Sprintf("Copy of droplet %s ended in state %s", e.DropletGUID, e.State)
+}
+
+// CopyDropletTimeoutError is returned when a droplet copy does not finish
+// within the staging timeout.
+type CopyDropletTimeoutError struct {
+	DropletGUID string
+	Timeout     time.Duration
+}
+
+func (e CopyDropletTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for copy of droplet %s", e.DropletGUID)
+}
+
 // AssignDropletError is returned when assigning the current droplet of an app
 // fails
 type AssignDropletError struct {
@@ -96,3 +168,239 @@ func (actor Actor) convertCCToActorDroplet(ccv3Droplet ccv3.Droplet) Droplet {
 		Image:      ccv3Droplet.Image,
 	}
 }
+
+// CopyApplicationDroplet copies the current droplet of the source application
+// to the destination application and waits for the copy to finish. It returns
+// a DropletNotFoundError if the source application has no current droplet.
+func (actor Actor) CopyApplicationDroplet(sourceAppGUID string, destinationAppGUID string) (Droplet, Warnings, error) {
+	allWarnings := Warnings{}
+	sourceDroplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(sourceAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			return Droplet{}, allWarnings, DropletNotFoundError{AppGUID: sourceAppGUID}
+		}
+		return Droplet{}, allWarnings, err
+	}
+
+	droplet, warnings, err := actor.CloudControllerClient.CopyDroplet(sourceDroplet.GUID, destinationAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	timeout := time.Now().Add(actor.Config.StagingTimeout())
+	for time.Now().Before(timeout) {
+		switch droplet.State {
+		case ccv3.DropletStateStaged:
+			return actor.convertCCToActorDroplet(droplet), allWarnings, nil
+		case ccv3.DropletStateCopying:
+			time.Sleep(actor.Config.PollingInterval())
+		default:
+			return Droplet{}, allWarnings, CopyDropletError{DropletGUID: droplet.GUID, State: DropletState(droplet.State)}
+		}
+
+		droplet, warnings, err = actor.CloudControllerClient.GetDroplet(droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Droplet{}, allWarnings, err
+		}
+	}
+
+	return Droplet{}, allWarnings, CopyDropletTimeoutError{DropletGUID: droplet.GUID, Timeout: actor.Config.StagingTimeout()}
+}
+
+// DownloadApplicationDroplet writes the bits of the application's droplet to
+// path and verifies their checksum. When dropletGUID is empty the current
+// droplet is downloaded. The file is only written once the checksum matches.
+func (actor Actor) DownloadApplicationDroplet(appName string, spaceGUID string, dropletGUID string, path string, progressBar ProgressBar) (Droplet, Warnings, error) {
+	allWarnings := Warnings{}
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	droplet, warnings, err := actor.getApplicationDroplet(application.GUID, dropletGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	bits, size, apiWarnings, err := actor.CloudControllerClient.DownloadDroplet(droplet.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+	defer bits.Close()
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), ".droplet")
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+	defer os.Remove(tempFile.Name())
+
+	checksum := newDropletHash(droplet.Checksum.Type)
+	reader := progressBar.NewProgressBarWrapper(bits, size)
+	_, err = io.Copy(io.MultiWriter(tempFile, checksum), reader)
+	closeErr := tempFile.Close()
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+	if closeErr != nil {
+		return Droplet{}, allWarnings, closeErr
+	}
+
+	if err = verifyDropletChecksum(droplet, checksum); err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	return actor.convertCCToActorDroplet(droplet), allWarnings, os.Rename(tempFile.Name(), path)
+}
+
+// UploadApplicationDroplet creates a droplet for the application from the
+// droplet bits at path, waits for the upload to be processed and verifies the
+// checksum of the uploaded bits. The droplet is not made the current droplet
+// of the application. When the upload fails, the new droplet is deleted; a
+// DropletUploadFailedError is returned when it cannot be.
+func (actor Actor) UploadApplicationDroplet(appName string, spaceGUID string, path string, progressBar ProgressBar) (Droplet, Warnings, error) {
+	allWarnings := Warnings{}
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+	defer file.Close()
+
+	sha1Checksum, sha256Checksum := sha1.New(), sha256.New()
+	size, err := io.Copy(io.MultiWriter(sha1Checksum, sha256Checksum), file)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	droplet, apiWarnings, err := actor.CloudControllerClient.CreateDroplet(application.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	uploaded, warnings, err := actor.uploadDropletBits(droplet.GUID, progressBar.NewProgressBarWrapper(file, size), size, sha1Checksum, sha256Checksum)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		warnings, cleanupErr := actor.deleteDroplet(droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if cleanupErr != nil {
+			return Droplet{}, allWarnings, DropletUploadFailedError{DropletGUID: droplet.GUID, Err: err, CleanupErr: cleanupErr}
+		}
+		return Droplet{}, allWarnings, err
+	}
+
+	return actor.convertCCToActorDroplet(uploaded), allWarnings, nil
+}
+
+// uploadDropletBits uploads the bits to the droplet, waits for the upload to
+// be processed and verifies the checksum of the bits.
+func (actor Actor) uploadDropletBits(dropletGUID string, bits io.Reader, size int64, sha1Checksum hash.Hash, sha256Checksum hash.Hash) (ccv3.Droplet, Warnings, error) {
+	allWarnings := Warnings{}
+
+	jobURL, apiWarnings, err := actor.CloudControllerClient.UploadDropletBits(dropletGUID, bits, size)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return ccv3.Droplet{}, allWarnings, err
+	}
+
+	apiWarnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return ccv3.Droplet{}, allWarnings, err
+	}
+
+	uploaded, apiWarnings, err := actor.CloudControllerClient.GetDroplet(dropletGUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return ccv3.Droplet{}, allWarnings, err
+	}
+
+	checksum := sha256Checksum
+	if uploaded.Checksum.Type == "sha1" {
+		checksum = sha1Checksum
+	}
+	return uploaded, allWarnings, verifyDropletChecksum(uploaded, checksum)
+}
+
+// deleteDroplet deletes the droplet and waits for the deletion to finish.
+func (actor Actor) deleteDroplet(dropletGUID string) (Warnings, error) {
+	allWarnings := Warnings{}
+
+	jobURL, apiWarnings, err := actor.CloudControllerClient.DeleteDroplet(dropletGUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	apiWarnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, apiWarnings...)
+	return allWarnings, err
+}
+
+// getApplicationDroplet returns the droplet of the application with the given
+// GUID, or the current droplet when dropletGUID is empty.
+func (actor Actor) getApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Droplet, Warnings, error) {
+	if dropletGUID == "" {
+		droplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(appGUID)
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			return ccv3.Droplet{}, Warnings(warnings), DropletNotFoundError{AppGUID: appGUID}
+		}
+		return droplet, Warnings(warnings), err
+	}
+
+	droplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(appGUID, url.Values{})
+	if err != nil {
+		return ccv3.Droplet{}, Warnings(warnings), err
+	}
+
+	for _, droplet := range droplets {
+		if droplet.GUID == dropletGUID {
+			return droplet, Warnings(warnings), nil
+		}
+	}
+
+	return ccv3.Droplet{}, Warnings(warnings), DropletNotFoundError{AppGUID: appGUID, DropletGUID: dropletGUID}
+}
+
+// newDropletHash returns the hash for the checksum type the Cloud Controller
+// reports. Older Cloud Controllers report sha1 checksums.
+func newDropletHash(checksumType string) hash.Hash {
+	if checksumType == "sha1" {
+		return sha1.New()
+	}
+	return sha256.New()
+}
+
+// verifyDropletChecksum compares the hash of the bits with the droplet's
+// checksum. Droplets without a checksum are not verified.
+func verifyDropletChecksum(droplet ccv3.Droplet, checksum hash.Hash) error {
+	if droplet.Checksum.Value == "" {
+		return nil
+	}
+
+	actual := hex.EncodeToString(checksum.Sum(nil))
+	if actual != droplet.Checksum.Value {
+		return DropletChecksumMismatchError{
+			DropletGUID: droplet.GUID,
+			Expected:    droplet.Checksum.Value,
+			Actual:      actual,
+		}
+	}
+
+	return nil
+}
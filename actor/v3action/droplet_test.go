@@ -1,8 +1,17 @@
 package v3action_test
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"io"
+	"io/ioutil"
 	"net/url"
+	"os"
+	"path/filepath"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -256,4 +265,392 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 	})
+
+	Describe("CopyApplicationDroplet", func() {
+		var (
+			fakeConfig *v3actionfakes.FakeConfig
+			droplet    Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			fakeConfig.StagingTimeoutReturns(time.Minute)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateStaged},
+				ccv3.Warnings{"get-current-droplet-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = actor.CopyApplicationDroplet("some-source-app-guid", "some-destination-app-guid")
+		})
+
+		Context("when the copy finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CopyDropletReturns(
+					ccv3.Droplet{GUID: "some-copy-guid", State: ccv3.DropletStateCopying},
+					ccv3.Warnings{"copy-droplet-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-copy-guid", State: ccv3.DropletStateStaged, Stack: "some-stack"},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("returns the copied droplet and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{GUID: "some-copy-guid", State: DropletStateStaged, Stack: "some-stack"}))
+				Expect(warnings).To(ConsistOf("get-current-droplet-warning", "copy-droplet-warning", "get-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-source-app-guid"))
+				dropletGUID, appGUID := fakeCloudControllerClient.CopyDropletArgsForCall(0)
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+				Expect(appGUID).To(Equal("some-destination-app-guid"))
+				Expect(fakeCloudControllerClient.GetDropletArgsForCall(0)).To(Equal("some-copy-guid"))
+			})
+		})
+
+		Context("when the copy fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CopyDropletReturns(
+					ccv3.Droplet{GUID: "some-copy-guid", State: ccv3.DropletStateCopying},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-copy-guid", State: ccv3.DropletStateFailed},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a CopyDropletError", func() {
+				Expect(executeErr).To(MatchError(CopyDropletError{DropletGUID: "some-copy-guid", State: DropletStateFailed}))
+			})
+		})
+
+		Context("when the source application has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					ccv3.Droplet{},
+					ccv3.Warnings{"get-current-droplet-warning"},
+					ccerror.DropletNotFoundError{},
+				)
+			})
+
+			It("returns a DropletNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(DropletNotFoundError{AppGUID: "some-source-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-current-droplet-warning"))
+				Expect(fakeCloudControllerClient.CopyDropletCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the copy request fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CopyDropletReturns(ccv3.Droplet{}, ccv3.Warnings{"copy-droplet-warning"}, errors.New("copy-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("copy-error"))
+				Expect(warnings).To(ConsistOf("get-current-droplet-warning", "copy-droplet-warning"))
+			})
+		})
+	})
+
+	Describe("DownloadApplicationDroplet", func() {
+		var (
+			fakeProgressBar *v3actionfakes.FakeProgressBar
+			tempDir         string
+			path            string
+			bits            []byte
+			droplet         Droplet
+			warnings        Warnings
+			executeErr      error
+			dropletGUID     string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "download-droplet")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(tempDir, "droplet.tgz")
+
+			fakeProgressBar = new(v3actionfakes.FakeProgressBar)
+			fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+				return reader
+			}
+
+			bits = []byte("some-droplet-bits")
+			checksum := sha256.Sum256(bits)
+
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-applications-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				ccv3.Droplet{
+					GUID:     "some-droplet-guid",
+					State:    ccv3.DropletStateStaged,
+					Checksum: ccv3.DropletChecksum{Type: "sha256", Value: hex.EncodeToString(checksum[:])},
+				},
+				ccv3.Warnings{"get-current-droplet-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DownloadDropletReturns(ioutil.NopCloser(bytes.NewReader(bits)), int64(len(bits)), ccv3.Warnings{"download-warning"}, nil)
+			dropletGUID = ""
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = actor.DownloadApplicationDroplet("some-app", "some-space-guid", dropletGUID, path, fakeProgressBar)
+		})
+
+		Context("when the checksum matches", func() {
+			It("writes the current droplet to the path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(droplet.GUID).To(Equal("some-droplet-guid"))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "download-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+				_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+				Expect(size).To(BeEquivalentTo(len(bits)))
+
+				Expect(ioutil.ReadFile(path)).To(Equal(bits))
+			})
+		})
+
+		Context("when the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", Checksum: ccv3.DropletChecksum{Type: "sha256", Value: "bad"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a DropletChecksumMismatchError and does not write the file", func() {
+				sum := sha256.Sum256(bits)
+				Expect(executeErr).To(MatchError(DropletChecksumMismatchError{
+					DropletGUID: "some-droplet-guid",
+					Expected:    "bad",
+					Actual:      hex.EncodeToString(sum[:]),
+				}))
+				_, err := os.Stat(path)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+				Expect(ioutil.ReadDir(tempDir)).To(BeEmpty())
+			})
+		})
+
+		Context("when a droplet GUID is provided", func() {
+			BeforeEach(func() {
+				dropletGUID = "old-droplet-guid"
+			})
+
+			Context("when the app has the droplet", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationDropletsReturns(
+						[]ccv3.Droplet{{GUID: "some-droplet-guid"}, {GUID: "old-droplet-guid"}},
+						ccv3.Warnings{"get-droplets-warning"},
+						nil,
+					)
+				})
+
+				It("downloads that droplet", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-applications-warning", "get-droplets-warning", "download-warning"))
+					Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("old-droplet-guid"))
+				})
+			})
+
+			Context("when the app does not have the droplet", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationDropletsReturns([]ccv3.Droplet{{GUID: "some-droplet-guid"}}, nil, nil)
+				})
+
+				It("returns a DropletNotFoundError", func() {
+					Expect(executeErr).To(MatchError(DropletNotFoundError{AppGUID: "some-app-guid", DropletGUID: "old-droplet-guid"}))
+					Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns a DropletNotFoundError", func() {
+				Expect(executeErr).To(MatchError(DropletNotFoundError{AppGUID: "some-app-guid"}))
+			})
+		})
+
+		Context("when the download fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletReturns(nil, 0, ccv3.Warnings{"download-warning"}, errors.New("download-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("download-error"))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "download-warning"))
+			})
+		})
+	})
+
+	Describe("UploadApplicationDroplet", func() {
+		var (
+			fakeProgressBar *v3actionfakes.FakeProgressBar
+			tempDir         string
+			path            string
+			bits            []byte
+			uploadedBits    []byte
+			droplet         Droplet
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "upload-droplet")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(tempDir, "droplet.tgz")
+			bits = []byte("some-droplet-bits")
+			Expect(ioutil.WriteFile(path, bits, 0600)).To(Succeed())
+
+			fakeProgressBar = new(v3actionfakes.FakeProgressBar)
+			fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+				return reader
+			}
+
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-applications-warning"}, nil)
+			fakeCloudControllerClient.CreateDropletReturns(ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateAwaitingUpload}, ccv3.Warnings{"create-droplet-warning"}, nil)
+			fakeCloudControllerClient.UploadDropletBitsStub = func(_ string, reader io.Reader, _ int64) (string, ccv3.Warnings, error) {
+				uploadedBits, _ = ioutil.ReadAll(reader)
+				return "some-job-url", ccv3.Warnings{"upload-warning"}, nil
+			}
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+
+			checksum := sha256.Sum256(bits)
+			fakeCloudControllerClient.GetDropletReturns(
+				ccv3.Droplet{
+					GUID:     "some-droplet-guid",
+					State:    ccv3.DropletStateStaged,
+					Checksum: ccv3.DropletChecksum{Type: "sha256", Value: hex.EncodeToString(checksum[:])},
+				},
+				ccv3.Warnings{"get-droplet-warning"},
+				nil,
+			)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = actor.UploadApplicationDroplet("some-app", "some-space-guid", path, fakeProgressBar)
+		})
+
+		Context("when the checksum matches", func() {
+			It("uploads the bits to a new droplet and returns it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: DropletStateStaged}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "create-droplet-warning", "upload-warning", "poll-warning", "get-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.CreateDropletArgsForCall(0)).To(Equal("some-app-guid"))
+				dropletGUID, _, size := fakeCloudControllerClient.UploadDropletBitsArgsForCall(0)
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+				Expect(size).To(BeEquivalentTo(len(bits)))
+				Expect(uploadedBits).To(Equal(bits))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("some-job-url"))
+			})
+		})
+
+		Context("when the Cloud Controller reports a sha1 checksum", func() {
+			BeforeEach(func() {
+				checksum := sha1.Sum(bits)
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", Checksum: ccv3.DropletChecksum{Type: "sha1", Value: hex.EncodeToString(checksum[:])}},
+					nil,
+					nil,
+				)
+			})
+
+			It("verifies the sha1 checksum", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", Checksum: ccv3.DropletChecksum{Type: "sha256", Value: "bad"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a DropletChecksumMismatchError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(DropletChecksumMismatchError{}))
+			})
+		})
+
+		Context("when processing the upload fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturnsOnCall(0, ccv3.Warnings{"poll-warning"}, errors.New("job-error"))
+				fakeCloudControllerClient.DeleteDropletReturns("delete-job-url", ccv3.Warnings{"delete-droplet-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturnsOnCall(1, ccv3.Warnings{"delete-poll-warning"}, nil)
+			})
+
+			It("deletes the droplet and returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("job-error"))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "create-droplet-warning", "upload-warning", "poll-warning", "delete-droplet-warning", "delete-poll-warning"))
+				Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(0))
+
+				Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(1)).To(Equal("delete-job-url"))
+			})
+
+			Context("when deleting the droplet fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"delete-droplet-warning"}, errors.New("delete-error"))
+				})
+
+				It("returns a DropletUploadFailedError and all warnings", func() {
+					Expect(executeErr).To(MatchError(DropletUploadFailedError{
+						DropletGUID: "some-droplet-guid",
+						Err:         errors.New("job-error"),
+						CleanupErr:  errors.New("delete-error"),
+					}))
+					Expect(warnings).To(ConsistOf("get-applications-warning", "create-droplet-warning", "upload-warning", "poll-warning", "delete-droplet-warning"))
+				})
+			})
+		})
+
+		Context("when the checksum does not match after the upload", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", Checksum: ccv3.DropletChecksum{Type: "sha256", Value: "bad"}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.DeleteDropletReturns("delete-job-url", nil, nil)
+			})
+
+			It("deletes the droplet", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(DropletChecksumMismatchError{}))
+				Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+			})
+		})
+	})
 })
@@ -57,3 +57,58 @@ func (actor Actor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appNa
 
 	return allWarnings, nil
 }
+
+// InstanceMetrics is the usage of a single instance of an application
+// process.
+type InstanceMetrics struct {
+	AppName     string
+	ProcessType string
+	Instance
+}
+
+// GetInstanceMetricsBySpace returns the usage of every process instance of
+// the applications in the space. When appNames are provided only the usage of
+// those applications is returned.
+func (actor Actor) GetInstanceMetricsBySpace(spaceGUID string, appNames []string) ([]InstanceMetrics, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(appNames) > 0 {
+		appsByName := map[string]Application{}
+		for _, app := range apps {
+			appsByName[app.Name] = app
+		}
+
+		apps = nil
+		for _, appName := range appNames {
+			app, ok := appsByName[appName]
+			if !ok {
+				return nil, allWarnings, ApplicationNotFoundError{Name: appName}
+			}
+			apps = append(apps, app)
+		}
+	}
+
+	var metrics []InstanceMetrics
+	for _, app := range apps {
+		processSummaries, warnings, err := actor.getProcessSummariesForApp(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, processSummary := range processSummaries {
+			for _, instance := range processSummary.InstanceDetails {
+				metrics = append(metrics, InstanceMetrics{
+					AppName:     app.Name,
+					ProcessType: processSummary.Type,
+					Instance:    instance,
+				})
+			}
+		}
+	}
+
+	return metrics, allWarnings, nil
+}
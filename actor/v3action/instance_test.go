@@ -118,4 +118,97 @@ var _ = Describe("instance actions", func() {
 			})
 		})
 	})
+
+	Describe("GetInstanceMetricsBySpace", func() {
+		var (
+			appNames []string
+			metrics  []InstanceMetrics
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			appNames = nil
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					{Name: "app-1", GUID: "app-1-guid"},
+					{Name: "app-2", GUID: "app-2-guid"},
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationProcessesStub = func(appGUID string) ([]ccv3.Process, ccv3.Warnings, error) {
+				return []ccv3.Process{{GUID: appGUID + "-web", Type: "web"}}, ccv3.Warnings{"get-processes-warning"}, nil
+			}
+			fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.Instance, ccv3.Warnings, error) {
+				return []ccv3.Instance{
+					{Index: 0, State: "RUNNING", CPU: 0.5},
+					{Index: 1, State: "CRASHED"},
+				}, ccv3.Warnings{"get-instances-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			metrics, warnings, err = actor.GetInstanceMetricsBySpace("some-space-guid", appNames)
+		})
+
+		It("returns the usage of every process instance in the space", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metrics).To(Equal([]InstanceMetrics{
+				{AppName: "app-1", ProcessType: "web", Instance: Instance{Index: 0, State: "RUNNING", CPU: 0.5}},
+				{AppName: "app-1", ProcessType: "web", Instance: Instance{Index: 1, State: "CRASHED"}},
+				{AppName: "app-2", ProcessType: "web", Instance: Instance{Index: 0, State: "RUNNING", CPU: 0.5}},
+				{AppName: "app-2", ProcessType: "web", Instance: Instance{Index: 1, State: "CRASHED"}},
+			}))
+			Expect(warnings).To(ConsistOf(
+				"get-apps-warning",
+				"get-processes-warning", "get-instances-warning",
+				"get-processes-warning", "get-instances-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+				"space_guids": []string{"some-space-guid"},
+			}))
+			Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(1)).To(Equal("app-2-guid-web"))
+		})
+
+		Context("when app names are provided", func() {
+			BeforeEach(func() {
+				appNames = []string{"app-2"}
+			})
+
+			It("only returns the usage of those apps", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metrics).To(HaveLen(2))
+				Expect(metrics[0].AppName).To(Equal("app-2"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(1))
+			})
+
+			Context("when an app does not exist", func() {
+				BeforeEach(func() {
+					appNames = []string{"app-2", "app-3"}
+				})
+
+				It("returns an ApplicationNotFoundError", func() {
+					Expect(err).To(MatchError(ApplicationNotFoundError{Name: "app-3"}))
+					Expect(warnings).To(ConsistOf("get-apps-warning"))
+				})
+			})
+		})
+
+		Context("when getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-instances-error")
+				fakeCloudControllerClient.GetProcessInstancesStub = nil
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
 })
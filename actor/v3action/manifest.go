@@ -0,0 +1,137 @@
+package v3action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+// AppNotFoundInManifestError is returned when the manifest does not declare
+// the application.
+type AppNotFoundInManifestError struct {
+	Name string
+}
+
+func (e AppNotFoundInManifestError) Error() string {
+	return fmt.Sprintf("Application %s not found in manifest", e.Name)
+}
+
+// ReadManifestProcesses returns the processes declared for the application in
+// the manifest.
+func (Actor) ReadManifestProcesses(pathToManifest string, appName string) ([]manifest.Process, error) {
+	apps, err := manifest.ReadAndMergeManifests(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps {
+		if app.Name == appName {
+			return app.Processes, nil
+		}
+	}
+
+	return nil, AppNotFoundInManifestError{Name: appName}
+}
+
+// UpdateApplicationProcesses sets the command, scale and health check of each
+// of the processes of the application to those declared in the manifest.
+// Processes of types that are not in the current droplet, for example because
+// the app has no Procfile, are created, so this must be called after the
+// droplet has been set.
+func (actor Actor) UpdateApplicationProcesses(appGUID string, processes []manifest.Process) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, manifestProcess := range processes {
+		warnings, err := actor.updateApplicationProcess(appGUID, manifestProcess)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) updateApplicationProcess(appGUID string, manifestProcess manifest.Process) (Warnings, error) {
+	healthCheckEndpoint := manifestProcess.HealthCheckHTTPEndpoint
+	if manifestProcess.HealthCheckType != "" && manifestProcess.HealthCheckType != "http" {
+		if healthCheckEndpoint != "" && healthCheckEndpoint != "/" {
+			return nil, HTTPHealthCheckInvalidError{}
+		}
+		healthCheckEndpoint = ""
+	}
+
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, manifestProcess.Type)
+	allWarnings := Warnings(warnings)
+	if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+		var createWarnings Warnings
+		process, createWarnings, err = actor.createApplicationProcess(appGUID, manifestProcess.Type)
+		allWarnings = append(allWarnings, createWarnings...)
+	}
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if manifestProcess.Command.IsSet {
+		warnings, err = actor.CloudControllerClient.PatchApplicationProcessCommand(process.GUID, manifestProcess.Command.Value)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if manifestProcess.Instances.IsSet || manifestProcess.Memory.IsSet || manifestProcess.DiskQuota.IsSet {
+		scale := Process{
+			Type:       manifestProcess.Type,
+			Instances:  manifestProcess.Instances,
+			MemoryInMB: types.NullUint64{IsSet: manifestProcess.Memory.IsSet, Value: manifestProcess.Memory.Value},
+			DiskInMB:   types.NullUint64{IsSet: manifestProcess.DiskQuota.IsSet, Value: manifestProcess.DiskQuota.Value},
+		}
+
+		scaleWarnings, err := actor.ScaleProcessByApplication(appGUID, scale)
+		allWarnings = append(allWarnings, scaleWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if manifestProcess.HealthCheckType != "" {
+		warnings, err = actor.CloudControllerClient.PatchApplicationProcessHealthCheck(process.GUID, manifestProcess.HealthCheckType, healthCheckEndpoint)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// createApplicationProcess creates a process of the given type for the
+// application and returns it.
+func (actor Actor) createApplicationProcess(appGUID string, processType string) (ccv3.Process, Warnings, error) {
+	jobURL, warnings, err := actor.CloudControllerClient.CreateApplicationProcess(appGUID, processType)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return ccv3.Process{}, allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ccv3.Process{}, allWarnings, err
+	}
+
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, processType)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return ccv3.Process{}, allWarnings, ProcessNotFoundError{ProcessType: processType}
+		}
+		return ccv3.Process{}, allWarnings, err
+	}
+
+	return process, allWarnings, nil
+}
@@ -0,0 +1,221 @@
+package v3action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("ReadManifestProcesses", func() {
+		var (
+			pathToManifest string
+			processes      []manifest.Process
+			err            error
+		)
+
+		BeforeEach(func() {
+			tempFile, tempErr := ioutil.TempFile("", "manifest-test-")
+			Expect(tempErr).ToNot(HaveOccurred())
+			Expect(tempFile.Close()).To(Succeed())
+			pathToManifest = tempFile.Name()
+
+			Expect(ioutil.WriteFile(pathToManifest, []byte(`---
+applications:
+- name: some-other-app
+- name: some-app
+  processes:
+  - type: worker
+    instances: 2
+`), 0666)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(pathToManifest)).To(Succeed())
+		})
+
+		It("returns the processes of the app", func() {
+			processes, err = actor.ReadManifestProcesses(pathToManifest, "some-app")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(processes).To(Equal([]manifest.Process{
+				{Type: "worker", Instances: types.NullInt{Value: 2, IsSet: true}},
+			}))
+		})
+
+		Context("when the app is not in the manifest", func() {
+			It("returns an AppNotFoundInManifestError", func() {
+				_, err = actor.ReadManifestProcesses(pathToManifest, "missing-app")
+				Expect(err).To(MatchError(AppNotFoundInManifestError{Name: "missing-app"}))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationProcesses", func() {
+		var (
+			processes []manifest.Process
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			processes = []manifest.Process{
+				{
+					Type:            "worker",
+					Command:         types.FilteredString{IsSet: true, Value: "some-command"},
+					Instances:       types.NullInt{Value: 2, IsSet: true},
+					Memory:          types.NullByteSizeInMb{Value: 512, IsSet: true},
+					HealthCheckType: "process",
+				},
+				{
+					Type:                    "clock",
+					HealthCheckType:         "http",
+					HealthCheckHTTPEndpoint: "/health",
+				},
+			}
+
+			fakeCloudControllerClient.GetApplicationProcessByTypeStub = func(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error) {
+				return ccv3.Process{GUID: processType + "-guid", Type: processType}, ccv3.Warnings{"get-process-warning"}, nil
+			}
+			fakeCloudControllerClient.PatchApplicationProcessCommandReturns(ccv3.Warnings{"command-warning"}, nil)
+			fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Warnings{"scale-warning"}, nil)
+			fakeCloudControllerClient.PatchApplicationProcessHealthCheckReturns(ccv3.Warnings{"health-check-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.UpdateApplicationProcesses("some-app-guid", processes)
+		})
+
+		It("updates only the declared properties of each process", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-process-warning", "command-warning", "scale-warning", "health-check-warning",
+				"get-process-warning", "health-check-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(2))
+			appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(processType).To(Equal("worker"))
+
+			Expect(fakeCloudControllerClient.PatchApplicationProcessCommandCallCount()).To(Equal(1))
+			processGUID, command := fakeCloudControllerClient.PatchApplicationProcessCommandArgsForCall(0)
+			Expect(processGUID).To(Equal("worker-guid"))
+			Expect(command).To(Equal("some-command"))
+
+			Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(1))
+			appGUID, scale := fakeCloudControllerClient.CreateApplicationProcessScaleArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(scale).To(Equal(ccv3.Process{
+				Type:       "worker",
+				Instances:  types.NullInt{Value: 2, IsSet: true},
+				MemoryInMB: types.NullUint64{Value: 512, IsSet: true},
+			}))
+
+			Expect(fakeCloudControllerClient.PatchApplicationProcessHealthCheckCallCount()).To(Equal(2))
+			processGUID, healthCheckType, endpoint := fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(0)
+			Expect(processGUID).To(Equal("worker-guid"))
+			Expect(healthCheckType).To(Equal("process"))
+			Expect(endpoint).To(BeEmpty())
+			processGUID, healthCheckType, endpoint = fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(1)
+			Expect(processGUID).To(Equal("clock-guid"))
+			Expect(healthCheckType).To(Equal("http"))
+			Expect(endpoint).To(Equal("/health"))
+		})
+
+		Context("when a non-http health check has an endpoint", func() {
+			BeforeEach(func() {
+				processes[0].HealthCheckHTTPEndpoint = "/health"
+			})
+
+			It("returns an HTTPHealthCheckInvalidError", func() {
+				Expect(err).To(MatchError(HTTPHealthCheckInvalidError{}))
+				Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the droplet does not have the process type", func() {
+			BeforeEach(func() {
+				processes = processes[:1]
+				fakeCloudControllerClient.GetApplicationProcessByTypeStub = nil
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturnsOnCall(0, ccv3.Process{}, ccv3.Warnings{"get-process-warning"}, ccerror.ProcessNotFoundError{})
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturnsOnCall(1, ccv3.Process{GUID: "worker-guid", Type: "worker"}, ccv3.Warnings{"get-created-process-warning"}, nil)
+				fakeCloudControllerClient.CreateApplicationProcessReturns("some-job-url", ccv3.Warnings{"create-process-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+			})
+
+			It("creates the process and updates it", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-process-warning", "create-process-warning", "poll-warning", "get-created-process-warning",
+					"command-warning", "scale-warning", "health-check-warning",
+				))
+
+				Expect(fakeCloudControllerClient.CreateApplicationProcessCallCount()).To(Equal(1))
+				appGUID, processType := fakeCloudControllerClient.CreateApplicationProcessArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("some-job-url"))
+
+				processGUID, _ := fakeCloudControllerClient.PatchApplicationProcessCommandArgsForCall(0)
+				Expect(processGUID).To(Equal("worker-guid"))
+			})
+
+			Context("when creating the process fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateApplicationProcessReturns("", ccv3.Warnings{"create-process-warning"}, errors.New("create-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError("create-error"))
+					Expect(warnings).To(ConsistOf("get-process-warning", "create-process-warning"))
+					Expect(fakeCloudControllerClient.PatchApplicationProcessCommandCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the created process cannot be found", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationProcessByTypeReturnsOnCall(1, ccv3.Process{}, nil, ccerror.ProcessNotFoundError{})
+				})
+
+				It("returns a ProcessNotFoundError", func() {
+					Expect(err).To(MatchError(ProcessNotFoundError{ProcessType: "worker"}))
+				})
+			})
+		})
+
+		Context("when scaling a process fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("scale-error")
+				fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Warnings{"scale-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-process-warning", "command-warning", "scale-warning"))
+				Expect(fakeCloudControllerClient.PatchApplicationProcessHealthCheckCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v3action
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
@@ -35,3 +36,19 @@ func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, e
 
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
+
+// GetOrganizationsByGUIDs returns the organizations with the given GUIDs.
+func (actor Actor) GetOrganizationsByGUIDs(orgGUIDs []string) ([]Organization, Warnings, error) {
+	ccv3Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(url.Values{
+		ccv3.GUIDFilter: []string{strings.Join(orgGUIDs, ",")},
+	})
+	if err != nil {
+		return []Organization{}, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccv3Orgs))
+	for i, ccv3Org := range ccv3Orgs {
+		orgs[i] = Organization(ccv3Org)
+	}
+	return orgs, Warnings(warnings), nil
+}
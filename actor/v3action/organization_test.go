@@ -102,4 +102,48 @@ var _ = Describe("Organization Actions", func() {
 			Expect(query).To(Equal(expectedQuery))
 		})
 	})
+
+	Describe("GetOrganizationsByGUIDs", func() {
+		Context("when the orgs exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{
+						{Name: "org-1", GUID: "org-guid-1"},
+						{Name: "org-2", GUID: "org-guid-2"},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the organizations and warnings", func() {
+				orgs, warnings, err := actor.GetOrganizationsByGUIDs([]string{"org-guid-1", "org-guid-2"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(Equal([]Organization{
+					{Name: "org-1", GUID: "org-guid-1"},
+					{Name: "org-2", GUID: "org-guid-2"},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter: []string{"org-guid-1,org-guid-2"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				_, warnings, err := actor.GetOrganizationsByGUIDs([]string{"org-guid-1"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
 })
@@ -31,3 +31,18 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// GetApplicationProcesses returns the processes of the application.
+func (actor Actor) GetApplicationProcesses(appGUID string) ([]Process, Warnings, error) {
+	ccv3Processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var processes []Process
+	for _, ccv3Process := range ccv3Processes {
+		processes = append(processes, Process(ccv3Process))
+	}
+
+	return processes, Warnings(warnings), nil
+}
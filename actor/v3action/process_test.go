@@ -94,4 +94,42 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationProcesses", func() {
+		Context("when getting the processes succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "web-guid", Type: constant.ProcessTypeWeb, Instances: types.NullInt{Value: 2, IsSet: true}},
+						{GUID: "worker-guid", Type: "worker"},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+			})
+
+			It("returns the processes and all warnings", func() {
+				processes, warnings, err := actor.GetApplicationProcesses("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(processes).To(Equal([]Process{
+					{GUID: "web-guid", Type: constant.ProcessTypeWeb, Instances: types.NullInt{Value: 2, IsSet: true}},
+					{GUID: "worker-guid", Type: "worker"},
+				}))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, errors.New("get-processes-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationProcesses("some-app-guid")
+				Expect(err).To(MatchError("get-processes-error"))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,228 @@
+package v3action
+
+import (
+	"net/url"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// PrunedResource is a droplet or package that was deleted, or that would be
+// deleted by a dry run. SizeInBytes is -1 when the size of its bits is
+// unknown.
+type PrunedResource struct {
+	AppName     string
+	GUID        string
+	State       string
+	CreatedAt   string
+	SizeInBytes int64
+}
+
+type prunable struct {
+	guid      string
+	state     string
+	createdAt string
+	inUse     bool
+	usable    bool
+	hasBits   bool
+}
+
+// PruneApplicationDroplets deletes the failed and expired droplets of the
+// application and all but the keep most recent staged droplets. The current
+// droplet and droplets that are still being staged, uploaded or copied are
+// never deleted. When dryRun is true nothing
+// is deleted and the droplets that would be deleted are returned.
+func (actor Actor) PruneApplicationDroplets(appName string, spaceGUID string, keep int, dryRun bool) ([]PrunedResource, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	pruned, warnings, err := actor.pruneDroplets(app, keep, dryRun)
+	allWarnings = append(allWarnings, warnings...)
+	return pruned, allWarnings, err
+}
+
+// PruneSpaceDroplets prunes the droplets of every application in the space
+// as PruneApplicationDroplets does.
+func (actor Actor) PruneSpaceDroplets(spaceGUID string, keep int, dryRun bool) ([]PrunedResource, Warnings, error) {
+	return actor.pruneSpace(spaceGUID, keep, dryRun, actor.pruneDroplets)
+}
+
+// PruneApplicationPackages deletes the failed and expired packages of the
+// application and all but the keep most recent ready packages. Packages that
+// are still being uploaded or copied are never deleted. When dryRun is true nothing is deleted and the packages that would
+// be deleted are returned.
+func (actor Actor) PruneApplicationPackages(appName string, spaceGUID string, keep int, dryRun bool) ([]PrunedResource, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	pruned, warnings, err := actor.prunePackages(app, keep, dryRun)
+	allWarnings = append(allWarnings, warnings...)
+	return pruned, allWarnings, err
+}
+
+// PruneSpacePackages prunes the packages of every application in the space
+// as PruneApplicationPackages does.
+func (actor Actor) PruneSpacePackages(spaceGUID string, keep int, dryRun bool) ([]PrunedResource, Warnings, error) {
+	return actor.pruneSpace(spaceGUID, keep, dryRun, actor.prunePackages)
+}
+
+func (actor Actor) pruneSpace(spaceGUID string, keep int, dryRun bool, prune func(Application, int, bool) ([]PrunedResource, Warnings, error)) ([]PrunedResource, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var allPruned []PrunedResource
+	for _, app := range apps {
+		pruned, warnings, err := prune(app, keep, dryRun)
+		allWarnings = append(allWarnings, warnings...)
+		allPruned = append(allPruned, pruned...)
+		if err != nil {
+			return allPruned, allWarnings, err
+		}
+	}
+
+	return allPruned, allWarnings, nil
+}
+
+func (actor Actor) pruneDroplets(app Application, keep int, dryRun bool) ([]PrunedResource, Warnings, error) {
+	allWarnings := Warnings{}
+	currentDroplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.DropletNotFoundError); !ok && err != nil {
+		return nil, allWarnings, err
+	}
+
+	droplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(app.GUID, url.Values{})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var resources []prunable
+	for _, droplet := range droplets {
+		resources = append(resources, prunable{
+			guid:      droplet.GUID,
+			state:     string(droplet.State),
+			createdAt: droplet.CreatedAt,
+			inUse: droplet.GUID == currentDroplet.GUID ||
+				droplet.State != ccv3.DropletStateStaged &&
+					droplet.State != ccv3.DropletStateFailed &&
+					droplet.State != ccv3.DropletStateExpired,
+			usable:  droplet.State == ccv3.DropletStateStaged,
+			hasBits: droplet.State == ccv3.DropletStateStaged,
+		})
+	}
+
+	pruned, pruneWarnings, err := actor.prune(app.Name, resources, keep, dryRun,
+		actor.CloudControllerClient.GetDropletSize,
+		actor.CloudControllerClient.DeleteDroplet,
+	)
+	allWarnings = append(allWarnings, pruneWarnings...)
+	return pruned, allWarnings, err
+}
+
+func (actor Actor) prunePackages(app Application, keep int, dryRun bool) ([]PrunedResource, Warnings, error) {
+	allWarnings := Warnings{}
+	packages, warnings, err := actor.CloudControllerClient.GetPackages(url.Values{
+		ccv3.AppGUIDFilter: []string{app.GUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var resources []prunable
+	for _, pkg := range packages {
+		resources = append(resources, prunable{
+			guid:      pkg.GUID,
+			state:     string(pkg.State),
+			createdAt: pkg.CreatedAt,
+			inUse: pkg.State != ccv3.PackageStateReady &&
+				pkg.State != ccv3.PackageStateFailed &&
+				pkg.State != ccv3.PackageStateExpired,
+			usable:  pkg.State == ccv3.PackageStateReady,
+			hasBits: pkg.State == ccv3.PackageStateReady && pkg.Type == ccv3.PackageTypeBits,
+		})
+	}
+
+	pruned, pruneWarnings, err := actor.prune(app.Name, resources, keep, dryRun,
+		actor.CloudControllerClient.GetPackageSize,
+		actor.CloudControllerClient.DeletePackage,
+	)
+	allWarnings = append(allWarnings, pruneWarnings...)
+	return pruned, allWarnings, err
+}
+
+// prune deletes the resources that are not in use, except for the keep most
+// recent usable ones, measuring the size of their bits first. Unusable
+// resources, such as failed droplets, do not count towards keep and are
+// deleted before any usable resource.
+func (actor Actor) prune(
+	appName string,
+	resources []prunable,
+	keep int,
+	dryRun bool,
+	getSize func(string) (int64, ccv3.Warnings, error),
+	deleteResource func(string) (string, ccv3.Warnings, error),
+) ([]PrunedResource, Warnings, error) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].createdAt > resources[j].createdAt
+	})
+
+	var unusable, usable []prunable
+	for _, resource := range resources {
+		switch {
+		case resource.usable && keep > 0:
+			keep--
+		case resource.inUse:
+		case !resource.usable:
+			unusable = append(unusable, resource)
+		default:
+			usable = append(usable, resource)
+		}
+	}
+
+	allWarnings := Warnings{}
+	var pruned []PrunedResource
+	for _, resource := range append(unusable, usable...) {
+		prunedResource := PrunedResource{
+			AppName:   appName,
+			GUID:      resource.guid,
+			State:     resource.state,
+			CreatedAt: resource.createdAt,
+		}
+
+		if resource.hasBits {
+			size, warnings, err := getSize(resource.guid)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return pruned, allWarnings, err
+			}
+			prunedResource.SizeInBytes = size
+		}
+
+		if !dryRun {
+			jobURL, warnings, err := deleteResource(resource.guid)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return pruned, allWarnings, err
+			}
+
+			warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return pruned, allWarnings, err
+			}
+		}
+
+		pruned = append(pruned, prunedResource)
+	}
+
+	return pruned, allWarnings, nil
+}
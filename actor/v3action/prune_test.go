@@ -0,0 +1,217 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prune Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"get-apps-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.DeleteDropletStub = func(guid string) (string, ccv3.Warnings, error) {
+			return "/v3/jobs/delete-" + guid, ccv3.Warnings{"delete-warning"}, nil
+		}
+		fakeCloudControllerClient.DeletePackageStub = func(guid string) (string, ccv3.Warnings, error) {
+			return "/v3/jobs/delete-" + guid, ccv3.Warnings{"delete-warning"}, nil
+		}
+		fakeCloudControllerClient.GetDropletSizeReturns(100, ccv3.Warnings{"size-warning"}, nil)
+		fakeCloudControllerClient.GetPackageSizeReturns(10, ccv3.Warnings{"size-warning"}, nil)
+	})
+
+	Describe("PruneApplicationDroplets", func() {
+		var (
+			dryRun   bool
+			pruned   []PrunedResource
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			dryRun = false
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				ccv3.Droplet{GUID: "droplet-1"},
+				ccv3.Warnings{"get-current-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletsReturns(
+				[]ccv3.Droplet{
+					{GUID: "droplet-1", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+					{GUID: "droplet-2", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+					{GUID: "droplet-3", State: ccv3.DropletStateFailed, CreatedAt: "2017-01-03T00:00:00Z"},
+					{GUID: "droplet-4", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-04T00:00:00Z"},
+					{GUID: "droplet-5", State: ccv3.DropletStateAwaitingUpload, CreatedAt: "2017-01-05T00:00:00Z"},
+					{GUID: "droplet-6", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-06T00:00:00Z"},
+				},
+				ccv3.Warnings{"get-droplets-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			pruned, warnings, err = actor.PruneApplicationDroplets("some-app", "some-space-guid", 1, dryRun)
+		})
+
+		It("deletes the failed droplets first and all but the most recent staged droplets, keeping the current and unfinished droplets", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pruned).To(Equal([]PrunedResource{
+				{AppName: "some-app", GUID: "droplet-3", State: "FAILED", CreatedAt: "2017-01-03T00:00:00Z"},
+				{AppName: "some-app", GUID: "droplet-4", State: "STAGED", CreatedAt: "2017-01-04T00:00:00Z", SizeInBytes: 100},
+				{AppName: "some-app", GUID: "droplet-2", State: "STAGED", CreatedAt: "2017-01-02T00:00:00Z", SizeInBytes: 100},
+			}))
+			Expect(warnings).To(ContainElement("get-apps-warning"))
+			Expect(warnings).To(ContainElement("get-current-warning"))
+			Expect(warnings).To(ContainElement("get-droplets-warning"))
+			Expect(warnings).To(ContainElement("size-warning"))
+			Expect(warnings).To(ContainElement("delete-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeCloudControllerClient.GetDropletSizeCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("droplet-3"))
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("/v3/jobs/delete-droplet-3"))
+		})
+
+		Context("when the most recent droplets failed to stage", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletsReturns(
+					[]ccv3.Droplet{
+						{GUID: "droplet-1", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+						{GUID: "droplet-2", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+						{GUID: "droplet-3", State: ccv3.DropletStateFailed, CreatedAt: "2017-01-03T00:00:00Z"},
+						{GUID: "droplet-4", State: ccv3.DropletStateExpired, CreatedAt: "2017-01-04T00:00:00Z"},
+						{GUID: "droplet-5", State: ccv3.DropletStateFailed, CreatedAt: "2017-01-05T00:00:00Z"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("does not count them towards keep and keeps the most recent staged droplet", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pruned).To(Equal([]PrunedResource{
+					{AppName: "some-app", GUID: "droplet-5", State: "FAILED", CreatedAt: "2017-01-05T00:00:00Z"},
+					{AppName: "some-app", GUID: "droplet-4", State: "EXPIRED", CreatedAt: "2017-01-04T00:00:00Z"},
+					{AppName: "some-app", GUID: "droplet-3", State: "FAILED", CreatedAt: "2017-01-03T00:00:00Z"},
+				}))
+				Expect(fakeCloudControllerClient.GetDropletSizeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when it is a dry run", func() {
+			BeforeEach(func() {
+				dryRun = true
+			})
+
+			It("returns the droplets that would be deleted without deleting them", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pruned).To(HaveLen(3))
+				Expect(fakeCloudControllerClient.GetDropletSizeCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("also deletes the oldest droplet", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pruned).To(HaveLen(4))
+				Expect(pruned[3].GUID).To(Equal("droplet-1"))
+			})
+		})
+
+		Context("when deleting a droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete-error")
+				fakeCloudControllerClient.DeleteDropletStub = nil
+				fakeCloudControllerClient.DeleteDropletReturnsOnCall(0, "/v3/jobs/some-job", ccv3.Warnings{"delete-warning"}, nil)
+				fakeCloudControllerClient.DeleteDropletReturnsOnCall(1, "", ccv3.Warnings{"delete-warning-2"}, expectedErr)
+			})
+
+			It("returns the droplets deleted so far, the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(pruned).To(HaveLen(1))
+				Expect(warnings).To(ContainElement("delete-warning-2"))
+			})
+		})
+	})
+
+	Describe("PruneSpacePackages", func() {
+		var (
+			pruned   []PrunedResource
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					{Name: "app-1", GUID: "app-1-guid"},
+					{Name: "app-2", GUID: "app-2-guid"},
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetPackagesReturnsOnCall(0,
+				[]ccv3.Package{
+					{GUID: "package-1", Type: ccv3.PackageTypeBits, State: ccv3.PackageStateReady, CreatedAt: "2017-01-01T00:00:00Z"},
+					{GUID: "package-2", Type: ccv3.PackageTypeBits, State: ccv3.PackageStateReady, CreatedAt: "2017-01-02T00:00:00Z"},
+				},
+				ccv3.Warnings{"get-packages-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetPackagesReturnsOnCall(1,
+				[]ccv3.Package{
+					{GUID: "package-3", Type: ccv3.PackageTypeDocker, State: ccv3.PackageStateReady, CreatedAt: "2017-01-01T00:00:00Z"},
+					{GUID: "package-4", Type: ccv3.PackageTypeBits, State: ccv3.PackageStateProcessingUpload, CreatedAt: "2017-01-02T00:00:00Z"},
+					{GUID: "package-5", Type: ccv3.PackageTypeBits, State: ccv3.PackageStateReady, CreatedAt: "2017-01-03T00:00:00Z"},
+				},
+				ccv3.Warnings{"get-packages-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			pruned, warnings, err = actor.PruneSpacePackages("some-space-guid", 1, false)
+		})
+
+		It("prunes the packages of every app in the space", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pruned).To(Equal([]PrunedResource{
+				{AppName: "app-1", GUID: "package-1", State: "READY", CreatedAt: "2017-01-01T00:00:00Z", SizeInBytes: 10},
+				{AppName: "app-2", GUID: "package-3", State: "READY", CreatedAt: "2017-01-01T00:00:00Z"},
+			}))
+			Expect(warnings).To(ConsistOf("get-apps-warning", "get-packages-warning", "size-warning", "delete-warning", "get-packages-warning", "delete-warning"))
+
+			Expect(fakeCloudControllerClient.GetPackageSizeCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("package-1"))
+			Expect(fakeCloudControllerClient.DeletePackageArgsForCall(1)).To(Equal("package-3"))
+		})
+	})
+})
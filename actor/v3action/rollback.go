@@ -0,0 +1,89 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// RollbackDropletNotStagedError is returned when the droplet requested for a
+// rollback did not stage successfully.
+type RollbackDropletNotStagedError struct {
+	DropletGUID string
+	State       DropletState
+}
+
+func (e RollbackDropletNotStagedError) Error() string {
+	return fmt.Sprintf("droplet %s is %s", e.DropletGUID, e.State)
+}
+
+// NoPreviousDropletError is returned when the application does not have a
+// staged droplet the requested number of steps before its current droplet.
+type NoPreviousDropletError struct {
+	AppGUID string
+	Steps   int
+}
+
+func (e NoPreviousDropletError) Error() string {
+	return fmt.Sprintf("app %s has no staged droplet %d steps before its current droplet", e.AppGUID, e.Steps)
+}
+
+// GetRollbackDroplet returns the droplet to roll the application back to.
+// When dropletGUID is provided that droplet is returned, provided it is
+// staged. Otherwise the staged droplet steps before the current droplet, in
+// order of creation, is returned; droplets that failed staging or expired are
+// skipped.
+func (actor Actor) GetRollbackDroplet(appGUID string, dropletGUID string, steps int) (Droplet, Warnings, error) {
+	if dropletGUID != "" {
+		droplet, warnings, err := actor.getApplicationDroplet(appGUID, dropletGUID)
+		if err != nil {
+			return Droplet{}, warnings, err
+		}
+		if droplet.State != ccv3.DropletStateStaged {
+			return Droplet{}, warnings, RollbackDropletNotStagedError{DropletGUID: droplet.GUID, State: DropletState(droplet.State)}
+		}
+		return actor.convertCCToActorDroplet(droplet), warnings, nil
+	}
+
+	allWarnings := Warnings{}
+	currentDroplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			return Droplet{}, allWarnings, DropletNotFoundError{AppGUID: appGUID}
+		}
+		return Droplet{}, allWarnings, err
+	}
+
+	droplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(appGUID, url.Values{})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	sort.SliceStable(droplets, func(i, j int) bool {
+		return droplets[i].CreatedAt > droplets[j].CreatedAt
+	})
+
+	foundCurrent := false
+	remaining := steps
+	for _, droplet := range droplets {
+		if !foundCurrent {
+			foundCurrent = droplet.GUID == currentDroplet.GUID
+			continue
+		}
+		if droplet.State != ccv3.DropletStateStaged {
+			continue
+		}
+
+		remaining--
+		if remaining == 0 {
+			return actor.convertCCToActorDroplet(droplet), allWarnings, nil
+		}
+	}
+
+	return Droplet{}, allWarnings, NoPreviousDropletError{AppGUID: appGUID, Steps: steps}
+}
@@ -0,0 +1,149 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollback Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetRollbackDroplet", func() {
+		var (
+			dropletGUID string
+			steps       int
+			droplet     Droplet
+			warnings    Warnings
+			err         error
+		)
+
+		BeforeEach(func() {
+			dropletGUID = ""
+			steps = 1
+
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				ccv3.Droplet{GUID: "droplet-4"},
+				ccv3.Warnings{"get-current-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletsReturns(
+				[]ccv3.Droplet{
+					{GUID: "droplet-1", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+					{GUID: "droplet-2", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+					{GUID: "droplet-3", State: ccv3.DropletStateFailed, CreatedAt: "2017-01-03T00:00:00Z"},
+					{GUID: "droplet-5", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-05T00:00:00Z"},
+					{GUID: "droplet-4", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-04T00:00:00Z"},
+				},
+				ccv3.Warnings{"get-droplets-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, err = actor.GetRollbackDroplet("some-app-guid", dropletGUID, steps)
+		})
+
+		It("returns the previous staged droplet, skipping droplets that failed staging", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(droplet.GUID).To(Equal("droplet-2"))
+			Expect(warnings).To(ConsistOf("get-current-warning", "get-droplets-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+			appGUID, _ := fakeCloudControllerClient.GetApplicationDropletsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		Context("when rolling back more than one step", func() {
+			BeforeEach(func() {
+				steps = 2
+			})
+
+			It("returns the staged droplet that many steps before the current droplet", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet.GUID).To(Equal("droplet-1"))
+			})
+		})
+
+		Context("when there are not enough previous droplets", func() {
+			BeforeEach(func() {
+				steps = 3
+			})
+
+			It("returns a NoPreviousDropletError", func() {
+				Expect(err).To(MatchError(NoPreviousDropletError{AppGUID: "some-app-guid", Steps: 3}))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"get-current-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns a DropletNotFoundError", func() {
+				Expect(err).To(MatchError(DropletNotFoundError{AppGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-current-warning"))
+			})
+		})
+
+		Context("when getting the droplets fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-droplets-error")
+				fakeCloudControllerClient.GetApplicationDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-current-warning", "get-droplets-warning"))
+			})
+		})
+
+		Context("when a droplet GUID is provided", func() {
+			BeforeEach(func() {
+				dropletGUID = "droplet-1"
+			})
+
+			It("returns that droplet", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet.GUID).To(Equal("droplet-1"))
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(0))
+			})
+
+			Context("when the droplet failed staging", func() {
+				BeforeEach(func() {
+					dropletGUID = "droplet-3"
+				})
+
+				It("returns a RollbackDropletNotStagedError", func() {
+					Expect(err).To(MatchError(RollbackDropletNotStagedError{DropletGUID: "droplet-3", State: DropletStateFailed}))
+				})
+			})
+
+			Context("when the app does not have the droplet", func() {
+				BeforeEach(func() {
+					dropletGUID = "droplet-9"
+				})
+
+				It("returns a DropletNotFoundError", func() {
+					Expect(err).To(MatchError(DropletNotFoundError{AppGUID: "some-app-guid", DropletGUID: "droplet-9"}))
+				})
+			})
+		})
+	})
+})
@@ -1,5 +1,64 @@
 package v3action
 
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Space represents a V3 actor space.
+type Space struct {
+	Name             string
+	GUID             string
+	OrganizationGUID string
+}
+
+// SpaceNotFoundError represents the error that occurs when the space is not
+// found.
+type SpaceNotFoundError struct {
+	Name string
+}
+
+func (e SpaceNotFoundError) Error() string {
+	return fmt.Sprintf("Space '%s' not found.", e.Name)
+}
+
+// GetSpaceByNameAndOrganization returns the space with the given name in the
+// given organization.
+func (actor Actor) GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (Space, Warnings, error) {
+	ccv3Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+		ccv3.NameFilter:             []string{spaceName},
+		ccv3.OrganizationGUIDFilter: []string{orgGUID},
+	})
+	if err != nil {
+		return Space{}, Warnings(warnings), err
+	}
+
+	if len(ccv3Spaces) == 0 {
+		return Space{}, Warnings(warnings), SpaceNotFoundError{Name: spaceName}
+	}
+
+	return actor.convertCCToActorSpace(ccv3Spaces[0]), Warnings(warnings), nil
+}
+
+// GetSpacesByGUIDs returns the spaces with the given GUIDs.
+func (actor Actor) GetSpacesByGUIDs(spaceGUIDs []string) ([]Space, Warnings, error) {
+	ccv3Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+		ccv3.GUIDFilter: []string{strings.Join(spaceGUIDs, ",")},
+	})
+	if err != nil {
+		return []Space{}, Warnings(warnings), err
+	}
+
+	spaces := make([]Space, len(ccv3Spaces))
+	for i, ccv3Space := range ccv3Spaces {
+		spaces[i] = actor.convertCCToActorSpace(ccv3Space)
+	}
+	return spaces, Warnings(warnings), nil
+}
+
 // ResetSpaceIsolationSegment disassociates a space from an isolation segment.
 //
 // If the space's organization has a default isolation segment, return its
@@ -31,3 +90,11 @@ func (actor Actor) ResetSpaceIsolationSegment(orgGUID string, spaceGUID string)
 
 	return isoSegName, allWarnings, nil
 }
+
+func (Actor) convertCCToActorSpace(space ccv3.Space) Space {
+	return Space{
+		Name:             space.Name,
+		GUID:             space.GUID,
+		OrganizationGUID: space.Relationships[ccv3.OrganizationRelationship].GUID,
+	}
+}
@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"net/url"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -145,4 +146,124 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceByNameAndOrganization", func() {
+		Context("when the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{
+						{
+							Name: "some-space-name",
+							GUID: "some-space-guid",
+							Relationships: ccv3.Relationships{
+								ccv3.OrganizationRelationship: ccv3.Relationship{GUID: "some-org-guid"},
+							},
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the space and warnings", func() {
+				space, warnings, err := actor.GetSpaceByNameAndOrganization("some-space-name", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(space).To(Equal(Space{
+					Name:             "some-space-name",
+					GUID:             "some-space-guid",
+					OrganizationGUID: "some-org-guid",
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter:             []string{"some-space-name"},
+					ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+				}))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{}, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError and the warnings", func() {
+				_, warnings, err := actor.GetSpaceByNameAndOrganization("some-space-name", "some-org-guid")
+				Expect(err).To(MatchError(SpaceNotFoundError{Name: "some-space-name"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				_, warnings, err := actor.GetSpaceByNameAndOrganization("some-space-name", "some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpacesByGUIDs", func() {
+		Context("when the spaces exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{
+						{
+							Name: "space-1",
+							GUID: "space-guid-1",
+							Relationships: ccv3.Relationships{
+								ccv3.OrganizationRelationship: ccv3.Relationship{GUID: "org-guid-1"},
+							},
+						},
+						{
+							Name: "space-2",
+							GUID: "space-guid-2",
+							Relationships: ccv3.Relationships{
+								ccv3.OrganizationRelationship: ccv3.Relationship{GUID: "org-guid-2"},
+							},
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the spaces and warnings", func() {
+				spaces, warnings, err := actor.GetSpacesByGUIDs([]string{"space-guid-1", "space-guid-2"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaces).To(Equal([]Space{
+					{Name: "space-1", GUID: "space-guid-1", OrganizationGUID: "org-guid-1"},
+					{Name: "space-2", GUID: "space-guid-2", OrganizationGUID: "org-guid-2"},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter: []string{"space-guid-1,space-guid-2"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				_, warnings, err := actor.GetSpacesByGUIDs([]string{"space-guid-1"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
 })
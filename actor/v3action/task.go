@@ -71,6 +71,27 @@ func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]T
 	return allTasks, actorWarnings, nil
 }
 
+// GetRunningTasksCountByOrganization returns the number of tasks running in
+// all spaces of the organization, which count against the app task limit of
+// its quota.
+func (actor Actor) GetRunningTasksCountByOrganization(orgGUID string) (int, Warnings, error) {
+	tasks, warnings, err := actor.CloudControllerClient.GetTasks(url.Values{
+		ccv3.OrganizationGUIDFilter: []string{orgGUID},
+		ccv3.StateFilter:            []string{"RUNNING"},
+	})
+	return len(tasks), Warnings(warnings), err
+}
+
+// GetRunningTasksCountBySpace returns the number of tasks running in the
+// space, which count against the app task limit of its quotas.
+func (actor Actor) GetRunningTasksCountBySpace(spaceGUID string) (int, Warnings, error) {
+	tasks, warnings, err := actor.CloudControllerClient.GetTasks(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+		ccv3.StateFilter:     []string{"RUNNING"},
+	})
+	return len(tasks), Warnings(warnings), err
+}
+
 func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (Task, Warnings, error) {
 	query := url.Values{
 		"sequence_ids": []string{strconv.Itoa(sequenceID)},
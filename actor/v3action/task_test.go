@@ -202,6 +202,78 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetRunningTasksCountByOrganization", func() {
+		Context("when getting the tasks succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTasksReturns(
+					[]ccv3.Task{{GUID: "task-1-guid"}, {GUID: "task-2-guid"}, {GUID: "task-3-guid"}},
+					ccv3.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the number of running tasks in the organization", func() {
+				count, warnings, err := actor.GetRunningTasksCountByOrganization("some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(3))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetTasksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetTasksArgsForCall(0)).To(Equal(url.Values{
+					ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+					ccv3.StateFilter:            []string{"RUNNING"},
+				}))
+			})
+		})
+
+		Context("when getting the tasks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTasksReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("tasks-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetRunningTasksCountByOrganization("some-org-guid")
+				Expect(err).To(MatchError("tasks-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetRunningTasksCountBySpace", func() {
+		Context("when getting the tasks succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTasksReturns(
+					[]ccv3.Task{{GUID: "task-1-guid"}, {GUID: "task-2-guid"}},
+					ccv3.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the number of running tasks in the space", func() {
+				count, warnings, err := actor.GetRunningTasksCountBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(2))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetTasksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetTasksArgsForCall(0)).To(Equal(url.Values{
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+					ccv3.StateFilter:     []string{"RUNNING"},
+				}))
+			})
+		})
+
+		Context("when getting the tasks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTasksReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("tasks-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetRunningTasksCountBySpace("some-space-guid")
+				Expect(err).To(MatchError("tasks-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetTaskBySequenceIDAndApplication", func() {
 		Context("when the cloud controller client does not return an error", func() {
 			Context("when the task is found", func() {
@@ -2,6 +2,7 @@
 package v3actionfakes
 
 import (
+	"io"
 	"net/url"
 	"sync"
 
@@ -35,6 +36,22 @@ type FakeCloudControllerClient struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CopyDropletStub        func(dropletGUID string, appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	copyDropletMutex       sync.RWMutex
+	copyDropletArgsForCall []struct {
+		dropletGUID string
+		appGUID     string
+	}
+	copyDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	copyDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationStub        func(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -50,6 +67,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationProcessStub        func(appGUID string, processType string) (string, ccv3.Warnings, error)
+	createApplicationProcessMutex       sync.RWMutex
+	createApplicationProcessArgsForCall []struct {
+		appGUID     string
+		processType string
+	}
+	createApplicationProcessReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationProcessReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(appGUID string, process ccv3.Process) (ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -95,6 +128,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateDropletStub        func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	createDropletMutex       sync.RWMutex
+	createDropletArgsForCall []struct {
+		appGUID string
+	}
+	createDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateIsolationSegmentStub        func(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	createIsolationSegmentMutex       sync.RWMutex
 	createIsolationSegmentArgsForCall []struct {
@@ -155,6 +203,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteDropletStub        func(dropletGUID string) (string, ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	deleteDropletReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(guid string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -168,6 +231,38 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeletePackageStub        func(packageGUID string) (string, ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		packageGUID string
+	}
+	deletePackageReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	DownloadDropletStub        func(dropletGUID string) (io.ReadCloser, int64, ccv3.Warnings, error)
+	downloadDropletMutex       sync.RWMutex
+	downloadDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	downloadDropletReturns struct {
+		result1 io.ReadCloser
+		result2 int64
+		result3 ccv3.Warnings
+		result4 error
+	}
+	downloadDropletReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 int64
+		result3 ccv3.Warnings
+		result4 error
+	}
 	EntitleIsolationSegmentToOrganizationsStub        func(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
 	entitleIsolationSegmentToOrganizationsArgsForCall []struct {
@@ -184,6 +279,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationDropletCurrentStub        func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	getApplicationDropletCurrentMutex       sync.RWMutex
+	getApplicationDropletCurrentArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationDropletCurrentReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationDropletCurrentReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationDropletsStub        func(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
 	getApplicationDropletsMutex       sync.RWMutex
 	getApplicationDropletsArgsForCall []struct {
@@ -307,6 +417,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDropletSizeStub        func(dropletGUID string) (int64, ccv3.Warnings, error)
+	getDropletSizeMutex       sync.RWMutex
+	getDropletSizeArgsForCall []struct {
+		dropletGUID string
+	}
+	getDropletSizeReturns struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDropletSizeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetIsolationSegmentStub        func(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	getIsolationSegmentMutex       sync.RWMutex
 	getIsolationSegmentArgsForCall []struct {
@@ -412,6 +537,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetPackageSizeStub        func(packageGUID string) (int64, ccv3.Warnings, error)
+	getPackageSizeMutex       sync.RWMutex
+	getPackageSizeArgsForCall []struct {
+		packageGUID string
+	}
+	getPackageSizeReturns struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getPackageSizeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetProcessInstancesStub        func(processGUID string) ([]ccv3.Instance, ccv3.Warnings, error)
 	getProcessInstancesMutex       sync.RWMutex
 	getProcessInstancesArgsForCall []struct {
@@ -442,6 +582,50 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetSpacesStub        func(query url.Values) ([]ccv3.Space, ccv3.Warnings, error)
+	getSpacesMutex       sync.RWMutex
+	getSpacesArgsForCall []struct {
+		query url.Values
+	}
+	getSpacesReturns struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpacesReturnsOnCall map[int]struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetTasksStub        func(query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
+	getTasksMutex       sync.RWMutex
+	getTasksArgsForCall []struct {
+		query url.Values
+	}
+	getTasksReturns struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getTasksReturnsOnCall map[int]struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	PatchApplicationProcessCommandStub        func(processGUID string, command string) (ccv3.Warnings, error)
+	patchApplicationProcessCommandMutex       sync.RWMutex
+	patchApplicationProcessCommandArgsForCall []struct {
+		processGUID string
+		command     string
+	}
+	patchApplicationProcessCommandReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	patchApplicationProcessCommandReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	PatchApplicationProcessHealthCheckStub        func(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (ccv3.Warnings, error)
 	patchApplicationProcessHealthCheckMutex       sync.RWMutex
 	patchApplicationProcessHealthCheckArgsForCall []struct {
@@ -588,6 +772,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UploadDropletBitsStub        func(dropletGUID string, bits io.Reader, bitsLength int64) (string, ccv3.Warnings, error)
+	uploadDropletBitsMutex       sync.RWMutex
+	uploadDropletBitsArgsForCall []struct {
+		dropletGUID string
+		bits        io.Reader
+		bitsLength  int64
+	}
+	uploadDropletBitsReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	uploadDropletBitsReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UploadPackageStub        func(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 	uploadPackageMutex       sync.RWMutex
 	uploadPackageArgsForCall []struct {
@@ -703,6 +904,61 @@ func (fake *FakeCloudControllerClient) CloudControllerAPIVersionReturnsOnCall(i
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) CopyDroplet(dropletGUID string, appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.copyDropletMutex.Lock()
+	ret, specificReturn := fake.copyDropletReturnsOnCall[len(fake.copyDropletArgsForCall)]
+	fake.copyDropletArgsForCall = append(fake.copyDropletArgsForCall, struct {
+		dropletGUID string
+		appGUID     string
+	}{dropletGUID, appGUID})
+	fake.recordInvocation("CopyDroplet", []interface{}{dropletGUID, appGUID})
+	fake.copyDropletMutex.Unlock()
+	if fake.CopyDropletStub != nil {
+		return fake.CopyDropletStub(dropletGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.copyDropletReturns.result1, fake.copyDropletReturns.result2, fake.copyDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletCallCount() int {
+	fake.copyDropletMutex.RLock()
+	defer fake.copyDropletMutex.RUnlock()
+	return len(fake.copyDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletArgsForCall(i int) (string, string) {
+	fake.copyDropletMutex.RLock()
+	defer fake.copyDropletMutex.RUnlock()
+	return fake.copyDropletArgsForCall[i].dropletGUID, fake.copyDropletArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CopyDropletStub = nil
+	fake.copyDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CopyDropletStub = nil
+	if fake.copyDropletReturnsOnCall == nil {
+		fake.copyDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.copyDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.createApplicationMutex.Lock()
 	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
@@ -757,6 +1013,61 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationProcess(appGUID string, processType string) (string, ccv3.Warnings, error) {
+	fake.createApplicationProcessMutex.Lock()
+	ret, specificReturn := fake.createApplicationProcessReturnsOnCall[len(fake.createApplicationProcessArgsForCall)]
+	fake.createApplicationProcessArgsForCall = append(fake.createApplicationProcessArgsForCall, struct {
+		appGUID     string
+		processType string
+	}{appGUID, processType})
+	fake.recordInvocation("CreateApplicationProcess", []interface{}{appGUID, processType})
+	fake.createApplicationProcessMutex.Unlock()
+	if fake.CreateApplicationProcessStub != nil {
+		return fake.CreateApplicationProcessStub(appGUID, processType)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationProcessReturns.result1, fake.createApplicationProcessReturns.result2, fake.createApplicationProcessReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationProcessCallCount() int {
+	fake.createApplicationProcessMutex.RLock()
+	defer fake.createApplicationProcessMutex.RUnlock()
+	return len(fake.createApplicationProcessArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationProcessArgsForCall(i int) (string, string) {
+	fake.createApplicationProcessMutex.RLock()
+	defer fake.createApplicationProcessMutex.RUnlock()
+	return fake.createApplicationProcessArgsForCall[i].appGUID, fake.createApplicationProcessArgsForCall[i].processType
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationProcessReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationProcessStub = nil
+	fake.createApplicationProcessReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationProcessReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationProcessStub = nil
+	if fake.createApplicationProcessReturnsOnCall == nil {
+		fake.createApplicationProcessReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationProcessReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -918,6 +1229,60 @@ func (fake *FakeCloudControllerClient) CreateBuildReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.createDropletMutex.Lock()
+	ret, specificReturn := fake.createDropletReturnsOnCall[len(fake.createDropletArgsForCall)]
+	fake.createDropletArgsForCall = append(fake.createDropletArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("CreateDroplet", []interface{}{appGUID})
+	fake.createDropletMutex.Unlock()
+	if fake.CreateDropletStub != nil {
+		return fake.CreateDropletStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createDropletReturns.result1, fake.createDropletReturns.result2, fake.createDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateDropletCallCount() int {
+	fake.createDropletMutex.RLock()
+	defer fake.createDropletMutex.RUnlock()
+	return len(fake.createDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateDropletArgsForCall(i int) string {
+	fake.createDropletMutex.RLock()
+	defer fake.createDropletMutex.RUnlock()
+	return fake.createDropletArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CreateDropletStub = nil
+	fake.createDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateDropletReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CreateDropletStub = nil
+	if fake.createDropletReturnsOnCall == nil {
+		fake.createDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateIsolationSegment(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.createIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.createIsolationSegmentReturnsOnCall[len(fake.createIsolationSegmentArgsForCall)]
@@ -1133,6 +1498,60 @@ func (fake *FakeCloudControllerClient) DeleteApplicationProcessInstanceReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(dropletGUID string) (string, ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("DeleteDroplet", []interface{}{dropletGUID})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteDropletReturns.result1, fake.deleteDropletReturns.result2, fake.deleteDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return fake.deleteDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(guid string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -1184,6 +1603,117 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(packageGUID string) (string, ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("DeletePackage", []interface{}{packageGUID})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(packageGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2, fake.deletePackageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].packageGUID
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DownloadDroplet(dropletGUID string) (io.ReadCloser, int64, ccv3.Warnings, error) {
+	fake.downloadDropletMutex.Lock()
+	ret, specificReturn := fake.downloadDropletReturnsOnCall[len(fake.downloadDropletArgsForCall)]
+	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("DownloadDroplet", []interface{}{dropletGUID})
+	fake.downloadDropletMutex.Unlock()
+	if fake.DownloadDropletStub != nil {
+		return fake.DownloadDropletStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.downloadDropletReturns.result1, fake.downloadDropletReturns.result2, fake.downloadDropletReturns.result3, fake.downloadDropletReturns.result4
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletCallCount() int {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return len(fake.downloadDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletArgsForCall(i int) string {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return fake.downloadDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletReturns(result1 io.ReadCloser, result2 int64, result3 ccv3.Warnings, result4 error) {
+	fake.DownloadDropletStub = nil
+	fake.downloadDropletReturns = struct {
+		result1 io.ReadCloser
+		result2 int64
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletReturnsOnCall(i int, result1 io.ReadCloser, result2 int64, result3 ccv3.Warnings, result4 error) {
+	fake.DownloadDropletStub = nil
+	if fake.downloadDropletReturnsOnCall == nil {
+		fake.downloadDropletReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 int64
+			result3 ccv3.Warnings
+			result4 error
+		})
+	}
+	fake.downloadDropletReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 int64
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
 	var orgGUIDsCopy []string
 	if orgGUIDs != nil {
@@ -1244,6 +1774,60 @@ func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizationsRet
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrent(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getApplicationDropletCurrentMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletCurrentReturnsOnCall[len(fake.getApplicationDropletCurrentArgsForCall)]
+	fake.getApplicationDropletCurrentArgsForCall = append(fake.getApplicationDropletCurrentArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationDropletCurrent", []interface{}{appGUID})
+	fake.getApplicationDropletCurrentMutex.Unlock()
+	if fake.GetApplicationDropletCurrentStub != nil {
+		return fake.GetApplicationDropletCurrentStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationDropletCurrentReturns.result1, fake.getApplicationDropletCurrentReturns.result2, fake.getApplicationDropletCurrentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentCallCount() int {
+	fake.getApplicationDropletCurrentMutex.RLock()
+	defer fake.getApplicationDropletCurrentMutex.RUnlock()
+	return len(fake.getApplicationDropletCurrentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentArgsForCall(i int) string {
+	fake.getApplicationDropletCurrentMutex.RLock()
+	defer fake.getApplicationDropletCurrentMutex.RUnlock()
+	return fake.getApplicationDropletCurrentArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDropletCurrentStub = nil
+	fake.getApplicationDropletCurrentReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDropletCurrentStub = nil
+	if fake.getApplicationDropletCurrentReturnsOnCall == nil {
+		fake.getApplicationDropletCurrentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationDropletCurrentReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getApplicationDropletsMutex.Lock()
 	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
@@ -1679,6 +2263,60 @@ func (fake *FakeCloudControllerClient) GetDropletReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDropletSize(dropletGUID string) (int64, ccv3.Warnings, error) {
+	fake.getDropletSizeMutex.Lock()
+	ret, specificReturn := fake.getDropletSizeReturnsOnCall[len(fake.getDropletSizeArgsForCall)]
+	fake.getDropletSizeArgsForCall = append(fake.getDropletSizeArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("GetDropletSize", []interface{}{dropletGUID})
+	fake.getDropletSizeMutex.Unlock()
+	if fake.GetDropletSizeStub != nil {
+		return fake.GetDropletSizeStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDropletSizeReturns.result1, fake.getDropletSizeReturns.result2, fake.getDropletSizeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDropletSizeCallCount() int {
+	fake.getDropletSizeMutex.RLock()
+	defer fake.getDropletSizeMutex.RUnlock()
+	return len(fake.getDropletSizeArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDropletSizeArgsForCall(i int) string {
+	fake.getDropletSizeMutex.RLock()
+	defer fake.getDropletSizeMutex.RUnlock()
+	return fake.getDropletSizeArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) GetDropletSizeReturns(result1 int64, result2 ccv3.Warnings, result3 error) {
+	fake.GetDropletSizeStub = nil
+	fake.getDropletSizeReturns = struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDropletSizeReturnsOnCall(i int, result1 int64, result2 ccv3.Warnings, result3 error) {
+	fake.GetDropletSizeStub = nil
+	if fake.getDropletSizeReturnsOnCall == nil {
+		fake.getDropletSizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDropletSizeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.getIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentArgsForCall)]
@@ -2057,6 +2695,60 @@ func (fake *FakeCloudControllerClient) GetPackageReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetPackageSize(packageGUID string) (int64, ccv3.Warnings, error) {
+	fake.getPackageSizeMutex.Lock()
+	ret, specificReturn := fake.getPackageSizeReturnsOnCall[len(fake.getPackageSizeArgsForCall)]
+	fake.getPackageSizeArgsForCall = append(fake.getPackageSizeArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("GetPackageSize", []interface{}{packageGUID})
+	fake.getPackageSizeMutex.Unlock()
+	if fake.GetPackageSizeStub != nil {
+		return fake.GetPackageSizeStub(packageGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getPackageSizeReturns.result1, fake.getPackageSizeReturns.result2, fake.getPackageSizeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetPackageSizeCallCount() int {
+	fake.getPackageSizeMutex.RLock()
+	defer fake.getPackageSizeMutex.RUnlock()
+	return len(fake.getPackageSizeArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetPackageSizeArgsForCall(i int) string {
+	fake.getPackageSizeMutex.RLock()
+	defer fake.getPackageSizeMutex.RUnlock()
+	return fake.getPackageSizeArgsForCall[i].packageGUID
+}
+
+func (fake *FakeCloudControllerClient) GetPackageSizeReturns(result1 int64, result2 ccv3.Warnings, result3 error) {
+	fake.GetPackageSizeStub = nil
+	fake.getPackageSizeReturns = struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPackageSizeReturnsOnCall(i int, result1 int64, result2 ccv3.Warnings, result3 error) {
+	fake.GetPackageSizeStub = nil
+	if fake.getPackageSizeReturnsOnCall == nil {
+		fake.getPackageSizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getPackageSizeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetProcessInstances(processGUID string) ([]ccv3.Instance, ccv3.Warnings, error) {
 	fake.getProcessInstancesMutex.Lock()
 	ret, specificReturn := fake.getProcessInstancesReturnsOnCall[len(fake.getProcessInstancesArgsForCall)]
@@ -2165,6 +2857,166 @@ func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaces(query url.Values) ([]ccv3.Space, ccv3.Warnings, error) {
+	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
+	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetSpaces", []interface{}{query})
+	fake.getSpacesMutex.Unlock()
+	if fake.GetSpacesStub != nil {
+		return fake.GetSpacesStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2, fake.getSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesCallCount() int {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return len(fake.getSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesArgsForCall(i int) url.Values {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return fake.getSpacesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturns(result1 []ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	fake.getSpacesReturns = struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Space
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetTasks(query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
+	fake.getTasksMutex.Lock()
+	ret, specificReturn := fake.getTasksReturnsOnCall[len(fake.getTasksArgsForCall)]
+	fake.getTasksArgsForCall = append(fake.getTasksArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetTasks", []interface{}{query})
+	fake.getTasksMutex.Unlock()
+	if fake.GetTasksStub != nil {
+		return fake.GetTasksStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getTasksReturns.result1, fake.getTasksReturns.result2, fake.getTasksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetTasksCallCount() int {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return len(fake.getTasksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetTasksArgsForCall(i int) url.Values {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return fake.getTasksArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetTasksReturns(result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTasksStub = nil
+	fake.getTasksReturns = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetTasksReturnsOnCall(i int, result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTasksStub = nil
+	if fake.getTasksReturnsOnCall == nil {
+		fake.getTasksReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Task
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getTasksReturnsOnCall[i] = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommand(processGUID string, command string) (ccv3.Warnings, error) {
+	fake.patchApplicationProcessCommandMutex.Lock()
+	ret, specificReturn := fake.patchApplicationProcessCommandReturnsOnCall[len(fake.patchApplicationProcessCommandArgsForCall)]
+	fake.patchApplicationProcessCommandArgsForCall = append(fake.patchApplicationProcessCommandArgsForCall, struct {
+		processGUID string
+		command     string
+	}{processGUID, command})
+	fake.recordInvocation("PatchApplicationProcessCommand", []interface{}{processGUID, command})
+	fake.patchApplicationProcessCommandMutex.Unlock()
+	if fake.PatchApplicationProcessCommandStub != nil {
+		return fake.PatchApplicationProcessCommandStub(processGUID, command)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.patchApplicationProcessCommandReturns.result1, fake.patchApplicationProcessCommandReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandCallCount() int {
+	fake.patchApplicationProcessCommandMutex.RLock()
+	defer fake.patchApplicationProcessCommandMutex.RUnlock()
+	return len(fake.patchApplicationProcessCommandArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandArgsForCall(i int) (string, string) {
+	fake.patchApplicationProcessCommandMutex.RLock()
+	defer fake.patchApplicationProcessCommandMutex.RUnlock()
+	return fake.patchApplicationProcessCommandArgsForCall[i].processGUID, fake.patchApplicationProcessCommandArgsForCall[i].command
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandReturns(result1 ccv3.Warnings, result2 error) {
+	fake.PatchApplicationProcessCommandStub = nil
+	fake.patchApplicationProcessCommandReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PatchApplicationProcessCommandReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.PatchApplicationProcessCommandStub = nil
+	if fake.patchApplicationProcessCommandReturnsOnCall == nil {
+		fake.patchApplicationProcessCommandReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.patchApplicationProcessCommandReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (ccv3.Warnings, error) {
 	fake.patchApplicationProcessHealthCheckMutex.Lock()
 	ret, specificReturn := fake.patchApplicationProcessHealthCheckReturnsOnCall[len(fake.patchApplicationProcessHealthCheckArgsForCall)]
@@ -2696,6 +3548,62 @@ func (fake *FakeCloudControllerClient) UpdateTaskReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UploadDropletBits(dropletGUID string, bits io.Reader, bitsLength int64) (string, ccv3.Warnings, error) {
+	fake.uploadDropletBitsMutex.Lock()
+	ret, specificReturn := fake.uploadDropletBitsReturnsOnCall[len(fake.uploadDropletBitsArgsForCall)]
+	fake.uploadDropletBitsArgsForCall = append(fake.uploadDropletBitsArgsForCall, struct {
+		dropletGUID string
+		bits        io.Reader
+		bitsLength  int64
+	}{dropletGUID, bits, bitsLength})
+	fake.recordInvocation("UploadDropletBits", []interface{}{dropletGUID, bits, bitsLength})
+	fake.uploadDropletBitsMutex.Unlock()
+	if fake.UploadDropletBitsStub != nil {
+		return fake.UploadDropletBitsStub(dropletGUID, bits, bitsLength)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.uploadDropletBitsReturns.result1, fake.uploadDropletBitsReturns.result2, fake.uploadDropletBitsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsCallCount() int {
+	fake.uploadDropletBitsMutex.RLock()
+	defer fake.uploadDropletBitsMutex.RUnlock()
+	return len(fake.uploadDropletBitsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsArgsForCall(i int) (string, io.Reader, int64) {
+	fake.uploadDropletBitsMutex.RLock()
+	defer fake.uploadDropletBitsMutex.RUnlock()
+	return fake.uploadDropletBitsArgsForCall[i].dropletGUID, fake.uploadDropletBitsArgsForCall[i].bits, fake.uploadDropletBitsArgsForCall[i].bitsLength
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.UploadDropletBitsStub = nil
+	fake.uploadDropletBitsReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.UploadDropletBitsStub = nil
+	if fake.uploadDropletBitsReturnsOnCall == nil {
+		fake.uploadDropletBitsReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.uploadDropletBitsReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error) {
 	fake.uploadPackageMutex.Lock()
 	ret, specificReturn := fake.uploadPackageReturnsOnCall[len(fake.uploadPackageArgsForCall)]
@@ -2758,14 +3666,20 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.assignSpaceToIsolationSegmentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.copyDropletMutex.RLock()
+	defer fake.copyDropletMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationProcessMutex.RLock()
+	defer fake.createApplicationProcessMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
 	defer fake.createApplicationTaskMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createDropletMutex.RLock()
+	defer fake.createDropletMutex.RUnlock()
 	fake.createIsolationSegmentMutex.RLock()
 	defer fake.createIsolationSegmentMutex.RUnlock()
 	fake.createPackageMutex.RLock()
@@ -2774,10 +3688,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
 	defer fake.deleteApplicationProcessInstanceMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
+	fake.getApplicationDropletCurrentMutex.RLock()
+	defer fake.getApplicationDropletCurrentMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
 	defer fake.getApplicationDropletsMutex.RUnlock()
 	fake.getApplicationEnvironmentVariablesMutex.RLock()
@@ -2794,6 +3716,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getBuildMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
+	fake.getDropletSizeMutex.RLock()
+	defer fake.getDropletSizeMutex.RUnlock()
 	fake.getIsolationSegmentMutex.RLock()
 	defer fake.getIsolationSegmentMutex.RUnlock()
 	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
@@ -2808,10 +3732,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPackagesMutex.RUnlock()
 	fake.getPackageMutex.RLock()
 	defer fake.getPackageMutex.RUnlock()
+	fake.getPackageSizeMutex.RLock()
+	defer fake.getPackageSizeMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	fake.patchApplicationProcessCommandMutex.RLock()
+	defer fake.patchApplicationProcessCommandMutex.RUnlock()
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
 	fake.patchOrganizationDefaultIsolationSegmentMutex.RLock()
@@ -2832,6 +3764,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.patchApplicationUserProvidedEnvironmentVariablesMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
+	fake.uploadDropletBitsMutex.RLock()
+	defer fake.uploadDropletBitsMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
 	defer fake.uploadPackageMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
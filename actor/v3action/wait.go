@@ -0,0 +1,91 @@
+package v3action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// TaskFailedError is returned when waiting for a task that has failed.
+type TaskFailedError struct {
+	SequenceID int
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %d failed", e.SequenceID)
+}
+
+// PollApplicationInstancesRunning polls the web process of the application
+// until at least the given number of its instances are running. When
+// instances is zero, all of the instances of the process must be running. A
+// WaitTimeoutError is returned if this has not happened before the timeout.
+func (actor Actor) PollApplicationInstancesRunning(appGUID string, instances int, timeout time.Duration) (Warnings, error) {
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, constant.ProcessTypeWeb)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return allWarnings, ProcessNotFoundError{ProcessType: constant.ProcessTypeWeb}
+		}
+		return allWarnings, err
+	}
+
+	if instances == 0 {
+		instances = process.Instances.Value
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		processInstances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		running := 0
+		for _, instance := range processInstances {
+			if instance.State == "RUNNING" {
+				running++
+			}
+		}
+
+		if running >= instances {
+			return allWarnings, nil
+		}
+
+		if !time.Now().Before(deadline) {
+			return allWarnings, actionerror.WaitTimeoutError{Timeout: timeout}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
+// PollTaskDone polls the task with the given sequence ID until it has either
+// succeeded or failed. A TaskFailedError is returned along with the task if it
+// failed, and a WaitTimeoutError if it is still running at the timeout.
+func (actor Actor) PollTaskDone(appGUID string, sequenceID int, timeout time.Duration) (Task, Warnings, error) {
+	var allWarnings Warnings
+
+	deadline := time.Now().Add(timeout)
+	for {
+		task, warnings, err := actor.GetTaskBySequenceIDAndApplication(sequenceID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+
+		switch task.State {
+		case "SUCCEEDED":
+			return task, allWarnings, nil
+		case "FAILED":
+			return task, allWarnings, TaskFailedError{SequenceID: sequenceID}
+		}
+
+		if !time.Now().Before(deadline) {
+			return task, allWarnings, actionerror.WaitTimeoutError{Timeout: timeout}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
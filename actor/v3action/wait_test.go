@@ -0,0 +1,204 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Wait Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.PollingIntervalReturns(0)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+	})
+
+	Describe("PollApplicationInstancesRunning", func() {
+		var (
+			instances int
+			timeout   time.Duration
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			instances = 0
+			timeout = time.Second
+
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+				ccv3.Process{GUID: "some-process-guid", Instances: types.NullInt{Value: 2, IsSet: true}},
+				ccv3.Warnings{"get-process-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+				[]ccv3.Instance{{State: "STARTING"}, {State: "STARTING"}},
+				ccv3.Warnings{"get-instances-warning-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+				[]ccv3.Instance{{State: "RUNNING"}, {State: "STARTING"}},
+				ccv3.Warnings{"get-instances-warning-2"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.Instance{{State: "RUNNING"}, {State: "RUNNING"}},
+				ccv3.Warnings{"get-instances-warning-3"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.PollApplicationInstancesRunning("some-app-guid", instances, timeout)
+		})
+
+		It("polls until all instances of the web process are running", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning-1", "get-instances-warning-2", "get-instances-warning-3"))
+
+			appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+		})
+
+		Context("when a number of instances is given", func() {
+			BeforeEach(func() {
+				instances = 1
+			})
+
+			It("polls until that many instances are running", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the instances are not running before the timeout", func() {
+			BeforeEach(func() {
+				timeout = 0
+			})
+
+			It("returns a WaitTimeoutError", func() {
+				Expect(err).To(MatchError(actionerror.WaitTimeoutError{Timeout: 0}))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the app has no web process", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{}, ccv3.Warnings{"get-process-warning"}, ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError", func() {
+				Expect(err).To(MatchError(ProcessNotFoundError{ProcessType: "web"}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+			})
+		})
+
+		Context("when getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-instances-error")
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, nil, ccv3.Warnings{"get-instances-warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning-1"))
+			})
+		})
+	})
+
+	Describe("PollTaskDone", func() {
+		var (
+			timeout  time.Duration
+			task     Task
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			timeout = time.Second
+
+			fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(0,
+				[]ccv3.Task{{GUID: "some-task-guid", SequenceID: 3, State: "RUNNING"}},
+				ccv3.Warnings{"get-tasks-warning-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationTasksReturns(
+				[]ccv3.Task{{GUID: "some-task-guid", SequenceID: 3, State: "SUCCEEDED"}},
+				ccv3.Warnings{"get-tasks-warning-2"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, err = actor.PollTaskDone("some-app-guid", 3, timeout)
+		})
+
+		It("polls until the task has succeeded", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(task).To(Equal(Task{GUID: "some-task-guid", SequenceID: 3, State: "SUCCEEDED"}))
+			Expect(warnings).To(ConsistOf("get-tasks-warning-1", "get-tasks-warning-2"))
+
+			Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(2))
+			appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(query.Get("sequence_ids")).To(Equal("3"))
+		})
+
+		Context("when the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{GUID: "some-task-guid", SequenceID: 3, State: "FAILED"}},
+					ccv3.Warnings{"get-tasks-warning-2"},
+					nil,
+				)
+			})
+
+			It("returns a TaskFailedError", func() {
+				Expect(err).To(MatchError(TaskFailedError{SequenceID: 3}))
+				Expect(task.State).To(Equal("FAILED"))
+			})
+		})
+
+		Context("when the task is still running at the timeout", func() {
+			BeforeEach(func() {
+				timeout = 0
+			})
+
+			It("returns a WaitTimeoutError", func() {
+				Expect(err).To(MatchError(actionerror.WaitTimeoutError{Timeout: 0}))
+				Expect(task.State).To(Equal("RUNNING"))
+			})
+		})
+
+		Context("when the task does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(0, nil, ccv3.Warnings{"get-tasks-warning-1"}, nil)
+			})
+
+			It("returns a TaskNotFoundError", func() {
+				Expect(err).To(MatchError(TaskNotFoundError{SequenceID: 3}))
+				Expect(warnings).To(ConsistOf("get-tasks-warning-1"))
+			})
+		})
+	})
+})
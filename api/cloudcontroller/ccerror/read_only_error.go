@@ -0,0 +1,14 @@
+package ccerror
+
+import "fmt"
+
+// ReadOnlyError is returned when a request that could modify resources is
+// made while the CLI is in read-only mode. The request is not sent.
+type ReadOnlyError struct {
+	Method string
+	URL    string
+}
+
+func (e ReadOnlyError) Error() string {
+	return fmt.Sprintf("read-only mode: %s %s was not sent", e.Method, e.URL)
+}
@@ -0,0 +1,30 @@
+package constant
+
+// OrganizationUserRole is an enumeration of the roles a user can have in an
+// organization. The value is the path of the role's endpoints.
+type OrganizationUserRole string
+
+const (
+	// OrgUserRole is membership of the organization, which is required
+	// before a user can be given a space role.
+	OrgUserRole OrganizationUserRole = "users"
+	// OrgManagerRole represents the Org Manager role.
+	OrgManagerRole OrganizationUserRole = "managers"
+	// OrgBillingManagerRole represents the Billing Manager role.
+	OrgBillingManagerRole OrganizationUserRole = "billing_managers"
+	// OrgAuditorRole represents the Org Auditor role.
+	OrgAuditorRole OrganizationUserRole = "auditors"
+)
+
+// SpaceUserRole is an enumeration of the roles a user can have in a space.
+// The value is the path of the role's endpoints.
+type SpaceUserRole string
+
+const (
+	// SpaceManagerRole represents the Space Manager role.
+	SpaceManagerRole SpaceUserRole = "managers"
+	// SpaceDeveloperRole represents the Space Developer role.
+	SpaceDeveloperRole SpaceUserRole = "developers"
+	// SpaceAuditorRole represents the Space Auditor role.
+	SpaceAuditorRole SpaceUserRole = "auditors"
+)
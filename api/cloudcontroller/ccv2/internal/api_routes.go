@@ -15,59 +15,77 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
-	DeleteOrganizationRequest              = "DeleteOrganization"
-	DeleteRouteRequest                     = "DeleteRoute"
-	DeleteRunningSecurityGroupSpaceRequest = "DeleteRunningSecurityGroupSpace"
-	DeleteSecurityGroupSpaceRequest        = "DeleteSecurityGroupSpace"
-	DeleteServiceBindingRequest            = "DeleteServiceBinding"
-	DeleteSpaceRequest                     = "DeleteSpaceRequest"
-	DeleteStagingSecurityGroupSpaceRequest = "DeleteStagingSecurityGroupSpace"
-	GetAppInstancesRequest                 = "GetAppInstances"
-	GetAppRequest                          = "GetApp"
-	GetAppRoutesRequest                    = "GetAppRoutes"
-	GetAppsRequest                         = "GetApps"
-	GetAppStatsRequest                     = "GetAppStats"
-	GetInfoRequest                         = "GetInfo"
-	GetJobRequest                          = "GetJob"
-	GetOrganizationPrivateDomainsRequest   = "GetOrganizationPrivateDomains"
-	GetOrganizationQuotaDefinitionRequest  = "GetOrganizationQuotaDefinition"
-	GetOrganizationRequest                 = "GetOrganization"
-	GetOrganizationsRequest                = "GetOrganizations"
-	GetPrivateDomainRequest                = "GetPrivateDomain"
-	GetRouteAppsRequest                    = "GetRouteApps"
-	GetRouteReservedRequest                = "GetRouteReserved"
-	GetRouteReservedDeprecatedRequest      = "GetRouteReservedDeprecated"
-	GetRouteRouteMappingsRequest           = "GetRouteRouteMappings"
-	GetRoutesRequest                       = "GetRoutes"
-	GetSecurityGroupRunningSpacesRequest   = "GetSecurityGroupRunningSpaces"
-	GetSecurityGroupsRequest               = "GetSecurityGroups"
-	GetSecurityGroupStagingSpacesRequest   = "GetSecurityGroupStagingSpaces"
-	GetServiceBindingsRequest              = "GetServiceBindings"
-	GetServiceInstanceRequest              = "GetServiceInstance"
-	GetServiceInstancesRequest             = "GetServiceInstances"
-	GetSharedDomainRequest                 = "GetSharedDomain"
-	GetSharedDomainsRequest                = "GetSharedDomains"
-	GetSpaceQuotaDefinitionRequest         = "GetSpaceQuotaDefinition"
-	GetSpaceRoutesRequest                  = "GetSpaceRoutes"
-	GetSpaceRunningSecurityGroupsRequest   = "GetSpaceRunningSecurityGroups"
-	GetSpaceServiceInstancesRequest        = "GetSpaceServiceInstances"
-	GetSpacesRequest                       = "GetSpaces"
-	GetSpaceStagingSecurityGroupsRequest   = "GetSpaceStagingSecurityGroups"
-	GetStackRequest                        = "GetStack"
-	GetStacksRequest                       = "GetStacks"
-	GetUsersRequest                        = "GetUsers"
-	PostAppRequest                         = "PostApp"
-	PostAppRestageRequest                  = "PostAppRestage"
-	PostRouteRequest                       = "PostRoute"
-	PostServiceBindingRequest              = "PostServiceBinding"
-	PostUserRequest                        = "PostUser"
-	PutAppBitsRequest                      = "PutAppBits"
-	PutAppRequest                          = "PutApp"
-	PutRouteAppRequest                     = "PutRouteApp"
-	DeleteRouteAppRequest                  = "DeleteRouteAppRequest"
-	PutResourceMatch                       = "PutResourceMatch"
-	PutRunningSecurityGroupSpaceRequest    = "PutRunningSecurityGroupSpace"
-	PutStagingSecurityGroupSpaceRequest    = "PutStagingSecurityGroupSpace"
+	DeleteOrganizationRequest               = "DeleteOrganization"
+	DeleteRouteRequest                      = "DeleteRoute"
+	DeleteRunningSecurityGroupSpaceRequest  = "DeleteRunningSecurityGroupSpace"
+	DeleteSecurityGroupSpaceRequest         = "DeleteSecurityGroupSpace"
+	DeleteServiceBindingRequest             = "DeleteServiceBinding"
+	DeleteSpaceRequest                      = "DeleteSpaceRequest"
+	DeleteStagingSecurityGroupSpaceRequest  = "DeleteStagingSecurityGroupSpace"
+	GetAppInstancesRequest                  = "GetAppInstances"
+	GetAppRequest                           = "GetApp"
+	GetAppRoutesRequest                     = "GetAppRoutes"
+	GetAppsRequest                          = "GetApps"
+	GetAppStatsRequest                      = "GetAppStats"
+	GetInfoRequest                          = "GetInfo"
+	GetJobRequest                           = "GetJob"
+	GetOrganizationPrivateDomainsRequest    = "GetOrganizationPrivateDomains"
+	GetOrganizationQuotaDefinitionRequest   = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest  = "GetOrganizationQuotaDefinitions"
+	GetOrganizationRequest                  = "GetOrganization"
+	GetOrganizationSpaceQuotasRequest       = "GetOrganizationSpaceQuotas"
+	GetOrganizationsRequest                 = "GetOrganizations"
+	GetOrganizationUsersByRoleRequest       = "GetOrganizationUsersByRole"
+	GetPrivateDomainRequest                 = "GetPrivateDomain"
+	GetRouteAppsRequest                     = "GetRouteApps"
+	GetRouteReservedRequest                 = "GetRouteReserved"
+	GetRouteReservedDeprecatedRequest       = "GetRouteReservedDeprecated"
+	GetRouteRouteMappingsRequest            = "GetRouteRouteMappings"
+	GetRoutesRequest                        = "GetRoutes"
+	GetSecurityGroupRunningSpacesRequest    = "GetSecurityGroupRunningSpaces"
+	GetSecurityGroupsRequest                = "GetSecurityGroups"
+	GetSecurityGroupStagingSpacesRequest    = "GetSecurityGroupStagingSpaces"
+	GetServiceBindingsRequest               = "GetServiceBindings"
+	GetServiceInstanceRequest               = "GetServiceInstance"
+	GetServiceInstancesRequest              = "GetServiceInstances"
+	GetSharedDomainRequest                  = "GetSharedDomain"
+	GetSharedDomainsRequest                 = "GetSharedDomains"
+	GetSpaceQuotaDefinitionRequest          = "GetSpaceQuotaDefinition"
+	GetSpaceRoutesRequest                   = "GetSpaceRoutes"
+	GetSpaceRunningSecurityGroupsRequest    = "GetSpaceRunningSecurityGroups"
+	GetSpaceServiceInstancesRequest         = "GetSpaceServiceInstances"
+	GetSpacesRequest                        = "GetSpaces"
+	GetSpaceStagingSecurityGroupsRequest    = "GetSpaceStagingSecurityGroups"
+	GetSpaceUsersByRoleRequest              = "GetSpaceUsersByRole"
+	GetStackRequest                         = "GetStack"
+	GetStacksRequest                        = "GetStacks"
+	GetUserProvidedServiceInstanceRequest   = "GetUserProvidedServiceInstance"
+	GetUsersRequest                         = "GetUsers"
+	PostAppRequest                          = "PostApp"
+	PostAppRestageRequest                   = "PostAppRestage"
+	PostOrganizationQuotaDefinitionRequest  = "PostOrganizationQuotaDefinition"
+	PostOrganizationRequest                 = "PostOrganization"
+	PostOrganizationUserByRoleRemoveRequest = "PostOrganizationUserByRoleRemove"
+	PostRouteRequest                        = "PostRoute"
+	PostServiceBindingRequest               = "PostServiceBinding"
+	PostSpaceQuotaDefinitionRequest         = "PostSpaceQuotaDefinition"
+	PostSpaceRequest                        = "PostSpace"
+	PostSpaceUserByRoleRemoveRequest        = "PostSpaceUserByRoleRemove"
+	PostUserProvidedServiceInstanceRequest  = "PostUserProvidedServiceInstance"
+	PostUserRequest                         = "PostUser"
+	PutAppBitsRequest                       = "PutAppBits"
+	PutAppRequest                           = "PutApp"
+	PutOrganizationQuotaDefinitionRequest   = "PutOrganizationQuotaDefinition"
+	PutOrganizationRequest                  = "PutOrganization"
+	PutOrganizationUserByRoleRequest        = "PutOrganizationUserByRole"
+	PutRouteAppRequest                      = "PutRouteApp"
+	PutSpaceQuotaDefinitionRequest          = "PutSpaceQuotaDefinition"
+	PutSpaceRequest                         = "PutSpace"
+	PutSpaceUserByRoleRequest               = "PutSpaceUserByRole"
+	DeleteRouteAppRequest                   = "DeleteRouteAppRequest"
+	PutResourceMatch                        = "PutResourceMatch"
+	PutRunningSecurityGroupSpaceRequest     = "PutRunningSecurityGroupSpace"
+	PutStagingSecurityGroupSpaceRequest     = "PutStagingSecurityGroupSpace"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -85,11 +103,20 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotasRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodGet, Name: GetOrganizationUsersByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodPut, Name: PutOrganizationUserByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role/remove", Method: http.MethodPost, Name: PostOrganizationUserByRoleRemoveRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodPost, Name: PostOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
+	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodPut, Name: PutOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatch},
 	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
 	{Path: "/v2/routes", Method: http.MethodPost, Name: PostRouteRequest},
@@ -114,14 +141,23 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
+	{Path: "/v2/space_quota_definitions", Method: http.MethodPost, Name: PostSpaceQuotaDefinitionRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodGet, Name: GetSpaceUsersByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodPut, Name: PutSpaceUserByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role/remove", Method: http.MethodPost, Name: PostSpaceUserByRoleRemoveRequest},
 	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
+	{Path: "/v2/user_provided_service_instances", Method: http.MethodPost, Name: PostUserProvidedServiceInstanceRequest},
+	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid", Method: http.MethodGet, Name: GetUserProvidedServiceInstanceRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
 }
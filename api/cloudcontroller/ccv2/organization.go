@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -37,6 +38,70 @@ func (org *Organization) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// organizationRequestBody represents the body of an organization create or
+// update request.
+type organizationRequestBody struct {
+	Name                string `json:"name,omitempty"`
+	QuotaDefinitionGUID string `json:"quota_definition_guid,omitempty"`
+}
+
+// CreateOrganization creates an organization with the provided name and,
+// if set, quota definition.
+func (client *Client) CreateOrganization(org Organization) (Organization, Warnings, error) {
+	body, err := json.Marshal(organizationRequestBody{
+		Name:                org.Name,
+		QuotaDefinitionGUID: org.QuotaDefinitionGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var createdOrg Organization
+	response := cloudcontroller.Response{
+		Result: &createdOrg,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdOrg, response.Warnings, err
+}
+
+// UpdateOrganization updates the name and quota definition of the
+// organization with the provided GUID. Empty fields are left unchanged.
+func (client *Client) UpdateOrganization(org Organization) (Organization, Warnings, error) {
+	body, err := json.Marshal(organizationRequestBody{
+		Name:                org.Name,
+		QuotaDefinitionGUID: org.QuotaDefinitionGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		URIParams:   Params{"organization_guid": org.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var updatedOrg Organization
+	response := cloudcontroller.Response{
+		Result: &updatedOrg,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedOrg, response.Warnings, err
+}
+
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid.go.template delete_organization.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid_test.go.template delete_organization_test.go
 
@@ -1,16 +1,30 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
-// OrganizationQuota is the definition of a quota for an organization.
+// OrganizationQuota is the definition of a quota for an organization. Limits
+// of -1 are unlimited.
 type OrganizationQuota struct {
-	GUID string
-	Name string
+	GUID string `json:"-"`
+	Name string `json:"name"`
+
+	// MemoryLimit is the total memory in megabytes of all the apps.
+	MemoryLimit int `json:"memory_limit"`
+	// InstanceMemoryLimit is the memory in megabytes of a single app instance.
+	InstanceMemoryLimit     int  `json:"instance_memory_limit"`
+	AppInstanceLimit        int  `json:"app_instance_limit"`
+	AppTaskLimit            int  `json:"app_task_limit"`
+	TotalRoutes             int  `json:"total_routes"`
+	TotalReservedRoutePorts int  `json:"total_reserved_route_ports"`
+	TotalServices           int  `json:"total_services"`
+	NonBasicServicesAllowed bool `json:"non_basic_services_allowed"`
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
@@ -18,7 +32,15 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string `json:"name"`
+			MemoryLimit             int    `json:"memory_limit"`
+			InstanceMemoryLimit     int    `json:"instance_memory_limit"`
+			AppInstanceLimit        int    `json:"app_instance_limit"`
+			AppTaskLimit            int    `json:"app_task_limit"`
+			TotalRoutes             int    `json:"total_routes"`
+			TotalReservedRoutePorts int    `json:"total_reserved_route_ports"`
+			TotalServices           int    `json:"total_services"`
+			NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccOrgQuota); err != nil {
@@ -27,10 +49,42 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccOrgQuota.Metadata.GUID
 	application.Name = ccOrgQuota.Entity.Name
+	application.MemoryLimit = ccOrgQuota.Entity.MemoryLimit
+	application.InstanceMemoryLimit = ccOrgQuota.Entity.InstanceMemoryLimit
+	application.AppInstanceLimit = ccOrgQuota.Entity.AppInstanceLimit
+	application.AppTaskLimit = ccOrgQuota.Entity.AppTaskLimit
+	application.TotalRoutes = ccOrgQuota.Entity.TotalRoutes
+	application.TotalReservedRoutePorts = ccOrgQuota.Entity.TotalReservedRoutePorts
+	application.TotalServices = ccOrgQuota.Entity.TotalServices
+	application.NonBasicServicesAllowed = ccOrgQuota.Entity.NonBasicServicesAllowed
 
 	return nil
 }
 
+// CreateOrganizationQuota creates an organization quota (quota definition).
+func (client *Client) CreateOrganizationQuota(quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationQuotaDefinitionRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var createdQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		Result: &createdQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdQuota, response.Warnings, err
+}
+
 // GetOrganizaitonQuota gets an organization quota (quota definition) from the API.
 func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -49,3 +103,56 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// GetOrganizationQuotas returns a list of organization quotas (quota
+// definitions) based off of the provided queries.
+func (client *Client) GetOrganizationQuotas(queries ...Query) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotaDefinitionsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if quota, ok := item.(OrganizationQuota); ok {
+			fullQuotasList = append(fullQuotasList, quota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullQuotasList, warnings, err
+}
+
+// UpdateOrganizationQuota updates the name and limits of an organization
+// quota (quota definition).
+func (client *Client) UpdateOrganizationQuota(quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationQuotaDefinitionRequest,
+		URIParams:   Params{"organization_quota_guid": quota.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var updatedQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		Result: &updatedQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedQuota, response.Warnings, err
+}
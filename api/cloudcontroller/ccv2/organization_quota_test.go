@@ -73,4 +73,131 @@ var _ = Describe("OrganizationQuota", func() {
 		})
 
 	})
+
+	Describe("GetOrganizationQuotas", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "default-guid"},
+						"entity": {
+							"name": "default",
+							"memory_limit": 10240,
+							"instance_memory_limit": -1,
+							"app_instance_limit": 25,
+							"app_task_limit": 5,
+							"total_routes": 1000,
+							"total_reserved_route_ports": 0,
+							"total_services": 100,
+							"non_basic_services_allowed": true
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:default"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the organization quotas and warnings", func() {
+			quotas, warnings, err := client.GetOrganizationQuotas(Query{
+				Filter:   NameFilter,
+				Operator: EqualOperator,
+				Values:   []string{"default"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(quotas).To(ConsistOf(OrganizationQuota{
+				GUID:                    "default-guid",
+				Name:                    "default",
+				MemoryLimit:             10240,
+				InstanceMemoryLimit:     -1,
+				AppInstanceLimit:        25,
+				AppTaskLimit:            5,
+				TotalRoutes:             1000,
+				TotalServices:           100,
+				NonBasicServicesAllowed: true,
+			}))
+		})
+	})
+
+	Describe("CreateOrganizationQuota", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "some-quota-guid"},
+				"entity": {"name": "some-quota", "memory_limit": 2048}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/quota_definitions"),
+					VerifyJSON(`{
+						"name": "some-quota",
+						"memory_limit": 2048,
+						"instance_memory_limit": -1,
+						"app_instance_limit": -1,
+						"app_task_limit": -1,
+						"total_routes": 10,
+						"total_reserved_route_ports": 0,
+						"total_services": 5,
+						"non_basic_services_allowed": false
+					}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the organization quota and returns warnings", func() {
+			quota, warnings, err := client.CreateOrganizationQuota(OrganizationQuota{
+				Name:                "some-quota",
+				MemoryLimit:         2048,
+				InstanceMemoryLimit: -1,
+				AppInstanceLimit:    -1,
+				AppTaskLimit:        -1,
+				TotalRoutes:         10,
+				TotalServices:       5,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(quota).To(Equal(OrganizationQuota{GUID: "some-quota-guid", Name: "some-quota", MemoryLimit: 2048}))
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 240001,
+					"description": "Quota Definition could not be found: some-quota-guid",
+					"error_code": "CF-QuotaDefinitionNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/quota_definitions/some-quota-guid"),
+						VerifyJSONRepresenting(map[string]interface{}{
+							"name":                       "some-quota",
+							"memory_limit":               1024,
+							"instance_memory_limit":      0,
+							"app_instance_limit":         0,
+							"app_task_limit":             0,
+							"total_routes":               0,
+							"total_reserved_route_ports": 0,
+							"total_services":             0,
+							"non_basic_services_allowed": false,
+						}),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateOrganizationQuota(OrganizationQuota{GUID: "some-quota-guid", Name: "some-quota", MemoryLimit: 1024})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Quota Definition could not be found: some-quota-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
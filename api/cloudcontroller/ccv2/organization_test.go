@@ -212,4 +212,50 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "some-org-guid"},
+				"entity": {"name": "some-org", "quota_definition_guid": "some-quota-guid"}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/organizations"),
+					VerifyJSON(`{"name": "some-org", "quota_definition_guid": "some-quota-guid"}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the organization and returns warnings", func() {
+			org, warnings, err := client.CreateOrganization(Organization{Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}))
+		})
+	})
+
+	Describe("UpdateOrganization", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "some-org-guid"},
+				"entity": {"name": "some-org", "quota_definition_guid": "other-quota-guid"}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+					VerifyJSON(`{"quota_definition_guid": "other-quota-guid"}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("updates only the provided fields and returns warnings", func() {
+			org, warnings, err := client.UpdateOrganization(Organization{GUID: "some-org-guid", QuotaDefinitionGUID: "other-quota-guid"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(org.QuotaDefinitionGUID).To(Equal("other-quota-guid"))
+		})
+	})
 })
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // SecurityGroupLifecycle represents the lifecycle phase of a security group
@@ -25,6 +26,12 @@ type SecurityGroupRule struct {
 	Destination string
 	Ports       string
 	Protocol    string
+	// Type and Code are the ICMP type and code of an icmp rule; -1 matches
+	// every type or code.
+	Type types.NullInt
+	Code types.NullInt
+	// Log is true when the packets allowed by the rule are logged.
+	Log bool
 }
 
 type SecurityGroup struct {
@@ -43,10 +50,13 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 			GUID  string `json:"guid"`
 			Name  string `json:"name"`
 			Rules []struct {
-				Description string `json:"description"`
-				Destination string `json:"destination"`
-				Ports       string `json:"ports"`
-				Protocol    string `json:"protocol"`
+				Description string        `json:"description"`
+				Destination string        `json:"destination"`
+				Ports       string        `json:"ports"`
+				Protocol    string        `json:"protocol"`
+				Type        types.NullInt `json:"type"`
+				Code        types.NullInt `json:"code"`
+				Log         bool          `json:"log"`
 			} `json:"rules"`
 			RunningDefault bool `json:"running_default"`
 			StagingDefault bool `json:"staging_default"`
@@ -65,6 +75,9 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 		securityGroup.Rules[i].Destination = ccRule.Destination
 		securityGroup.Rules[i].Ports = ccRule.Ports
 		securityGroup.Rules[i].Protocol = ccRule.Protocol
+		securityGroup.Rules[i].Type = ccRule.Type
+		securityGroup.Rules[i].Code = ccRule.Code
+		securityGroup.Rules[i].Log = ccRule.Log
 	}
 	securityGroup.RunningDefault = ccSecurityGroup.Entity.RunningDefault
 	securityGroup.StagingDefault = ccSecurityGroup.Entity.StagingDefault
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -304,6 +305,13 @@ var _ = Describe("Security Groups", func() {
 										"ports": "8008,4443",
 										"description": "description-6",
 										"destination": "254.41.191.0-254.44.255.1"
+									},
+									{
+										"protocol": "icmp",
+										"type": 8,
+										"code": -1,
+										"log": true,
+										"destination": "10.0.0.0/8"
 									}
 								]
 							}
@@ -385,6 +393,13 @@ var _ = Describe("Security Groups", func() {
 								Description: "description-6",
 								Destination: "254.41.191.0-254.44.255.1",
 							},
+							{
+								Protocol:    "icmp",
+								Type:        types.NullInt{Value: 8, IsSet: true},
+								Code:        types.NullInt{Value: -1, IsSet: true},
+								Log:         true,
+								Destination: "10.0.0.0/8",
+							},
 						},
 					},
 				))
@@ -19,12 +19,37 @@ const (
 	ManagedService ServiceInstanceType = "managed_service_instance"
 )
 
+// LastOperationState is the state of the last operation performed on a
+// Service Instance.
+type LastOperationState string
+
+const (
+	// LastOperationInProgress is when the broker is still performing the
+	// operation.
+	LastOperationInProgress LastOperationState = "in progress"
+
+	// LastOperationSucceeded is when the operation completed successfully.
+	LastOperationSucceeded LastOperationState = "succeeded"
+
+	// LastOperationFailed is when the operation could not be completed.
+	LastOperationFailed LastOperationState = "failed"
+)
+
+// LastOperation is the last operation performed on a Service Instance, such
+// as its creation, update or deletion.
+type LastOperation struct {
+	Type        string
+	State       LastOperationState
+	Description string
+}
+
 // ServiceInstance represents a Cloud Controller Service Instance.
 type ServiceInstance struct {
-	GUID      string
-	Name      string
-	SpaceGUID string
-	Type      ServiceInstanceType
+	GUID          string
+	Name          string
+	SpaceGUID     string
+	Type          ServiceInstanceType
+	LastOperation LastOperation
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -32,9 +57,14 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name      string `json:"name"`
-			SpaceGUID string `json:"space_guid"`
-			Type      string `json:"type"`
+			Name          string `json:"name"`
+			SpaceGUID     string `json:"space_guid"`
+			Type          string `json:"type"`
+			LastOperation struct {
+				Type        string `json:"type"`
+				State       string `json:"state"`
+				Description string `json:"description"`
+			} `json:"last_operation"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -46,6 +76,11 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	serviceInstance.Name = ccServiceInstance.Entity.Name
 	serviceInstance.SpaceGUID = ccServiceInstance.Entity.SpaceGUID
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
+	serviceInstance.LastOperation = LastOperation{
+		Type:        ccServiceInstance.Entity.LastOperation.Type,
+		State:       LastOperationState(ccServiceInstance.Entity.LastOperation.State),
+		Description: ccServiceInstance.Entity.LastOperation.Description,
+	}
 	return nil
 }
 
@@ -128,7 +128,12 @@ var _ = Describe("Service Instance", func() {
 				"entity": {
 					"name": "some-service-name",
 					"space_guid": "some-space-guid",
-					"type": "managed_service_instance"
+					"type": "managed_service_instance",
+					"last_operation": {
+						"type": "create",
+						"state": "in progress",
+						"description": "some-description"
+					}
 				}
 			}`
 
@@ -150,6 +155,11 @@ var _ = Describe("Service Instance", func() {
 					GUID:      "some-service-guid",
 					SpaceGUID: "some-space-guid",
 					Type:      ManagedService,
+					LastOperation: LastOperation{
+						Type:        "create",
+						State:       LastOperationInProgress,
+						Description: "some-description",
+					},
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
@@ -1,8 +1,10 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -39,6 +41,71 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// spaceRequestBody represents the body of a space create or update request.
+type spaceRequestBody struct {
+	Name                     string `json:"name,omitempty"`
+	OrganizationGUID         string `json:"organization_guid,omitempty"`
+	SpaceQuotaDefinitionGUID string `json:"space_quota_definition_guid,omitempty"`
+}
+
+// CreateSpace creates a space with the provided name in the provided
+// organization and, if set, assigns it a space quota definition.
+func (client *Client) CreateSpace(space Space) (Space, Warnings, error) {
+	body, err := json.Marshal(spaceRequestBody{
+		Name:                     space.Name,
+		OrganizationGUID:         space.OrganizationGUID,
+		SpaceQuotaDefinitionGUID: space.SpaceQuotaDefinitionGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var createdSpace Space
+	response := cloudcontroller.Response{
+		Result: &createdSpace,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdSpace, response.Warnings, err
+}
+
+// UpdateSpace updates the name and space quota definition of the space with
+// the provided GUID. Empty fields are left unchanged.
+func (client *Client) UpdateSpace(space Space) (Space, Warnings, error) {
+	body, err := json.Marshal(spaceRequestBody{
+		Name:                     space.Name,
+		SpaceQuotaDefinitionGUID: space.SpaceQuotaDefinitionGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceRequest,
+		URIParams:   Params{"space_guid": space.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var updatedSpace Space
+	response := cloudcontroller.Response{
+		Result: &updatedSpace,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedSpace, response.Warnings, err
+}
+
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid.go.template delete_space.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid_test.go.template delete_space_test.go
 
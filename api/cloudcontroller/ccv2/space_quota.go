@@ -1,15 +1,31 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
+// SpaceQuota is the definition of a quota for spaces in an organization.
+// Limits of -1 are unlimited.
 type SpaceQuota struct {
-	GUID string
-	Name string
+	GUID             string `json:"-"`
+	Name             string `json:"name"`
+	OrganizationGUID string `json:"organization_guid"`
+
+	// MemoryLimit is the total memory in megabytes of all the apps.
+	MemoryLimit int `json:"memory_limit"`
+	// InstanceMemoryLimit is the memory in megabytes of a single app instance.
+	InstanceMemoryLimit     int  `json:"instance_memory_limit"`
+	AppInstanceLimit        int  `json:"app_instance_limit"`
+	AppTaskLimit            int  `json:"app_task_limit"`
+	TotalRoutes             int  `json:"total_routes"`
+	TotalReservedRoutePorts int  `json:"total_reserved_route_ports"`
+	TotalServices           int  `json:"total_services"`
+	NonBasicServicesAllowed bool `json:"non_basic_services_allowed"`
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -17,7 +33,16 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string `json:"name"`
+			OrganizationGUID        string `json:"organization_guid"`
+			MemoryLimit             int    `json:"memory_limit"`
+			InstanceMemoryLimit     int    `json:"instance_memory_limit"`
+			AppInstanceLimit        int    `json:"app_instance_limit"`
+			AppTaskLimit            int    `json:"app_task_limit"`
+			TotalRoutes             int    `json:"total_routes"`
+			TotalReservedRoutePorts int    `json:"total_reserved_route_ports"`
+			TotalServices           int    `json:"total_services"`
+			NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccSpaceQuota); err != nil {
@@ -26,9 +51,68 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.OrganizationGUID = ccSpaceQuota.Entity.OrganizationGUID
+	spaceQuota.MemoryLimit = ccSpaceQuota.Entity.MemoryLimit
+	spaceQuota.InstanceMemoryLimit = ccSpaceQuota.Entity.InstanceMemoryLimit
+	spaceQuota.AppInstanceLimit = ccSpaceQuota.Entity.AppInstanceLimit
+	spaceQuota.AppTaskLimit = ccSpaceQuota.Entity.AppTaskLimit
+	spaceQuota.TotalRoutes = ccSpaceQuota.Entity.TotalRoutes
+	spaceQuota.TotalReservedRoutePorts = ccSpaceQuota.Entity.TotalReservedRoutePorts
+	spaceQuota.TotalServices = ccSpaceQuota.Entity.TotalServices
+	spaceQuota.NonBasicServicesAllowed = ccSpaceQuota.Entity.NonBasicServicesAllowed
 	return nil
 }
 
+// CreateSpaceQuota creates a space quota in the organization of the quota.
+func (client *Client) CreateSpaceQuota(quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceQuotaDefinitionRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var createdQuota SpaceQuota
+	response := cloudcontroller.Response{
+		Result: &createdQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdQuota, response.Warnings, err
+}
+
+// GetOrganizationSpaceQuotas returns the space quotas of an organization.
+func (client *Client) GetOrganizationSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationSpaceQuotasRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullQuotasList []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if quota, ok := item.(SpaceQuota); ok {
+			fullQuotasList = append(fullQuotasList, quota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullQuotasList, warnings, err
+}
+
 // GetSpaceQuota returns a Space Quota.
 func (client *Client) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -47,3 +131,28 @@ func (client *Client) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	err = client.connection.Make(request, &response)
 	return spaceQuota, response.Warnings, err
 }
+
+// UpdateSpaceQuota updates the name and limits of a space quota.
+func (client *Client) UpdateSpaceQuota(quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionRequest,
+		URIParams:   Params{"space_quota_guid": quota.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var updatedQuota SpaceQuota
+	response := cloudcontroller.Response{
+		Result: &updatedQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedQuota, response.Warnings, err
+}
@@ -71,4 +71,118 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationSpaceQuotas", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "small-guid"},
+						"entity": {
+							"name": "small",
+							"organization_guid": "some-org-guid",
+							"memory_limit": 1024,
+							"instance_memory_limit": 512,
+							"app_instance_limit": 4,
+							"app_task_limit": -1,
+							"total_routes": 10,
+							"total_reserved_route_ports": 0,
+							"total_services": -1,
+							"non_basic_services_allowed": false
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the space quotas of the organization and warnings", func() {
+			quotas, warnings, err := client.GetOrganizationSpaceQuotas("some-org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(quotas).To(ConsistOf(SpaceQuota{
+				GUID:                "small-guid",
+				Name:                "small",
+				OrganizationGUID:    "some-org-guid",
+				MemoryLimit:         1024,
+				InstanceMemoryLimit: 512,
+				AppInstanceLimit:    4,
+				AppTaskLimit:        -1,
+				TotalRoutes:         10,
+				TotalServices:       -1,
+			}))
+		})
+	})
+
+	Describe("CreateSpaceQuota", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "small-guid"},
+				"entity": {"name": "small", "organization_guid": "some-org-guid", "memory_limit": 1024}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/space_quota_definitions"),
+					VerifyJSONRepresenting(map[string]interface{}{
+						"name":                       "small",
+						"organization_guid":          "some-org-guid",
+						"memory_limit":               1024,
+						"instance_memory_limit":      -1,
+						"app_instance_limit":         -1,
+						"app_task_limit":             -1,
+						"total_routes":               10,
+						"total_reserved_route_ports": 0,
+						"total_services":             -1,
+						"non_basic_services_allowed": true,
+					}),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the space quota and returns warnings", func() {
+			quota, warnings, err := client.CreateSpaceQuota(SpaceQuota{
+				Name:                    "small",
+				OrganizationGUID:        "some-org-guid",
+				MemoryLimit:             1024,
+				InstanceMemoryLimit:     -1,
+				AppInstanceLimit:        -1,
+				AppTaskLimit:            -1,
+				TotalRoutes:             10,
+				TotalServices:           -1,
+				NonBasicServicesAllowed: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(quota).To(Equal(SpaceQuota{GUID: "small-guid", Name: "small", OrganizationGUID: "some-org-guid", MemoryLimit: 1024}))
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "small-guid"},
+				"entity": {"name": "small", "organization_guid": "some-org-guid", "memory_limit": 2048}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/small-guid"),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("updates the space quota and returns warnings", func() {
+			quota, warnings, err := client.UpdateSpaceQuota(SpaceQuota{GUID: "small-guid", Name: "small", OrganizationGUID: "some-org-guid", MemoryLimit: 2048})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(quota.MemoryLimit).To(Equal(2048))
+		})
+	})
 })
@@ -437,4 +437,50 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpace", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "some-space-guid"},
+				"entity": {"name": "some-space", "organization_guid": "some-org-guid", "space_quota_definition_guid": null}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/spaces"),
+					VerifyJSON(`{"name": "some-space", "organization_guid": "some-org-guid"}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the space and returns warnings", func() {
+			space, warnings, err := client.CreateSpace(Space{Name: "some-space", OrganizationGUID: "some-org-guid"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space", OrganizationGUID: "some-org-guid"}))
+		})
+	})
+
+	Describe("UpdateSpace", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "some-space-guid"},
+				"entity": {"name": "some-space", "organization_guid": "some-org-guid", "space_quota_definition_guid": "small-guid"}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid"),
+					VerifyJSON(`{"space_quota_definition_guid": "small-guid"}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("updates only the provided fields and returns warnings", func() {
+			space, warnings, err := client.UpdateSpace(Space{GUID: "some-space-guid", OrganizationGUID: "ignored-org-guid", SpaceQuotaDefinitionGUID: "small-guid"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(space.SpaceQuotaDefinitionGUID).To(Equal("small-guid"))
+		})
+	})
 })
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
 // User represents a Cloud Controller User.
 type User struct {
-	GUID string
+	GUID     string
+	Username string
 }
 
 // userRequestBody represents the body of the request.
@@ -22,12 +25,16 @@ type userRequestBody struct {
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccUser); err != nil {
 		return err
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -61,3 +68,98 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// usernameRequestBody represents the body of a request that associates a user
+// with an organization or space by username.
+type usernameRequestBody struct {
+	Username string `json:"username"`
+}
+
+// GetOrganizationUsersByRole returns the users with the provided role in the
+// organization.
+func (client *Client) GetOrganizationUsersByRole(role constant.OrganizationUserRole, orgGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationUsersByRoleRequest,
+		URIParams:   Params{"organization_guid": orgGUID, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// GetSpaceUsersByRole returns the users with the provided role in the space.
+func (client *Client) GetSpaceUsersByRole(role constant.SpaceUserRole, spaceGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceUsersByRoleRequest,
+		URIParams:   Params{"space_guid": spaceGUID, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// UpdateOrganizationUserByRole gives the user with the provided username the
+// provided role in the organization.
+func (client *Client) UpdateOrganizationUserByRole(role constant.OrganizationUserRole, orgGUID string, username string) (Warnings, error) {
+	return client.makeUsernameRequest(internal.PutOrganizationUserByRoleRequest, Params{"organization_guid": orgGUID, "role": string(role)}, username)
+}
+
+// DeleteOrganizationUserByRole removes the provided role in the organization
+// from the user with the provided username.
+func (client *Client) DeleteOrganizationUserByRole(role constant.OrganizationUserRole, orgGUID string, username string) (Warnings, error) {
+	return client.makeUsernameRequest(internal.PostOrganizationUserByRoleRemoveRequest, Params{"organization_guid": orgGUID, "role": string(role)}, username)
+}
+
+// UpdateSpaceUserByRole gives the user with the provided username the
+// provided role in the space. The user must be a member of the space's
+// organization.
+func (client *Client) UpdateSpaceUserByRole(role constant.SpaceUserRole, spaceGUID string, username string) (Warnings, error) {
+	return client.makeUsernameRequest(internal.PutSpaceUserByRoleRequest, Params{"space_guid": spaceGUID, "role": string(role)}, username)
+}
+
+// DeleteSpaceUserByRole removes the provided role in the space from the user
+// with the provided username.
+func (client *Client) DeleteSpaceUserByRole(role constant.SpaceUserRole, spaceGUID string, username string) (Warnings, error) {
+	return client.makeUsernameRequest(internal.PostSpaceUserByRoleRemoveRequest, Params{"space_guid": spaceGUID, "role": string(role)}, username)
+}
+
+func (client *Client) makeUsernameRequest(requestName string, params Params, username string) (Warnings, error) {
+	body, err := json.Marshal(usernameRequestBody{Username: username})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   params,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+func (client *Client) paginateUsers(request *cloudcontroller.Request) ([]User, Warnings, error) {
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
@@ -0,0 +1,108 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// UserProvidedServiceInstance represents a Cloud Controller User Provided
+// Service Instance.
+type UserProvidedServiceInstance struct {
+	GUID            string
+	Name            string
+	SpaceGUID       string
+	Credentials     map[string]interface{}
+	SyslogDrainURL  string
+	RouteServiceURL string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller User Provided Service
+// Instance response.
+func (serviceInstance *UserProvidedServiceInstance) UnmarshalJSON(data []byte) error {
+	var ccServiceInstance struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Name            string                 `json:"name"`
+			SpaceGUID       string                 `json:"space_guid"`
+			Credentials     map[string]interface{} `json:"credentials"`
+			SyslogDrainURL  string                 `json:"syslog_drain_url"`
+			RouteServiceURL string                 `json:"route_service_url"`
+		}
+	}
+	err := json.Unmarshal(data, &ccServiceInstance)
+	if err != nil {
+		return err
+	}
+
+	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
+	serviceInstance.Name = ccServiceInstance.Entity.Name
+	serviceInstance.SpaceGUID = ccServiceInstance.Entity.SpaceGUID
+	serviceInstance.Credentials = ccServiceInstance.Entity.Credentials
+	serviceInstance.SyslogDrainURL = ccServiceInstance.Entity.SyslogDrainURL
+	serviceInstance.RouteServiceURL = ccServiceInstance.Entity.RouteServiceURL
+	return nil
+}
+
+// userProvidedServiceInstanceRequestBody represents the body of a user
+// provided service instance create request.
+type userProvidedServiceInstanceRequestBody struct {
+	Name            string                 `json:"name"`
+	SpaceGUID       string                 `json:"space_guid"`
+	Credentials     map[string]interface{} `json:"credentials,omitempty"`
+	SyslogDrainURL  string                 `json:"syslog_drain_url,omitempty"`
+	RouteServiceURL string                 `json:"route_service_url,omitempty"`
+}
+
+// CreateUserProvidedServiceInstance creates a user provided service instance
+// with the provided name, credentials and URLs in the provided space.
+func (client *Client) CreateUserProvidedServiceInstance(serviceInstance UserProvidedServiceInstance) (UserProvidedServiceInstance, Warnings, error) {
+	body, err := json.Marshal(userProvidedServiceInstanceRequestBody{
+		Name:            serviceInstance.Name,
+		SpaceGUID:       serviceInstance.SpaceGUID,
+		Credentials:     serviceInstance.Credentials,
+		SyslogDrainURL:  serviceInstance.SyslogDrainURL,
+		RouteServiceURL: serviceInstance.RouteServiceURL,
+	})
+	if err != nil {
+		return UserProvidedServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostUserProvidedServiceInstanceRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return UserProvidedServiceInstance{}, nil, err
+	}
+
+	var createdServiceInstance UserProvidedServiceInstance
+	response := cloudcontroller.Response{
+		Result: &createdServiceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdServiceInstance, response.Warnings, err
+}
+
+// GetUserProvidedServiceInstance returns the user provided service instance,
+// including its credentials, with the given GUID.
+func (client *Client) GetUserProvidedServiceInstance(serviceInstanceGUID string) (UserProvidedServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetUserProvidedServiceInstanceRequest,
+		URIParams:   Params{"user_provided_service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return UserProvidedServiceInstance{}, nil, err
+	}
+
+	var serviceInstance UserProvidedServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
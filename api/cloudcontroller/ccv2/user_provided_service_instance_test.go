@@ -0,0 +1,143 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("User Provided Service Instance", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"space_guid": "some-space-guid",
+						"credentials": {"password": "some-password"},
+						"syslog_drain_url": "syslog://example.com"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/user_provided_service_instances"),
+						VerifyJSON(`{"name": "some-service-instance", "space_guid": "some-space-guid", "credentials": {"password": "some-password"}, "syslog_drain_url": "syslog://example.com"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the created service instance and warnings", func() {
+				serviceInstance, warnings, err := client.CreateUserProvidedServiceInstance(UserProvidedServiceInstance{
+					Name:           "some-service-instance",
+					SpaceGUID:      "some-space-guid",
+					Credentials:    map[string]interface{}{"password": "some-password"},
+					SyslogDrainURL: "syslog://example.com",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(UserProvidedServiceInstance{
+					GUID:           "some-service-instance-guid",
+					Name:           "some-service-instance",
+					SpaceGUID:      "some-space-guid",
+					Credentials:    map[string]interface{}{"password": "some-password"},
+					SyslogDrainURL: "syslog://example.com",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60002,
+					"description": "The service instance name is taken: some-service-instance",
+					"error_code": "CF-ServiceInstanceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/user_provided_service_instances"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateUserProvidedServiceInstance(UserProvidedServiceInstance{Name: "some-service-instance"})
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The service instance name is taken: some-service-instance"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetUserProvidedServiceInstance", func() {
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"space_guid": "some-space-guid",
+						"credentials": {"uri": "postgres://example.com"},
+						"route_service_url": "https://route-service.example.com"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/user_provided_service_instances/some-service-instance-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the service instance with its credentials and warnings", func() {
+				serviceInstance, warnings, err := client.GetUserProvidedServiceInstance("some-service-instance-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(UserProvidedServiceInstance{
+					GUID:            "some-service-instance-guid",
+					Name:            "some-service-instance",
+					SpaceGUID:       "some-space-guid",
+					Credentials:     map[string]interface{}{"uri": "postgres://example.com"},
+					RouteServiceURL: "https://route-service.example.com",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60004,
+					"description": "The service instance could not be found: some-service-instance-guid",
+					"error_code": "CF-ServiceInstanceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/user_provided_service_instances/some-service-instance-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetUserProvidedServiceInstance("some-service-instance-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service instance could not be found: some-service-instance-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -87,4 +88,135 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{"metadata": {"guid": "user-guid-1"}, "entity": {"username": "alice"}},
+					{"metadata": {"guid": "user-guid-2"}, "entity": {"username": "bob"}}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/billing_managers"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the users with the role and warnings", func() {
+			users, warnings, err := client.GetOrganizationUsersByRole(constant.OrgBillingManagerRole, "some-org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(users).To(Equal([]User{
+				{GUID: "user-guid-1", Username: "alice"},
+				{GUID: "user-guid-2", Username: "bob"},
+			}))
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{"metadata": {"guid": "user-guid-1"}, "entity": {"username": "alice"}}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the users with the role and warnings", func() {
+			users, warnings, err := client.GetSpaceUsersByRole(constant.SpaceDeveloperRole, "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(users).To(Equal([]User{{GUID: "user-guid-1", Username: "alice"}}))
+		})
+	})
+
+	Describe("UpdateOrganizationUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/managers"),
+					VerifyJSON(`{"username": "alice"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("gives the user the role and returns warnings", func() {
+			warnings, err := client.UpdateOrganizationUserByRole(constant.OrgManagerRole, "some-org-guid", "alice")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("DeleteOrganizationUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/organizations/some-org-guid/auditors/remove"),
+					VerifyJSON(`{"username": "alice"}`),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("removes the role from the user and returns warnings", func() {
+			warnings, err := client.DeleteOrganizationUserByRole(constant.OrgAuditorRole, "some-org-guid", "alice")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateSpaceUserByRole", func() {
+		Context("when the user is not a member of the organization", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 1002,
+					"description": "Invalid relation: Could not find VCAP::CloudController::User to associate",
+					"error_code": "CF-InvalidRelation"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/developers"),
+						VerifyJSON(`{"username": "alice"}`),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateSpaceUserByRole(constant.SpaceDeveloperRole, "some-space-guid", "alice")
+				Expect(err).To(MatchError(ccerror.InvalidRelationError{Message: "Invalid relation: Could not find VCAP::CloudController::User to associate"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("DeleteSpaceUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/spaces/some-space-guid/managers/remove"),
+					VerifyJSON(`{"username": "alice"}`),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("removes the role from the user and returns warnings", func() {
+			warnings, err := client.DeleteSpaceUserByRole(constant.SpaceManagerRole, "some-space-guid", "alice")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
 })
@@ -0,0 +1,30 @@
+package ccv3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// getBitsSize returns the size in bytes of the bits behind a download
+// request, as reported by the Content-Length of a HEAD request. The size is
+// -1 when the blobstore does not report it.
+func (client *Client) getBitsSize(requestName string, uriParams internal.Params) (int64, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	request.Method = http.MethodHead
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return 0, response.Warnings, err
+	}
+
+	return response.HTTPResponse.ContentLength, response.Warnings, nil
+}
@@ -1,6 +1,10 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -15,6 +19,9 @@ const (
 	DropletStateFailed  DropletState = "FAILED"
 	DropletStateCopying DropletState = "COPYING"
 	DropletStateExpired DropletState = "EXPIRED"
+
+	DropletStateAwaitingUpload   DropletState = "AWAITING_UPLOAD"
+	DropletStateProcessingUpload DropletState = "PROCESSING_UPLOAD"
 )
 
 type Droplet struct {
@@ -24,6 +31,14 @@ type Droplet struct {
 	Stack      string             `json:"stack,omitempty"`
 	Buildpacks []DropletBuildpack `json:"buildpacks,omitempty"`
 	Image      string             `json:"image"`
+	Checksum   DropletChecksum    `json:"checksum"`
+}
+
+// DropletChecksum is the checksum of the droplet bits. Type is sha256 or, on
+// older Cloud Controllers, sha1.
+type DropletChecksum struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
 
 type DropletBuildpack struct {
@@ -75,3 +90,216 @@ func (client *Client) GetDroplet(dropletGUID string) (Droplet, Warnings, error)
 
 	return responseDroplet, response.Warnings, err
 }
+
+// GetApplicationDropletCurrent returns the current droplet of the app.
+func (client *Client) GetApplicationDropletCurrent(appGUID string) (Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationDropletCurrentRequest,
+		URIParams:   map[string]string{"app_guid": appGUID},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
+
+// CopyDroplet copies the droplet to the app. The copy is in the COPYING state
+// until its bits have been copied.
+func (client *Client) CopyDroplet(dropletGUID string, appGUID string) (Droplet, Warnings, error) {
+	return client.postDroplet(appGUID, url.Values{"source_guid": {dropletGUID}})
+}
+
+// CreateDroplet creates an empty droplet for the app. The droplet is in the
+// AWAITING_UPLOAD state until its bits are uploaded with UploadDropletBits.
+func (client *Client) CreateDroplet(appGUID string) (Droplet, Warnings, error) {
+	return client.postDroplet(appGUID, nil)
+}
+
+// DeleteDroplet deletes the droplet and returns the URL of the job deleting
+// its bits.
+func (client *Client) DeleteDroplet(dropletGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   map[string]string{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
+
+// DownloadDroplet returns a stream of the bits of the droplet and their size
+// in bytes, which is -1 when the Cloud Controller does not provide it. The
+// caller must close the stream.
+func (client *Client) DownloadDroplet(dropletGUID string) (io.ReadCloser, int64, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletBitsRequest,
+		URIParams:   map[string]string{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	response := cloudcontroller.Response{StreamBody: true}
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, 0, response.Warnings, err
+	}
+
+	return response.Body, response.HTTPResponse.ContentLength, response.Warnings, nil
+}
+
+// GetDropletSize returns the size in bytes of the droplet bits, or -1 when
+// the size is unknown.
+func (client *Client) GetDropletSize(dropletGUID string) (int64, Warnings, error) {
+	return client.getBitsSize(internal.GetDropletBitsRequest, internal.Params{"droplet_guid": dropletGUID})
+}
+
+// UploadDropletBits streams the droplet bits to the droplet and returns the
+// URL of the job processing the upload.
+func (client *Client) UploadDropletBits(dropletGUID string, bits io.Reader, bitsLength int64) (string, Warnings, error) {
+	contentLength, err := client.dropletUploadSize(bitsLength)
+	if err != nil {
+		return "", nil, err
+	}
+
+	contentType, body, writeErrors := client.createDropletUploadStream(bits)
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDropletBitsRequest,
+		URIParams:   map[string]string{"droplet_guid": dropletGUID},
+		Body:        body,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	request.ContentLength = contentLength
+
+	response := cloudcontroller.Response{}
+	httpErrors := make(chan error)
+	go func() {
+		defer close(httpErrors)
+
+		err := client.connection.Make(request, &response)
+		if err != nil {
+			httpErrors <- err
+		}
+	}()
+
+	// Either side ending early closes the pipe, which ends the other side, so
+	// both channels are drained before returning the first error.
+	var firstError error
+	for writeErrors != nil || httpErrors != nil {
+		select {
+		case writeErr, ok := <-writeErrors:
+			if !ok {
+				writeErrors = nil
+				continue
+			}
+			if firstError == nil {
+				firstError = writeErr
+			}
+		case httpErr, ok := <-httpErrors:
+			if !ok {
+				httpErrors = nil
+				continue
+			}
+			if firstError == nil {
+				firstError = httpErr
+			}
+		}
+	}
+
+	return response.ResourceLocationURL, response.Warnings, firstError
+}
+
+func (client *Client) postDroplet(appGUID string, query url.Values) (Droplet, Warnings, error) {
+	var body struct {
+		Relationships struct {
+			App Relationship `json:"app"`
+		} `json:"relationships"`
+	}
+	body.Relationships.App.GUID = appGUID
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDropletRequest,
+		Query:       query,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
+
+func (*Client) createDropletUploadStream(bits io.Reader) (string, io.ReadSeeker, <-chan error) {
+	writerOutput, writerInput := cloudcontroller.NewPipeBomb()
+	form := multipart.NewWriter(writerInput)
+
+	writeErrors := make(chan error)
+
+	go func() {
+		defer close(writeErrors)
+		defer writerInput.Close()
+
+		writer, err := form.CreateFormFile("bits", "droplet.tgz")
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		_, err = io.Copy(writer, bits)
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		err = form.Close()
+		if err != nil {
+			writeErrors <- err
+		}
+	}()
+
+	return form.FormDataContentType(), writerOutput, writeErrors
+}
+
+func (*Client) dropletUploadSize(bitsLength int64) (int64, error) {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	_, err := form.CreateFormFile("bits", "droplet.tgz")
+	if err != nil {
+		return 0, err
+	}
+	err = form.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(body.Len()) + bitsLength, nil
+}
@@ -1,7 +1,10 @@
 package ccv3_test
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 
@@ -232,4 +235,349 @@ var _ = Describe("Droplet", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationDropletCurrent", func() {
+		Context("when the app has a current droplet", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-droplet-guid",
+					"state": "STAGED",
+					"stack": "some-stack",
+					"created_at": "2016-03-28T23:39:34Z"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the current droplet and all warnings", func() {
+				droplet, warnings, err := client.GetApplicationDropletCurrent("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{
+					GUID:      "some-droplet-guid",
+					State:     DropletStateStaged,
+					Stack:     "some-stack",
+					CreatedAt: "2016-03-28T23:39:34Z",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the app does not have a current droplet", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a DropletNotFoundError and all warnings", func() {
+				_, warnings, err := client.GetApplicationDropletCurrent("some-app-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CopyDroplet", func() {
+		Context("when the copy is started", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-copy-guid",
+					"state": "COPYING",
+					"created_at": "2016-03-28T23:39:34Z"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets", "source_guid=some-droplet-guid"),
+						VerifyJSON(`{"relationships": {"app": {"data": {"guid": "some-app-guid"}}}}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the copied droplet and all warnings", func() {
+				droplet, warnings, err := client.CopyDroplet("some-droplet-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{
+					GUID:      "some-copy-guid",
+					State:     DropletStateCopying,
+					CreatedAt: "2016-03-28T23:39:34Z",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets", "source_guid=some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CopyDroplet("some-droplet-guid", "some-app-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateDroplet", func() {
+		BeforeEach(func() {
+			response := `{
+				"guid": "some-droplet-guid",
+				"state": "AWAITING_UPLOAD",
+				"created_at": "2016-03-28T23:39:34Z"
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/droplets", ""),
+					VerifyJSON(`{"relationships": {"app": {"data": {"guid": "some-app-guid"}}}}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the created droplet and all warnings", func() {
+			droplet, warnings, err := client.CreateDroplet("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(droplet).To(Equal(Droplet{
+				GUID:      "some-droplet-guid",
+				State:     DropletStateAwaitingUpload,
+				CreatedAt: "2016-03-28T23:39:34Z",
+			}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		Context("when the download succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a stream of the droplet bits, their size and all warnings", func() {
+				bits, size, warnings, err := client.DownloadDroplet("some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(size).To(BeNumerically("==", len("some-droplet-bits")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				raw, err := ioutil.ReadAll(bits)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bits.Close()).To(Succeed())
+				Expect(string(raw)).To(Equal("some-droplet-bits"))
+			})
+		})
+
+		Context("when the droplet does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a DropletNotFoundError and all warnings", func() {
+				_, _, warnings, err := client.DownloadDroplet("some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UploadDropletBits", func() {
+		var bits []byte
+
+		BeforeEach(func() {
+			bits = []byte("some-droplet-bits")
+
+			verifyHeaderAndBody := func(_ http.ResponseWriter, req *http.Request) {
+				contentType := req.Header.Get("Content-Type")
+				Expect(contentType).To(MatchRegexp("multipart/form-data; boundary=[\\w\\d]+"))
+				Expect(req.ContentLength).To(BeNumerically(">", len(bits)))
+
+				defer req.Body.Close()
+				requestReader := multipart.NewReader(req.Body, contentType[30:])
+
+				part, err := requestReader.NextPart()
+				Expect(err).NotTo(HaveOccurred())
+				defer part.Close()
+
+				Expect(part.FormName()).To(Equal("bits"))
+				Expect(part.FileName()).To(Equal("droplet.tgz"))
+				Expect(ioutil.ReadAll(part)).To(Equal(bits))
+			}
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/droplets/some-droplet-guid/upload"),
+					verifyHeaderAndBody,
+					RespondWith(http.StatusAccepted, `{"guid": "some-droplet-guid"}`, http.Header{
+						"X-Cf-Warnings": {"warning-1"},
+						"Location":      {"https://api.example.com/v3/jobs/some-job-guid"},
+					}),
+				),
+			)
+		})
+
+		It("streams the bits and returns the job URL and all warnings", func() {
+			jobURL, warnings, err := client.UploadDropletBits("some-droplet-guid", bytes.NewReader(bits), int64(len(bits)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobURL).To(Equal("https://api.example.com/v3/jobs/some-job-guid"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("DeleteDroplet", func() {
+		Context("when the droplet is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				jobURL, warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the droplet does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a DropletNotFoundError and all warnings", func() {
+				_, warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetDropletSize", func() {
+		Context("when the blobstore reports the size", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodHead, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusOK, "", http.Header{
+							"X-Cf-Warnings":  {"warning-1"},
+							"Content-Length": {"123456"},
+						}),
+					),
+				)
+			})
+
+			It("returns the size from the Content-Length header and all warnings", func() {
+				size, warnings, err := client.GetDropletSize("some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(size).To(BeNumerically("==", 123456))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the blobstore does not report the size", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodHead, "/v3/droplets/some-droplet-guid/download"),
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.Header().Set("Transfer-Encoding", "chunked")
+							w.WriteHeader(http.StatusOK)
+						},
+					),
+				)
+			})
+
+			It("returns -1", func() {
+				size, _, err := client.GetDropletSize("some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(size).To(BeNumerically("==", -1))
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodHead, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusNotFound, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetDropletSize("some-droplet-guid")
+				Expect(err).To(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 })
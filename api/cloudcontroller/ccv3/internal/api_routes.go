@@ -13,38 +13,48 @@ import "net/http"
 const (
 	DeleteApplicationProcessInstanceRequest                 = "DeleteApplicationProcessInstanceRequest"
 	DeleteApplicationRequest                                = "DeleteApplication"
+	DeleteDropletRequest                                    = "DeleteDroplet"
 	DeleteIsolationSegmentRelationshipOrganizationRequest   = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                           = "DeleteIsolationSegment"
+	DeletePackageRequest                                    = "DeletePackage"
 	GetAppDropletsRequest                                   = "GetAppDroplets"
+	GetApplicationDropletCurrentRequest                     = "GetApplicationDropletCurrent"
 	GetApplicationEnvironmentVariables                      = "GetApplicationEnvironmentVariables"
 	GetApplicationProcessByTypeRequest                      = "GetApplicationProcessByType"
 	GetAppProcessesRequest                                  = "GetAppProcesses"
 	GetAppsRequest                                          = "GetApps"
 	GetAppTasksRequest                                      = "GetAppTasks"
 	GetBuildRequest                                         = "GetBuild"
+	GetDropletBitsRequest                                   = "GetDropletBits"
 	GetDropletRequest                                       = "GetDroplet"
 	GetIsolationSegmentOrganizationsRequest                 = "GetIsolationSegmentRelationshipOrganizations"
 	GetIsolationSegmentRequest                              = "GetIsolationSegment"
 	GetIsolationSegmentsRequest                             = "GetIsolationSegments"
 	GetOrganizationDefaultIsolationSegmentRequest           = "GetOrganizationDefaultIsolationSegment"
 	GetOrgsRequest                                          = "GetOrgs"
+	GetPackageBitsRequest                                   = "GetPackageBits"
 	GetPackageRequest                                       = "GetPackage"
 	GetPackagesRequest                                      = "GetPackages"
 	GetProcessInstancesRequest                              = "GetProcessInstances"
 	GetSpaceRelationshipIsolationSegmentRequest             = "GetSpaceRelationshipIsolationSegmentRequest"
 	GetSpacesRequest                                        = "GetSpaces"
+	GetTasksRequest                                         = "GetTasks"
 	PatchApplicationCurrentDropletRequest                   = "PatchApplicationCurrentDroplet"
+	PatchApplicationProcessCommandRequest                   = "PatchApplicationProcessCommand"
 	PatchApplicationProcessHealthCheckRequest               = "PatchApplicationProcessHealthCheck"
 	PatchApplicationRequest                                 = "PatchApplicationRequest"
 	PatchApplicationUserProvidedEnvironmentVariablesRequest = "PatchApplicationUserProvidedEnvironmentVariablesRequest"
 	PatchOrganizationDefaultIsolationSegmentRequest         = "PatchOrganizationDefaultIsolationSegmentRequest"
 	PatchSpaceRelationshipIsolationSegmentRequest           = "PatchSpaceRelationshipIsolationSegmentRequest"
+	PostApplicationManifestRequest                          = "PostApplicationManifest"
 	PostApplicationProcessScaleRequest                      = "PostApplicationProcessScale"
 	PostApplicationRequest                                  = "PostApplicationRequest"
 	PostApplicationStartRequest                             = "PostApplicationStart"
 	PostApplicationStopRequest                              = "PostApplicationStop"
 	PostAppTasksRequest                                     = "PostAppTasks"
 	PostBuildRequest                                        = "PostBuild"
+	PostDropletBitsRequest                                  = "PostDropletBits"
+	PostDropletRequest                                      = "PostDroplet"
 	PostIsolationSegmentRelationshipOrganizationsRequest    = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                            = "PostIsolationSegments"
 	PostPackageRequest                                      = "PostPackageRequest"
@@ -70,15 +80,19 @@ var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetOrgsRequest, Resource: OrgsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetPackagesRequest, Resource: PackagesResource},
 	{Path: "/", Method: http.MethodGet, Name: GetSpacesRequest, Resource: SpacesResource},
+	{Path: "/", Method: http.MethodGet, Name: GetTasksRequest, Resource: TasksResource},
 	{Path: "/", Method: http.MethodPost, Name: PostApplicationRequest, Resource: AppsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostBuildRequest, Resource: BuildsResource},
+	{Path: "/", Method: http.MethodPost, Name: PostDropletRequest, Resource: DropletsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostPackageRequest, Resource: PackagesResource},
 	{Path: "/:app_guid", Method: http.MethodDelete, Name: DeleteApplicationRequest, Resource: AppsResource},
 	{Path: "/:app_guid", Method: http.MethodPatch, Name: PatchApplicationRequest, Resource: AppsResource},
+	{Path: "/:app_guid/actions/apply_manifest", Method: http.MethodPost, Name: PostApplicationManifestRequest, Resource: AppsResource},
 	{Path: "/:app_guid/actions/start", Method: http.MethodPost, Name: PostApplicationStartRequest, Resource: AppsResource},
 	{Path: "/:app_guid/actions/stop", Method: http.MethodPost, Name: PostApplicationStopRequest, Resource: AppsResource},
 	{Path: "/:app_guid/droplets", Method: http.MethodGet, Name: GetAppDropletsRequest, Resource: AppsResource},
+	{Path: "/:app_guid/droplets/current", Method: http.MethodGet, Name: GetApplicationDropletCurrentRequest, Resource: AppsResource},
 	{Path: "/:app_guid/env", Method: http.MethodGet, Name: GetApplicationEnvironmentVariables, Resource: AppsResource},
 	{Path: "/:app_guid/environment_variables", Method: http.MethodPatch, Name: PatchApplicationUserProvidedEnvironmentVariablesRequest, Resource: AppsResource},
 	{Path: "/:app_guid/processes", Method: http.MethodGet, Name: GetAppProcessesRequest, Resource: AppsResource},
@@ -89,7 +103,10 @@ var APIRoutes = []Route{
 	{Path: "/:app_guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:app_guid/tasks", Method: http.MethodPost, Name: PostAppTasksRequest, Resource: AppsResource},
 	{Path: "/:build_guid", Method: http.MethodGet, Name: GetBuildRequest, Resource: BuildsResource},
+	{Path: "/:droplet_guid", Method: http.MethodDelete, Name: DeleteDropletRequest, Resource: DropletsResource},
 	{Path: "/:droplet_guid", Method: http.MethodGet, Name: GetDropletRequest, Resource: DropletsResource},
+	{Path: "/:droplet_guid/download", Method: http.MethodGet, Name: GetDropletBitsRequest, Resource: DropletsResource},
+	{Path: "/:droplet_guid/upload", Method: http.MethodPost, Name: PostDropletBitsRequest, Resource: DropletsResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodGet, Name: GetIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:isolation_segment_guid/organizations", Method: http.MethodGet, Name: GetIsolationSegmentOrganizationsRequest, Resource: IsolationSegmentsResource},
@@ -97,7 +114,10 @@ var APIRoutes = []Route{
 	{Path: "/:isolation_segment_guid/relationships/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodGet, Name: GetOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodPatch, Name: PatchOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
+	{Path: "/:package_guid", Method: http.MethodDelete, Name: DeletePackageRequest, Resource: PackagesResource},
 	{Path: "/:package_guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
+	{Path: "/:package_guid/download", Method: http.MethodGet, Name: GetPackageBitsRequest, Resource: PackagesResource},
+	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchApplicationProcessCommandRequest, Resource: ProcessesResource},
 	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchApplicationProcessHealthCheckRequest, Resource: ProcessesResource},
 	{Path: "/:process_guid/stats", Method: http.MethodGet, Name: GetProcessInstancesRequest, Resource: ProcessesResource},
 	{Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodGet, Name: GetSpaceRelationshipIsolationSegmentRequest, Resource: SpacesResource},
@@ -185,6 +185,29 @@ func (client *Client) UploadPackage(pkg Package, fileToUpload string) (Package,
 	return responsePackage, response.Warnings, err
 }
 
+// DeletePackage deletes the package and returns the URL of the job deleting
+// its bits.
+func (client *Client) DeletePackage(packageGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
+
+// GetPackageSize returns the size in bytes of the package bits, or -1 when
+// the size is unknown.
+func (client *Client) GetPackageSize(packageGUID string) (int64, Warnings, error) {
+	return client.getBitsSize(internal.GetPackageBitsRequest, internal.Params{"package_guid": packageGUID})
+}
+
 // GetPackages returns the list of packages.
 func (client *Client) GetPackages(query url.Values) ([]Package, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
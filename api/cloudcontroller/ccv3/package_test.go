@@ -491,4 +491,85 @@ var _ = Describe("Package", func() {
 		})
 
 	})
+
+	Describe("DeletePackage", func() {
+		Context("when the package is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				jobURL, warnings, err := client.DeletePackage("some-package-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.DeletePackage("some-package-guid")
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetPackageSize", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodHead, "/v3/packages/some-package-guid/download"),
+					RespondWith(http.StatusOK, "", http.Header{
+						"X-Cf-Warnings":  {"warning-1"},
+						"Content-Length": {"4096"},
+					}),
+				),
+			)
+		})
+
+		It("returns the size of the package bits and all warnings", func() {
+			size, warnings, err := client.GetPackageSize("some-package-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(BeNumerically("==", 4096))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
 })
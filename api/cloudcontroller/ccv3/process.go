@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 	"code.cloudfoundry.org/cli/types"
+	yaml "gopkg.in/yaml.v2"
 )
 
 type Process struct {
@@ -119,6 +120,70 @@ func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, pro
 	return response.Warnings, err
 }
 
+// PatchApplicationProcessCommand updates the command of the process. An empty
+// command resets the process to the command detected during staging.
+func (client *Client) PatchApplicationProcessCommand(processGUID string, command string) (Warnings, error) {
+	var ccProcess struct {
+		Command interface{} `json:"command"`
+	}
+	if command != "" {
+		ccProcess.Command = command
+	}
+
+	body, err := json.Marshal(ccProcess)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationProcessCommandRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   internal.Params{"process_guid": processGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// CreateApplicationProcess creates a process of the given type for the app
+// by applying a manifest that only declares that process type. It returns
+// the URL of the job applying the manifest.
+func (client *Client) CreateApplicationProcess(appGUID string, processType string) (string, Warnings, error) {
+	type manifestProcess struct {
+		Type string `yaml:"type"`
+	}
+	type manifestApplication struct {
+		Processes []manifestProcess `yaml:"processes"`
+	}
+	body, err := yaml.Marshal(struct {
+		Applications []manifestApplication `yaml:"applications"`
+	}{
+		Applications: []manifestApplication{{Processes: []manifestProcess{{Type: processType}}}},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostApplicationManifestRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   internal.Params{"app_guid": appGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-yaml")
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
+
 // CreateApplicationProcessScale updates process instances count, memory or disk
 func (client *Client) CreateApplicationProcessScale(appGUID string, process Process) (Warnings, error) {
 	ccProcessScale := struct {
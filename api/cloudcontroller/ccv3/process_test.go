@@ -267,6 +267,133 @@ var _ = Describe("Process", func() {
 		})
 	})
 
+	Describe("CreateApplicationProcess", func() {
+		Context("when the manifest is applied", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/apply_manifest"),
+						VerifyContentType("application/x-yaml"),
+						VerifyBody([]byte("applications:\n- processes:\n  - type: worker\n")),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+							"Location":      {"/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				jobURL, warnings, err := client.CreateApplicationProcess("some-app-guid", "worker")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/apply_manifest"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateApplicationProcess("some-app-guid", "worker")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: command presence"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("PatchApplicationProcessCommand", func() {
+		var (
+			command string
+
+			warnings []string
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.PatchApplicationProcessCommand("some-process-guid", command)
+		})
+
+		Context("when the command is non-empty", func() {
+			BeforeEach(func() {
+				command = "some-command"
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(`{"command": "some-command"}`),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("patches the process's command", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the command is empty", func() {
+			BeforeEach(func() {
+				command = ""
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(`{"command": null}`),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("resets the process's command", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				command = "some-command"
+				response := `{
+					"errors": [
+						{
+							"detail": "Process not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error and warnings", func() {
+				Expect(err).To(MatchError(ccerror.ProcessNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("PatchApplicationProcessHealthCheck", func() {
 		var (
 			endpoint string
@@ -11,6 +11,8 @@ const (
 	OrganizationGUIDFilter = "organization_guids"
 	// SpaceGUIDFilter is a query paramater for listing objects by Space GUID.
 	SpaceGUIDFilter = "space_guids"
+	// StateFilter is a query paramater for listing objects by state.
+	StateFilter = "states"
 
 	// OrderBy is a query paramater to specify how to order objects.
 	OrderBy = "order_by"
@@ -9,8 +9,9 @@ import (
 
 // Space represents a Cloud Controller V3 Space.
 type Space struct {
-	Name string `json:"name"`
-	GUID string `json:"guid"`
+	Name          string        `json:"name"`
+	GUID          string        `json:"guid"`
+	Relationships Relationships `json:"relationships,omitempty"`
 }
 
 // GetSpaces lists spaces with optional filters.
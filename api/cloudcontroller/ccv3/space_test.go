@@ -31,7 +31,12 @@ var _ = Describe("Spaces", func() {
   "resources": [
     {
       "name": "space-name-1",
-      "guid": "space-guid-1"
+      "guid": "space-guid-1",
+      "relationships": {
+        "organization": {
+          "data": { "guid": "org-guid-1" }
+        }
+      }
     },
     {
       "name": "space-name-2",
@@ -72,7 +77,13 @@ var _ = Describe("Spaces", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(spaces).To(ConsistOf(
-					Space{Name: "space-name-1", GUID: "space-guid-1"},
+					Space{
+						Name: "space-name-1",
+						GUID: "space-guid-1",
+						Relationships: Relationships{
+							OrganizationRelationship: Relationship{GUID: "org-guid-1"},
+						},
+					},
 					Space{Name: "space-name-2", GUID: "space-guid-2"},
 					Space{Name: "space-name-3", GUID: "space-guid-3"},
 				))
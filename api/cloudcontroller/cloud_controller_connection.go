@@ -99,6 +99,11 @@ func (connection *CloudControllerConnection) populateResponse(response *http.Res
 		passedResponse.ResourceLocationURL = resourceLocationURL
 	}
 
+	if passedResponse.StreamBody && response.StatusCode < 400 {
+		passedResponse.Body = response.Body
+		return nil
+	}
+
 	rawBytes, err := ioutil.ReadAll(response.Body)
 	defer response.Body.Close()
 	if err != nil {
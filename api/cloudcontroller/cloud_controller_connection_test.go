@@ -2,6 +2,7 @@ package cloudcontroller_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"runtime"
 	"strings"
@@ -109,6 +110,21 @@ var _ = Describe("Cloud Controller Connection", func() {
 
 				Expect(response.HTTPResponse.Status).To(Equal("200 OK"))
 			})
+
+			Context("when StreamBody is set", func() {
+				It("leaves the body unread in Body", func() {
+					response := Response{StreamBody: true}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.RawResponse).To(BeEmpty())
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Body.Close()).To(Succeed())
+					Expect(string(body)).To(Equal("{}"))
+				})
+			})
 		})
 
 		Describe("Response Headers", func() {
@@ -275,6 +291,20 @@ var _ = Describe("Cloud Controller Connection", func() {
 
 					Expect(server.ReceivedRequests()).To(HaveLen(1))
 				})
+
+				Context("when StreamBody is set", func() {
+					It("reads the body into the error", func() {
+						req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+						Expect(err).ToNot(HaveOccurred())
+						request := &Request{Request: req}
+
+						response := Response{StreamBody: true}
+						err = connection.Make(request, &response)
+						Expect(err).To(BeAssignableToTypeOf(ccerror.RawHTTPStatusError{}))
+						Expect(err.(ccerror.RawHTTPStatusError).RawResponse).To(Equal([]byte(ccResponse)))
+						Expect(response.Body).To(BeNil())
+					})
+				})
 			})
 		})
 	})
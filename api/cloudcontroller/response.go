@@ -1,6 +1,9 @@
 package cloudcontroller
 
-import "net/http"
+import (
+	"io"
+	"net/http"
+)
 
 // Response represents a Cloud Controller response object.
 type Response struct {
@@ -20,10 +23,20 @@ type Response struct {
 
 	// ResourceLocationURL represents the Location header value
 	ResourceLocationURL string
+
+	// StreamBody, when set before making the request, leaves the body of a
+	// successful response unread in Body instead of reading it into
+	// RawResponse.
+	StreamBody bool
+
+	// Body is the unread body of a successful response when StreamBody is
+	// set. The caller must close it.
+	Body io.ReadCloser
 }
 
 func (r *Response) reset() {
 	r.RawResponse = []byte{}
 	r.Warnings = []string{}
 	r.HTTPResponse = nil
+	r.Body = nil
 }
@@ -0,0 +1,43 @@
+package wrapper
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/targetsafety"
+)
+
+// ProtectedTarget is a wrapper that asks for confirmation before the first
+// request that could modify resources is sent to the Cloud Controller.
+type ProtectedTarget struct {
+	confirm    func() error
+	confirmed  bool
+	connection cloudcontroller.Connection
+}
+
+// NewProtectedTarget returns a pointer to a ProtectedTarget wrapper. confirm
+// returns an error when the user does not confirm the change.
+func NewProtectedTarget(confirm func() error) *ProtectedTarget {
+	return &ProtectedTarget{
+		confirm: confirm,
+	}
+}
+
+// Wrap sets the connection in the ProtectedTarget and returns itself.
+func (protected *ProtectedTarget) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	protected.connection = innerconnection
+	return protected
+}
+
+// Make confirms POST, PUT, PATCH and DELETE requests that could modify
+// resources until one of them is confirmed, and returns the confirmation
+// error without sending the request when it is not.
+func (protected *ProtectedTarget) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	if !protected.confirmed && targetsafety.IsMutatingRequest(request.Method, request.URL.Path) {
+		err := protected.confirm()
+		if err != nil {
+			return err
+		}
+		protected.confirmed = true
+	}
+
+	return protected.connection.Make(request, passedResponse)
+}
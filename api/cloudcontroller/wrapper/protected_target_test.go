@@ -0,0 +1,75 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Protected Target", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		confirmCalls   int
+		confirmErr     error
+		wrapper        cloudcontroller.Connection
+	)
+
+	makeRequestTo := func(method string, path string) error {
+		req, err := http.NewRequest(method, "https://api.example.com"+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return wrapper.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})
+	}
+
+	makeRequest := func(method string) error {
+		return makeRequestTo(method, "/v2/apps")
+	}
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		confirmCalls = 0
+		confirmErr = nil
+		wrapper = NewProtectedTarget(func() error {
+			confirmCalls++
+			return confirmErr
+		}).Wrap(fakeConnection)
+	})
+
+	It("sends GET requests without confirmation", func() {
+		Expect(makeRequest(http.MethodGet)).To(Succeed())
+		Expect(confirmCalls).To(Equal(0))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	It("sends resource matching requests without confirmation", func() {
+		Expect(makeRequestTo(http.MethodPut, "/v2/resource_match")).To(Succeed())
+		Expect(confirmCalls).To(Equal(0))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	Context("when the change is confirmed", func() {
+		It("asks once and sends the requests", func() {
+			Expect(makeRequest(http.MethodPost)).To(Succeed())
+			Expect(makeRequest(http.MethodDelete)).To(Succeed())
+
+			Expect(confirmCalls).To(Equal(1))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when the change is not confirmed", func() {
+		BeforeEach(func() {
+			confirmErr = errors.New("not confirmed")
+		})
+
+		It("returns the error without sending the request", func() {
+			Expect(makeRequest(http.MethodPut)).To(MatchError(confirmErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,38 @@
+package wrapper
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/targetsafety"
+)
+
+// ReadOnly is a wrapper that rejects requests that could modify resources
+// before they are sent to the Cloud Controller.
+type ReadOnly struct {
+	connection cloudcontroller.Connection
+}
+
+// NewReadOnly returns a pointer to a ReadOnly wrapper.
+func NewReadOnly() *ReadOnly {
+	return new(ReadOnly)
+}
+
+// Wrap sets the connection in the ReadOnly and returns itself.
+func (readOnly *ReadOnly) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	readOnly.connection = innerconnection
+	return readOnly
+}
+
+// Make returns a ReadOnlyError for POST, PUT, PATCH and DELETE requests that
+// could modify resources and passes any other request through to the inner
+// connection.
+func (readOnly *ReadOnly) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	if targetsafety.IsMutatingRequest(request.Method, request.URL.Path) {
+		return ccerror.ReadOnlyError{
+			Method: request.Method,
+			URL:    request.URL.String(),
+		}
+	}
+
+	return readOnly.connection.Make(request, passedResponse)
+}
@@ -0,0 +1,66 @@
+package wrapper_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Read Only", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		wrapper        cloudcontroller.Connection
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		wrapper = NewReadOnly().Wrap(fakeConnection)
+	})
+
+	DescribeTable("Make",
+		func(method string, sent bool) {
+			req, err := http.NewRequest(method, "https://api.example.com/v2/apps", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request := cloudcontroller.NewRequest(req, nil)
+			response := &cloudcontroller.Response{}
+
+			makeErr := wrapper.Make(request, response)
+
+			if sent {
+				Expect(makeErr).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				passedRequest, passedResponse := fakeConnection.MakeArgsForCall(0)
+				Expect(passedRequest).To(Equal(request))
+				Expect(passedResponse).To(Equal(response))
+			} else {
+				Expect(makeErr).To(MatchError(ccerror.ReadOnlyError{
+					Method: method,
+					URL:    "https://api.example.com/v2/apps",
+				}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+			}
+		},
+
+		Entry("GET requests are sent", http.MethodGet, true),
+		Entry("HEAD requests are sent", http.MethodHead, true),
+		Entry("POST requests are rejected", http.MethodPost, false),
+		Entry("PUT requests are rejected", http.MethodPut, false),
+		Entry("PATCH requests are rejected", http.MethodPatch, false),
+		Entry("DELETE requests are rejected", http.MethodDelete, false),
+	)
+
+	It("sends resource matching requests", func() {
+		req, err := http.NewRequest(http.MethodPut, "https://api.example.com/v2/resource_match", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(wrapper.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})).To(Succeed())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+})
@@ -0,0 +1,81 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/cassette"
+)
+
+// CassetteName is the name of the cassette in a record or replay directory
+// that holds the Cloud Controller interactions.
+const CassetteName = "cloud_controller.jsonl"
+
+// RequestRecorder is the wrapper that records requests to and responses from
+// the Cloud Controller to a cassette.
+type RequestRecorder struct {
+	connection cloudcontroller.Connection
+	recorder   *cassette.Recorder
+}
+
+// NewRequestRecorder returns a pointer to a RequestRecorder wrapper that
+// records to the cassette in the provided directory.
+func NewRequestRecorder(cassetteDir string) *RequestRecorder {
+	return &RequestRecorder{
+		recorder: cassette.NewRecorder(filepath.Join(cassetteDir, CassetteName)),
+	}
+}
+
+// Wrap sets the connection on the RequestRecorder and returns itself.
+func (recorder *RequestRecorder) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// Make records the request and the response it received. Requests that did
+// not receive a response are not recorded. A streamed response body is read
+// in full so that it can be recorded.
+func (recorder *RequestRecorder) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	body, err := readMatchedBody(request)
+	if err != nil {
+		return err
+	}
+
+	err = recorder.connection.Make(request, passedResponse)
+
+	rawResponse := passedResponse.RawResponse
+	if passedResponse.Body != nil {
+		var readErr error
+		rawResponse, readErr = ioutil.ReadAll(passedResponse.Body)
+		passedResponse.Body.Close()
+		if readErr != nil {
+			return readErr
+		}
+		passedResponse.Body = ioutil.NopCloser(bytes.NewReader(rawResponse))
+	}
+
+	if passedResponse.HTTPResponse != nil {
+		interaction := cassette.NewInteraction(request.Request, body, passedResponse.HTTPResponse, rawResponse)
+		if recordErr := recorder.recorder.Record(interaction); recordErr != nil && err == nil {
+			return recordErr
+		}
+	}
+
+	return err
+}
+
+// readMatchedBody returns the body of requests whose body is recorded, and
+// rewinds it so that it can still be sent.
+func readMatchedBody(request *cloudcontroller.Request) ([]byte, error) {
+	if request.Body == nil || !cassette.HasMatchedBody(request.Header.Get("Content-Type")) {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	return body, request.ResetBody()
+}
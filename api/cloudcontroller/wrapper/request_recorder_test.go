@@ -0,0 +1,118 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/util/cassette"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Recorder", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		cassetteDir    string
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "request-recorder-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		wrapper = NewRequestRecorder(cassetteDir).Wrap(fakeConnection)
+
+		body := bytes.NewReader([]byte(`{"name":"some-app"}`))
+		req, err := http.NewRequest(http.MethodPost, "https://api.example.com/v2/apps", body)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "bearer some-token")
+		request = cloudcontroller.NewRequest(req, body)
+
+		response = &cloudcontroller.Response{}
+		fakeConnection.MakeStub = func(req *cloudcontroller.Request, resp *cloudcontroller.Response) error {
+			sent, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sent).To(Equal([]byte(`{"name":"some-app"}`)))
+
+			resp.HTTPResponse = &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}}
+			resp.RawResponse = []byte("some-response-body")
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	It("sends the request and records it with its response", func() {
+		Expect(makeErr).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+		recorded, err := ioutil.ReadFile(filepath.Join(cassetteDir, CassetteName))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(recorded)).To(ContainSubstring(`"url":"https://api.example.com/v2/apps"`))
+		Expect(string(recorded)).To(ContainSubstring(`"body":"{\"name\":\"some-app\"}"`))
+		Expect(string(recorded)).To(ContainSubstring(`"Authorization":["` + cassette.RedactedValue + `"]`))
+		Expect(string(recorded)).To(ContainSubstring(`"status_code":201`))
+		Expect(string(recorded)).To(ContainSubstring(`"body":"some-response-body"`))
+	})
+
+	Context("when the response body is streamed", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, resp *cloudcontroller.Response) error {
+				resp.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+				resp.Body = ioutil.NopCloser(bytes.NewReader([]byte("some-streamed-body")))
+				return nil
+			}
+		})
+
+		It("records the body and still returns it to the caller", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+
+			recorded, err := ioutil.ReadFile(filepath.Join(cassetteDir, CassetteName))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(recorded)).To(ContainSubstring(`"body":"some-streamed-body"`))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal("some-streamed-body"))
+		})
+	})
+
+	Context("when the request does not receive a response", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-connection-error")
+			fakeConnection.MakeStub = nil
+			fakeConnection.MakeReturns(expectedErr)
+		})
+
+		It("returns the error and records nothing", func() {
+			Expect(makeErr).To(MatchError(expectedErr))
+			_, err := os.Stat(filepath.Join(cassetteDir, CassetteName))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
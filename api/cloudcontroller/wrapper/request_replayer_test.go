@@ -0,0 +1,107 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/util/cassette"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func fakeConnectionReturning(httpResponse *http.Response, body string) cloudcontroller.Connection {
+	fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+	fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+		passedResponse.HTTPResponse = httpResponse
+		passedResponse.RawResponse = []byte(body)
+		return nil
+	}
+	return fakeConnection
+}
+
+var _ = Describe("Request Replayer", func() {
+	var (
+		cassetteDir string
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "request-replayer-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		recordedRequest, err := http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps/some-guid", nil)
+		Expect(err).ToNot(HaveOccurred())
+		recordedResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"X-Cf-Warnings": {"some-warning"}},
+		}
+		recorder := NewRequestRecorder(cassetteDir).Wrap(fakeConnectionReturning(recordedResponse, `{"metadata":{"guid":"some-guid"}}`))
+		Expect(recorder.Make(cloudcontroller.NewRequest(recordedRequest, nil), &cloudcontroller.Response{})).To(Succeed())
+
+		wrapper = NewRequestReplayer(cassetteDir).Wrap(cloudcontroller.NewConnection(cloudcontroller.Config{}))
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps/some-guid", nil)
+		Expect(err).ToNot(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+		response = &cloudcontroller.Response{}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	It("returns the recorded response without contacting the Cloud Controller", func() {
+		Expect(makeErr).ToNot(HaveOccurred())
+		Expect(response.RawResponse).To(MatchJSON(`{"metadata":{"guid":"some-guid"}}`))
+		Expect(response.Warnings).To(ConsistOf("some-warning"))
+		Expect(request.URL.String()).To(Equal("https://api.example.com/v2/apps/some-guid"))
+	})
+
+	Context("when the request was not recorded", func() {
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodDelete, "https://api.example.com/v2/apps/some-guid", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+		})
+
+		It("returns an UnmatchedRequestError", func() {
+			Expect(makeErr).To(BeAssignableToTypeOf(cassette.UnmatchedRequestError{}))
+		})
+	})
+
+	Context("when the recorded response is an error", func() {
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps/some-other-guid", nil)
+			Expect(err).ToNot(HaveOccurred())
+			recorder := NewRequestRecorder(cassetteDir).Wrap(fakeConnectionReturning(&http.Response{StatusCode: http.StatusNotFound}, `{"code":100004}`))
+			Expect(recorder.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})).To(Succeed())
+
+			req, err = http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps/some-other-guid", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+		})
+
+		It("returns the same error as the live response", func() {
+			Expect(makeErr).To(MatchError(ccerror.RawHTTPStatusError{
+				StatusCode:  http.StatusNotFound,
+				RawResponse: []byte(`{"code":100004}`),
+			}))
+		})
+	})
+})
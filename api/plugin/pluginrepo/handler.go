@@ -0,0 +1,62 @@
+package pluginrepo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	listPath     = "/list"
+	binariesPath = "/bin/"
+)
+
+type handler struct {
+	repository *Repository
+	binaries   http.Handler
+}
+
+// NewHandler returns an http.Handler that serves the repository's index at
+// /list and its plugin binaries under /bin/. The index is rebuilt on every
+// request, so binaries added to the directory are picked up without a
+// restart.
+func NewHandler(repository *Repository) http.Handler {
+	return handler{
+		repository: repository,
+		binaries:   http.StripPrefix(binariesPath, http.FileServer(http.Dir(repository.Dir))),
+	}
+}
+
+func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == listPath:
+		h.serveIndex(w, r)
+	case strings.HasPrefix(r.URL.Path, binariesPath):
+		h.binaries.ServeHTTP(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h handler) serveIndex(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	index, err := h.repository.Index(fmt.Sprintf("%s://%s%s", scheme, r.Host, binariesPath))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(index)
+}
@@ -0,0 +1,80 @@
+package pluginrepo_test
+
+import (
+	"debug/elf"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	. "code.cloudfoundry.org/cli/api/plugin/pluginrepo"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		repoDir  string
+		server   *httptest.Server
+		checksum string
+		contents []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+		repoDir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+
+		contents = elfBinary(elf.EM_X86_64)
+		checksum = writeFile(filepath.Join(repoDir, "plugin-1", "1.0.0", "plugin-1-linux64"), contents)
+
+		server = httptest.NewServer(NewHandler(NewRepository(repoDir)))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(repoDir)).To(Succeed())
+	})
+
+	It("serves an index that the plugin client can consume", func() {
+		client := plugin.NewClient(plugin.Config{AppName: "CF CLI API Plugin Repo Test", AppVersion: "Unknown"})
+		repository, err := client.GetPluginRepository(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repository.Plugins).To(ConsistOf(plugin.Plugin{
+			Name:    "plugin-1",
+			Version: "1.0.0",
+			Binaries: []plugin.PluginBinary{
+				{Platform: "linux64", URL: server.URL + "/bin/plugin-1/1.0.0/plugin-1-linux64", Checksum: checksum},
+			},
+		}))
+	})
+
+	It("serves the plugin binaries", func() {
+		response, err := http.Get(server.URL + "/bin/plugin-1/1.0.0/plugin-1-linux64")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(Equal(contents))
+	})
+
+	It("does not serve files outside of the repository directory", func() {
+		response, err := http.Get(server.URL + "/bin/../../etc/passwd")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("rejects writes to the index", func() {
+		response, err := http.Post(server.URL+"/list", "application/json", nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -0,0 +1,13 @@
+package pluginrepo_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPluginRepo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin Repo Suite")
+}
@@ -0,0 +1,205 @@
+// Package pluginrepo builds and serves a CF CLI plugin repository from a
+// directory of plugin binaries, so that teams without access to the public
+// plugin repository can host their own.
+//
+// The directory is expected to be laid out as:
+//
+//	DIR/PLUGIN_NAME/VERSION/BINARY
+//
+// with one binary per platform in each version directory. An optional
+// DIR/PLUGIN_NAME/description.txt provides the plugin's description.
+package pluginrepo
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/generic"
+	"github.com/blang/semver"
+)
+
+const descriptionFile = "description.txt"
+
+// Repository is a directory of plugin binaries.
+type Repository struct {
+	Dir string
+}
+
+// NewRepository returns a Repository for the provided directory.
+func NewRepository(dir string) *Repository {
+	return &Repository{Dir: dir}
+}
+
+// Index returns the plugin repository index for the directory. Only the newest
+// version of each plugin is listed. Binary URLs are relative to binariesURL.
+// Files that are not executables for a platform supported by the CLI are
+// ignored.
+func (repo Repository) Index(binariesURL string) (plugin.PluginRepository, error) {
+	baseURL, err := url.Parse(binariesURL)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	pluginDirs, err := ioutil.ReadDir(repo.Dir)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	index := plugin.PluginRepository{Plugins: []plugin.Plugin{}}
+	for _, pluginDir := range pluginDirs {
+		if !pluginDir.IsDir() {
+			continue
+		}
+
+		pluginInfo, found, err := repo.newestPlugin(pluginDir.Name(), baseURL)
+		if err != nil {
+			return plugin.PluginRepository{}, err
+		}
+		if found {
+			index.Plugins = append(index.Plugins, pluginInfo)
+		}
+	}
+
+	return index, nil
+}
+
+func (repo Repository) newestPlugin(name string, baseURL *url.URL) (plugin.Plugin, bool, error) {
+	pluginPath := filepath.Join(repo.Dir, name)
+
+	entries, err := ioutil.ReadDir(pluginPath)
+	if err != nil {
+		return plugin.Plugin{}, false, err
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i int, j int) bool {
+		return versionLessThan(versions[j], versions[i])
+	})
+
+	for _, version := range versions {
+		binaries, err := repo.binaries(name, version, baseURL)
+		if err != nil {
+			return plugin.Plugin{}, false, err
+		}
+		if len(binaries) == 0 {
+			continue
+		}
+
+		description, err := ioutil.ReadFile(filepath.Join(pluginPath, descriptionFile))
+		if err != nil && !os.IsNotExist(err) {
+			return plugin.Plugin{}, false, err
+		}
+
+		return plugin.Plugin{
+			Name:        name,
+			Description: strings.TrimSpace(string(description)),
+			Version:     version,
+			Binaries:    binaries,
+		}, true, nil
+	}
+
+	return plugin.Plugin{}, false, nil
+}
+
+func (repo Repository) binaries(name string, version string, baseURL *url.URL) ([]plugin.PluginBinary, error) {
+	versionPath := filepath.Join(repo.Dir, name, version)
+
+	files, err := ioutil.ReadDir(versionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var binaries []plugin.PluginBinary
+	for _, file := range files {
+		if !file.Mode().IsRegular() {
+			continue
+		}
+
+		binaryPath := filepath.Join(versionPath, file.Name())
+		platform := GetPlatform(binaryPath)
+		if platform == "" {
+			continue
+		}
+
+		checksum, err := util.NewSha1Checksum(binaryPath).ComputeFileSha1()
+		if err != nil {
+			return nil, err
+		}
+
+		binaryURL := *baseURL
+		binaryURL.Path = path.Join(baseURL.Path, name, version, file.Name())
+
+		binaries = append(binaries, plugin.PluginBinary{
+			Platform: platform,
+			URL:      binaryURL.String(),
+			Checksum: fmt.Sprintf("%x", checksum),
+		})
+	}
+
+	return binaries, nil
+}
+
+// GetPlatform returns the platform string, as used by plugin repositories,
+// of the executable at binaryPath. An empty string is returned if the file is
+// not an executable for a platform supported by the CLI.
+func GetPlatform(binaryPath string) string {
+	if file, err := elf.Open(binaryPath); err == nil {
+		defer file.Close()
+		switch file.Machine {
+		case elf.EM_X86_64:
+			return generic.GeneratePlatform("linux", "amd64")
+		case elf.EM_386:
+			return generic.GeneratePlatform("linux", "386")
+		}
+		return ""
+	}
+
+	if file, err := pe.Open(binaryPath); err == nil {
+		defer file.Close()
+		switch file.Machine {
+		case pe.IMAGE_FILE_MACHINE_AMD64:
+			return generic.GeneratePlatform("windows", "amd64")
+		case pe.IMAGE_FILE_MACHINE_I386:
+			return generic.GeneratePlatform("windows", "386")
+		}
+		return ""
+	}
+
+	if file, err := macho.Open(binaryPath); err == nil {
+		file.Close()
+		return generic.GeneratePlatform("darwin", "amd64")
+	}
+
+	if file, err := macho.OpenFat(binaryPath); err == nil {
+		file.Close()
+		return generic.GeneratePlatform("darwin", "amd64")
+	}
+
+	return ""
+}
+
+func versionLessThan(version1 string, version2 string) bool {
+	v1, err1 := semver.ParseTolerant(version1)
+	v2, err2 := semver.ParseTolerant(version2)
+	if err1 != nil || err2 != nil {
+		return version1 < version2
+	}
+
+	return v1.LT(v2)
+}
@@ -0,0 +1,166 @@
+package pluginrepo_test
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	. "code.cloudfoundry.org/cli/api/plugin/pluginrepo"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Repository", func() {
+	var (
+		repoDir    string
+		repository *Repository
+	)
+
+	BeforeEach(func() {
+		var err error
+		repoDir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+
+		repository = NewRepository(repoDir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(repoDir)).To(Succeed())
+	})
+
+	Describe("Index", func() {
+		Context("when the directory contains plugins", func() {
+			var linuxChecksum, windowsChecksum, osxChecksum string
+
+			BeforeEach(func() {
+				writeFile(filepath.Join(repoDir, "plugin-1", "description.txt"), []byte("useful plugin\n"))
+				writeFile(filepath.Join(repoDir, "plugin-1", "1.0.0", "plugin-1-linux64"), elfBinary(elf.EM_X86_64))
+				linuxChecksum = writeFile(filepath.Join(repoDir, "plugin-1", "1.10.0", "plugin-1-linux64"), elfBinary(elf.EM_X86_64))
+				windowsChecksum = writeFile(filepath.Join(repoDir, "plugin-1", "1.10.0", "plugin-1-win32.exe"), peBinary(pe.IMAGE_FILE_MACHINE_I386))
+				writeFile(filepath.Join(repoDir, "plugin-1", "1.10.0", "README"), []byte("not a binary"))
+				osxChecksum = writeFile(filepath.Join(repoDir, "plugin-2", "0.1.0", "plugin-2-osx"), machoBinary())
+			})
+
+			It("lists the newest version of each plugin with its binaries", func() {
+				index, err := repository.Index("http://example.com/bin/")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(index).To(Equal(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:        "plugin-1",
+							Description: "useful plugin",
+							Version:     "1.10.0",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "linux64", URL: "http://example.com/bin/plugin-1/1.10.0/plugin-1-linux64", Checksum: linuxChecksum},
+								{Platform: "win32", URL: "http://example.com/bin/plugin-1/1.10.0/plugin-1-win32.exe", Checksum: windowsChecksum},
+							},
+						},
+						{
+							Name:     "plugin-2",
+							Version:  "0.1.0",
+							Binaries: []plugin.PluginBinary{{Platform: "osx", URL: "http://example.com/bin/plugin-2/0.1.0/plugin-2-osx", Checksum: osxChecksum}},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when a plugin has no binaries", func() {
+			BeforeEach(func() {
+				writeFile(filepath.Join(repoDir, "plugin-1", "1.0.0", "README"), []byte("not a binary"))
+			})
+
+			It("omits the plugin", func() {
+				index, err := repository.Index("http://example.com/bin/")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(index.Plugins).To(BeEmpty())
+			})
+		})
+
+		Context("when the directory does not exist", func() {
+			BeforeEach(func() {
+				repository = NewRepository(filepath.Join(repoDir, "missing"))
+			})
+
+			It("returns the error", func() {
+				_, err := repository.Index("http://example.com/bin/")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("GetPlatform", func() {
+		DescribeTable("returns the platform of the binary",
+			func(contents []byte, expectedPlatform string) {
+				binaryPath := filepath.Join(repoDir, "binary")
+				writeFile(binaryPath, contents)
+				Expect(GetPlatform(binaryPath)).To(Equal(expectedPlatform))
+			},
+
+			Entry("linux 64 bit", elfBinary(elf.EM_X86_64), "linux64"),
+			Entry("linux 32 bit", elfBinary(elf.EM_386), "linux32"),
+			Entry("linux arm", elfBinary(elf.EM_ARM), ""),
+			Entry("windows 64 bit", peBinary(pe.IMAGE_FILE_MACHINE_AMD64), "win64"),
+			Entry("windows 32 bit", peBinary(pe.IMAGE_FILE_MACHINE_I386), "win32"),
+			Entry("osx", machoBinary(), "osx"),
+			Entry("not a binary", []byte("#!/bin/sh"), ""),
+		)
+	})
+})
+
+func writeFile(path string, contents []byte) string {
+	Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+	Expect(ioutil.WriteFile(path, contents, 0755)).To(Succeed())
+	return fmt.Sprintf("%x", sha1.Sum(contents))
+}
+
+func elfBinary(machine elf.Machine) []byte {
+	header := elf.Header64{
+		Type:    uint16(elf.ET_EXEC),
+		Machine: uint16(machine),
+		Version: uint32(elf.EV_CURRENT),
+		Ehsize:  64,
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+
+	buffer := &bytes.Buffer{}
+	_ = binary.Write(buffer, binary.LittleEndian, header)
+	return buffer.Bytes()
+}
+
+func peBinary(machine uint16) []byte {
+	dosHeader := make([]byte, 64)
+	copy(dosHeader, "MZ")
+	binary.LittleEndian.PutUint32(dosHeader[0x3c:], uint32(len(dosHeader)))
+
+	buffer := bytes.NewBuffer(dosHeader)
+	buffer.WriteString("PE\x00\x00")
+	_ = binary.Write(buffer, binary.LittleEndian, pe.FileHeader{Machine: machine})
+	// debug/pe reads a little past the file header even without sections
+	buffer.Write(make([]byte, 8))
+	return buffer.Bytes()
+}
+
+func machoBinary() []byte {
+	buffer := &bytes.Buffer{}
+	_ = binary.Write(buffer, binary.LittleEndian, macho.FileHeader{
+		Magic: macho.Magic64,
+		Cpu:   macho.CpuAmd64,
+		Type:  macho.TypeExec,
+	})
+	// 64 bit headers are padded with a reserved word
+	_ = binary.Write(buffer, binary.LittleEndian, uint32(0))
+	return buffer.Bytes()
+}
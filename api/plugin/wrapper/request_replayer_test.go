@@ -0,0 +1,61 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/util/cassette"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Recorder and Replayer", func() {
+	var (
+		cassetteDir string
+
+		newRequest func() *http.Request
+	)
+
+	BeforeEach(func() {
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "request-replayer-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		newRequest = func() *http.Request {
+			request, err := http.NewRequest(http.MethodGet, "https://plugins.example.com/list", nil)
+			Expect(err).ToNot(HaveOccurred())
+			return request
+		}
+
+		fakeConnection := new(pluginfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *plugin.Response, _ plugin.ProxyReader) error {
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+			passedResponse.RawResponse = []byte(`{"plugins":[]}`)
+			return nil
+		}
+
+		recorder := NewRequestRecorder(cassetteDir).Wrap(fakeConnection)
+		Expect(recorder.Make(newRequest(), &plugin.Response{}, nil)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	It("replays the recorded response for a matching request", func() {
+		replayer := NewRequestReplayer(cassetteDir).Wrap(plugin.NewConnection(false, time.Second))
+
+		response := plugin.Response{}
+		Expect(replayer.Make(newRequest(), &response, nil)).To(Succeed())
+		Expect(response.RawResponse).To(MatchJSON(`{"plugins":[]}`))
+
+		err := replayer.Make(newRequest(), &plugin.Response{}, nil)
+		Expect(err).To(BeAssignableToTypeOf(cassette.UnmatchedRequestError{}))
+	})
+})
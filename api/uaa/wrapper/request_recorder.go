@@ -0,0 +1,73 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/cassette"
+)
+
+// CassetteName is the name of the cassette in a record or replay directory
+// that holds the UAA interactions.
+const CassetteName = "uaa.jsonl"
+
+// RequestRecorder is the wrapper that records requests to and responses from
+// the UAA to a cassette.
+type RequestRecorder struct {
+	connection uaa.Connection
+	recorder   *cassette.Recorder
+}
+
+// NewRequestRecorder returns a pointer to a RequestRecorder wrapper that
+// records to the cassette in the provided directory.
+func NewRequestRecorder(cassetteDir string) *RequestRecorder {
+	return &RequestRecorder{
+		recorder: cassette.NewRecorder(filepath.Join(cassetteDir, CassetteName)),
+	}
+}
+
+// Wrap sets the connection on the RequestRecorder and returns itself.
+func (recorder *RequestRecorder) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// Make records the request and the response it received. Requests that did
+// not receive a response are not recorded.
+func (recorder *RequestRecorder) Make(request *http.Request, passedResponse *uaa.Response) error {
+	body, err := readMatchedBody(request)
+	if err != nil {
+		return err
+	}
+
+	err = recorder.connection.Make(request, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		interaction := cassette.NewInteraction(request, body, passedResponse.HTTPResponse, passedResponse.RawResponse)
+		if recordErr := recorder.recorder.Record(interaction); recordErr != nil && err == nil {
+			return recordErr
+		}
+	}
+
+	return err
+}
+
+// readMatchedBody returns the body of requests whose body is recorded, and
+// replaces it so that it can still be sent.
+func readMatchedBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || !cassette.HasMatchedBody(request.Header.Get("Content-Type")) {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	defer request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	return body, nil
+}
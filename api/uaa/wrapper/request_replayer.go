@@ -0,0 +1,53 @@
+package wrapper
+
+import (
+	"net/http"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/cassette"
+)
+
+// RequestReplayer is the wrapper that answers UAA requests with the responses
+// recorded in a cassette instead of contacting the UAA.
+type RequestReplayer struct {
+	connection uaa.Connection
+	player     *cassette.Player
+}
+
+// NewRequestReplayer returns a pointer to a RequestReplayer wrapper that
+// replays the cassette in the provided directory.
+func NewRequestReplayer(cassetteDir string) *RequestReplayer {
+	return &RequestReplayer{
+		player: cassette.NewPlayer(filepath.Join(cassetteDir, CassetteName)),
+	}
+}
+
+// Wrap sets the connection on the RequestReplayer and returns itself.
+func (replayer *RequestReplayer) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	replayer.connection = innerconnection
+	return replayer
+}
+
+// Make sends the request to the local server that serves the matching
+// recorded response. It returns a cassette.UnmatchedRequestError when no
+// recorded request matches.
+func (replayer *RequestReplayer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	body, err := readMatchedBody(request)
+	if err != nil {
+		return err
+	}
+
+	replayURL, err := replayer.player.Replay(request, body)
+	if err != nil {
+		return err
+	}
+
+	originalURL, originalHost := request.URL, request.Host
+	request.URL, request.Host = replayURL, replayURL.Host
+	defer func() {
+		request.URL, request.Host = originalURL, originalHost
+	}()
+
+	return replayer.connection.Make(request, passedResponse)
+}
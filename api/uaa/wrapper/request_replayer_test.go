@@ -0,0 +1,74 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/util/cassette"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Recorder and Replayer", func() {
+	var (
+		cassetteDir string
+
+		newTokenRequest func(password string) *http.Request
+	)
+
+	BeforeEach(func() {
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "request-replayer-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		newTokenRequest = func(password string) *http.Request {
+			request, err := http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", strings.NewReader("grant_type=password&password="+password))
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return request
+		}
+
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+			}
+			passedResponse.RawResponse = []byte(`{"access_token":"some-header.some-claims.some-signature","token_type":"bearer"}`)
+			return nil
+		}
+
+		recorder := NewRequestRecorder(cassetteDir).Wrap(fakeConnection)
+		Expect(recorder.Make(newTokenRequest("some-password"), &uaa.Response{})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	It("records the interaction without the password or the token signature", func() {
+		recorded, err := ioutil.ReadFile(filepath.Join(cassetteDir, CassetteName))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(recorded)).ToNot(ContainSubstring("some-password"))
+		Expect(string(recorded)).ToNot(ContainSubstring("some-signature"))
+	})
+
+	It("replays the recorded response for a matching request", func() {
+		replayer := NewRequestReplayer(cassetteDir).Wrap(uaa.NewConnection(false, time.Second))
+
+		response := uaa.Response{}
+		Expect(replayer.Make(newTokenRequest("some-other-password"), &response)).To(Succeed())
+		Expect(response.RawResponse).To(MatchJSON(`{"access_token":"some-header.some-claims.REDACTED","token_type":"bearer"}`))
+
+		err := replayer.Make(newTokenRequest("some-other-password"), &uaa.Response{})
+		Expect(err).To(BeAssignableToTypeOf(cassette.UnmatchedRequestError{}))
+	})
+})
@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/confighelpers"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/net"
@@ -119,6 +120,9 @@ func Main(traceEnv string, args []string) {
 		}
 
 		err = cmd.Execute(flagContext)
+		if exitErr, ok := err.(*cferrors.ExitStatusError); ok {
+			os.Exit(exitErr.ExitCode())
+		}
 		if err != nil {
 			deps.UI.Failed(err.Error())
 			os.Exit(1)
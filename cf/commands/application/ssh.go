@@ -3,7 +3,6 @@ package application
 import (
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"golang.org/x/crypto/ssh"
@@ -11,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/net"
@@ -165,7 +165,7 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 					"ExitCode": exitStatus,
 				}))
 			}
-			os.Exit(exitStatus)
+			return cferrors.NewExitStatusError(exitStatus)
 		} else {
 			return errors.New(T("Error: ") + err.Error())
 		}
@@ -41,6 +41,8 @@ type Data struct {
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+	Aliases                  map[string]string `json:",omitempty"`
+	TargetSafety             map[string]string `json:",omitempty"`
 }
 
 func NewData() *Data {
@@ -1,11 +1,13 @@
 package coreconfig
 
 import (
+	"os"
 	"strings"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/targetsafety"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -17,6 +19,7 @@ type ConfigRepository struct {
 	initOnce     *sync.Once
 	persistor    configuration.Persistor
 	onError      func(error)
+	envReadOnly  string
 }
 
 type CCInfo struct {
@@ -47,11 +50,12 @@ func NewRepositoryFromPersistor(persistor configuration.Persistor, errorHandler
 	}
 
 	return &ConfigRepository{
-		data:      data,
-		mutex:     new(sync.RWMutex),
-		initOnce:  new(sync.Once),
-		persistor: persistor,
-		onError:   errorHandler,
+		data:        data,
+		mutex:       new(sync.RWMutex),
+		initOnce:    new(sync.Once),
+		persistor:   persistor,
+		onError:     errorHandler,
+		envReadOnly: os.Getenv("CF_READ_ONLY"),
 	}
 }
 
@@ -95,6 +99,9 @@ type Reader interface {
 	Locale() string
 
 	PluginRepos() []models.PluginRepo
+
+	TargetSafety() string
+	ReadOnly() bool
 }
 
 //go:generate counterfeiter . ReadWriter
@@ -425,6 +432,23 @@ func (c *ConfigRepository) PluginRepos() (repos []models.PluginRepo) {
 	return
 }
 
+// TargetSafety returns the safety of the targeted API, either "read-only",
+// "protected" or empty.
+func (c *ConfigRepository) TargetSafety() (safety string) {
+	target := c.APIEndpoint()
+	c.read(func() {
+		safety = c.data.TargetSafety[target]
+	})
+	return
+}
+
+// ReadOnly returns true if requests that could modify resources must not be
+// sent, because $CF_READ_ONLY was set to true when the config was loaded or
+// the targeted API is read-only.
+func (c *ConfigRepository) ReadOnly() bool {
+	return targetsafety.ReadOnly(c.envReadOnly, c.TargetSafety())
+}
+
 // SETTERS
 
 func (c *ConfigRepository) ClearSession() {
@@ -127,6 +127,46 @@ var _ = Describe("Configuration Repository", func() {
 		})
 	})
 
+	Describe("ReadOnly", func() {
+		BeforeEach(func() {
+			persistor.LoadStub = func(data configuration.DataInterface) error {
+				data.(*coreconfig.Data).Target = "https://api.example.com"
+				data.(*coreconfig.Data).TargetSafety = map[string]string{"https://api.example.com": "read-only"}
+				return nil
+			}
+		})
+
+		It("returns true when the targeted API is read-only", func() {
+			Expect(config.ReadOnly()).To(BeTrue())
+		})
+
+		Context("when $CF_READ_ONLY is set to true", func() {
+			BeforeEach(func() {
+				persistor.LoadStub = nil
+				Expect(os.Setenv("CF_READ_ONLY", "true")).To(Succeed())
+				config = coreconfig.NewRepositoryFromPersistor(persistor, func(err error) { panic(err) })
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_READ_ONLY")).To(Succeed())
+			})
+
+			It("returns true", func() {
+				Expect(config.ReadOnly()).To(BeTrue())
+			})
+		})
+
+		Context("when the targeted API is not read-only", func() {
+			BeforeEach(func() {
+				persistor.LoadStub = nil
+			})
+
+			It("returns false", func() {
+				Expect(config.ReadOnly()).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("HasAPIEndpoint", func() {
 		Context("when both endpoint and version are set", func() {
 			BeforeEach(func() {
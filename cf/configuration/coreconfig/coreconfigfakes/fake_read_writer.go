@@ -318,6 +318,18 @@ type FakeReadWriter struct {
 	setCLIVersionArgsForCall []struct {
 		arg1 string
 	}
+	TargetSafetyStub        func() string
+	targetSafetyMutex       sync.RWMutex
+	targetSafetyArgsForCall []struct{}
+	targetSafetyReturns     struct {
+		result1 string
+	}
+	ReadOnlyStub        func() bool
+	readOnlyMutex       sync.RWMutex
+	readOnlyArgsForCall []struct{}
+	readOnlyReturns     struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1681,6 +1693,56 @@ func (fake *FakeReadWriter) SetCLIVersionArgsForCall(i int) string {
 	return fake.setCLIVersionArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) TargetSafety() string {
+	fake.targetSafetyMutex.Lock()
+	fake.targetSafetyArgsForCall = append(fake.targetSafetyArgsForCall, struct{}{})
+	fake.recordInvocation("TargetSafety", []interface{}{})
+	fake.targetSafetyMutex.Unlock()
+	if fake.TargetSafetyStub != nil {
+		return fake.TargetSafetyStub()
+	} else {
+		return fake.targetSafetyReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) TargetSafetyCallCount() int {
+	fake.targetSafetyMutex.RLock()
+	defer fake.targetSafetyMutex.RUnlock()
+	return len(fake.targetSafetyArgsForCall)
+}
+
+func (fake *FakeReadWriter) TargetSafetyReturns(result1 string) {
+	fake.TargetSafetyStub = nil
+	fake.targetSafetyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) ReadOnly() bool {
+	fake.readOnlyMutex.Lock()
+	fake.readOnlyArgsForCall = append(fake.readOnlyArgsForCall, struct{}{})
+	fake.recordInvocation("ReadOnly", []interface{}{})
+	fake.readOnlyMutex.Unlock()
+	if fake.ReadOnlyStub != nil {
+		return fake.ReadOnlyStub()
+	} else {
+		return fake.readOnlyReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) ReadOnlyCallCount() int {
+	fake.readOnlyMutex.RLock()
+	defer fake.readOnlyMutex.RUnlock()
+	return len(fake.readOnlyArgsForCall)
+}
+
+func (fake *FakeReadWriter) ReadOnlyReturns(result1 bool) {
+	fake.ReadOnlyStub = nil
+	fake.readOnlyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1794,6 +1856,10 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.unSetPluginRepoMutex.RUnlock()
 	fake.setCLIVersionMutex.RLock()
 	defer fake.setCLIVersionMutex.RUnlock()
+	fake.targetSafetyMutex.RLock()
+	defer fake.targetSafetyMutex.RUnlock()
+	fake.readOnlyMutex.RLock()
+	defer fake.readOnlyMutex.RUnlock()
 	return fake.invocations
 }
 
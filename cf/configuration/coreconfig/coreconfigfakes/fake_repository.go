@@ -318,9 +318,21 @@ type FakeRepository struct {
 	setCLIVersionArgsForCall []struct {
 		arg1 string
 	}
-	CloseStub        func()
-	closeMutex       sync.RWMutex
-	closeArgsForCall []struct{}
+	CloseStub               func()
+	closeMutex              sync.RWMutex
+	closeArgsForCall        []struct{}
+	TargetSafetyStub        func() string
+	targetSafetyMutex       sync.RWMutex
+	targetSafetyArgsForCall []struct{}
+	targetSafetyReturns     struct {
+		result1 string
+	}
+	ReadOnlyStub        func() bool
+	readOnlyMutex       sync.RWMutex
+	readOnlyArgsForCall []struct{}
+	readOnlyReturns     struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1700,6 +1712,56 @@ func (fake *FakeRepository) CloseCallCount() int {
 	return len(fake.closeArgsForCall)
 }
 
+func (fake *FakeRepository) TargetSafety() string {
+	fake.targetSafetyMutex.Lock()
+	fake.targetSafetyArgsForCall = append(fake.targetSafetyArgsForCall, struct{}{})
+	fake.recordInvocation("TargetSafety", []interface{}{})
+	fake.targetSafetyMutex.Unlock()
+	if fake.TargetSafetyStub != nil {
+		return fake.TargetSafetyStub()
+	} else {
+		return fake.targetSafetyReturns.result1
+	}
+}
+
+func (fake *FakeRepository) TargetSafetyCallCount() int {
+	fake.targetSafetyMutex.RLock()
+	defer fake.targetSafetyMutex.RUnlock()
+	return len(fake.targetSafetyArgsForCall)
+}
+
+func (fake *FakeRepository) TargetSafetyReturns(result1 string) {
+	fake.TargetSafetyStub = nil
+	fake.targetSafetyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) ReadOnly() bool {
+	fake.readOnlyMutex.Lock()
+	fake.readOnlyArgsForCall = append(fake.readOnlyArgsForCall, struct{}{})
+	fake.recordInvocation("ReadOnly", []interface{}{})
+	fake.readOnlyMutex.Unlock()
+	if fake.ReadOnlyStub != nil {
+		return fake.ReadOnlyStub()
+	} else {
+		return fake.readOnlyReturns.result1
+	}
+}
+
+func (fake *FakeRepository) ReadOnlyCallCount() int {
+	fake.readOnlyMutex.RLock()
+	defer fake.readOnlyMutex.RUnlock()
+	return len(fake.readOnlyArgsForCall)
+}
+
+func (fake *FakeRepository) ReadOnlyReturns(result1 bool) {
+	fake.ReadOnlyStub = nil
+	fake.readOnlyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1815,6 +1877,10 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.setCLIVersionMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.targetSafetyMutex.RLock()
+	defer fake.targetSafetyMutex.RUnlock()
+	fake.readOnlyMutex.RLock()
+	defer fake.readOnlyMutex.RUnlock()
 	return fake.invocations
 }
 
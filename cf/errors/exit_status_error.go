@@ -0,0 +1,21 @@
+package errors
+
+import . "code.cloudfoundry.org/cli/cf/i18n"
+
+// ExitStatusError is returned by a command that finished with an exit status
+// of its own, such as the exit status of a process run over SSH.
+type ExitStatusError struct {
+	Status int
+}
+
+func NewExitStatusError(status int) *ExitStatusError {
+	return &ExitStatusError{Status: status}
+}
+
+func (err *ExitStatusError) Error() string {
+	return T("Exited with status {{.Status}}", map[string]interface{}{"Status": err.Status})
+}
+
+func (err *ExitStatusError) ExitCode() int {
+	return err.Status
+}
@@ -0,0 +1,20 @@
+package errors
+
+import . "code.cloudfoundry.org/cli/cf/i18n"
+
+type ReadOnlyError struct {
+	Method string
+	URL    string
+}
+
+func NewReadOnlyError(method, url string) *ReadOnlyError {
+	return &ReadOnlyError{Method: method, URL: url}
+}
+
+func (err *ReadOnlyError) Error() string {
+	return T("Read-only mode is enabled, {{.Method}} {{.URL}} was not sent.",
+		map[string]interface{}{
+			"Method": err.Method,
+			"URL":    err.URL,
+		})
+}
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig/coreconfigfakes"
 	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
 	. "code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
@@ -86,4 +88,113 @@ var _ = Describe("Cloud Controller Gateway", func() {
 			Expect(gateway.DialTimeout).To(Equal(5 * time.Second))
 		})
 	})
+
+	Describe("target safety", func() {
+		var (
+			fakeConfig *coreconfigfakes.FakeRepository
+			fakeUI     *terminalfakes.FakeUI
+			ts         *httptest.Server
+			requests   int
+		)
+
+		BeforeEach(func() {
+			requests = 0
+			ts = httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				requests++
+				writer.WriteHeader(http.StatusOK)
+			}))
+			ts.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+
+			fakeConfig = new(coreconfigfakes.FakeRepository)
+			fakeConfig.APIEndpointReturns("https://api.prod.example.com")
+			fakeConfig.OrganizationFieldsReturns(models.OrganizationFields{Name: "some-org"})
+			fakeUI = new(terminalfakes.FakeUI)
+		})
+
+		JustBeforeEach(func() {
+			gateway = NewCloudControllerGateway(fakeConfig, time.Now, fakeUI, new(tracefakes.FakePrinter), timeout)
+			gateway.SetTrustedCerts(ts.TLS.Certificates)
+		})
+
+		AfterEach(func() {
+			ts.Close()
+		})
+
+		performRequestTo := func(method string, path string) error {
+			request, err := gateway.NewRequest(method, ts.URL+path, "TOKEN", nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = gateway.PerformRequest(request)
+			return err
+		}
+
+		performRequest := func(method string) error {
+			return performRequestTo(method, "/v2/apps")
+		}
+
+		Context("when the config is read-only", func() {
+			BeforeEach(func() {
+				fakeConfig.ReadOnlyReturns(true)
+			})
+
+			It("sends GET requests", func() {
+				Expect(performRequest("GET")).To(Succeed())
+				Expect(requests).To(Equal(1))
+			})
+
+			It("rejects requests that could modify resources without sending them", func() {
+				for _, method := range []string{"POST", "PUT", "PATCH", "DELETE"} {
+					err := performRequest(method)
+					Expect(err).To(Equal(errors.NewReadOnlyError(method, ts.URL+"/v2/apps")))
+				}
+				Expect(requests).To(Equal(0))
+			})
+
+			It("sends resource matching requests", func() {
+				Expect(performRequestTo("PUT", "/v2/resource_match")).To(Succeed())
+				Expect(requests).To(Equal(1))
+			})
+		})
+
+		Context("when the target is protected", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetSafetyReturns("protected")
+			})
+
+			It("does not ask for confirmation of GET requests", func() {
+				Expect(performRequest("GET")).To(Succeed())
+				Expect(fakeUI.AskCallCount()).To(Equal(0))
+			})
+
+			It("does not ask for confirmation of resource matching requests", func() {
+				Expect(performRequestTo("PUT", "/v2/resource_match")).To(Succeed())
+				Expect(fakeUI.AskCallCount()).To(Equal(0))
+			})
+
+			Context("when the org name is typed", func() {
+				BeforeEach(func() {
+					fakeUI.AskReturns("some-org")
+				})
+
+				It("asks once and sends the requests", func() {
+					Expect(performRequest("POST")).To(Succeed())
+					Expect(performRequest("DELETE")).To(Succeed())
+					Expect(requests).To(Equal(2))
+
+					Expect(fakeUI.AskCallCount()).To(Equal(1))
+					Expect(fakeUI.AskArgsForCall(0)).To(ContainSubstring("https://api.prod.example.com is a protected target. Type some-org to confirm the change"))
+				})
+			})
+
+			Context("when something else is typed", func() {
+				BeforeEach(func() {
+					fakeUI.AskReturns("other-org")
+				})
+
+				It("does not send the request", func() {
+					Expect(performRequest("POST")).To(MatchError("The change was not confirmed, no request was sent."))
+					Expect(requests).To(Equal(0))
+				})
+			})
+		})
+	})
 })
@@ -70,6 +70,7 @@ type Gateway struct {
 	ui              terminal.UI
 	logger          trace.Printer
 	DialTimeout     time.Duration
+	safety          *targetSafety
 }
 
 func (gateway *Gateway) AsyncTimeout() time.Duration {
@@ -359,6 +360,13 @@ func (gateway Gateway) waitForJob(jobURL, accessToken string, timeout time.Durat
 func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response, error) {
 	httpReq := request.HTTPReq
 
+	if gateway.safety != nil {
+		err := gateway.safety.check(httpReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if request.SeekableBody != nil {
 		httpReq.Body = ioutil.NopCloser(request.SeekableBody)
 	}
@@ -0,0 +1,64 @@
+package net
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/targetsafety"
+)
+
+// targetSafety rejects requests that could modify resources when the CLI is
+// in read-only mode, and asks for the org name before the first such request
+// to a protected target.
+type targetSafety struct {
+	config    coreconfig.Reader
+	ui        terminal.UI
+	confirmed bool
+}
+
+func newTargetSafety(config coreconfig.Reader, ui terminal.UI) *targetSafety {
+	return &targetSafety{
+		config: config,
+		ui:     ui,
+	}
+}
+
+func (safety *targetSafety) check(request *http.Request) error {
+	if !targetsafety.IsMutatingRequest(request.Method, request.URL.Path) {
+		return nil
+	}
+
+	if safety.config.ReadOnly() {
+		return errors.NewReadOnlyError(request.Method, request.URL.String())
+	}
+	if safety.config.TargetSafety() == targetsafety.ProtectedSafety {
+		return safety.confirm()
+	}
+	return nil
+}
+
+func (safety *targetSafety) confirm() error {
+	if safety.confirmed {
+		return nil
+	}
+
+	expected := safety.config.OrganizationFields().Name
+	if expected == "" {
+		expected = safety.config.APIEndpoint()
+	}
+
+	answer := safety.ui.Ask(T(targetsafety.ConfirmPrompt,
+		map[string]interface{}{
+			"Target":   safety.config.APIEndpoint(),
+			"Expected": expected,
+		}))
+	if answer != expected {
+		return errors.New(T("The change was not confirmed, no request was sent."))
+	}
+
+	safety.confirmed = true
+	return nil
+}
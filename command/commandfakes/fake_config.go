@@ -30,6 +30,15 @@ type FakeConfig struct {
 		name string
 		url  string
 	}
+	AliasesStub        func() map[string]string
+	aliasesMutex       sync.RWMutex
+	aliasesArgsForCall []struct{}
+	aliasesReturns     struct {
+		result1 map[string]string
+	}
+	aliasesReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
 	aPIVersionArgsForCall []struct{}
@@ -104,6 +113,19 @@ type FakeConfig struct {
 	experimentalReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	GetAliasStub        func(name string) (string, bool)
+	getAliasMutex       sync.RWMutex
+	getAliasArgsForCall []struct {
+		name string
+	}
+	getAliasReturns struct {
+		result1 string
+		result2 bool
+	}
+	getAliasReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
 	GetPluginStub        func(pluginName string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -220,6 +242,11 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RemoveAliasStub        func(name string)
+	removeAliasMutex       sync.RWMutex
+	removeAliasArgsForCall []struct {
+		name string
+	}
 	RemovePluginStub        func(string)
 	removePluginMutex       sync.RWMutex
 	removePluginArgsForCall []struct {
@@ -230,6 +257,12 @@ type FakeConfig struct {
 	setAccessTokenArgsForCall []struct {
 		token string
 	}
+	SetAliasStub        func(name string, command string)
+	setAliasMutex       sync.RWMutex
+	setAliasArgsForCall []struct {
+		name    string
+		command string
+	}
 	SetOrganizationInformationStub        func(guid string, name string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
@@ -378,6 +411,65 @@ type FakeConfig struct {
 	writePluginConfigReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IsTTYStub        func() bool
+	isTTYMutex       sync.RWMutex
+	isTTYArgsForCall []struct{}
+	isTTYReturns     struct {
+		result1 bool
+	}
+	isTTYReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RecordDirectoryStub        func() string
+	recordDirectoryMutex       sync.RWMutex
+	recordDirectoryArgsForCall []struct{}
+	recordDirectoryReturns     struct {
+		result1 string
+	}
+	recordDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ReplayDirectoryStub        func() string
+	replayDirectoryMutex       sync.RWMutex
+	replayDirectoryArgsForCall []struct{}
+	replayDirectoryReturns     struct {
+		result1 string
+	}
+	replayDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ProtectedTargetStub        func() bool
+	protectedTargetMutex       sync.RWMutex
+	protectedTargetArgsForCall []struct{}
+	protectedTargetReturns     struct {
+		result1 bool
+	}
+	protectedTargetReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	ReadOnlyStub        func() bool
+	readOnlyMutex       sync.RWMutex
+	readOnlyArgsForCall []struct{}
+	readOnlyReturns     struct {
+		result1 bool
+	}
+	readOnlyReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	SetTargetSafetyStub        func(safety configv3.TargetSafety)
+	setTargetSafetyMutex       sync.RWMutex
+	setTargetSafetyArgsForCall []struct {
+		safety configv3.TargetSafety
+	}
+	TargetSafetyStub        func() configv3.TargetSafety
+	targetSafetyMutex       sync.RWMutex
+	targetSafetyArgsForCall []struct{}
+	targetSafetyReturns     struct {
+		result1 configv3.TargetSafety
+	}
+	targetSafetyReturnsOnCall map[int]struct {
+		result1 configv3.TargetSafety
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -471,6 +563,46 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return fake.addPluginRepositoryArgsForCall[i].name, fake.addPluginRepositoryArgsForCall[i].url
 }
 
+func (fake *FakeConfig) Aliases() map[string]string {
+	fake.aliasesMutex.Lock()
+	ret, specificReturn := fake.aliasesReturnsOnCall[len(fake.aliasesArgsForCall)]
+	fake.aliasesArgsForCall = append(fake.aliasesArgsForCall, struct{}{})
+	fake.recordInvocation("Aliases", []interface{}{})
+	fake.aliasesMutex.Unlock()
+	if fake.AliasesStub != nil {
+		return fake.AliasesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.aliasesReturns.result1
+}
+
+func (fake *FakeConfig) AliasesCallCount() int {
+	fake.aliasesMutex.RLock()
+	defer fake.aliasesMutex.RUnlock()
+	return len(fake.aliasesArgsForCall)
+}
+
+func (fake *FakeConfig) AliasesReturns(result1 map[string]string) {
+	fake.AliasesStub = nil
+	fake.aliasesReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeConfig) AliasesReturnsOnCall(i int, result1 map[string]string) {
+	fake.AliasesStub = nil
+	if fake.aliasesReturnsOnCall == nil {
+		fake.aliasesReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.aliasesReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeConfig) APIVersion() string {
 	fake.aPIVersionMutex.Lock()
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
@@ -794,6 +926,57 @@ func (fake *FakeConfig) ExperimentalReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) GetAlias(name string) (string, bool) {
+	fake.getAliasMutex.Lock()
+	ret, specificReturn := fake.getAliasReturnsOnCall[len(fake.getAliasArgsForCall)]
+	fake.getAliasArgsForCall = append(fake.getAliasArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetAlias", []interface{}{name})
+	fake.getAliasMutex.Unlock()
+	if fake.GetAliasStub != nil {
+		return fake.GetAliasStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAliasReturns.result1, fake.getAliasReturns.result2
+}
+
+func (fake *FakeConfig) GetAliasCallCount() int {
+	fake.getAliasMutex.RLock()
+	defer fake.getAliasMutex.RUnlock()
+	return len(fake.getAliasArgsForCall)
+}
+
+func (fake *FakeConfig) GetAliasArgsForCall(i int) string {
+	fake.getAliasMutex.RLock()
+	defer fake.getAliasMutex.RUnlock()
+	return fake.getAliasArgsForCall[i].name
+}
+
+func (fake *FakeConfig) GetAliasReturns(result1 string, result2 bool) {
+	fake.GetAliasStub = nil
+	fake.getAliasReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetAliasReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.GetAliasStub = nil
+	if fake.getAliasReturnsOnCall == nil {
+		fake.getAliasReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.getAliasReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) GetPlugin(pluginName string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -1296,6 +1479,30 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RemoveAlias(name string) {
+	fake.removeAliasMutex.Lock()
+	fake.removeAliasArgsForCall = append(fake.removeAliasArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemoveAlias", []interface{}{name})
+	fake.removeAliasMutex.Unlock()
+	if fake.RemoveAliasStub != nil {
+		fake.RemoveAliasStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemoveAliasCallCount() int {
+	fake.removeAliasMutex.RLock()
+	defer fake.removeAliasMutex.RUnlock()
+	return len(fake.removeAliasArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveAliasArgsForCall(i int) string {
+	fake.removeAliasMutex.RLock()
+	defer fake.removeAliasMutex.RUnlock()
+	return fake.removeAliasArgsForCall[i].name
+}
+
 func (fake *FakeConfig) RemovePlugin(arg1 string) {
 	fake.removePluginMutex.Lock()
 	fake.removePluginArgsForCall = append(fake.removePluginArgsForCall, struct {
@@ -1344,6 +1551,31 @@ func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
 	return fake.setAccessTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetAlias(name string, command string) {
+	fake.setAliasMutex.Lock()
+	fake.setAliasArgsForCall = append(fake.setAliasArgsForCall, struct {
+		name    string
+		command string
+	}{name, command})
+	fake.recordInvocation("SetAlias", []interface{}{name, command})
+	fake.setAliasMutex.Unlock()
+	if fake.SetAliasStub != nil {
+		fake.SetAliasStub(name, command)
+	}
+}
+
+func (fake *FakeConfig) SetAliasCallCount() int {
+	fake.setAliasMutex.RLock()
+	defer fake.setAliasMutex.RUnlock()
+	return len(fake.setAliasArgsForCall)
+}
+
+func (fake *FakeConfig) SetAliasArgsForCall(i int) (string, string) {
+	fake.setAliasMutex.RLock()
+	defer fake.setAliasMutex.RUnlock()
+	return fake.setAliasArgsForCall[i].name, fake.setAliasArgsForCall[i].command
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(guid string, name string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -1974,6 +2206,270 @@ func (fake *FakeConfig) WritePluginConfigReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeConfig) IsTTY() bool {
+	fake.isTTYMutex.Lock()
+	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
+	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct{}{})
+	fake.recordInvocation("IsTTY", []interface{}{})
+	fake.isTTYMutex.Unlock()
+	if fake.IsTTYStub != nil {
+		return fake.IsTTYStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isTTYReturns.result1
+}
+
+func (fake *FakeConfig) IsTTYCallCount() int {
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	return len(fake.isTTYArgsForCall)
+}
+
+func (fake *FakeConfig) IsTTYReturns(result1 bool) {
+	fake.IsTTYStub = nil
+	fake.isTTYReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
+	fake.IsTTYStub = nil
+	if fake.isTTYReturnsOnCall == nil {
+		fake.isTTYReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isTTYReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) RecordDirectory() string {
+	fake.recordDirectoryMutex.Lock()
+	ret, specificReturn := fake.recordDirectoryReturnsOnCall[len(fake.recordDirectoryArgsForCall)]
+	fake.recordDirectoryArgsForCall = append(fake.recordDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("RecordDirectory", []interface{}{})
+	fake.recordDirectoryMutex.Unlock()
+	if fake.RecordDirectoryStub != nil {
+		return fake.RecordDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recordDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) RecordDirectoryCallCount() int {
+	fake.recordDirectoryMutex.RLock()
+	defer fake.recordDirectoryMutex.RUnlock()
+	return len(fake.recordDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) RecordDirectoryReturns(result1 string) {
+	fake.RecordDirectoryStub = nil
+	fake.recordDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RecordDirectoryReturnsOnCall(i int, result1 string) {
+	fake.RecordDirectoryStub = nil
+	if fake.recordDirectoryReturnsOnCall == nil {
+		fake.recordDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.recordDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ReplayDirectory() string {
+	fake.replayDirectoryMutex.Lock()
+	ret, specificReturn := fake.replayDirectoryReturnsOnCall[len(fake.replayDirectoryArgsForCall)]
+	fake.replayDirectoryArgsForCall = append(fake.replayDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("ReplayDirectory", []interface{}{})
+	fake.replayDirectoryMutex.Unlock()
+	if fake.ReplayDirectoryStub != nil {
+		return fake.ReplayDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.replayDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) ReplayDirectoryCallCount() int {
+	fake.replayDirectoryMutex.RLock()
+	defer fake.replayDirectoryMutex.RUnlock()
+	return len(fake.replayDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) ReplayDirectoryReturns(result1 string) {
+	fake.ReplayDirectoryStub = nil
+	fake.replayDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ReplayDirectoryReturnsOnCall(i int, result1 string) {
+	fake.ReplayDirectoryStub = nil
+	if fake.replayDirectoryReturnsOnCall == nil {
+		fake.replayDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.replayDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProtectedTarget() bool {
+	fake.protectedTargetMutex.Lock()
+	ret, specificReturn := fake.protectedTargetReturnsOnCall[len(fake.protectedTargetArgsForCall)]
+	fake.protectedTargetArgsForCall = append(fake.protectedTargetArgsForCall, struct{}{})
+	fake.recordInvocation("ProtectedTarget", []interface{}{})
+	fake.protectedTargetMutex.Unlock()
+	if fake.ProtectedTargetStub != nil {
+		return fake.ProtectedTargetStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.protectedTargetReturns.result1
+}
+
+func (fake *FakeConfig) ProtectedTargetCallCount() int {
+	fake.protectedTargetMutex.RLock()
+	defer fake.protectedTargetMutex.RUnlock()
+	return len(fake.protectedTargetArgsForCall)
+}
+
+func (fake *FakeConfig) ProtectedTargetReturns(result1 bool) {
+	fake.ProtectedTargetStub = nil
+	fake.protectedTargetReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ProtectedTargetReturnsOnCall(i int, result1 bool) {
+	fake.ProtectedTargetStub = nil
+	if fake.protectedTargetReturnsOnCall == nil {
+		fake.protectedTargetReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.protectedTargetReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ReadOnly() bool {
+	fake.readOnlyMutex.Lock()
+	ret, specificReturn := fake.readOnlyReturnsOnCall[len(fake.readOnlyArgsForCall)]
+	fake.readOnlyArgsForCall = append(fake.readOnlyArgsForCall, struct{}{})
+	fake.recordInvocation("ReadOnly", []interface{}{})
+	fake.readOnlyMutex.Unlock()
+	if fake.ReadOnlyStub != nil {
+		return fake.ReadOnlyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.readOnlyReturns.result1
+}
+
+func (fake *FakeConfig) ReadOnlyCallCount() int {
+	fake.readOnlyMutex.RLock()
+	defer fake.readOnlyMutex.RUnlock()
+	return len(fake.readOnlyArgsForCall)
+}
+
+func (fake *FakeConfig) ReadOnlyReturns(result1 bool) {
+	fake.ReadOnlyStub = nil
+	fake.readOnlyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ReadOnlyReturnsOnCall(i int, result1 bool) {
+	fake.ReadOnlyStub = nil
+	if fake.readOnlyReturnsOnCall == nil {
+		fake.readOnlyReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.readOnlyReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) SetTargetSafety(safety configv3.TargetSafety) {
+	fake.setTargetSafetyMutex.Lock()
+	fake.setTargetSafetyArgsForCall = append(fake.setTargetSafetyArgsForCall, struct {
+		safety configv3.TargetSafety
+	}{safety})
+	fake.recordInvocation("SetTargetSafety", []interface{}{safety})
+	fake.setTargetSafetyMutex.Unlock()
+	if fake.SetTargetSafetyStub != nil {
+		fake.SetTargetSafetyStub(safety)
+	}
+}
+
+func (fake *FakeConfig) SetTargetSafetyCallCount() int {
+	fake.setTargetSafetyMutex.RLock()
+	defer fake.setTargetSafetyMutex.RUnlock()
+	return len(fake.setTargetSafetyArgsForCall)
+}
+
+func (fake *FakeConfig) SetTargetSafetyArgsForCall(i int) configv3.TargetSafety {
+	fake.setTargetSafetyMutex.RLock()
+	defer fake.setTargetSafetyMutex.RUnlock()
+	return fake.setTargetSafetyArgsForCall[i].safety
+}
+
+func (fake *FakeConfig) TargetSafety() configv3.TargetSafety {
+	fake.targetSafetyMutex.Lock()
+	ret, specificReturn := fake.targetSafetyReturnsOnCall[len(fake.targetSafetyArgsForCall)]
+	fake.targetSafetyArgsForCall = append(fake.targetSafetyArgsForCall, struct{}{})
+	fake.recordInvocation("TargetSafety", []interface{}{})
+	fake.targetSafetyMutex.Unlock()
+	if fake.TargetSafetyStub != nil {
+		return fake.TargetSafetyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.targetSafetyReturns.result1
+}
+
+func (fake *FakeConfig) TargetSafetyCallCount() int {
+	fake.targetSafetyMutex.RLock()
+	defer fake.targetSafetyMutex.RUnlock()
+	return len(fake.targetSafetyArgsForCall)
+}
+
+func (fake *FakeConfig) TargetSafetyReturns(result1 configv3.TargetSafety) {
+	fake.TargetSafetyStub = nil
+	fake.targetSafetyReturns = struct {
+		result1 configv3.TargetSafety
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetSafetyReturnsOnCall(i int, result1 configv3.TargetSafety) {
+	fake.TargetSafetyStub = nil
+	if fake.targetSafetyReturnsOnCall == nil {
+		fake.targetSafetyReturnsOnCall = make(map[int]struct {
+			result1 configv3.TargetSafety
+		})
+	}
+	fake.targetSafetyReturnsOnCall[i] = struct {
+		result1 configv3.TargetSafety
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1983,6 +2479,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.aliasesMutex.RLock()
+	defer fake.aliasesMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
@@ -1999,6 +2497,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.dockerPasswordMutex.RUnlock()
 	fake.experimentalMutex.RLock()
 	defer fake.experimentalMutex.RUnlock()
+	fake.getAliasMutex.RLock()
+	defer fake.getAliasMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
@@ -2023,10 +2523,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pollingIntervalMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.removeAliasMutex.RLock()
+	defer fake.removeAliasMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setAliasMutex.RLock()
+	defer fake.setAliasMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -2065,6 +2569,20 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	fake.recordDirectoryMutex.RLock()
+	defer fake.recordDirectoryMutex.RUnlock()
+	fake.replayDirectoryMutex.RLock()
+	defer fake.replayDirectoryMutex.RUnlock()
+	fake.protectedTargetMutex.RLock()
+	defer fake.protectedTargetMutex.RUnlock()
+	fake.readOnlyMutex.RLock()
+	defer fake.readOnlyMutex.RUnlock()
+	fake.setTargetSafetyMutex.RLock()
+	defer fake.setTargetSafetyMutex.RUnlock()
+	fake.targetSafetyMutex.RLock()
+	defer fake.targetSafetyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
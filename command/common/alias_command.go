@@ -0,0 +1,101 @@
+package common
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . AliasActor
+
+type AliasActor interface {
+	GetAliases() []pluginaction.Alias
+	RemoveAlias(name string) error
+	SetAlias(commandList pluginaction.CommandList, name string, command string) error
+}
+
+type AliasCommand struct {
+	OptionalArgs    flag.AliasArgs `positional-args:"yes"`
+	usage           interface{}    `usage:"CF_NAME alias [list]\n   CF_NAME alias set ALIAS_NAME COMMAND\n   CF_NAME alias delete ALIAS_NAME\n\n   COMMAND can refer to the arguments the alias is called with as $1, $2, etc., or\n   to all of them as $@. Arguments that are not referred to are appended to COMMAND.\n\n   An alias cannot have the same name as a command, a command alias or a plugin command.\n\nEXAMPLES:\n   CF_NAME alias set sw 'v3-scale $1 --process worker -i $2'\n   CF_NAME sw my-app 4\n   CF_NAME alias delete sw"`
+	relatedCommands interface{}    `related_commands:"help, plugins"`
+	UI              command.UI
+	Config          command.Config
+	Actor           AliasActor
+}
+
+func (cmd *AliasCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd AliasCommand) Execute([]string) error {
+	switch cmd.OptionalArgs.Action {
+	case "set":
+		return cmd.setAlias()
+	case "delete":
+		return cmd.deleteAlias()
+	default:
+		return cmd.displayAliases()
+	}
+}
+
+func (cmd AliasCommand) setAlias() error {
+	if cmd.OptionalArgs.Name == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "ALIAS_NAME"}
+	}
+	if cmd.OptionalArgs.Command == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "COMMAND"}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting alias {{.AliasName}} to '{{.Command}}'...", map[string]interface{}{
+		"AliasName": cmd.OptionalArgs.Name,
+		"Command":   cmd.OptionalArgs.Command,
+	})
+
+	err := cmd.Actor.SetAlias(Commands, cmd.OptionalArgs.Name, cmd.OptionalArgs.Command)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd AliasCommand) deleteAlias() error {
+	if cmd.OptionalArgs.Name == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "ALIAS_NAME"}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting alias {{.AliasName}}...", map[string]interface{}{
+		"AliasName": cmd.OptionalArgs.Name,
+	})
+
+	err := cmd.Actor.RemoveAlias(cmd.OptionalArgs.Name)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd AliasCommand) displayAliases() error {
+	aliases := cmd.Actor.GetAliases()
+	if len(aliases) == 0 {
+		cmd.UI.DisplayText("No aliases found.")
+		return nil
+	}
+
+	table := [][]string{{"alias", "command"}}
+	for _, alias := range aliases {
+		table = append(table, []string{alias.Name, alias.Command})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,167 @@
+package common_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("alias Command", func() {
+	var (
+		cmd        AliasCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeAliasActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeAliasActor)
+
+		cmd = AliasCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("listing aliases", func() {
+		Context("when there are aliases", func() {
+			BeforeEach(func() {
+				fakeActor.GetAliasesReturns([]pluginaction.Alias{
+					{Name: "a3", Command: "v3-apps"},
+					{Name: "sw", Command: "v3-scale $1 --process worker -i $2"},
+				})
+			})
+
+			It("displays the aliases", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`alias\s+command`))
+				Expect(testUI.Out).To(Say(`a3\s+v3-apps`))
+				Expect(testUI.Out).To(Say(`sw\s+v3-scale \$1 --process worker -i \$2`))
+			})
+		})
+
+		Context("when there are no aliases", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Action = "list"
+				fakeActor.GetAliasesReturns([]pluginaction.Alias{})
+			})
+
+			It("displays a message", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("No aliases found."))
+			})
+		})
+	})
+
+	Describe("setting an alias", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "set"
+		})
+
+		Context("when the alias name is not provided", func() {
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "ALIAS_NAME"}))
+			})
+		})
+
+		Context("when the command is not provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Name = "sw"
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "COMMAND"}))
+			})
+		})
+
+		Context("when the alias name and command are provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Name = "sw"
+				cmd.OptionalArgs.Command = "v3-scale $1 --process worker"
+			})
+
+			It("sets the alias", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Setting alias sw to 'v3-scale \$1 --process worker'\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.SetAliasCallCount()).To(Equal(1))
+				commandList, name, command := fakeActor.SetAliasArgsForCall(0)
+				Expect(commandList).To(Equal(Commands))
+				Expect(name).To(Equal("sw"))
+				Expect(command).To(Equal("v3-scale $1 --process worker"))
+			})
+
+			Context("when the alias name conflicts with a command", func() {
+				BeforeEach(func() {
+					fakeActor.SetAliasReturns(pluginaction.AliasNameConflictError{Name: "sw"})
+				})
+
+				It("returns an AliasNameConflictError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.AliasNameConflictError{Name: "sw"}))
+				})
+			})
+		})
+	})
+
+	Describe("deleting an alias", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "delete"
+		})
+
+		Context("when the alias name is not provided", func() {
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "ALIAS_NAME"}))
+			})
+		})
+
+		Context("when the alias name is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Name = "sw"
+			})
+
+			It("deletes the alias", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Deleting alias sw\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.RemoveAliasCallCount()).To(Equal(1))
+				Expect(fakeActor.RemoveAliasArgsForCall(0)).To(Equal("sw"))
+			})
+
+			Context("when removing the alias fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("remove alias error")
+					fakeActor.RemoveAliasReturns(expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+		})
+	})
+})
@@ -38,8 +38,12 @@ type commandList struct {
 
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AddNetworkPolicy                   v3.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
+	Alias                              AliasCommand                                 `command:"alias" description:"Set, delete or list user defined command aliases"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
+	ApplyFoundation                    v3.ApplyFoundationCommand                    `command:"apply-foundation" description:"Create, update and optionally delete orgs, spaces, quotas, isolation segment entitlements and roles to match a directory"`
+	ApplyNetworkPolicies               v3.ApplyNetworkPoliciesCommand               `command:"apply-network-policies" description:"Create, and optionally remove, network policies to match a YAML file"`
+	ApplyRoles                         v3.ApplyRolesCommand                         `command:"apply-roles" description:"Give users, and optionally remove, org and space roles to match a YAML or CSV file"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for an app"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate user non-interactively"`
@@ -50,6 +54,8 @@ type commandList struct {
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	CloneSpace                         v3.CloneSpaceCommand                         `command:"clone-space" description:"Clone the apps, user-provided services, routes and network policies of a space into a new space"`
+	Completion                         CompletionCommand                            `command:"completion" description:"Print a shell completion script for bash, zsh or fish"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -87,18 +93,24 @@ type commandList struct {
 	DeleteSpace                        v2.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteUser                         v2.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
 	Delete                             v2.DeleteCommand                             `command:"delete" alias:"d" description:"Delete an app"`
+	DiffSecurityGroups                 v2.DiffSecurityGroupsCommand                 `command:"diff-security-groups" description:"Compare security group rules and bindings with a directory written by export-security-groups"`
 	DisableFeatureFlag                 v2.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v3.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableServiceAccess               v2.DisableServiceAccessCommand               `command:"disable-service-access" description:"Disable access to a service or service plan for one or all orgs"`
 	DisableSSH                         v2.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
 	DisallowSpaceSSH                   v2.DisallowSpaceSSHCommand                   `command:"disallow-space-ssh" description:"Disallow SSH access for the space"`
 	Domains                            v2.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
+	DownloadDroplet                    v3.DownloadDropletCommand                    `command:"download-droplet" description:"Download the droplet of an app to a file"`
+	EgressCheck                        v3.EgressCheckCommand                        `command:"egress-check" description:"Check whether security groups and network policies allow traffic from an app to a destination"`
 	EnableFeatureFlag                  v2.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Allow use of a feature"`
 	EnableOrgIsolation                 v3.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
 	EnableServiceAccess                v2.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service or service plan for one or all orgs"`
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v2.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v2.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportFoundation                   v3.ExportFoundationCommand                   `command:"export-foundation" description:"Write the orgs, spaces, quotas, isolation segments and roles of the foundation to a directory"`
+	ExportRoles                        v3.ExportRolesCommand                        `command:"export-roles" description:"Show or write the org and space roles of the users of an org"`
+	ExportSecurityGroups               v2.ExportSecurityGroupsCommand               `command:"export-security-groups" description:"Write security group rules and bindings to a directory"`
 	FeatureFlags                       v2.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status of each flag-able feature"`
 	FeatureFlag                        v2.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
@@ -120,6 +132,8 @@ type commandList struct {
 	Org                                v2.OrgCommand                                `command:"org" description:"Show org info"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	PruneDroplets                      v3.PruneDropletsCommand                      `command:"prune-droplets" description:"Delete all but the most recent droplets of an app"`
+	PrunePackages                      v3.PrunePackagesCommand                      `command:"prune-packages" description:"Delete all but the most recent packages of an app"`
 	PurgeServiceInstance               v2.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v2.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v2.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
@@ -139,6 +153,7 @@ type commandList struct {
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate the running application Instance at the given index and instantiate a new instance of the application with the same index"`
 	Restart                            v2.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This causes downtime."`
+	Rollback                           v3.RollbackCommand                           `command:"rollback" description:"Roll an app back to a previous droplet and restart it"`
 	RouterGroups                       v2.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v2.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v2.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
@@ -181,8 +196,10 @@ type commandList struct {
 	Start                              v2.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v2.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
 	Target                             v2.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	TargetSafety                       v2.TargetSafetyCommand                       `command:"target-safety" description:"Set or view the protection of the targeted api endpoint against changes"`
 	Tasks                              v3.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v3.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
+	Top                                v3.TopCommand                                `command:"top" description:"Show live CPU, memory and disk usage of app instances"`
 	UnbindRouteService                 v2.UnbindRouteServiceCommand                 `command:"unbind-route-service" alias:"urs" description:"Unbind a service instance from an HTTP route"`
 	UnbindRunningSecurityGroup         v2.UnbindRunningSecurityGroupCommand         `command:"unbind-running-security-group" description:"Unbind a security group from the set of security groups for running applications"`
 	UnbindSecurityGroup                v2.UnbindSecurityGroupCommand                `command:"unbind-security-group" description:"Unbind a security group from a space"`
@@ -203,7 +220,11 @@ type commandList struct {
 	UpdateService                      v2.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v2.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v2.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UploadDroplet                      v3.UploadDropletCommand                      `command:"upload-droplet" description:"Create a droplet for an app from a file written by download-droplet"`
+	Usage                              v3.UsageCommand                              `command:"usage" description:"Show the usage of an org and its spaces compared with their quotas"`
+	ValidateManifest                   v2.ValidateManifestCommand                   `command:"validate-manifest" description:"Check a manifest for unknown properties, invalid values and conflicting properties"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
+	Wait                               v3.WaitCommand                               `command:"wait" description:"Wait until an app is running, a task is done or a service instance is ready"`
 }
 
 // HasCommand returns true if the command name is in the command list.
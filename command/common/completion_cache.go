@@ -0,0 +1,70 @@
+package common
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// completionCacheTTL is how long resource names looked up for shell
+// completion are reused before being fetched again.
+const completionCacheTTL = time.Minute
+
+type completionCacheEntry struct {
+	Values  []string  `json:"values"`
+	Expires time.Time `json:"expires"`
+}
+
+type completionCache map[string]completionCacheEntry
+
+func loadCompletionCache(path string) completionCache {
+	cache := completionCache{}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+
+	// A corrupt cache is treated the same as an empty one.
+	if err := json.Unmarshal(raw, &cache); err != nil {
+		return completionCache{}
+	}
+
+	return cache
+}
+
+func readCompletionCache(path string, key string, now time.Time) ([]string, bool) {
+	entry, found := loadCompletionCache(path)[key]
+	if !found || !now.Before(entry.Expires) {
+		return nil, false
+	}
+	return entry.Values, true
+}
+
+func writeCompletionCache(path string, key string, values []string, now time.Time) error {
+	cache := loadCompletionCache(path)
+	for existingKey, entry := range cache {
+		if !now.Before(entry.Expires) {
+			delete(cache, existingKey)
+		}
+	}
+
+	cache[key] = completionCacheEntry{
+		Values:  values,
+		Expires: now.Add(completionCacheTTL),
+	}
+
+	raw, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
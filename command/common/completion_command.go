@@ -0,0 +1,473 @@
+package common
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . CompletionActor
+
+type CompletionActor interface {
+	CommandCompletions(commandList interface{}) []sharedaction.CommandCompletion
+}
+
+//go:generate counterfeiter . CompletionValuesActor
+
+type CompletionValuesActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+type CompletionCommand struct {
+	RequiredArgs    flag.CompletionArgs `positional-args:"yes"`
+	Values          string              `long:"values" hidden:"true" description:"Print the names of the given resource type for use by completion scripts"`
+	usage           interface{}         `usage:"CF_NAME completion SHELL\n\n   SHELL must be one of bash, zsh or fish.\n\n   App, org, space and service instance names are looked up in the targeted\n   org and space, and are cached for a minute.\n\nEXAMPLES:\n   source <(CF_NAME completion bash)\n   source <(CF_NAME completion zsh)\n   CF_NAME completion fish | source"`
+	relatedCommands interface{}         `related_commands:"help"`
+
+	UI            command.UI
+	Config        command.Config
+	Actor         CompletionActor
+	ValuesActor   CompletionValuesActor
+	CacheFilePath string
+}
+
+func (cmd *CompletionCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config, nil)
+	cmd.CacheFilePath = configv3.CompletionCacheFilePath()
+
+	if cmd.Values == "" {
+		return nil
+	}
+
+	ccClient, uaaClient, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ValuesActor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd CompletionCommand) Execute(args []string) error {
+	if cmd.Values != "" {
+		return cmd.displayValues(sharedaction.CompletionValueType(cmd.Values))
+	}
+
+	return cmd.displayScript()
+}
+
+func (cmd CompletionCommand) displayScript() error {
+	var script string
+	switch cmd.RequiredArgs.Shell {
+	case "zsh":
+		script = zshCompletionScript
+	case "fish":
+		script = fishCompletionScript
+	default:
+		script = bashCompletionScript
+	}
+
+	tmpl, err := template.New("completion").Funcs(completionFuncs).Parse(script)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(cmd.UI.GetOut(), map[string]interface{}{
+		"BinaryName":   cmd.Config.BinaryName(),
+		"FunctionName": completionFunctionName(cmd.Config.BinaryName()),
+		"Commands":     cmd.completions(),
+	})
+}
+
+func (cmd CompletionCommand) completions() []sharedaction.CommandCompletion {
+	completions := cmd.Actor.CommandCompletions(Commands)
+
+	for _, plugin := range cmd.Config.Plugins() {
+		for _, pluginCommand := range plugin.Commands {
+			completions = append(completions, convertPluginToCommandCompletion(pluginCommand))
+		}
+	}
+
+	return completions
+}
+
+func (cmd CompletionCommand) displayValues(valueType sharedaction.CompletionValueType) error {
+	key, ok := cmd.cacheKey(valueType)
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	values, found := readCompletionCache(cmd.CacheFilePath, key, now)
+	if !found {
+		var (
+			warnings v2action.Warnings
+			err      error
+		)
+		values, warnings, err = cmd.fetchValues(valueType)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return sharedV2.HandleError(err)
+		}
+
+		// The cache is only an optimization; failing to write it is not fatal.
+		_ = writeCompletionCache(cmd.CacheFilePath, key, values, now)
+	}
+
+	out := cmd.UI.GetOut()
+	for _, value := range values {
+		_, _ = out.Write([]byte(value + "\n"))
+	}
+
+	return nil
+}
+
+// cacheKey returns the key the values are cached under, and false if the
+// values cannot be looked up with the current target.
+func (cmd CompletionCommand) cacheKey(valueType sharedaction.CompletionValueType) (string, bool) {
+	switch valueType {
+	case sharedaction.CompletionValueOrgs:
+		return strings.Join([]string{cmd.Config.Target(), string(valueType)}, "|"), true
+	case sharedaction.CompletionValueSpaces:
+		if !cmd.Config.HasTargetedOrganization() {
+			return "", false
+		}
+		return strings.Join([]string{cmd.Config.Target(), cmd.Config.TargetedOrganization().GUID, string(valueType)}, "|"), true
+	case sharedaction.CompletionValueApps, sharedaction.CompletionValueServices:
+		if !cmd.Config.HasTargetedOrganization() || !cmd.Config.HasTargetedSpace() {
+			return "", false
+		}
+		return strings.Join([]string{cmd.Config.Target(), cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, string(valueType)}, "|"), true
+	default:
+		return "", false
+	}
+}
+
+func (cmd CompletionCommand) fetchValues(valueType sharedaction.CompletionValueType) ([]string, v2action.Warnings, error) {
+	var names []string
+
+	switch valueType {
+	case sharedaction.CompletionValueOrgs:
+		orgs, warnings, err := cmd.ValuesActor.GetOrganizations()
+		if err != nil {
+			return nil, warnings, err
+		}
+		for _, org := range orgs {
+			names = append(names, org.Name)
+		}
+		sort.Strings(names)
+		return names, warnings, nil
+	case sharedaction.CompletionValueSpaces:
+		spaces, warnings, err := cmd.ValuesActor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
+		if err != nil {
+			return nil, warnings, err
+		}
+		for _, space := range spaces {
+			names = append(names, space.Name)
+		}
+		sort.Strings(names)
+		return names, warnings, nil
+	case sharedaction.CompletionValueApps:
+		apps, warnings, err := cmd.ValuesActor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+		if err != nil {
+			return nil, warnings, err
+		}
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+		sort.Strings(names)
+		return names, warnings, nil
+	default:
+		serviceInstances, warnings, err := cmd.ValuesActor.GetServiceInstancesBySpace(cmd.Config.TargetedSpace().GUID)
+		if err != nil {
+			return nil, warnings, err
+		}
+		for _, serviceInstance := range serviceInstances {
+			names = append(names, serviceInstance.Name)
+		}
+		sort.Strings(names)
+		return names, warnings, nil
+	}
+}
+
+func convertPluginToCommandCompletion(pluginCommand configv3.PluginCommand) sharedaction.CommandCompletion {
+	completion := sharedaction.CommandCompletion{
+		Name:        pluginCommand.Name,
+		Description: pluginCommand.HelpText,
+		Alias:       pluginCommand.Alias,
+		Flags:       []sharedaction.CompletionFlag{},
+		Args:        []sharedaction.CompletionValueType{},
+	}
+
+	flagNames := make([]string, 0, len(pluginCommand.UsageDetails.Options))
+	for flagName := range pluginCommand.UsageDetails.Options {
+		flagNames = append(flagNames, flagName)
+	}
+	sort.Strings(flagNames)
+
+	for _, flagName := range flagNames {
+		completionFlag := sharedaction.CompletionFlag{
+			Description: pluginCommand.UsageDetails.Options[flagName],
+		}
+		strippedName := strings.TrimLeft(flagName, "-")
+		if len(strippedName) == 1 {
+			completionFlag.Short = strippedName
+		} else {
+			completionFlag.Long = strippedName
+		}
+		completion.Flags = append(completion.Flags, completionFlag)
+	}
+
+	return completion
+}
+
+var nonIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func completionFunctionName(binaryName string) string {
+	return nonIdentifierCharacters.ReplaceAllString(binaryName, "_")
+}
+
+func completionFlagNames(completionFlag sharedaction.CompletionFlag) []string {
+	var names []string
+	if completionFlag.Short != "" {
+		names = append(names, "-"+completionFlag.Short)
+	}
+	if completionFlag.Long != "" {
+		names = append(names, "--"+completionFlag.Long)
+	}
+	return names
+}
+
+var completionFuncs = template.FuncMap{
+	// commandNames returns the name and alias of a command separated by sep.
+	"commandNames": func(completion sharedaction.CommandCompletion, sep string) string {
+		if completion.Alias == "" {
+			return completion.Name
+		}
+		return completion.Name + sep + completion.Alias
+	},
+
+	// allCommandNames returns every command name and alias separated by spaces.
+	"allCommandNames": func(completions []sharedaction.CommandCompletion) string {
+		var names []string
+		for _, completion := range completions {
+			names = append(names, completion.Name)
+			if completion.Alias != "" {
+				names = append(names, completion.Alias)
+			}
+		}
+		return strings.Join(names, " ")
+	},
+
+	// flagNames returns every flag of a command separated by spaces.
+	"flagNames": func(completion sharedaction.CommandCompletion) string {
+		var names []string
+		for _, completionFlag := range completion.Flags {
+			names = append(names, completionFlagNames(completionFlag)...)
+		}
+		return strings.Join(names, " ")
+	},
+
+	// valueFlagNames returns the flags of a command that take a value,
+	// separated by spaces.
+	"valueFlagNames": func(completion sharedaction.CommandCompletion) string {
+		var names []string
+		for _, completionFlag := range completion.Flags {
+			if completionFlag.TakesValue {
+				names = append(names, completionFlagNames(completionFlag)...)
+			}
+		}
+		return strings.Join(names, " ")
+	},
+
+	// valueFlagTypes returns " FLAG:TYPE " pairs for the flags of a command
+	// that take a value. TYPE is empty when the value cannot be completed.
+	"valueFlagTypes": func(completion sharedaction.CommandCompletion) string {
+		var pairs []string
+		for _, completionFlag := range completion.Flags {
+			if !completionFlag.TakesValue {
+				continue
+			}
+			for _, name := range completionFlagNames(completionFlag) {
+				pairs = append(pairs, name+":"+string(completionFlag.ValueType))
+			}
+		}
+		return " " + strings.Join(pairs, " ") + " "
+	},
+
+	// argTypes returns the value type of each positional argument separated
+	// by spaces, using - for arguments that cannot be completed.
+	"argTypes": func(completion sharedaction.CommandCompletion) string {
+		var types []string
+		for _, argType := range completion.Args {
+			if argType == sharedaction.CompletionValueNone {
+				types = append(types, "-")
+			} else {
+				types = append(types, string(argType))
+			}
+		}
+		return strings.Join(types, " ")
+	},
+
+	// fishQuote quotes a string for use in a fish script.
+	"fishQuote": func(s string) string {
+		s = strings.Replace(s, `\`, `\\`, -1)
+		s = strings.Replace(s, `'`, `\'`, -1)
+		s = strings.Replace(s, "\n", " ", -1)
+		return "'" + s + "'"
+	},
+}
+
+const bashCompletionScript = `# bash completion for {{.BinaryName}}
+
+__{{.FunctionName}}_values() {
+    "${COMP_WORDS[0]}" completion bash --values "$1" 2>/dev/null
+}
+
+__{{.FunctionName}}_flags() {
+    case "$1" in
+{{- range .Commands}}
+        {{commandNames . "|"}}) echo "{{flagNames .}}" ;;
+{{- end}}
+    esac
+}
+
+__{{.FunctionName}}_value_flags() {
+    case "$1" in
+{{- range .Commands}}
+        {{commandNames . "|"}}) echo "{{valueFlagTypes .}}" ;;
+{{- end}}
+    esac
+}
+
+__{{.FunctionName}}_args() {
+    case "$1" in
+{{- range .Commands}}
+        {{commandNames . "|"}}) echo "{{argTypes .}}" ;;
+{{- end}}
+    esac
+}
+
+_{{.FunctionName}}() {
+    local cur prev cmd value_flags value_type word arg i
+    COMPREPLY=()
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+
+    if [ "$COMP_CWORD" -eq 1 ]; then
+        COMPREPLY=( $(compgen -W "{{allCommandNames .Commands}}" -- "$cur") )
+        return 0
+    fi
+
+    cmd="${COMP_WORDS[1]}"
+    value_flags="$(__{{.FunctionName}}_value_flags "$cmd")"
+
+    if [[ "$prev" == -* && "$value_flags" == *" $prev:"* ]]; then
+        value_type="${value_flags#* $prev:}"
+        value_type="${value_type%% *}"
+        if [ -n "$value_type" ]; then
+            COMPREPLY=( $(compgen -W "$(__{{.FunctionName}}_values "$value_type")" -- "$cur") )
+        fi
+        return 0
+    fi
+
+    if [[ "$cur" == -* ]]; then
+        COMPREPLY=( $(compgen -W "$(__{{.FunctionName}}_flags "$cmd")" -- "$cur") )
+        return 0
+    fi
+
+    arg=0
+    for (( i=2; i<COMP_CWORD; i++ )); do
+        word="${COMP_WORDS[i]}"
+        if [[ "$word" == -* ]]; then
+            if [[ "$value_flags" == *" $word:"* ]]; then
+                (( i++ ))
+            fi
+            continue
+        fi
+        (( arg++ ))
+    done
+
+    local arg_types=( $(__{{.FunctionName}}_args "$cmd") )
+    value_type="${arg_types[$arg]}"
+    if [ -n "$value_type" ] && [ "$value_type" != "-" ]; then
+        COMPREPLY=( $(compgen -W "$(__{{.FunctionName}}_values "$value_type")" -- "$cur") )
+    fi
+    return 0
+}
+
+complete -o default -F _{{.FunctionName}} {{.BinaryName}}
+`
+
+const zshCompletionScript = `# zsh completion for {{.BinaryName}}
+
+autoload -U +X bashcompinit && bashcompinit
+
+` + bashCompletionScript
+
+const fishCompletionScript = `# fish completion for {{.BinaryName}}
+
+function __{{.FunctionName}}_needs_command
+    test (count (commandline -opc)) -eq 1
+end
+
+function __{{.FunctionName}}_using_command
+    set -l words (commandline -opc)
+    test (count $words) -gt 1; and contains -- $words[2] $argv
+end
+
+function __{{.FunctionName}}_arg_index
+    set -l words (commandline -opc)
+    set -l index 0
+    set -l skip 0
+    for i in (seq 3 (count $words))
+        if test $skip -eq 1
+            set skip 0
+        else if string match -q -- '-*' $words[$i]
+            if contains -- $words[$i] $argv
+                set skip 1
+            end
+        else
+            set index (math $index + 1)
+        end
+    end
+    echo $index
+end
+
+function __{{.FunctionName}}_values
+    set -l words (commandline -opc)
+    $words[1] completion fish --values $argv[1] 2>/dev/null
+end
+
+complete -c {{.BinaryName}} -f
+{{- $fn := .FunctionName}}{{$bin := .BinaryName}}
+{{- range .Commands}}
+{{- $command := .}}
+complete -c {{$bin}} -n '__{{$fn}}_needs_command' -a {{fishQuote .Name}} -d {{fishQuote .Description}}
+{{- if .Alias}}
+complete -c {{$bin}} -n '__{{$fn}}_needs_command' -a {{fishQuote .Alias}} -d {{fishQuote .Description}}
+{{- end}}
+{{- range .Flags}}
+complete -c {{$bin}} -n '__{{$fn}}_using_command {{commandNames $command " "}}'{{if .Short}} -s {{.Short}}{{end}}{{if .Long}} -l {{.Long}}{{end}}{{if .ValueType}} -x -a '(__{{$fn}}_values {{.ValueType}})'{{else if .TakesValue}} -r{{end}} -d {{fishQuote .Description}}
+{{- end}}
+{{- range $index, $argType := .Args}}
+{{- if $argType}}
+complete -c {{$bin}} -n '__{{$fn}}_using_command {{commandNames $command " "}}; and test (__{{$fn}}_arg_index {{valueFlagNames $command}}) -eq {{$index}}' -a '(__{{$fn}}_values {{$argType}})'
+{{- end}}
+{{- end}}
+{{- end}}
+`
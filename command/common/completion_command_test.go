@@ -0,0 +1,292 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("completion Command", func() {
+	var (
+		cmd             CompletionCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeCompletionActor
+		fakeValuesActor *commonfakes.FakeCompletionValuesActor
+		cacheDir        string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor = new(commonfakes.FakeCompletionActor)
+		fakeValuesActor = new(commonfakes.FakeCompletionValuesActor)
+
+		var err error
+		cacheDir, err = ioutil.TempDir("", "completion-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = CompletionCommand{
+			UI:            testUI,
+			Config:        fakeConfig,
+			Actor:         fakeActor,
+			ValuesActor:   fakeValuesActor,
+			CacheFilePath: filepath.Join(cacheDir, "completion_cache.json"),
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when generating a script", func() {
+		BeforeEach(func() {
+			fakeActor.CommandCompletionsReturns([]sharedaction.CommandCompletion{
+				{
+					Name:        "target",
+					Alias:       "t",
+					Description: "Set or view the targeted org or space",
+					Flags: []sharedaction.CompletionFlag{
+						{Short: "o", Description: "Organization", TakesValue: true, ValueType: sharedaction.CompletionValueOrgs},
+						{Short: "s", Description: "Space", TakesValue: true, ValueType: sharedaction.CompletionValueSpaces},
+					},
+					Args: []sharedaction.CompletionValueType{},
+				},
+				{
+					Name:        "bind-service",
+					Alias:       "bs",
+					Description: "Bind a service instance to an app",
+					Flags: []sharedaction.CompletionFlag{
+						{Short: "c", Description: "Valid JSON object", TakesValue: true},
+					},
+					Args: []sharedaction.CompletionValueType{sharedaction.CompletionValueApps, sharedaction.CompletionValueServices},
+				},
+			})
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{
+					Name: "some-plugin",
+					Commands: []configv3.PluginCommand{
+						{
+							Name:     "plugin-command",
+							Alias:    "pc",
+							HelpText: "Don't panic",
+							UsageDetails: configv3.PluginUsageDetails{
+								Options: map[string]string{
+									"f":     "Force",
+									"stuff": "Some stuff",
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+
+		Context("for bash", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Shell = "bash"
+			})
+
+			It("outputs a bash completion script for all commands", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.CommandCompletionsCallCount()).To(Equal(1))
+				Expect(fakeActor.CommandCompletionsArgsForCall(0)).To(Equal(Commands))
+
+				Expect(testUI.Out).To(Say(`# bash completion for faceman`))
+				Expect(testUI.Out).To(Say(`target\|t\) echo "-o -s" ;;`))
+				Expect(testUI.Out).To(Say(`bind-service\|bs\) echo "-c" ;;`))
+				Expect(testUI.Out).To(Say(`plugin-command\|pc\) echo "-f --stuff" ;;`))
+				Expect(testUI.Out).To(Say(`target\|t\) echo " -o:orgs -s:spaces " ;;`))
+				Expect(testUI.Out).To(Say(`bind-service\|bs\) echo " -c: " ;;`))
+				Expect(testUI.Out).To(Say(`bind-service\|bs\) echo "apps services" ;;`))
+				Expect(testUI.Out).To(Say(`compgen -W "target t bind-service bs plugin-command pc"`))
+				Expect(testUI.Out).To(Say(`complete -o default -F _faceman faceman`))
+			})
+		})
+
+		Context("for zsh", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Shell = "zsh"
+			})
+
+			It("outputs the bash completion script loaded through bashcompinit", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`# zsh completion for faceman`))
+				Expect(testUI.Out).To(Say(`autoload -U \+X bashcompinit && bashcompinit`))
+				Expect(testUI.Out).To(Say(`complete -o default -F _faceman faceman`))
+			})
+		})
+
+		Context("for fish", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Shell = "fish"
+			})
+
+			It("outputs a fish completion script for all commands", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`# fish completion for faceman`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_needs_command' -a 'target' -d 'Set or view the targeted org or space'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_needs_command' -a 't' -d 'Set or view the targeted org or space'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_using_command target t' -s o -x -a '\(__faceman_values orgs\)' -d 'Organization'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_using_command bind-service bs' -s c -r -d 'Valid JSON object'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_using_command bind-service bs; and test \(__faceman_arg_index -c\) -eq 0' -a '\(__faceman_values apps\)'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_using_command bind-service bs; and test \(__faceman_arg_index -c\) -eq 1' -a '\(__faceman_values services\)'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_needs_command' -a 'plugin-command' -d 'Don\\'t panic'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_using_command plugin-command pc' -s f -d 'Force'`))
+				Expect(testUI.Out).To(Say(`complete -c faceman -n '__faceman_using_command plugin-command pc' -l stuff -d 'Some stuff'`))
+			})
+		})
+	})
+
+	Context("when listing values", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.example.com")
+		})
+
+		Context("when listing orgs", func() {
+			BeforeEach(func() {
+				cmd.Values = "orgs"
+				fakeValuesActor.GetOrganizationsReturns(
+					[]v2action.Organization{{Name: "org-2"}, {Name: "org-1"}},
+					v2action.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("displays the sorted org names and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("org-1\norg-2\n"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+
+			Context("when the values were fetched less than a minute ago", func() {
+				BeforeEach(func() {
+					Expect(cmd.Execute(nil)).To(Succeed())
+					fakeValuesActor.GetOrganizationsReturns([]v2action.Organization{{Name: "org-3"}}, nil, nil)
+				})
+
+				It("displays the cached values", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeValuesActor.GetOrganizationsCallCount()).To(Equal(1))
+					Expect(testUI.Out).To(Say("org-1\norg-2\norg-1\norg-2\n"))
+				})
+			})
+
+			Context("when the cache is corrupt", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(cmd.CacheFilePath, []byte("not-json"), 0600)).To(Succeed())
+				})
+
+				It("fetches the values again", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeValuesActor.GetOrganizationsCallCount()).To(Equal(1))
+					Expect(testUI.Out).To(Say("org-1\norg-2\n"))
+				})
+			})
+
+			Context("when getting the orgs fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get orgs error")
+					fakeValuesActor.GetOrganizationsReturns(nil, v2action.Warnings{"warning-1"}, expectedErr)
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
+		})
+
+		Context("when listing spaces", func() {
+			BeforeEach(func() {
+				cmd.Values = "spaces"
+			})
+
+			Context("when no org is targeted", func() {
+				It("displays nothing", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeValuesActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+					Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+				})
+			})
+
+			Context("when an org is targeted", func() {
+				BeforeEach(func() {
+					fakeConfig.HasTargetedOrganizationReturns(true)
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+					fakeValuesActor.GetOrganizationSpacesReturns([]v2action.Space{{Name: "space-1"}}, nil, nil)
+				})
+
+				It("displays the spaces in the targeted org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeValuesActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+					Expect(testUI.Out).To(Say("space-1\n"))
+				})
+			})
+		})
+
+		Context("when listing apps and services", func() {
+			BeforeEach(func() {
+				fakeConfig.HasTargetedOrganizationReturns(true)
+				fakeConfig.HasTargetedSpaceReturns(true)
+				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+				fakeValuesActor.GetApplicationsBySpaceReturns([]v2action.Application{{Name: "app-1"}}, nil, nil)
+				fakeValuesActor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{{Name: "service-1"}}, nil, nil)
+			})
+
+			Context("when listing apps", func() {
+				BeforeEach(func() {
+					cmd.Values = "apps"
+				})
+
+				It("displays the apps in the targeted space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeValuesActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(testUI.Out).To(Say("app-1\n"))
+				})
+			})
+
+			Context("when listing services", func() {
+				BeforeEach(func() {
+					cmd.Values = "services"
+				})
+
+				It("displays the service instances in the targeted space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeValuesActor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(testUI.Out).To(Say("service-1\n"))
+				})
+			})
+		})
+	})
+})
@@ -311,6 +311,9 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_READ_ONLY=true", cmd.UI.TranslateText("Reject API requests that modify resources before they are sent")},
+		{"CF_RECORD=path/to/dir/", cmd.UI.TranslateText("Record API requests and responses to cassettes in a directory")},
+		{"CF_REPLAY=path/to/dir/", cmd.UI.TranslateText("Replay API responses from cassettes in a directory instead of contacting the API")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
@@ -599,6 +599,9 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_READ_ONLY=true                  Reject API requests that modify resources before they are sent"))
+				Expect(testUI.Out).To(Say("   CF_RECORD=path/to/dir/             Record API requests and responses to cassettes in a directory"))
+				Expect(testUI.Out).To(Say("   CF_REPLAY=path/to/dir/             Replay API responses from cassettes in a directory instead of contacting the API"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
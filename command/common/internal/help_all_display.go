@@ -5,7 +5,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "GETTING STARTED:",
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
-			{"api", "auth"},
+			{"api", "auth", "target-safety"},
 		},
 	},
 	{
@@ -14,11 +14,13 @@ var HelpCategoryList = []HelpCategory{
 			{"apps", "app"},
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
-			{"run-task", "tasks", "terminate-task"},
-			{"events", "files", "logs"},
+			{"run-task", "tasks", "terminate-task", "wait"},
+			{"events", "files", "logs", "top"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "validate-manifest"},
+			{"download-droplet", "upload-droplet"},
+			{"prune-droplets", "prune-packages", "rollback"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -46,6 +48,7 @@ var HelpCategoryList = []HelpCategory{
 			{"spaces", "space"},
 			{"create-space", "delete-space", "rename-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
+			{"clone-space"},
 		},
 	},
 	{
@@ -64,7 +67,8 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
-			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"network-policies", "add-network-policy", "remove-network-policy", "apply-network-policies"},
+			{"egress-check"},
 		},
 	},
 	{
@@ -79,14 +83,16 @@ var HelpCategoryList = []HelpCategory{
 			{"create-user", "delete-user"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"export-roles", "apply-roles"},
 		},
 	},
 	{
 		CategoryName: "ORG ADMIN:",
 		CommandList: [][]string{
-			{"quotas", "quota", "set-quota"},
+			{"quotas", "quota", "set-quota", "usage"},
 			{"create-quota", "delete-quota", "update-quota"},
 			{"share-private-domain", "unshare-private-domain"},
+			{"export-foundation", "apply-foundation"},
 		},
 	},
 	{
@@ -112,6 +118,7 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"export-security-groups", "diff-security-groups"},
 		},
 	},
 	{
@@ -135,7 +142,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "config", "oauth-token", "ssh-code", "completion", "alias"},
 		},
 	},
 	{
@@ -13,6 +13,7 @@ type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
+	Aliases() map[string]string
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
@@ -21,10 +22,12 @@ type Config interface {
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
+	GetAlias(name string) (string, bool)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	IsTTY() bool
 	Locale() string
 	MinCLIVersion() string
 	OverallPollingTimeout() time.Duration
@@ -32,12 +35,19 @@ type Config interface {
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	ProtectedTarget() bool
+	ReadOnly() bool
+	RecordDirectory() string
 	RefreshToken() string
+	RemoveAlias(name string)
 	RemovePlugin(string)
+	ReplayDirectory() string
 	SetAccessToken(token string)
+	SetAlias(name string, command string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
+	SetTargetSafety(safety configv3.TargetSafety)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetUAAEndpoint(uaaEndpoint string)
@@ -48,6 +58,7 @@ type Config interface {
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TargetSafety() configv3.TargetSafety
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetOrganizationInformation()
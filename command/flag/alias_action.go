@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type AliasAction string
+
+func (AliasAction) Complete(prefix string) []flags.Completion {
+	return completions([]string{"delete", "list", "set"}, prefix, false)
+}
+
+func (a *AliasAction) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "delete", "list", "set":
+		*a = AliasAction(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `ACTION must be "set", "delete", or "list"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AliasAction", func() {
+	var action AliasAction
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := action.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'set' when passed 's'", "s",
+				[]flags.Completion{{Item: "set"}}),
+			Entry("returns 'delete' when passed 'D'", "D",
+				[]flags.Completion{{Item: "delete"}}),
+			Entry("completes to 'delete', 'list', and 'set' when passed nothing", "",
+				[]flags.Completion{{Item: "delete"}, {Item: "list"}, {Item: "set"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			action = ""
+		})
+
+		DescribeTable("downcases and sets the action",
+			func(input string, expectedAction AliasAction) {
+				err := action.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(action).To(Equal(expectedAction))
+			},
+			Entry("sets 'set' when passed 'set'", "set", AliasAction("set")),
+			Entry("sets 'delete' when passed 'DELETE'", "DELETE", AliasAction("delete")),
+			Entry("sets 'list' when passed 'lIst'", "lIst", AliasAction("list")),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := action.UnmarshalFlag("rename")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `ACTION must be "set", "delete", or "list"`,
+				}))
+				Expect(action).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -277,6 +277,22 @@ type SecurityGroupArgs struct {
 	PathToJsonRules PathWithExistenceCheck `positional-arg-name:"PATH_TO_JSON_RULES_FILE" required:"true" description:"Path to file of JSON describing security group rules"`
 }
 
+type ExportSecurityGroupsArgs struct {
+	Directory Path `positional-arg-name:"DIR" required:"true" description:"Directory to write the security group rules and bindings to"`
+}
+
+type DiffSecurityGroupsArgs struct {
+	Directory PathWithExistenceCheck `positional-arg-name:"DIR" required:"true" description:"Directory of security group rules and bindings written by export-security-groups"`
+}
+
+type ExportFoundationArgs struct {
+	Directory Path `positional-arg-name:"DIR" required:"true" description:"Directory to write the foundation configuration to"`
+}
+
+type ApplyFoundationArgs struct {
+	Directory PathWithExistenceCheck `positional-arg-name:"DIR" required:"true" description:"Directory of foundation configuration written by export-foundation"`
+}
+
 type AddPluginRepoArgs struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
@@ -322,6 +338,45 @@ type AddNetworkPolicyArgs struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 }
 
+type EgressCheckArgs struct {
+	AppName     string            `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Destination EgressDestination `positional-arg-name:"DEST:PORT/PROTO" required:"true" description:"The destination IP address, host name or app name, port and protocol"`
+}
+
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type CompletionArgs struct {
+	Shell CompletionShell `positional-arg-name:"SHELL" required:"true" description:"The shell to generate the completion script for"`
+}
+
+type AliasArgs struct {
+	Action  AliasAction `positional-arg-name:"ACTION" description:"One of set, delete or list"`
+	Name    string      `positional-arg-name:"ALIAS_NAME" description:"The alias name"`
+	Command string      `positional-arg-name:"COMMAND" description:"The command the alias expands to"`
+}
+
+type CloneSpaceArgs struct {
+	SourceSpace      string `positional-arg-name:"SOURCE_SPACE" required:"true" description:"The space to clone"`
+	DestinationSpace string `positional-arg-name:"DEST_SPACE" required:"true" description:"The name of the space to create"`
+}
+
+type UploadDropletArgs struct {
+	AppName string                 `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Path    PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"The droplet file written by download-droplet"`
+}
+
+type OptionalAppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
+type WaitArgs struct {
+	Condition  WaitCondition `positional-arg-name:"CONDITION" required:"true" description:"One of app-running, task-done or service-ready"`
+	Name       string        `positional-arg-name:"NAME" required:"true" description:"The application name, or the service instance name for service-ready"`
+	SequenceID string        `positional-arg-name:"TASK_ID" description:"The task's unique sequence ID, for task-done"`
+}
+
+type TargetSafetyArgs struct {
+	Safety TargetSafety `positional-arg-name:"SAFETY" description:"One of read-only, protected or off"`
+}
@@ -0,0 +1,45 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// EgressDestination is a destination of outbound traffic in the form
+// DESTINATION:PORT/PROTOCOL, where DESTINATION is an IP address, a host name
+// or an app name.
+type EgressDestination struct {
+	Destination string
+	Port        int
+	Protocol    string
+}
+
+func (e *EgressDestination) UnmarshalFlag(val string) error {
+	invalidErr := &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `DEST:PORT/PROTO must be a destination, a port and one of tcp or udp, for example 10.0.0.1:443/tcp`,
+	}
+
+	protocolIndex := strings.LastIndex(val, "/")
+	portIndex := strings.LastIndex(val, ":")
+	if protocolIndex == -1 || portIndex == -1 || portIndex > protocolIndex || portIndex == 0 {
+		return invalidErr
+	}
+
+	port, err := strconv.Atoi(val[portIndex+1 : protocolIndex])
+	if err != nil || port < 1 || port > 65535 {
+		return invalidErr
+	}
+
+	protocol := strings.ToLower(val[protocolIndex+1:])
+	if protocol != "tcp" && protocol != "udp" {
+		return invalidErr
+	}
+
+	e.Destination = strings.Trim(val[:portIndex], "[]")
+	e.Port = port
+	e.Protocol = protocol
+	return nil
+}
@@ -0,0 +1,46 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressDestination", func() {
+	var destination EgressDestination
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			destination = EgressDestination{}
+		})
+
+		DescribeTable("it sets the destination, port and protocol",
+			func(input string, expected EgressDestination) {
+				err := destination.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(destination).To(Equal(expected))
+			},
+			Entry("an IPv4 address", "10.0.0.1:443/tcp", EgressDestination{Destination: "10.0.0.1", Port: 443, Protocol: "tcp"}),
+			Entry("an app name with an upper case protocol", "backend:8080/UDP", EgressDestination{Destination: "backend", Port: 8080, Protocol: "udp"}),
+			Entry("a bracketed IPv6 address", "[fd00::1]:53/udp", EgressDestination{Destination: "fd00::1", Port: 53, Protocol: "udp"}),
+		)
+
+		DescribeTable("errors correctly",
+			func(input string) {
+				err := destination.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `DEST:PORT/PROTO must be a destination, a port and one of tcp or udp, for example 10.0.0.1:443/tcp`,
+				}))
+			},
+			Entry("no port or protocol", "10.0.0.1"),
+			Entry("no protocol", "10.0.0.1:443"),
+			Entry("no destination", ":443/tcp"),
+			Entry("a non-numeric port", "10.0.0.1:https/tcp"),
+			Entry("an out of range port", "10.0.0.1:70000/tcp"),
+			Entry("an unsupported protocol", "10.0.0.1:443/icmp"),
+		)
+	})
+})
@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type TargetSafety string
+
+func (TargetSafety) Complete(prefix string) []flags.Completion {
+	return completions([]string{"off", "protected", "read-only"}, prefix, false)
+}
+
+func (t *TargetSafety) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "off", "protected", "read-only":
+		*t = TargetSafety(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SAFETY must be "read-only", "protected", or "off"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TargetSafety", func() {
+	var safety TargetSafety
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := safety.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'read-only' when passed 'r'", "r",
+				[]flags.Completion{{Item: "read-only"}}),
+			Entry("returns 'protected' when passed 'P'", "P",
+				[]flags.Completion{{Item: "protected"}}),
+			Entry("completes to 'off', 'protected', and 'read-only' when passed nothing", "",
+				[]flags.Completion{{Item: "off"}, {Item: "protected"}, {Item: "read-only"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			safety = ""
+		})
+
+		DescribeTable("downcases and sets the safety",
+			func(input string, expectedSafety TargetSafety) {
+				err := safety.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(safety).To(Equal(expectedSafety))
+			},
+			Entry("sets 'read-only' when passed 'read-only'", "read-only", TargetSafety("read-only")),
+			Entry("sets 'protected' when passed 'PROTECTED'", "PROTECTED", TargetSafety("protected")),
+			Entry("sets 'off' when passed 'Off'", "Off", TargetSafety("off")),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := safety.UnmarshalFlag("readonly")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SAFETY must be "read-only", "protected", or "off"`,
+				}))
+				Expect(safety).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type WaitCondition string
+
+const (
+	WaitConditionAppRunning   WaitCondition = "app-running"
+	WaitConditionServiceReady WaitCondition = "service-ready"
+	WaitConditionTaskDone     WaitCondition = "task-done"
+)
+
+func (WaitCondition) Complete(prefix string) []flags.Completion {
+	return completions([]string{string(WaitConditionAppRunning), string(WaitConditionServiceReady), string(WaitConditionTaskDone)}, prefix, false)
+}
+
+func (c *WaitCondition) UnmarshalFlag(val string) error {
+	valLower := WaitCondition(strings.ToLower(val))
+	switch valLower {
+	case WaitConditionAppRunning, WaitConditionServiceReady, WaitConditionTaskDone:
+		*c = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `CONDITION must be "app-running", "service-ready", or "task-done"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WaitCondition", func() {
+	var condition WaitCondition
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := condition.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'app-running' when passed 'a'", "a",
+				[]flags.Completion{{Item: "app-running"}}),
+			Entry("returns 'task-done' when passed 'T'", "T",
+				[]flags.Completion{{Item: "task-done"}}),
+			Entry("completes to all conditions when passed nothing", "",
+				[]flags.Completion{{Item: "app-running"}, {Item: "service-ready"}, {Item: "task-done"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			condition = ""
+		})
+
+		DescribeTable("downcases and sets the condition",
+			func(input string, expectedCondition WaitCondition) {
+				err := condition.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(condition).To(Equal(expectedCondition))
+			},
+			Entry("sets 'app-running' when passed 'app-running'", "app-running", WaitConditionAppRunning),
+			Entry("sets 'service-ready' when passed 'SERVICE-READY'", "SERVICE-READY", WaitConditionServiceReady),
+			Entry("sets 'task-done' when passed 'Task-Done'", "Task-Done", WaitConditionTaskDone),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := condition.UnmarshalFlag("app-stopped")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `CONDITION must be "app-running", "service-ready", or "task-done"`,
+				}))
+				Expect(condition).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -21,6 +21,16 @@ func HandleError(err error) error {
 
 	case pluginaction.AddPluginRepositoryError:
 		return translatableerror.AddPluginRepositoryError{Name: e.Name, URL: e.URL, Message: e.Message}
+	case pluginaction.AliasArgumentsError:
+		return translatableerror.AliasArgumentsError{Name: e.Name, Expected: e.Expected}
+	case pluginaction.AliasInvalidError:
+		return translatableerror.AliasInvalidError{Name: e.Name}
+	case pluginaction.AliasNameConflictError:
+		return translatableerror.AliasNameConflictError{Name: e.Name}
+	case pluginaction.AliasNameInvalidError:
+		return translatableerror.AliasNameInvalidError{Name: e.Name}
+	case pluginaction.AliasNotFoundError:
+		return translatableerror.AliasNotFoundError{Name: e.Name}
 	case pluginaction.GettingPluginRepositoryError:
 		return translatableerror.GettingPluginRepositoryError{Name: e.Name, Message: e.Message}
 	case pluginaction.NoCompatibleBinaryError:
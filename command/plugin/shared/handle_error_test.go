@@ -45,6 +45,21 @@ var _ = Describe("HandleError", func() {
 		Entry("pluginaction.AddPluginRepositoryError -> AddPluginRepositoryError",
 			pluginaction.AddPluginRepositoryError{Name: "some-repo", URL: "some-URL", Message: "404"},
 			translatableerror.AddPluginRepositoryError{Name: "some-repo", URL: "some-URL", Message: "404"}),
+		Entry("pluginaction.AliasArgumentsError -> AliasArgumentsError",
+			pluginaction.AliasArgumentsError{Name: "some-alias", Expected: 2},
+			translatableerror.AliasArgumentsError{Name: "some-alias", Expected: 2}),
+		Entry("pluginaction.AliasInvalidError -> AliasInvalidError",
+			pluginaction.AliasInvalidError{Name: "some-alias"},
+			translatableerror.AliasInvalidError{Name: "some-alias"}),
+		Entry("pluginaction.AliasNameConflictError -> AliasNameConflictError",
+			pluginaction.AliasNameConflictError{Name: "some-alias"},
+			translatableerror.AliasNameConflictError{Name: "some-alias"}),
+		Entry("pluginaction.AliasNameInvalidError -> AliasNameInvalidError",
+			pluginaction.AliasNameInvalidError{Name: "-some-alias"},
+			translatableerror.AliasNameInvalidError{Name: "-some-alias"}),
+		Entry("pluginaction.AliasNotFoundError -> AliasNotFoundError",
+			pluginaction.AliasNotFoundError{Name: "some-alias"},
+			translatableerror.AliasNotFoundError{Name: "some-alias"}),
 		Entry("pluginaction.GettingPluginRepositoryError -> GettingPluginRepositoryError",
 			pluginaction.GettingPluginRepositoryError{Name: "some-repo", Message: "404"},
 			translatableerror.GettingPluginRepositoryError{Name: "some-repo", Message: "404"}),
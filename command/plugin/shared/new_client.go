@@ -19,6 +19,12 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 		SkipSSLValidation: skipSSLValidation,
 	})
 
+	if replayDir := config.ReplayDirectory(); replayDir != "" {
+		pluginClient.WrapConnection(wrapper.NewRequestReplayer(replayDir))
+	} else if recordDir := config.RecordDirectory(); recordDir != "" {
+		pluginClient.WrapConnection(wrapper.NewRequestRecorder(recordDir))
+	}
+
 	if verbose {
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
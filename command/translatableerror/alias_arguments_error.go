@@ -0,0 +1,19 @@
+package translatableerror
+
+// AliasArgumentsError is returned when an alias is called with fewer
+// arguments than its command refers to.
+type AliasArgumentsError struct {
+	Name     string
+	Expected int
+}
+
+func (AliasArgumentsError) Error() string {
+	return "Alias '{{.Name}}' requires at least {{.Expected}} argument(s)."
+}
+
+func (e AliasArgumentsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":     e.Name,
+		"Expected": e.Expected,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// AliasInvalidError is returned when an alias command is empty, has an
+// unterminated quote, or does not start with a valid command name.
+type AliasInvalidError struct {
+	Name string
+}
+
+func (AliasInvalidError) Error() string {
+	return "Alias '{{.Name}}' must have a command that starts with a command name and has no unterminated quotes."
+}
+
+func (e AliasInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"Name": e.Name})
+}
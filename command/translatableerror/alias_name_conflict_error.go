@@ -0,0 +1,15 @@
+package translatableerror
+
+// AliasNameConflictError is returned when an alias name is already the name
+// or alias of a core or plugin command.
+type AliasNameConflictError struct {
+	Name string
+}
+
+func (AliasNameConflictError) Error() string {
+	return "Alias '{{.Name}}' cannot be used because it is already the name or alias of a command."
+}
+
+func (e AliasNameConflictError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"Name": e.Name})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// AliasNameInvalidError is returned when an alias name is empty, starts with
+// a dash or contains whitespace.
+type AliasNameInvalidError struct {
+	Name string
+}
+
+func (AliasNameInvalidError) Error() string {
+	return "Alias name '{{.Name}}' must not be empty, start with '-' or contain whitespace."
+}
+
+func (e AliasNameInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"Name": e.Name})
+}
@@ -0,0 +1,14 @@
+package translatableerror
+
+// AliasNotFoundError is returned when an alias does not exist.
+type AliasNotFoundError struct {
+	Name string
+}
+
+func (AliasNotFoundError) Error() string {
+	return "Alias '{{.Name}}' does not exist."
+}
+
+func (e AliasNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"Name": e.Name})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// DropletChecksumMismatchError is returned when the checksum of droplet bits
+// does not match the checksum the Cloud Controller has for the droplet.
+type DropletChecksumMismatchError struct {
+	DropletGUID string
+	Expected    string
+	Actual      string
+}
+
+func (DropletChecksumMismatchError) Error() string {
+	return "Checksum mismatch for droplet {{.DropletGUID}}: expected {{.Expected}}, got {{.Actual}}"
+}
+
+func (e DropletChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DropletGUID": e.DropletGUID,
+		"Expected":    e.Expected,
+		"Actual":      e.Actual,
+	})
+}
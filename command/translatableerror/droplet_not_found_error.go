@@ -0,0 +1,22 @@
+package translatableerror
+
+// DropletNotFoundError is returned when an app has no current droplet, or does
+// not have the droplet with DropletGUID.
+type DropletNotFoundError struct {
+	AppName     string
+	DropletGUID string
+}
+
+func (e DropletNotFoundError) Error() string {
+	if e.DropletGUID != "" {
+		return "Droplet {{.DropletGUID}} not found for app {{.AppName}}"
+	}
+	return "App {{.AppName}} has no current droplet"
+}
+
+func (e DropletNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"DropletGUID": e.DropletGUID,
+	})
+}
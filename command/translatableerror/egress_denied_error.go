@@ -0,0 +1,24 @@
+package translatableerror
+
+// EgressDeniedError is returned by egress-check when the traffic would be
+// denied. The command exits with status 2 so that scripts can tell it apart
+// from other failures.
+type EgressDeniedError struct {
+	AppName     string
+	Destination string
+}
+
+func (EgressDeniedError) Error() string {
+	return "Egress from app {{.AppName}} to {{.Destination}} is not allowed"
+}
+
+func (e EgressDeniedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"Destination": e.Destination,
+	})
+}
+
+func (EgressDeniedError) ExitCode() int {
+	return 2
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+// EgressDestinationNotResolvedError is returned by egress-check when the
+// destination is not the name of an app in the targeted space and cannot be
+// resolved to an IP address.
+type EgressDestinationNotResolvedError struct {
+	Host string
+	Err  error
+}
+
+func (EgressDestinationNotResolvedError) Error() string {
+	return "Destination {{.Host}} is not an app in the targeted space and could not be resolved: {{.Err}}"
+}
+
+func (e EgressDestinationNotResolvedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Host": e.Host,
+		"Err":  e.Err,
+	})
+}
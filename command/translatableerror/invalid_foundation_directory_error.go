@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidFoundationDirectoryError is returned when a foundation directory
+// cannot be parsed or is inconsistent.
+type InvalidFoundationDirectoryError struct {
+	Path    string
+	Message string
+}
+
+func (InvalidFoundationDirectoryError) Error() string {
+	return "Invalid foundation directory {{.Path}}: {{.Message}}"
+}
+
+func (e InvalidFoundationDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,18 @@
+package translatableerror
+
+// InvalidManifestError is returned when validating a manifest finds problems.
+type InvalidManifestError struct {
+	Path     string
+	Problems int
+}
+
+func (InvalidManifestError) Error() string {
+	return "Manifest {{.Path}} has {{.Problems}} problem(s)"
+}
+
+func (e InvalidManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":     e.Path,
+		"Problems": e.Problems,
+	})
+}
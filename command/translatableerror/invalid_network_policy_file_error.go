@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidNetworkPolicyFileError is returned when a network policy file cannot
+// be parsed or contains an invalid policy.
+type InvalidNetworkPolicyFileError struct {
+	Path    string
+	Message string
+}
+
+func (InvalidNetworkPolicyFileError) Error() string {
+	return "Invalid network policy file {{.Path}}: {{.Message}}"
+}
+
+func (e InvalidNetworkPolicyFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
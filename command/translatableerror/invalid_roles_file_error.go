@@ -0,0 +1,18 @@
+package translatableerror
+
+// InvalidRolesFileError is returned when a roles file cannot be parsed.
+type InvalidRolesFileError struct {
+	Path    string
+	Message string
+}
+
+func (InvalidRolesFileError) Error() string {
+	return "Invalid roles file {{.Path}}: {{.Message}}"
+}
+
+func (e InvalidRolesFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
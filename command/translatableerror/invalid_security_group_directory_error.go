@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidSecurityGroupDirectoryError is returned when a security group
+// directory cannot be parsed or contains an invalid rule or binding.
+type InvalidSecurityGroupDirectoryError struct {
+	Path    string
+	Message string
+}
+
+func (InvalidSecurityGroupDirectoryError) Error() string {
+	return "Invalid security group directory {{.Path}}: {{.Message}}"
+}
+
+func (e InvalidSecurityGroupDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ManifestProcessTypeMissingError is returned when a process in the processes
+// section of an application in the manifest has no type.
+type ManifestProcessTypeMissingError struct {
+	AppName string
+}
+
+func (ManifestProcessTypeMissingError) Error() string {
+	return "Error reading manifest: a process of app '{{.AppName}}' is missing the required 'type' property"
+}
+
+func (e ManifestProcessTypeMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
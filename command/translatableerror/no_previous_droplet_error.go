@@ -0,0 +1,19 @@
+package translatableerror
+
+// NoPreviousDropletError is returned when an app does not have a staged
+// droplet the requested number of steps before its current droplet.
+type NoPreviousDropletError struct {
+	AppName string
+	Steps   int
+}
+
+func (NoPreviousDropletError) Error() string {
+	return "App {{.AppName}} has no staged droplet {{.Steps}} step(s) before its current droplet"
+}
+
+func (e NoPreviousDropletError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Steps":   e.Steps,
+	})
+}
@@ -0,0 +1,14 @@
+package translatableerror
+
+// ProtectedTargetNotConfirmedError is returned when the user does not confirm
+// a change to a protected target.
+type ProtectedTargetNotConfirmedError struct {
+}
+
+func (ProtectedTargetNotConfirmedError) Error() string {
+	return "The change was not confirmed, no request was sent."
+}
+
+func (e ProtectedTargetNotConfirmedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// ReadOnlyModeError is returned when a command would modify resources while
+// the CLI is in read-only mode.
+type ReadOnlyModeError struct {
+	Method string
+	URL    string
+}
+
+func (ReadOnlyModeError) Error() string {
+	return "Read-only mode is enabled, {{.Method}} {{.URL}} was not sent."
+}
+
+func (e ReadOnlyModeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Method": e.Method,
+		"URL":    e.URL,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// RoleChangesFailedError is returned when some of the role changes of
+// apply-roles could not be made.
+type RoleChangesFailedError struct {
+	Failed int
+	Count  int
+}
+
+func (RoleChangesFailedError) Error() string {
+	return "{{.Failed}} of {{.Count}} role changes failed."
+}
+
+func (e RoleChangesFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Count":  e.Count,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// RollbackDropletNotStagedError is returned when the droplet requested for a
+// rollback did not stage successfully.
+type RollbackDropletNotStagedError struct {
+	DropletGUID string
+	State       string
+}
+
+func (RollbackDropletNotStagedError) Error() string {
+	return "Droplet {{.DropletGUID}} cannot be used for a rollback because its state is {{.State}}"
+}
+
+func (e RollbackDropletNotStagedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DropletGUID": e.DropletGUID,
+		"State":       e.State,
+	})
+}
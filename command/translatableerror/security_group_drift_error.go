@@ -0,0 +1,19 @@
+package translatableerror
+
+// SecurityGroupDriftError is returned when the security groups of the
+// foundation differ from a security group directory.
+type SecurityGroupDriftError struct {
+	Path    string
+	Changes int
+}
+
+func (SecurityGroupDriftError) Error() string {
+	return "Security groups differ from {{.Path}}: {{.Changes}} change(s) found."
+}
+
+func (e SecurityGroupDriftError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Changes": e.Changes,
+	})
+}
@@ -0,0 +1,26 @@
+package translatableerror
+
+// ServiceInstanceOperationFailedError is returned when waiting for a service
+// instance whose last operation has failed. The command exits with status 3
+// as the service instance can no longer become ready.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (ServiceInstanceOperationFailedError) Error() string {
+	return "Service instance {{.Name}} {{.Operation}} failed: {{.Description}}"
+}
+
+func (e ServiceInstanceOperationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":        e.Name,
+		"Operation":   e.Operation,
+		"Description": e.Description,
+	})
+}
+
+func (ServiceInstanceOperationFailedError) ExitCode() int {
+	return 3
+}
@@ -0,0 +1,23 @@
+package translatableerror
+
+// TaskFailedError is returned when waiting for a task that has failed. The
+// command exits with status 3 as the task can no longer succeed.
+type TaskFailedError struct {
+	SequenceID int
+	AppName    string
+}
+
+func (TaskFailedError) Error() string {
+	return "Task {{.SequenceID}} of app {{.AppName}} failed"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceID": e.SequenceID,
+		"AppName":    e.AppName,
+	})
+}
+
+func (TaskFailedError) ExitCode() int {
+	return 3
+}
@@ -37,6 +37,11 @@ var _ = Describe("Translatable Errors", func() {
 		},
 
 		Entry("AddPluginRepositoryError", AddPluginRepositoryError{}),
+		Entry("AliasArgumentsError", AliasArgumentsError{}),
+		Entry("AliasInvalidError", AliasInvalidError{}),
+		Entry("AliasNameConflictError", AliasNameConflictError{}),
+		Entry("AliasNameInvalidError", AliasNameInvalidError{}),
+		Entry("AliasNotFoundError", AliasNotFoundError{}),
 		Entry("APINotFoundError", APINotFoundError{}),
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
@@ -48,6 +53,10 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
+		Entry("DropletChecksumMismatchError", DropletChecksumMismatchError{}),
+		Entry("DropletNotFoundError", DropletNotFoundError{}),
+		Entry("EgressDeniedError", EgressDeniedError{}),
+		Entry("EgressDestinationNotResolvedError", EgressDestinationNotResolvedError{Err: errors.New("some-error")}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
@@ -55,12 +64,18 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
+		Entry("InvalidFoundationDirectoryError", InvalidFoundationDirectoryError{}),
+		Entry("InvalidManifestError", InvalidManifestError{}),
+		Entry("InvalidNetworkPolicyFileError", InvalidNetworkPolicyFileError{}),
+		Entry("InvalidRolesFileError", InvalidRolesFileError{}),
+		Entry("InvalidSecurityGroupDirectoryError", InvalidSecurityGroupDirectoryError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("ManifestProcessTypeMissingError", ManifestProcessTypeMissingError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
 		Entry("NetworkPolicyProtocolOrPortNotProvidedError", NetworkPolicyProtocolOrPortNotProvidedError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
@@ -68,6 +83,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
+		Entry("NoPreviousDropletError", NoPreviousDropletError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
@@ -84,14 +100,20 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
 		Entry("PropertyCombinationError", PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
+		Entry("ProtectedTargetNotConfirmedError", ProtectedTargetNotConfirmedError{}),
+		Entry("ReadOnlyModeError", ReadOnlyModeError{}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
+		Entry("RoleChangesFailedError", RoleChangesFailedError{}),
+		Entry("RollbackDropletNotStagedError", RollbackDropletNotStagedError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RunTaskError", RunTaskError{}),
+		Entry("SecurityGroupDriftError", SecurityGroupDriftError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServiceInstanceOperationFailedError", ServiceInstanceOperationFailedError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
@@ -100,11 +122,13 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("TaskFailedError", TaskFailedError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
+		Entry("WaitTimeoutError", WaitTimeoutError{}),
 	)
 
 	Describe("PluginInvalidError", func() {
@@ -0,0 +1,26 @@
+package translatableerror
+
+import "time"
+
+// WaitTimeoutError is returned when the condition of a wait is not met before
+// the timeout. The command exits with status 2 so that it can be told apart
+// from other failures.
+type WaitTimeoutError struct {
+	Condition string
+	Timeout   time.Duration
+}
+
+func (WaitTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for {{.Condition}}"
+}
+
+func (e WaitTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Condition": e.Condition,
+		"Timeout":   e.Timeout,
+	})
+}
+
+func (WaitTimeoutError) ExitCode() int {
+	return 2
+}
@@ -10,7 +10,7 @@ import (
 
 type CreateSpaceCommand struct {
 	RequiredArgs    flag.Space  `positional-args:"yes"`
-	Organization    string      `short:"o" description:"Organization"`
+	Organization    string      `short:"o" description:"Organization" completion:"orgs"`
 	Quota           string      `short:"q" description:"Quota to assign to the newly created space"`
 	usage           interface{} `usage:"CF_NAME create-space SPACE [-o ORG] [-q SPACE_QUOTA]"`
 	relatedCommands interface{} `related_commands:"set-space-isolation-segment, space-quotas, spaces, target"`
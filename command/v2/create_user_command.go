@@ -71,6 +71,13 @@ func (cmd *CreateUserCommand) Execute(args []string) error {
 		"TargetUser": cmd.Args.Username,
 	})
 
+	// The UAA user is created before the Cloud Controller user, so the target
+	// safety is checked first to avoid leaving a UAA user behind.
+	err = shared.CheckTargetSafety(cmd.Config, cmd.UI, "POST", "/Users")
+	if err != nil {
+		return err
+	}
+
 	_, warnings, err := cmd.Actor.CreateUser(cmd.Args.Username, password, cmd.Origin)
 	cmd.UI.DisplayWarnings(warnings)
 
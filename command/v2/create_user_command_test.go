@@ -67,6 +67,17 @@ var _ = Describe("create-user Command", func() {
 		})
 	})
 
+	Context("when the config is read-only", func() {
+		BeforeEach(func() {
+			fakeConfig.ReadOnlyReturns(true)
+		})
+
+		It("returns a ReadOnlyModeError without creating the user", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ReadOnlyModeError{Method: "POST", URL: "/Users"}))
+			Expect(fakeActor.CreateUserCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when the user is logged in", func() {
 		Context("when password is not provided", func() {
 			BeforeEach(func() {
@@ -0,0 +1,147 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . DiffSecurityGroupsActor
+
+type DiffSecurityGroupsActor interface {
+	CloudControllerAPIVersion() string
+	DiffSecurityGroups(dir string, includeStaging bool) ([]v2action.SecurityGroupChange, v2action.Warnings, error)
+}
+
+type DiffSecurityGroupsCommand struct {
+	RequiredArgs    flag.DiffSecurityGroupsArgs `positional-args:"yes"`
+	usage           interface{}                 `usage:"CF_NAME diff-security-groups DIR\n\n   Compares the security groups, rules and bindings in DIR, as written by\n   export-security-groups, with the security groups of the foundation. A '+' marks\n   something in DIR that is missing from the foundation, a '-' marks something on\n   the foundation that is missing from DIR, and a '~' marks a rule whose description\n   differs. The command exits with a non-zero status when there are differences.\n\nEXAMPLES:\n   CF_NAME diff-security-groups ./security-groups"`
+	relatedCommands interface{}                 `related_commands:"export-security-groups, security-groups"`
+
+	SharedActor command.SharedActor
+	Config      command.Config
+	UI          command.UI
+	Actor       DiffSecurityGroupsActor
+}
+
+func (cmd *DiffSecurityGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd DiffSecurityGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	includeStaging, err := securityGroupsIncludeStaging(cmd.Actor.CloudControllerAPIVersion())
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing security groups with {{.Dir}} as {{.User}}...", map[string]interface{}{
+		"Dir":  cmd.RequiredArgs.Directory,
+		"User": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.DiffSecurityGroups(string(cmd.RequiredArgs.Directory), includeStaging)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Security groups match {{.Dir}}.", map[string]interface{}{
+			"Dir": cmd.RequiredArgs.Directory,
+		})
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("security group"),
+			cmd.UI.TranslateText("lifecycle"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+	for _, change := range changes {
+		table = append(table, cmd.changeRow(change))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	return translatableerror.SecurityGroupDriftError{
+		Path:    string(cmd.RequiredArgs.Directory),
+		Changes: len(changes),
+	}
+}
+
+func (cmd DiffSecurityGroupsCommand) changeRow(change v2action.SecurityGroupChange) []string {
+	var marker string
+	switch change.Type {
+	case v2action.SecurityGroupChangeAdded:
+		marker = "+"
+	case v2action.SecurityGroupChangeRemoved:
+		marker = "-"
+	default:
+		marker = "~"
+	}
+
+	switch {
+	case change.Rule != nil:
+		rule := strings.TrimSpace(fmt.Sprintf("%s %s %s", change.Rule.Protocol, change.Rule.Destination, change.Rule.Ports))
+		if change.Rule.Type.IsSet {
+			rule = fmt.Sprintf("%s type %d", rule, change.Rule.Type.Value)
+		}
+		if change.Rule.Code.IsSet {
+			rule = fmt.Sprintf("%s code %d", rule, change.Rule.Code.Value)
+		}
+		if change.Rule.Log {
+			rule = fmt.Sprintf("%s log", rule)
+		}
+		if change.Rule.Description != "" {
+			rule = fmt.Sprintf("%s (%s)", rule, change.Rule.Description)
+		}
+		return []string{marker, change.SecurityGroupName, "", cmd.UI.TranslateText("rule {{.Rule}}", map[string]interface{}{"Rule": rule})}
+
+	case change.Binding != nil:
+		details := cmd.UI.TranslateText("bound to all spaces")
+		if change.Binding.Space != "" {
+			details = cmd.UI.TranslateText("bound to org {{.Org}} / space {{.Space}}", map[string]interface{}{
+				"Org":   change.Binding.Organization,
+				"Space": change.Binding.Space,
+			})
+		}
+		return []string{marker, change.SecurityGroupName, string(change.Binding.Lifecycle), details}
+	}
+
+	return []string{marker, change.SecurityGroupName, "", cmd.UI.TranslateText("security group")}
+}
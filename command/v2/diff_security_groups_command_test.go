@@ -0,0 +1,120 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-security-groups Command", func() {
+	var (
+		cmd             DiffSecurityGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDiffSecurityGroupsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDiffSecurityGroupsActor)
+
+		cmd = DiffSecurityGroupsCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			RequiredArgs: flag.DiffSecurityGroupsArgs{Directory: "some-dir"},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLifecyleStagingV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when there are no differences", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSecurityGroupsReturns(nil, v2action.Warnings{"diff-warning"}, nil)
+		})
+
+		It("reports that the security groups match", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			dir, includeStaging := fakeActor.DiffSecurityGroupsArgsForCall(0)
+			Expect(dir).To(Equal("some-dir"))
+			Expect(includeStaging).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Comparing security groups with some-dir as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("Security groups match some-dir."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("diff-warning"))
+		})
+	})
+
+	Context("when there are differences", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSecurityGroupsReturns(
+				[]v2action.SecurityGroupChange{
+					{Type: v2action.SecurityGroupChangeChanged, SecurityGroupName: "db", Rule: &ccv2.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.16.0/24", Ports: "5432", Description: "primary"}},
+					{Type: v2action.SecurityGroupChangeRemoved, SecurityGroupName: "db", Binding: &v2action.SecurityGroupBinding{SecurityGroupName: "db", Organization: "acme", Space: "prod", Lifecycle: ccv2.SecurityGroupLifecycleRunning}},
+					{Type: v2action.SecurityGroupChangeAdded, SecurityGroupName: "dns"},
+					{Type: v2action.SecurityGroupChangeAdded, SecurityGroupName: "dns", Rule: &ccv2.SecurityGroupRule{Protocol: "icmp", Destination: "10.0.0.0/8", Type: types.NullInt{Value: 8, IsSet: true}, Code: types.NullInt{Value: -1, IsSet: true}, Log: true}},
+					{Type: v2action.SecurityGroupChangeAdded, SecurityGroupName: "dns", Binding: &v2action.SecurityGroupBinding{SecurityGroupName: "dns", Lifecycle: ccv2.SecurityGroupLifecycleStaging}},
+				},
+				nil,
+				nil)
+		})
+
+		It("displays the differences and returns a SecurityGroupDriftError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SecurityGroupDriftError{Path: "some-dir", Changes: 5}))
+
+			Expect(testUI.Out).To(Say(`security group\s+lifecycle\s+details`))
+			Expect(testUI.Out).To(Say(`~\s+db\s+rule tcp 10\.0\.16\.0/24 5432 \(primary\)`))
+			Expect(testUI.Out).To(Say(`-\s+db\s+running\s+bound to org acme / space prod`))
+			Expect(testUI.Out).To(Say(`\+\s+dns\s+security group`))
+			Expect(testUI.Out).To(Say(`\+\s+dns\s+rule icmp 10\.0\.0\.0/8 type 8 code -1 log`))
+			Expect(testUI.Out).To(Say(`\+\s+dns\s+staging\s+bound to all spaces`))
+		})
+	})
+
+	Context("when the directory is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSecurityGroupsReturns(nil, nil, v2action.InvalidSecurityGroupDirectoryError{Path: "some-dir", Message: "some-message"})
+		})
+
+		It("returns an InvalidSecurityGroupDirectoryError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidSecurityGroupDirectoryError{Path: "some-dir", Message: "some-message"}))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ExportSecurityGroupsActor
+
+type ExportSecurityGroupsActor interface {
+	CloudControllerAPIVersion() string
+	ExportSecurityGroups(dir string, includeStaging bool) (int, v2action.Warnings, error)
+}
+
+type ExportSecurityGroupsCommand struct {
+	RequiredArgs    flag.ExportSecurityGroupsArgs `positional-args:"yes"`
+	usage           interface{}                   `usage:"CF_NAME export-security-groups DIR\n\n   The rules of each security group are written to DIR/SECURITY_GROUP.json in the\n   format accepted by create-security-group and update-security-group. The running\n   and staging bindings of the security groups are written to DIR/bindings.yml.\n   Other .json files in DIR are removed.\n\nEXAMPLES:\n   CF_NAME export-security-groups ./security-groups"`
+	relatedCommands interface{}                   `related_commands:"diff-security-groups, security-groups, update-security-group"`
+
+	SharedActor command.SharedActor
+	Config      command.Config
+	UI          command.UI
+	Actor       ExportSecurityGroupsActor
+}
+
+func (cmd *ExportSecurityGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ExportSecurityGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	includeStaging, err := securityGroupsIncludeStaging(cmd.Actor.CloudControllerAPIVersion())
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting security groups to {{.Dir}} as {{.User}}...", map[string]interface{}{
+		"Dir":  cmd.RequiredArgs.Directory,
+		"User": user.Name,
+	})
+
+	count, warnings, err := cmd.Actor.ExportSecurityGroups(string(cmd.RequiredArgs.Directory), includeStaging)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Exported {{.Count}} security groups.", map[string]interface{}{
+		"Count": count,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// securityGroupsIncludeStaging returns whether the API supports binding
+// security groups to spaces for the staging lifecycle.
+func securityGroupsIncludeStaging(apiVersion string) (bool, error) {
+	err := command.MinimumAPIVersionCheck(apiVersion, ccversion.MinVersionLifecyleStagingV2)
+	if err != nil {
+		if _, ok := err.(translatableerror.MinimumAPIVersionNotMetError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
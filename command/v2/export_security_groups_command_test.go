@@ -0,0 +1,122 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-security-groups Command", func() {
+	var (
+		cmd             ExportSecurityGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeExportSecurityGroupsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeExportSecurityGroupsActor)
+
+		cmd = ExportSecurityGroupsCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			RequiredArgs: flag.ExportSecurityGroupsArgs{Directory: "some-dir"},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLifecyleStagingV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	Context("when the export succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.ExportSecurityGroupsReturns(3, v2action.Warnings{"export-warning"}, nil)
+		})
+
+		It("exports the security groups including staging bindings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ExportSecurityGroupsCallCount()).To(Equal(1))
+			dir, includeStaging := fakeActor.ExportSecurityGroupsArgsForCall(0)
+			Expect(dir).To(Equal("some-dir"))
+			Expect(includeStaging).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Exporting security groups to some-dir as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("Exported 3 security groups."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("export-warning"))
+		})
+
+		Context("when the API does not support staging bindings to spaces", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.36.0")
+			})
+
+			It("exports without staging space bindings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, includeStaging := fakeActor.ExportSecurityGroupsArgsForCall(0)
+				Expect(includeStaging).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when the export fails", func() {
+		BeforeEach(func() {
+			fakeActor.ExportSecurityGroupsReturns(0, v2action.Warnings{"export-warning"}, errors.New("export-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("export-error"))
+			Expect(testUI.Err).To(Say("export-warning"))
+		})
+	})
+})
@@ -40,10 +40,19 @@ func GetApplicationChanges(appConfig pushaction.ApplicationConfig) []ui.Change {
 			})
 	}
 
-	// Existing buildpack and existing detected buildpack are mutually exclusive
+	// Existing buildpack and existing detected buildpack are mutually exclusive.
+	// The buildpacks of the current application come from its V3 lifecycle, so
+	// pushing the same buildpacks again shows no change.
 	oldBuildpack := appConfig.CurrentApplication.CalculatedBuildpack()
 	newBuildpack := appConfig.DesiredApplication.CalculatedBuildpack()
-	if oldBuildpack != "" || newBuildpack != "" {
+	if len(appConfig.CurrentApplication.Buildpacks) > 1 || len(appConfig.DesiredApplication.Buildpacks) > 1 {
+		changes = append(changes,
+			ui.Change{
+				Header:       "buildpacks:",
+				CurrentValue: appConfig.CurrentApplication.CalculatedBuildpacks(),
+				NewValue:     appConfig.DesiredApplication.CalculatedBuildpacks(),
+			})
+	} else if oldBuildpack != "" || newBuildpack != "" {
 		changes = append(changes,
 			ui.Change{
 				Header:       "buildpack:",
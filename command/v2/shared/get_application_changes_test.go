@@ -165,6 +165,36 @@ var _ = Describe("GetApplicationChanges", func() {
 				"some-detected-buildpack", "",
 			),
 		)
+
+		Context("when multiple buildpacks are specified", func() {
+			BeforeEach(func() {
+				appConfig.CurrentApplication.Buildpack = types.FilteredString{IsSet: true, Value: "some-old-buildpack"}
+				appConfig.DesiredApplication.Buildpacks = []string{"some-buildpack-1", "some-buildpack-2"}
+			})
+
+			It("provides a buildpacks change", func() {
+				Expect(changes[2]).To(Equal(ui.Change{
+					Header:       "buildpacks:",
+					CurrentValue: []string{"some-old-buildpack"},
+					NewValue:     []string{"some-buildpack-1", "some-buildpack-2"},
+				}))
+			})
+		})
+
+		Context("when the current application already has the same buildpacks", func() {
+			BeforeEach(func() {
+				appConfig.CurrentApplication.Buildpacks = []string{"some-buildpack-1", "some-buildpack-2"}
+				appConfig.DesiredApplication.Buildpacks = []string{"some-buildpack-1", "some-buildpack-2"}
+			})
+
+			It("provides an unchanged buildpacks change", func() {
+				Expect(changes[2]).To(Equal(ui.Change{
+					Header:       "buildpacks:",
+					CurrentValue: []string{"some-buildpack-1", "some-buildpack-2"},
+					NewValue:     []string{"some-buildpack-1", "some-buildpack-2"},
+				}))
+			})
+		})
 	})
 
 	Describe("command", func() {
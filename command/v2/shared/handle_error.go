@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/manifest"
@@ -21,6 +22,8 @@ func HandleError(err error) error {
 		return translatableerror.SSLCertError(e)
 	case ccerror.UnverifiedServerError:
 		return translatableerror.InvalidSSLCertError{API: e.URL}
+	case ccerror.ReadOnlyError:
+		return translatableerror.ReadOnlyModeError(e)
 
 	case ccerror.JobFailedError:
 		return translatableerror.JobFailedError(e)
@@ -43,6 +46,8 @@ func HandleError(err error) error {
 		return translatableerror.ApplicationNotFoundError{Name: e.Name}
 	case v2action.OrganizationNotFoundError:
 		return translatableerror.OrganizationNotFoundError{Name: e.Name}
+	case v2action.InvalidSecurityGroupDirectoryError:
+		return translatableerror.InvalidSecurityGroupDirectoryError(e)
 	case v2action.SecurityGroupNotFoundError:
 		return translatableerror.SecurityGroupNotFoundError(e)
 	case v2action.ServiceInstanceNotFoundError:
@@ -74,6 +79,8 @@ func HandleError(err error) error {
 		return translatableerror.NoDomainsFoundError{}
 	case pushaction.NonexistentAppPathError:
 		return translatableerror.FileNotFoundError(e)
+	case pushaction.MultipleBuildpacksNotSupportedError:
+		return translatableerror.MinimumAPIVersionNotMetError{Command: "Multiple buildpacks", MinimumVersion: ccversion.MinVersionV3}
 	case pushaction.MissingNameError:
 		return translatableerror.RequiredNameForPushError{}
 	case pushaction.UploadFailedError:
@@ -85,6 +92,8 @@ func HandleError(err error) error {
 
 	case manifest.ManifestCreationError:
 		return translatableerror.ManifestCreationError(e)
+	case manifest.ProcessTypeMissingError:
+		return translatableerror.ManifestProcessTypeMissingError(e)
 	}
 
 	return err
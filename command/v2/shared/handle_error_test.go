@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
@@ -44,6 +45,10 @@ var _ = Describe("HandleError", func() {
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			translatableerror.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("ccerror.ReadOnlyError -> ReadOnlyModeError",
+			ccerror.ReadOnlyError{Method: "DELETE", URL: "some-url"},
+			translatableerror.ReadOnlyModeError{Method: "DELETE", URL: "some-url"}),
+
 		Entry("ccerror.SSLValidationHostnameError -> SSLCertErrorError",
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			translatableerror.SSLCertError{Message: "some-message"}),
@@ -56,6 +61,10 @@ var _ = Describe("HandleError", func() {
 			actionerror.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v2action.InvalidSecurityGroupDirectoryError -> InvalidSecurityGroupDirectoryError",
+			v2action.InvalidSecurityGroupDirectoryError{Path: "some-path", Message: "some-message"},
+			translatableerror.InvalidSecurityGroupDirectoryError{Path: "some-path", Message: "some-message"}),
+
 		Entry("v2action.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			v2action.SecurityGroupNotFoundError{Name: "some-security-group"},
 			translatableerror.SecurityGroupNotFoundError{Name: "some-security-group"}),
@@ -151,6 +160,11 @@ var _ = Describe("HandleError", func() {
 			translatableerror.PortNotAllowedWithHTTPDomainError{Domain: "some-domain"},
 		),
 
+		Entry("pushaction.MultipleBuildpacksNotSupportedError -> MinimumAPIVersionNotMetError",
+			pushaction.MultipleBuildpacksNotSupportedError{},
+			translatableerror.MinimumAPIVersionNotMetError{Command: "Multiple buildpacks", MinimumVersion: ccversion.MinVersionV3},
+		),
+
 		Entry("pushaction.MissingNameError -> RequiredNameForPushError",
 			pushaction.MissingNameError{},
 			translatableerror.RequiredNameForPushError{},
@@ -176,6 +190,11 @@ var _ = Describe("HandleError", func() {
 			translatableerror.ManifestCreationError{Err: errors.New("some-error")},
 		),
 
+		Entry("manifest.ProcessTypeMissingError -> ManifestProcessTypeMissingError",
+			manifest.ProcessTypeMissingError{AppName: "some-app"},
+			translatableerror.ManifestProcessTypeMissingError{AppName: "some-app"},
+		),
+
 		Entry("default case -> original error",
 			err,
 			err),
@@ -14,6 +14,12 @@ import (
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Client, *uaa.Client, error) {
 	ccWrappers := []ccv2.ConnectionWrapper{}
 
+	if replayDir := config.ReplayDirectory(); replayDir != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestReplayer(replayDir))
+	} else if recordDir := config.RecordDirectory(); recordDir != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestRecorder(recordDir))
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -28,6 +34,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	ccWrappers = append(ccWrappers, authWrapper)
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
 
+	if config.ReadOnly() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewReadOnly())
+	} else if config.ProtectedTarget() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewProtectedTarget(ConfirmProtectedTarget(config, ui)))
+	}
+
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
@@ -68,6 +80,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		SkipSSLValidation: config.SkipSSLValidation(),
 	})
 
+	if replayDir := config.ReplayDirectory(); replayDir != "" {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestReplayer(replayDir))
+	} else if recordDir := config.RecordDirectory(); recordDir != "" {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestRecorder(recordDir))
+	}
+
 	if verbose {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
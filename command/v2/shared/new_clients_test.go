@@ -1,6 +1,9 @@
 package shared_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
@@ -64,6 +67,31 @@ var _ = Describe("New Clients", func() {
 		})
 	})
 
+	Context("when replaying API responses", func() {
+		var replayDir string
+
+		BeforeEach(func() {
+			var err error
+			replayDir, err = ioutil.TempDir("", "new-clients-replay")
+			Expect(err).ToNot(HaveOccurred())
+
+			cassette := `{"request":{"method":"GET","url":"https://potato.bananapants11122.co.uk/v2/info"},"response":{"status_code":200,"body":"{\"api_version\":\"2.100.0\"}"}}` + "\n"
+			Expect(ioutil.WriteFile(filepath.Join(replayDir, "cloud_controller.jsonl"), []byte(cassette), 0600)).To(Succeed())
+
+			fakeConfig.TargetReturns("https://potato.bananapants11122.co.uk")
+			fakeConfig.ReplayDirectoryReturns(replayDir)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(replayDir)).To(Succeed())
+		})
+
+		It("targets the Cloud Controller with the recorded responses", func() {
+			_, _, err := NewClients(fakeConfig, testUI, true)
+			Expect(err).To(MatchError(translatableerror.AuthorizationEndpointNotFoundError{}))
+		})
+	})
+
 	Context("when not targetting", func() {
 		It("does not target and returns no UAA client", func() {
 			ccClient, uaaClient, err := NewClients(fakeConfig, testUI, false)
@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/targetsafety"
+)
+
+// confirmedProtectedTargets are the targets that changes were confirmed for,
+// so that commands using several clients only ask once.
+var confirmedProtectedTargets = map[string]bool{}
+
+// ConfirmProtectedTarget returns a function that asks the user to type the
+// targeted org name, or the API endpoint when no org is targeted, before
+// changes are made to a protected target.
+func ConfirmProtectedTarget(config command.Config, ui command.UI) func() error {
+	return func() error {
+		expected := config.TargetedOrganization().Name
+		if expected == "" {
+			expected = config.Target()
+		}
+
+		key := config.Target() + " " + expected
+		if confirmedProtectedTargets[key] {
+			return nil
+		}
+
+		response, err := ui.DisplayTextPrompt(targetsafety.ConfirmPrompt, map[string]interface{}{
+			"Target":   config.Target(),
+			"Expected": expected,
+		})
+		if err != nil || strings.TrimSpace(response) != expected {
+			return translatableerror.ProtectedTargetNotConfirmedError{}
+		}
+
+		confirmedProtectedTargets[key] = true
+		return nil
+	}
+}
+
+// CheckTargetSafety guards a change that is not sent through a Cloud
+// Controller client, such as creating a UAA user. It returns a
+// ReadOnlyModeError in read-only mode, and asks for confirmation when the
+// target is protected.
+func CheckTargetSafety(config command.Config, ui command.UI, method string, url string) error {
+	if config.ReadOnly() {
+		return translatableerror.ReadOnlyModeError{Method: method, URL: url}
+	}
+
+	if config.ProtectedTarget() {
+		return ConfirmProtectedTarget(config, ui)()
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ConfirmProtectedTarget", func() {
+	var (
+		fakeConfig *commandfakes.FakeConfig
+		input      *Buffer
+		testUI     *ui.UI
+		confirm    func() error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+	})
+
+	JustBeforeEach(func() {
+		confirm = ConfirmProtectedTarget(fakeConfig, testUI)
+	})
+
+	Context("when an org is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.org-targeted.example.com")
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		})
+
+		Context("when the org name is typed", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("some-org\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("confirms the change once", func() {
+				Expect(confirm()).To(Succeed())
+				Expect(testUI.Out).To(Say("https://api.org-targeted.example.com is a protected target. Type some-org to confirm the change"))
+
+				Expect(ConfirmProtectedTarget(fakeConfig, testUI)()).To(Succeed())
+				Expect(testUI.Out).ToNot(Say("protected target"))
+			})
+		})
+
+		Context("when something else is typed", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetReturns("https://api.wrong-org.example.com")
+				_, err := input.Write([]byte("other-org\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns a ProtectedTargetNotConfirmedError", func() {
+				Expect(confirm()).To(MatchError(translatableerror.ProtectedTargetNotConfirmedError{}))
+			})
+		})
+	})
+
+	Context("when no org is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.no-org.example.com")
+			_, err := input.Write([]byte("https://api.no-org.example.com\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("asks for the API endpoint", func() {
+			Expect(confirm()).To(Succeed())
+			Expect(testUI.Out).To(Say("Type https://api.no-org.example.com to confirm the change"))
+		})
+	})
+})
+
+var _ = Describe("CheckTargetSafety", func() {
+	var (
+		fakeConfig *commandfakes.FakeConfig
+		input      *Buffer
+		testUI     *ui.UI
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.TargetReturns("https://api.check-safety.example.com")
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = CheckTargetSafety(fakeConfig, testUI, "POST", "/Users")
+	})
+
+	It("allows the change when the target is neither read-only nor protected", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+	})
+
+	Context("when the config is read-only", func() {
+		BeforeEach(func() {
+			fakeConfig.ReadOnlyReturns(true)
+			fakeConfig.ProtectedTargetReturns(true)
+		})
+
+		It("returns a ReadOnlyModeError without asking", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ReadOnlyModeError{Method: "POST", URL: "/Users"}))
+			Expect(testUI.Out).ToNot(Say("protected target"))
+		})
+	})
+
+	Context("when the target is protected", func() {
+		BeforeEach(func() {
+			fakeConfig.ProtectedTargetReturns(true)
+			_, err := input.Write([]byte("some-other-target\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("asks for confirmation", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ProtectedTargetNotConfirmedError{}))
+			Expect(testUI.Out).To(Say("https://api.check-safety.example.com is a protected target"))
+		})
+	})
+})
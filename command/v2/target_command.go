@@ -19,8 +19,8 @@ type TargetActor interface {
 }
 
 type TargetCommand struct {
-	Organization    string      `short:"o" description:"Organization"`
-	Space           string      `short:"s" description:"Space"`
+	Organization    string      `short:"o" description:"Organization" completion:"orgs"`
+	Space           string      `short:"s" description:"Space" completion:"spaces"`
 	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
 	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 
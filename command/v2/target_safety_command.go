@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type TargetSafetyCommand struct {
+	OptionalArgs    flag.TargetSafetyArgs `positional-args:"yes"`
+	usage           interface{}           `usage:"CF_NAME target-safety [read-only | protected | off]\n\n   Protects the targeted API against changes. A read-only API only receives requests that\n   do not modify resources. Changes to a protected API have to be confirmed by typing the\n   targeted org name.\n\n   Setting CF_READ_ONLY=1 makes every API read-only.\n\nEXAMPLES:\n   CF_NAME target-safety read-only\n   CF_NAME target-safety off"`
+	relatedCommands interface{}           `related_commands:"api, config, curl"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *TargetSafetyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd TargetSafetyCommand) Execute(args []string) error {
+	if cmd.Config.Target() == "" {
+		return translatableerror.NoAPISetError{
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	}
+
+	if cmd.OptionalArgs.Safety == "" {
+		return cmd.displayTargetSafety()
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting safety of {{.API}} to {{.Safety}}...", map[string]interface{}{
+		"API":    cmd.Config.Target(),
+		"Safety": cmd.OptionalArgs.Safety,
+	})
+
+	switch cmd.OptionalArgs.Safety {
+	case "read-only":
+		cmd.Config.SetTargetSafety(configv3.TargetSafetyReadOnly)
+	case "protected":
+		cmd.Config.SetTargetSafety(configv3.TargetSafetyProtected)
+	default:
+		cmd.Config.SetTargetSafety(configv3.TargetSafetyNone)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd TargetSafetyCommand) displayTargetSafety() error {
+	safety := cmd.UI.TranslateText("off")
+	switch {
+	case cmd.Config.TargetSafety() == configv3.TargetSafetyReadOnly:
+		safety = cmd.UI.TranslateText("read-only")
+	case cmd.Config.ReadOnly():
+		safety = cmd.UI.TranslateText("read-only (CF_READ_ONLY)")
+	case cmd.Config.ProtectedTarget():
+		safety = cmd.UI.TranslateText("protected")
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
+		{cmd.UI.TranslateText("safety:"), safety},
+	}, 3)
+	return nil
+}
@@ -0,0 +1,102 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("target-safety Command", func() {
+	var (
+		cmd        TargetSafetyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.prod.example.com")
+
+		cmd = TargetSafetyCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no API is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("")
+		})
+
+		It("returns a NoAPISetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoAPISetError{BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when no safety is provided", func() {
+		It("displays that the target is not protected", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`api endpoint:\s+https://api.prod.example.com`))
+			Expect(testUI.Out).To(Say(`safety:\s+off`))
+			Expect(fakeConfig.SetTargetSafetyCallCount()).To(Equal(0))
+		})
+
+		Context("when the target is protected", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetSafetyReturns(configv3.TargetSafetyProtected)
+				fakeConfig.ProtectedTargetReturns(true)
+			})
+
+			It("displays the safety", func() {
+				Expect(testUI.Out).To(Say(`safety:\s+protected`))
+			})
+		})
+
+		Context("when CF_READ_ONLY makes the target read-only", func() {
+			BeforeEach(func() {
+				fakeConfig.ReadOnlyReturns(true)
+			})
+
+			It("displays where the safety comes from", func() {
+				Expect(testUI.Out).To(Say(`safety:\s+read-only \(CF_READ_ONLY\)`))
+			})
+		})
+	})
+
+	Context("when a safety is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Safety = "read-only"
+		})
+
+		It("sets the safety of the target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Setting safety of https://api.prod.example.com to read-only..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.SetTargetSafetyCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetTargetSafetyArgsForCall(0)).To(Equal(configv3.TargetSafetyReadOnly))
+		})
+
+		Context("when the safety is off", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Safety = "off"
+			})
+
+			It("removes the safety of the target", func() {
+				Expect(fakeConfig.SetTargetSafetyArgsForCall(0)).To(Equal(configv3.TargetSafetyNone))
+			})
+		})
+	})
+})
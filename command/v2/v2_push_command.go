@@ -3,18 +3,24 @@ package v2
 import (
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	oldcmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bytefmt"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
 )
@@ -33,36 +39,38 @@ type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig, progressBar pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	PreviewUpload(path string, matchResources bool) (pushaction.UploadPreview, pushaction.Warnings, error)
 	ReadManifest(pathToManifest string) ([]manifest.Application, error)
 }
 
 type V2PushCommand struct {
-	OptionalArgs    flag.OptionalAppName        `positional-args:"yes"`
-	Buildpack       flag.Buildpack              `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	Command         flag.Command                `short:"c" description:"Startup command, set to null to reset to default start command"`
-	Domain          string                      `short:"d" description:"Domain (e.g. example.com)"`
-	DockerImage     flag.DockerImage            `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
-	DockerUsername  string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
-	HealthCheckType flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
-	// Hostname             string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
-	Instances flag.Instances `short:"i" description:"Number of instances"`
-	DiskQuota flag.Megabytes `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	Memory    flag.Megabytes `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	// NoHostname           bool                        `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest bool                        `long:"no-manifest" description:"Ignore manifest file"`
-	NoRoute    bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart    bool                        `long:"no-start" description:"Do not start an app after pushing"`
-	AppPath    flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	// RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
-	// RoutePath            string                      `long:"route-path" description:"Path for the route"`
-	StackName           string      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	HealthCheckTimeout  int         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	dockerPassword      interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-
-	usage           interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	OptionalArgs        flag.OptionalAppName        `positional-args:"yes"`
+	Buildpack           flag.Buildpack              `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	Command             flag.Command                `short:"c" description:"Startup command, set to null to reset to default start command"`
+	Domain              string                      `short:"d" description:"Domain (e.g. example.com)"`
+	DockerImage         flag.DockerImage            `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	DockerUsername      string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	PathToManifest      flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
+	HealthCheckType     flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	Hostname            string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	Instances           flag.Instances              `short:"i" description:"Number of instances"`
+	DiskQuota           flag.Megabytes              `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	ListFiles           bool                        `long:"list-files" description:"List the files that would be uploaded and the ignore rule that excludes each skipped path, without pushing"`
+	Memory              flag.Megabytes              `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoHostname          bool                        `long:"no-hostname" description:"Map the root domain to this app"`
+	NoManifest          bool                        `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute             bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoStart             bool                        `long:"no-start" description:"Do not start an app after pushing"`
+	AppPath             flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip, jar, war, tar or tgz archive of the contents of the app directory"`
+	RandomRoute         bool                        `long:"random-route" description:"Create a random route for this app"`
+	RoutePath           string                      `long:"route-path" description:"Path for the route"`
+	StackName           string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	HealthCheckTimeout  int                         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	envCFStagingTimeout interface{}                 `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                 `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword      interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+
+	usage           interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]\n\n   cf v2-push [APP_NAME] --list-files [-f MANIFEST_PATH | --no-manifest] [-p PATH]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI          command.UI
@@ -79,6 +87,14 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
 	sharedActor := sharedaction.NewActor(config, nil)
+	cmd.SharedActor = sharedActor
+
+	// Listing files works without an API target or a login; the Cloud
+	// Controller is only asked which files it already has when logged in.
+	if cmd.ListFiles && sharedActor.CheckTarget(false, false) != nil {
+		cmd.Actor = pushaction.NewActor(nil, nil, sharedActor)
+		return nil
+	}
 
 	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
 	if err != nil {
@@ -86,8 +102,19 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	v2Actor := v2action.NewActor(ccClient, uaaClient, config)
 	cmd.RestartActor = v2Actor
-	cmd.Actor = pushaction.NewActor(v2Actor, sharedActor)
-	cmd.SharedActor = sharedActor
+
+	// The V3 API is only needed to push apps with multiple buildpacks.
+	var v3Actor pushaction.V3Actor
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		v3Actor = v3action.NewActor(ccClientV3, config, sharedActor, nil)
+	}
+
+	cmd.Actor = pushaction.NewActor(v2Actor, v3Actor, sharedActor)
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
@@ -97,6 +124,10 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 func (cmd V2PushCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
+	if cmd.ListFiles {
+		return cmd.listFiles()
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -250,11 +281,15 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 		DockerPassword:     dockerPassword,
 		HealthCheckTimeout: cmd.HealthCheckTimeout,
 		HealthCheckType:    cmd.HealthCheckType.Type,
+		Hostname:           cmd.Hostname,
 		Instances:          cmd.Instances.NullInt,
 		Memory:             cmd.Memory.Value,
 		Name:               cmd.OptionalArgs.AppName,
+		NoHostname:         cmd.NoHostname,
 		NoRoute:            cmd.NoRoute,
 		ProvidedAppPath:    string(cmd.AppPath),
+		RandomRoute:        cmd.RandomRoute,
+		RoutePath:          cmd.routePath(),
 		StackName:          cmd.StackName,
 		Domain:             cmd.Domain,
 	}
@@ -263,30 +298,34 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 	return config, nil
 }
 
-func (cmd V2PushCommand) findAndReadManifestWithFlavorText(settings pushaction.CommandLineSettings) ([]manifest.Application, error) {
-	var pathToManifest string
-
+func (cmd V2PushCommand) findManifest(settings pushaction.CommandLineSettings) string {
 	switch {
 	case cmd.NoManifest:
 		log.Debug("skipping reading of manifest")
+		return ""
 	case cmd.PathToManifest != "":
 		log.Debug("using specified manifest file")
-		pathToManifest = string(cmd.PathToManifest)
-	default:
-		log.Debug("searching for manifest file")
-		pathToManifest = filepath.Join(settings.CurrentDirectory, "manifest.yml")
+		return string(cmd.PathToManifest)
+	}
+
+	log.Debug("searching for manifest file")
+	pathToManifest := filepath.Join(settings.CurrentDirectory, "manifest.yml")
+	if _, err := os.Stat(pathToManifest); os.IsNotExist(err) {
+		log.WithField("pathToManifest", pathToManifest).Debug("could not find")
+
+		// While this is unlikely to be used, it is kept for backwards
+		// compatibility.
+		pathToManifest = filepath.Join(settings.CurrentDirectory, "manifest.yaml")
 		if _, err := os.Stat(pathToManifest); os.IsNotExist(err) {
 			log.WithField("pathToManifest", pathToManifest).Debug("could not find")
-
-			// While this is unlikely to be used, it is kept for backwards
-			// compatibility.
-			pathToManifest = filepath.Join(settings.CurrentDirectory, "manifest.yaml")
-			if _, err := os.Stat(pathToManifest); os.IsNotExist(err) {
-				log.WithField("pathToManifest", pathToManifest).Debug("could not find")
-				pathToManifest = ""
-			}
+			return ""
 		}
 	}
+	return pathToManifest
+}
+
+func (cmd V2PushCommand) findAndReadManifestWithFlavorText(settings pushaction.CommandLineSettings) ([]manifest.Application, error) {
+	pathToManifest := cmd.findManifest(settings)
 
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
@@ -422,7 +461,170 @@ func (cmd V2PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"-f", "--no-manifest"},
 		}
+	case cmd.NoRoute && cmd.Hostname != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--hostname", "-n", "--no-route"},
+		}
+	case cmd.NoRoute && cmd.NoHostname:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--no-hostname", "--no-route"},
+		}
+	case cmd.NoRoute && cmd.RandomRoute:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--no-route", "--random-route"},
+		}
+	case cmd.NoRoute && cmd.RoutePath != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--no-route", "--route-path"},
+		}
+	case cmd.Hostname != "" && cmd.NoHostname:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--hostname", "-n", "--no-hostname"},
+		}
+	case cmd.RandomRoute && (cmd.Hostname != "" || cmd.NoHostname):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--hostname", "-n", "--no-hostname", "--random-route"},
+		}
 	}
 
 	return nil
 }
+
+// routePath returns the provided route path with a leading slash.
+func (cmd V2PushCommand) routePath() string {
+	if cmd.RoutePath != "" && !strings.HasPrefix(cmd.RoutePath, "/") {
+		return "/" + cmd.RoutePath
+	}
+	return cmd.RoutePath
+}
+
+// listFiles displays the files that would be uploaded for each application
+// and why the others are skipped, without pushing. Resource matching is only
+// done when logged in.
+func (cmd V2PushCommand) listFiles() error {
+	loggedIn := cmd.SharedActor.CheckTarget(false, false) == nil
+
+	cliSettings, err := cmd.GetCommandLineSettings()
+	if err != nil {
+		log.Errorln("reading flags:", err)
+		return shared.HandleError(err)
+	}
+
+	var rawApps []manifest.Application
+	if pathToManifest := cmd.findManifest(cliSettings); pathToManifest != "" {
+		cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
+			"Path": pathToManifest,
+		})
+		rawApps, err = cmd.Actor.ReadManifest(pathToManifest)
+		if err != nil {
+			log.Errorln("reading manifest:", err)
+			return shared.HandleError(err)
+		}
+	}
+
+	manifestApplications, err := cmd.Actor.MergeAndValidateSettingsAndManifests(cliSettings, rawApps)
+	if err != nil {
+		log.Errorln("merging manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	for _, app := range manifestApplications {
+		cmd.UI.DisplayNewline()
+		if app.DockerImage != "" {
+			cmd.UI.DisplayText("App {{.AppName}} uses docker image {{.DockerImage}}, no files are uploaded.", map[string]interface{}{
+				"AppName":     app.Name,
+				"DockerImage": app.DockerImage,
+			})
+			continue
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Listing files for app {{.AppName}} from {{.Path}}...", map[string]interface{}{
+			"AppName": app.Name,
+			"Path":    app.Path,
+		})
+
+		preview, warnings, err := cmd.Actor.PreviewUpload(app.Path, loggedIn)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			log.Errorln("previewing upload:", err)
+			return shared.HandleError(err)
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.displayUploadPreview(preview)
+		cmd.UI.DisplayNewline()
+		if !loggedIn {
+			cmd.UI.DisplayText("Not logged in, files that the Cloud Controller already has are listed as uploaded.")
+		}
+	}
+
+	return nil
+}
+
+func (cmd V2PushCommand) displayUploadPreview(preview pushaction.UploadPreview) {
+	matched := map[string]bool{}
+	for _, resource := range preview.Matched {
+		matched[resource.Filename+":"+resource.SHA1] = true
+	}
+
+	rows := map[string][]string{}
+	var (
+		uploadCount int
+		uploadSize  int64
+	)
+	for _, resource := range preview.Resources {
+		// Directories are the only resources without a SHA1.
+		if resource.SHA1 == "" {
+			rows[resource.Filename] = []string{resource.Filename, "", "", cmd.UI.TranslateText("upload")}
+			continue
+		}
+
+		status := cmd.UI.TranslateText("upload")
+		if matched[resource.Filename+":"+resource.SHA1] {
+			status = cmd.UI.TranslateText("already on the Cloud Controller")
+		} else {
+			uploadCount++
+			uploadSize += resource.Size
+		}
+		rows[resource.Filename] = []string{resource.Filename, bytefmt.ByteSize(uint64(resource.Size)), resource.SHA1, status}
+	}
+
+	for _, ignored := range preview.Ignored {
+		var status string
+		if ignored.Rule.Source == "" {
+			status = cmd.UI.TranslateText("ignored by default rule {{.Pattern}}", map[string]interface{}{
+				"Pattern": ignored.Rule.Pattern,
+			})
+		} else {
+			status = cmd.UI.TranslateText("ignored by {{.Source}}:{{.Line}} {{.Pattern}}", map[string]interface{}{
+				"Source":  ignored.Rule.Source,
+				"Line":    ignored.Rule.Line,
+				"Pattern": ignored.Rule.Pattern,
+			})
+		}
+		rows[ignored.Filename] = []string{ignored.Filename, "", "", status}
+	}
+
+	filenames := make([]string, 0, len(rows))
+	for filename := range rows {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	table := [][]string{{
+		cmd.UI.TranslateText("path"),
+		cmd.UI.TranslateText("size"),
+		cmd.UI.TranslateText("sha1"),
+		cmd.UI.TranslateText("status"),
+	}}
+	for _, filename := range filenames {
+		table = append(table, rows[filename])
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Count}} files, {{.Size}} to upload.", map[string]interface{}{
+		"Count": uploadCount,
+		"Size":  bytefmt.ByteSize(uint64(uploadSize)),
+	})
+}
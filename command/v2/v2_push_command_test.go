@@ -22,6 +22,7 @@ import (
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
 )
@@ -91,6 +92,91 @@ var _ = Describe("v2-push Command", func() {
 			})
 		})
 
+		Context("when --list-files is provided", func() {
+			BeforeEach(func() {
+				cmd.ListFiles = true
+				cmd.NoManifest = true
+
+				fakeActor.MergeAndValidateSettingsAndManifestsReturns([]manifest.Application{
+					{Name: appName, Path: "some-path"},
+				}, nil)
+				fakeActor.PreviewUploadReturns(pushaction.UploadPreview{
+					Resources: []sharedaction.Resource{
+						{Filename: "lib", Mode: 0755},
+						{Filename: "lib/app.js", SHA1: "some-sha", Size: 2048, Mode: 0644},
+						{Filename: "package.json", SHA1: "some-other-sha", Size: 512, Mode: 0644},
+					},
+					Ignored: []sharedaction.IgnoredResource{
+						{Filename: ".git", Rule: sharedaction.IgnoreRule{Pattern: ".git"}},
+						{Filename: "node_modules", Rule: sharedaction.IgnoreRule{Source: ".cfignore", Line: 2, Pattern: "node_modules"}},
+					},
+					Matched: []sharedaction.Resource{
+						{Filename: "package.json", SHA1: "some-other-sha", Size: 512, Mode: 0644},
+					},
+				}, pushaction.Warnings{"some-preview-warning"}, nil)
+			})
+
+			It("lists the files push would upload without pushing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Listing files for app some-app from some-path..."))
+				Expect(testUI.Out).To(Say(`path\s+size\s+sha1\s+status`))
+				Expect(testUI.Out).To(Say(`\.git\s+ignored by default rule \.git`))
+				Expect(testUI.Out).To(Say(`lib\s+upload`))
+				Expect(testUI.Out).To(Say(`lib/app.js\s+2K\s+some-sha\s+upload`))
+				Expect(testUI.Out).To(Say(`node_modules\s+ignored by \.cfignore:2 node_modules`))
+				Expect(testUI.Out).To(Say(`package.json\s+512B\s+some-other-sha\s+already on the Cloud Controller`))
+				Expect(testUI.Out).To(Say("1 files, 2K to upload."))
+				Expect(testUI.Err).To(Say("some-preview-warning"))
+
+				Expect(fakeActor.PreviewUploadCallCount()).To(Equal(1))
+				path, matchResources := fakeActor.PreviewUploadArgsForCall(0)
+				Expect(path).To(Equal("some-path"))
+				Expect(matchResources).To(BeTrue())
+
+				Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+				Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+			})
+
+			Context("when the user is not logged in", func() {
+				BeforeEach(func() {
+					fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+				})
+
+				It("lists the files without matching resources", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Not logged in, files that the Cloud Controller already has are listed as uploaded."))
+
+					_, matchResources := fakeActor.PreviewUploadArgsForCall(0)
+					Expect(matchResources).To(BeFalse())
+				})
+			})
+
+			Context("when the app uses a docker image", func() {
+				BeforeEach(func() {
+					fakeActor.MergeAndValidateSettingsAndManifestsReturns([]manifest.Application{
+						{Name: appName, DockerImage: "some-image"},
+					}, nil)
+				})
+
+				It("does not list any files", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("App some-app uses docker image some-image, no files are uploaded."))
+					Expect(fakeActor.PreviewUploadCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when previewing the upload fails", func() {
+				BeforeEach(func() {
+					fakeActor.PreviewUploadReturns(pushaction.UploadPreview{}, nil, errors.New("preview-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("preview-error"))
+				})
+			})
+		})
+
 		Context("when the user is logged in, and org and space are targeted", func() {
 			BeforeEach(func() {
 				fakeConfig.HasTargetedOrganizationReturns(true)
@@ -738,6 +824,69 @@ var _ = Describe("v2-push Command", func() {
 			})
 		})
 
+		Context("when passed route related flags", func() {
+			BeforeEach(func() {
+				cmd.Hostname = "some-hostname"
+				cmd.RoutePath = "some-path"
+			})
+
+			It("sets them on the command line settings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(settings.Hostname).To(Equal("some-hostname"))
+				Expect(settings.RoutePath).To(Equal("/some-path"))
+			})
+
+			Context("when --no-hostname is passed", func() {
+				BeforeEach(func() {
+					cmd.Hostname = ""
+					cmd.NoHostname = true
+				})
+
+				It("sets no-hostname on the command line settings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(settings.NoHostname).To(BeTrue())
+				})
+			})
+
+			Context("when --random-route is passed", func() {
+				BeforeEach(func() {
+					cmd.Hostname = ""
+					cmd.RandomRoute = true
+				})
+
+				It("sets random-route on the command line settings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(settings.RandomRoute).To(BeTrue())
+				})
+			})
+		})
+
+		DescribeTable("route flags that cannot be used together",
+			func(setup func(), expectedErr error) {
+				setup()
+				_, err := cmd.GetCommandLineSettings()
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("--hostname and --no-route",
+				func() { cmd.Hostname = "some-hostname"; cmd.NoRoute = true },
+				translatableerror.ArgumentCombinationError{Args: []string{"--hostname", "-n", "--no-route"}}),
+			Entry("--no-hostname and --no-route",
+				func() { cmd.NoHostname = true; cmd.NoRoute = true },
+				translatableerror.ArgumentCombinationError{Args: []string{"--no-hostname", "--no-route"}}),
+			Entry("--random-route and --no-route",
+				func() { cmd.RandomRoute = true; cmd.NoRoute = true },
+				translatableerror.ArgumentCombinationError{Args: []string{"--no-route", "--random-route"}}),
+			Entry("--route-path and --no-route",
+				func() { cmd.RoutePath = "some-path"; cmd.NoRoute = true },
+				translatableerror.ArgumentCombinationError{Args: []string{"--no-route", "--route-path"}}),
+			Entry("--hostname and --no-hostname",
+				func() { cmd.Hostname = "some-hostname"; cmd.NoHostname = true },
+				translatableerror.ArgumentCombinationError{Args: []string{"--hostname", "-n", "--no-hostname"}}),
+			Entry("--random-route and --no-hostname",
+				func() { cmd.RandomRoute = true; cmd.NoHostname = true },
+				translatableerror.ArgumentCombinationError{Args: []string{"--hostname", "-n", "--no-hostname", "--random-route"}}),
+		)
+
 		Context("when the -o and -p flags are both given", func() {
 			BeforeEach(func() {
 				cmd.DockerImage.Path = "some-docker-image"
@@ -60,6 +60,22 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	PreviewUploadStub        func(path string, matchResources bool) (pushaction.UploadPreview, pushaction.Warnings, error)
+	previewUploadMutex       sync.RWMutex
+	previewUploadArgsForCall []struct {
+		path           string
+		matchResources bool
+	}
+	previewUploadReturns struct {
+		result1 pushaction.UploadPreview
+		result2 pushaction.Warnings
+		result3 error
+	}
+	previewUploadReturnsOnCall map[int]struct {
+		result1 pushaction.UploadPreview
+		result2 pushaction.Warnings
+		result3 error
+	}
 	ReadManifestStub        func(pathToManifest string) ([]manifest.Application, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
@@ -254,6 +270,61 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) PreviewUpload(path string, matchResources bool) (pushaction.UploadPreview, pushaction.Warnings, error) {
+	fake.previewUploadMutex.Lock()
+	ret, specificReturn := fake.previewUploadReturnsOnCall[len(fake.previewUploadArgsForCall)]
+	fake.previewUploadArgsForCall = append(fake.previewUploadArgsForCall, struct {
+		path           string
+		matchResources bool
+	}{path, matchResources})
+	fake.recordInvocation("PreviewUpload", []interface{}{path, matchResources})
+	fake.previewUploadMutex.Unlock()
+	if fake.PreviewUploadStub != nil {
+		return fake.PreviewUploadStub(path, matchResources)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.previewUploadReturns.result1, fake.previewUploadReturns.result2, fake.previewUploadReturns.result3
+}
+
+func (fake *FakeV2PushActor) PreviewUploadCallCount() int {
+	fake.previewUploadMutex.RLock()
+	defer fake.previewUploadMutex.RUnlock()
+	return len(fake.previewUploadArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PreviewUploadArgsForCall(i int) (string, bool) {
+	fake.previewUploadMutex.RLock()
+	defer fake.previewUploadMutex.RUnlock()
+	return fake.previewUploadArgsForCall[i].path, fake.previewUploadArgsForCall[i].matchResources
+}
+
+func (fake *FakeV2PushActor) PreviewUploadReturns(result1 pushaction.UploadPreview, result2 pushaction.Warnings, result3 error) {
+	fake.PreviewUploadStub = nil
+	fake.previewUploadReturns = struct {
+		result1 pushaction.UploadPreview
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) PreviewUploadReturnsOnCall(i int, result1 pushaction.UploadPreview, result2 pushaction.Warnings, result3 error) {
+	fake.PreviewUploadStub = nil
+	if fake.previewUploadReturnsOnCall == nil {
+		fake.previewUploadReturnsOnCall = make(map[int]struct {
+			result1 pushaction.UploadPreview
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.previewUploadReturnsOnCall[i] = struct {
+		result1 pushaction.UploadPreview
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
 	fake.readManifestMutex.Lock()
 	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
@@ -314,6 +385,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.previewUploadMutex.RLock()
+	defer fake.previewUploadMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
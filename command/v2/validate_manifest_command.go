@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+//go:generate counterfeiter . ValidateManifestActor
+
+type ValidateManifestActor interface {
+	ValidateManifest(pathToManifest string) ([]manifest.Diagnostic, error)
+	ManifestJSONSchema() ([]byte, error)
+}
+
+type ValidateManifestCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to manifest (Default: manifest.yml or manifest.yaml in the current directory)"`
+	Schema          bool                        `long:"schema" description:"Print the JSON Schema of manifests for use with editors instead of validating"`
+	usage           interface{}                 `usage:"CF_NAME validate-manifest [-f MANIFEST_PATH]\n   CF_NAME validate-manifest --schema\n\nEXAMPLES:\n   CF_NAME validate-manifest -f ./staging-manifest.yml\n   CF_NAME validate-manifest --schema > manifest.schema.json"`
+	relatedCommands interface{}                 `related_commands:"create-app-manifest, push"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  ValidateManifestActor
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pushaction.NewActor(nil, nil, sharedaction.NewActor(config, nil))
+
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	if cmd.Schema {
+		if cmd.PathToManifest != "" {
+			return translatableerror.ArgumentCombinationError{Args: []string{"--schema", "-f"}}
+		}
+
+		schema, err := cmd.Actor.ManifestJSONSchema()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.UI.GetOut(), string(schema))
+		return nil
+	}
+
+	pathToManifest, err := cmd.findManifest()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Validating manifest {{.Path}}...", map[string]interface{}{
+		"Path": pathToManifest,
+	})
+
+	diagnostics, err := cmd.Actor.ValidateManifest(pathToManifest)
+	if err != nil {
+		return err
+	}
+
+	if len(diagnostics) > 0 {
+		cmd.UI.DisplayNewline()
+		for _, diagnostic := range diagnostics {
+			cmd.UI.DisplayText("{{.Path}}:{{.Line}}:{{.Column}}: {{.Message}}", map[string]interface{}{
+				"Path":    pathToManifest,
+				"Line":    diagnostic.Line,
+				"Column":  diagnostic.Column,
+				"Message": diagnostic.Message,
+			})
+		}
+		cmd.UI.DisplayNewline()
+
+		return translatableerror.InvalidManifestError{Path: pathToManifest, Problems: len(diagnostics)}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ValidateManifestCommand) findManifest() (string, error) {
+	if cmd.PathToManifest != "" {
+		return string(cmd.PathToManifest), nil
+	}
+
+	for _, name := range []string{"manifest.yml", "manifest.yaml"} {
+		if _, err := os.Stat(name); err == nil {
+			return name, nil
+		}
+	}
+
+	return "", translatableerror.FileNotFoundError{Path: "manifest.yml"}
+}
@@ -0,0 +1,149 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd        ValidateManifestCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeValidateManifestActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeValidateManifestActor)
+
+		cmd = ValidateManifestCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when a manifest path is provided", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = "some-manifest.yml"
+		})
+
+		Context("when the manifest is valid", func() {
+			It("validates the manifest and displays OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Validating manifest some-manifest.yml..."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.ValidateManifestCallCount()).To(Equal(1))
+				Expect(fakeActor.ValidateManifestArgsForCall(0)).To(Equal("some-manifest.yml"))
+			})
+		})
+
+		Context("when the manifest has problems", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateManifestReturns([]manifest.Diagnostic{
+					{Line: 3, Column: 3, Message: `unknown property "memroy"`},
+					{Line: 7, Column: 5, Message: `property "instances" must be an integer`},
+				}, nil)
+			})
+
+			It("displays each problem with its position and returns an InvalidManifestError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidManifestError{Path: "some-manifest.yml", Problems: 2}))
+				Expect(testUI.Out).To(Say(`some-manifest.yml:3:3: unknown property "memroy"`))
+				Expect(testUI.Out).To(Say(`some-manifest.yml:7:5: property "instances" must be an integer`))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		Context("when the manifest cannot be read", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateManifestReturns(nil, errors.New("read-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("read-error"))
+			})
+		})
+	})
+
+	Context("when no manifest path is provided", func() {
+		var (
+			tempDir    string
+			currentDir string
+		)
+
+		BeforeEach(func() {
+			var err error
+			currentDir, err = os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			tempDir, err = ioutil.TempDir("", "validate-manifest-test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Chdir(tempDir)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Chdir(currentDir)).To(Succeed())
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		Context("when the current directory has a manifest.yaml", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(tempDir, "manifest.yaml"), nil, 0666)).To(Succeed())
+			})
+
+			It("validates it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.ValidateManifestArgsForCall(0)).To(Equal("manifest.yaml"))
+			})
+		})
+
+		Context("when the current directory has no manifest", func() {
+			It("returns a FileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.FileNotFoundError{Path: "manifest.yml"}))
+				Expect(fakeActor.ValidateManifestCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when --schema is provided", func() {
+		BeforeEach(func() {
+			cmd.Schema = true
+			fakeActor.ManifestJSONSchemaReturns([]byte(`{"type": "object"}`), nil)
+		})
+
+		It("prints the JSON Schema without validating", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`{"type": "object"}`))
+			Expect(fakeActor.ValidateManifestCallCount()).To(Equal(0))
+		})
+
+		Context("when a manifest path is also provided", func() {
+			BeforeEach(func() {
+				cmd.PathToManifest = "some-manifest.yml"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--schema", "-f"}}))
+			})
+		})
+	})
+})
@@ -16,22 +16,32 @@ import (
 //go:generate counterfeiter . AddNetworkPolicyActor
 
 type AddNetworkPolicyActor interface {
-	AddNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 }
 
-type AddNetworkPolicyCommand struct {
-	RequiredArgs   flag.AddNetworkPolicyArgs `positional-args:"yes"`
-	DestinationApp string                    `long:"destination-app" required:"true" description:"Name of app to connect to"`
-	Port           flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
-	Protocol       flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
+//go:generate counterfeiter . MembershipActor
+
+type MembershipActor interface {
+	GetOrganizationByName(name string) (v3action.Organization, v3action.Warnings, error)
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error)
+}
 
-	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [(--protocol (tcp | udp) --port RANGE)]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090"`
+type AddNetworkPolicyCommand struct {
+	RequiredArgs     flag.AddNetworkPolicyArgs `positional-args:"yes"`
+	DestinationApp   string                    `long:"destination-app" required:"true" description:"Name of app to connect to" completion:"apps"`
+	DestinationSpace string                    `long:"destination-space" description:"Space of the destination app (Default: targeted space)" completion:"spaces"`
+	DestinationOrg   string                    `long:"destination-org" description:"Org of the destination space (Default: targeted org)" completion:"orgs"`
+	Port             flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
+	Protocol         flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
+
+	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [--destination-space SPACE [--destination-org ORG]] [(--protocol (tcp | udp) --port RANGE)]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090\n   CF_NAME add-network-policy frontend --destination-app backend --destination-space services --destination-org platform"`
 	relatedCommands interface{} `related_commands:"apps, network-policies"`
 
-	UI          command.UI
-	Config      command.Config
-	SharedActor command.SharedActor
-	Actor       AddNetworkPolicyActor
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           AddNetworkPolicyActor
+	MembershipActor MembershipActor
 }
 
 func (cmd *AddNetworkPolicyCommand) Setup(config command.Config, ui command.UI) error {
@@ -54,6 +64,7 @@ func (cmd *AddNetworkPolicyCommand) Setup(config command.Config, ui command.UI)
 		return err
 	}
 	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+	cmd.MembershipActor = v3Actor
 
 	return nil
 }
@@ -70,6 +81,10 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 		cmd.Port.EndPort = 8080
 	}
 
+	if cmd.DestinationOrg != "" && cmd.DestinationSpace == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--destination-org", Arg2: "--destination-space"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -79,14 +94,37 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
-	cmd.UI.DisplayTextWithFlavor("Adding network policy to app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-		"SrcAppName": cmd.RequiredArgs.SourceApp,
-		"Org":        cmd.Config.TargetedOrganization().Name,
-		"Space":      cmd.Config.TargetedSpace().Name,
-		"User":       user.Name,
-	})
-
-	warnings, err := cmd.Actor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+
+	destSpaceGUID := cmd.Config.TargetedSpace().GUID
+	if cmd.DestinationSpace == "" {
+		cmd.UI.DisplayTextWithFlavor("Adding network policy to app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"Org":        cmd.Config.TargetedOrganization().Name,
+			"Space":      cmd.Config.TargetedSpace().Name,
+			"User":       user.Name,
+		})
+	} else {
+		destOrgName := cmd.DestinationOrg
+		if destOrgName == "" {
+			destOrgName = cmd.Config.TargetedOrganization().Name
+		}
+		cmd.UI.DisplayTextWithFlavor("Adding network policy from app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} to app {{.DestAppName}} in org {{.DestOrg}} / space {{.DestSpace}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName":  cmd.RequiredArgs.SourceApp,
+			"Org":         cmd.Config.TargetedOrganization().Name,
+			"Space":       cmd.Config.TargetedSpace().Name,
+			"DestAppName": cmd.DestinationApp,
+			"DestOrg":     destOrgName,
+			"DestSpace":   cmd.DestinationSpace,
+			"User":        user.Name,
+		})
+
+		destSpaceGUID, err = destinationSpaceGUID(cmd.Config, cmd.UI, cmd.MembershipActor, cmd.DestinationOrg, cmd.DestinationSpace)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	warnings, err := cmd.Actor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, destSpaceGUID, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -95,3 +133,25 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// destinationSpaceGUID returns the GUID of the space with the given name in
+// the given org, or in the targeted org when orgName is empty.
+func destinationSpaceGUID(config command.Config, ui command.UI, actor MembershipActor, orgName string, spaceName string) (string, error) {
+	orgGUID := config.TargetedOrganization().GUID
+	if orgName != "" {
+		org, warnings, err := actor.GetOrganizationByName(orgName)
+		ui.DisplayWarnings(warnings)
+		if err != nil {
+			return "", err
+		}
+		orgGUID = org.GUID
+	}
+
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+
+	return space.GUID, nil
+}
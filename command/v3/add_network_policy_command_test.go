@@ -23,6 +23,7 @@ var _ = Describe("add-network-policy Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeAddNetworkPolicyActor
+		fakeMembership  *v3fakes.FakeMembershipActor
 		binaryName      string
 		executeErr      error
 		srcApp          string
@@ -35,18 +36,20 @@ var _ = Describe("add-network-policy Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeAddNetworkPolicyActor)
+		fakeMembership = new(v3fakes.FakeMembershipActor)
 
 		srcApp = "some-app"
 		destApp = "some-other-app"
 		protocol = "tcp"
 
 		cmd = AddNetworkPolicyCommand{
-			UI:             testUI,
-			Config:         fakeConfig,
-			SharedActor:    fakeSharedActor,
-			Actor:          fakeActor,
-			RequiredArgs:   flag.AddNetworkPolicyArgs{SourceApp: srcApp},
-			DestinationApp: destApp,
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			MembershipActor: fakeMembership,
+			RequiredArgs:    flag.AddNetworkPolicyArgs{SourceApp: srcApp},
+			DestinationApp:  destApp,
 		}
 
 		binaryName = "faceman"
@@ -76,7 +79,7 @@ var _ = Describe("add-network-policy Command", func() {
 		BeforeEach(func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
-			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
 		})
 
 		Context("when protocol is specified but port is not", func() {
@@ -115,9 +118,10 @@ var _ = Describe("add-network-policy Command", func() {
 				It("displays OK when no error occurs", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-					passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+					passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
 					Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedSrcAppName).To(Equal("some-app"))
+					Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedDestAppName).To(Equal("some-other-app"))
 					Expect(passedProtocol).To(Equal("tcp"))
 					Expect(passedStartPort).To(Equal(8080))
@@ -151,11 +155,95 @@ var _ = Describe("add-network-policy Command", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-				_, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+				_, _, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
 				Expect(passedEndPort).To(Equal(8080))
 			})
 		})
+
+		Context("when a destination space is specified", func() {
+			BeforeEach(func() {
+				cmd.DestinationSpace = "other-space"
+				fakeMembership.GetSpaceByNameAndOrganizationReturns(
+					v3action.Space{Name: "other-space", GUID: "other-space-guid"},
+					v3action.Warnings{"space-warning"},
+					nil)
+			})
+
+			It("adds the policy to the app in the destination space of the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeMembership.GetOrganizationByNameCallCount()).To(Equal(0))
+				Expect(fakeMembership.GetSpaceByNameAndOrganizationCallCount()).To(Equal(1))
+				spaceName, orgGUID := fakeMembership.GetSpaceByNameAndOrganizationArgsForCall(0)
+				Expect(spaceName).To(Equal("other-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+
+				passedSpaceGuid, _, passedDestSpaceGuid, passedDestAppName, _, _, _ := fakeActor.AddNetworkPolicyArgsForCall(0)
+				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("other-space-guid"))
+				Expect(passedDestAppName).To(Equal(destApp))
+
+				Expect(testUI.Out).To(Say(`Adding network policy from app %s in org some-org / space some-space to app %s in org some-org / space other-space as some-user\.\.\.`, srcApp, destApp))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+
+			Context("when a destination org is specified", func() {
+				BeforeEach(func() {
+					cmd.DestinationOrg = "other-org"
+					fakeMembership.GetOrganizationByNameReturns(
+						v3action.Organization{Name: "other-org", GUID: "other-org-guid"},
+						v3action.Warnings{"org-warning"},
+						nil)
+				})
+
+				It("looks up the destination space in the destination org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeMembership.GetOrganizationByNameCallCount()).To(Equal(1))
+					Expect(fakeMembership.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+					_, orgGUID := fakeMembership.GetSpaceByNameAndOrganizationArgsForCall(0)
+					Expect(orgGUID).To(Equal("other-org-guid"))
+
+					Expect(testUI.Out).To(Say(`to app %s in org other-org / space other-space as some-user`, destApp))
+					Expect(testUI.Err).To(Say("org-warning"))
+				})
+
+				Context("when the destination org does not exist", func() {
+					BeforeEach(func() {
+						fakeMembership.GetOrganizationByNameReturns(v3action.Organization{}, nil, v3action.OrganizationNotFoundError{Name: "other-org"})
+					})
+
+					It("returns an OrganizationNotFoundError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "other-org"}))
+						Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the destination space does not exist", func() {
+				BeforeEach(func() {
+					fakeMembership.GetSpaceByNameAndOrganizationReturns(v3action.Space{}, nil, v3action.SpaceNotFoundError{Name: "other-space"})
+				})
+
+				It("returns a SpaceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "other-space"}))
+					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a destination org is specified without a destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "other-org"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--destination-org", Arg2: "--destination-space"}))
+				Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
@@ -0,0 +1,205 @@
+package v3
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyFoundationActor
+
+type ApplyFoundationActor interface {
+	ApplyChange(change foundationaction.Change) (foundationaction.Warnings, error)
+	PlanFoundation(dir string, prune bool) ([]foundationaction.Change, foundationaction.Warnings, error)
+}
+
+type ApplyFoundationCommand struct {
+	RequiredArgs    flag.ApplyFoundationArgs `positional-args:"yes"`
+	Plan            bool                     `long:"plan" description:"Show the changes that would be made without making them"`
+	Prune           bool                     `long:"prune" description:"Delete orgs, spaces, isolation segment entitlements and roles that are not in the directory"`
+	Force           bool                     `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}              `usage:"CF_NAME apply-foundation DIR [--plan] [--prune [-f]]\n\n   Creates and updates the org quotas, isolation segments, entitlements, orgs, space\n   quotas, spaces and roles in DIR. An org or space without a quota or isolation\n   segment keeps its current one. Quotas and isolation segments are never deleted.\n\n   With --prune, the resources that will be deleted are listed and nothing is\n   deleted until the deletion is confirmed.\n\nEXAMPLES:\n   CF_NAME export-foundation ./foundation\n   CF_NAME apply-foundation ./foundation --plan\n   CF_NAME apply-foundation ./foundation --prune"`
+	relatedCommands interface{}              `related_commands:"export-foundation, isolation-segments, orgs, quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyFoundationActor
+}
+
+func (cmd *ApplyFoundationCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	actor, err := newFoundationActor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = actor
+
+	return nil
+}
+
+func (cmd ApplyFoundationCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Plan {
+		cmd.UI.DisplayTextWithFlavor("Planning foundation changes from {{.Dir}} as {{.User}}...", map[string]interface{}{
+			"Dir":  cmd.RequiredArgs.Directory,
+			"User": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Applying foundation from {{.Dir}} as {{.User}}...", map[string]interface{}{
+			"Dir":  cmd.RequiredArgs.Directory,
+			"User": user.Name,
+		})
+	}
+
+	changes, warnings, err := cmd.Actor.PlanFoundation(string(cmd.RequiredArgs.Directory), cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Foundation is up to date.")
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if cmd.Plan {
+		cmd.displayChanges(changes)
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("{{.Count}} changes planned. Run without --plan to make them.", map[string]interface{}{
+			"Count": len(changes),
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if deletions := foundationaction.Deletions(changes); len(deletions) > 0 && !cmd.Force {
+		cmd.UI.DisplayText("The following will be deleted:")
+		cmd.UI.DisplayNewline()
+		cmd.displayChanges(deletions)
+		cmd.UI.DisplayNewline()
+
+		deleteResources, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the {{.Count}} resources listed above?", map[string]interface{}{
+			"Count": len(deletions),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteResources {
+			cmd.UI.DisplayText("Apply cancelled, no changes were made.")
+			return nil
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	for i, change := range changes {
+		warnings, err = cmd.Actor.ApplyChange(change)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.displayChanges(changes[:i])
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayWarning("Stopped after {{.Applied}} of {{.Count}} changes.", map[string]interface{}{
+				"Applied": i,
+				"Count":   len(changes),
+			})
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.displayChanges(changes)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Made {{.Count}} changes.", map[string]interface{}{
+		"Count": len(changes),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplyFoundationCommand) displayChanges(changes []foundationaction.Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+	for _, change := range changes {
+		table = append(table, foundationChangeRow(cmd.UI, change))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// foundationChangeRow returns a table row of the marker, resource, name and
+// details of the change.
+func foundationChangeRow(ui command.UI, change foundationaction.Change) []string {
+	marker := "~"
+	switch change.Type {
+	case foundationaction.ChangeCreate:
+		marker = "+"
+	case foundationaction.ChangeDelete:
+		marker = "-"
+	}
+
+	name := change.Name
+	var details []string
+	switch change.Resource {
+	case foundationaction.EntitlementResource, foundationaction.SpaceQuotaResource:
+		details = append(details, ui.TranslateText("org {{.Org}}", map[string]interface{}{"Org": change.Organization}))
+	case foundationaction.OrganizationResource:
+		name = change.Organization
+	case foundationaction.SpaceResource:
+		name = change.Organization + " / " + change.Space
+	case foundationaction.OrganizationRoleResource:
+		details = append(details, ui.TranslateText("{{.Role}} in org {{.Org}}", map[string]interface{}{
+			"Role": change.Role,
+			"Org":  change.Organization,
+		}))
+	case foundationaction.SpaceRoleResource:
+		details = append(details, ui.TranslateText("{{.Role}} in org {{.Org}} / space {{.Space}}", map[string]interface{}{
+			"Role":  change.Role,
+			"Org":   change.Organization,
+			"Space": change.Space,
+		}))
+	}
+
+	if change.QuotaName != "" {
+		details = append(details, ui.TranslateText("quota {{.Quota}}", map[string]interface{}{"Quota": change.QuotaName}))
+	}
+	if change.IsolationSegment != "" {
+		details = append(details, ui.TranslateText("isolation segment {{.IsolationSegment}}", map[string]interface{}{"IsolationSegment": change.IsolationSegment}))
+	}
+	if change.Origin != "" {
+		details = append(details, ui.TranslateText("origin {{.Origin}}", map[string]interface{}{"Origin": change.Origin}))
+	}
+
+	return []string{marker, ui.TranslateText(string(change.Resource)), name, strings.Join(details, ", ")}
+}
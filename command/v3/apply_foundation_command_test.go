@@ -0,0 +1,203 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-foundation Command", func() {
+	var (
+		cmd             ApplyFoundationCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyFoundationActor
+		binaryName      string
+		executeErr      error
+		changes         []foundationaction.Change
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyFoundationActor)
+
+		cmd = ApplyFoundationCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Directory = "some-dir"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		changes = []foundationaction.Change{
+			{Type: foundationaction.ChangeCreate, Resource: foundationaction.OrganizationResource, Organization: "research", QuotaName: "large"},
+			{Type: foundationaction.ChangeUpdate, Resource: foundationaction.SpaceResource, Organization: "acme", Space: "dev", IsolationSegment: "secure"},
+			{Type: foundationaction.ChangeDelete, Resource: foundationaction.SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "mallory"},
+		}
+		fakeActor.PlanFoundationReturns(changes, foundationaction.Warnings{"plan-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.PlanFoundationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when planning fails", func() {
+		BeforeEach(func() {
+			fakeActor.PlanFoundationReturns(nil, nil, foundationaction.InvalidFoundationDirectoryError{Path: "some-dir", Message: "some-message"})
+		})
+
+		It("returns an InvalidFoundationDirectoryError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidFoundationDirectoryError{Path: "some-dir", Message: "some-message"}))
+		})
+	})
+
+	Context("when there are no changes", func() {
+		BeforeEach(func() {
+			fakeActor.PlanFoundationReturns(nil, nil, nil)
+		})
+
+		It("displays that the foundation is up to date", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Foundation is up to date."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyChangeCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --plan is provided", func() {
+		BeforeEach(func() {
+			cmd.Plan = true
+			cmd.Prune = true
+		})
+
+		It("displays the changes without making them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			dir, prune := fakeActor.PlanFoundationArgsForCall(0)
+			Expect(dir).To(Equal("some-dir"))
+			Expect(prune).To(BeTrue())
+			Expect(fakeActor.ApplyChangeCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say("Planning foundation changes from some-dir as some-user..."))
+			Expect(testUI.Out).To(Say(`\s+resource\s+name\s+details`))
+			Expect(testUI.Out).To(Say(`\+\s+org\s+research\s+quota large`))
+			Expect(testUI.Out).To(Say(`~\s+space\s+acme / dev\s+isolation segment secure`))
+			Expect(testUI.Out).To(Say(`-\s+space role\s+mallory\s+developers in org acme / space dev`))
+			Expect(testUI.Out).To(Say("3 changes planned. Run without --plan to make them."))
+			Expect(testUI.Err).To(Say("plan-warning"))
+		})
+	})
+
+	Context("when applying the changes", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			fakeActor.ApplyChangeReturns(foundationaction.Warnings{"apply-warning"}, nil)
+		})
+
+		It("makes every change in order and displays them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ApplyChangeCallCount()).To(Equal(3))
+			for i, change := range changes {
+				Expect(fakeActor.ApplyChangeArgsForCall(i)).To(Equal(change))
+			}
+
+			Expect(testUI.Out).To(Say("Applying foundation from some-dir as some-user..."))
+			Expect(testUI.Out).To(Say(`\+\s+org\s+research`))
+			Expect(testUI.Out).To(Say("Made 3 changes."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+
+		Context("when a change fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyChangeReturnsOnCall(1, nil, errors.New("apply-error"))
+			})
+
+			It("stops and displays the changes that were made", func() {
+				Expect(executeErr).To(MatchError("apply-error"))
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(2))
+
+				Expect(testUI.Out).To(Say(`\+\s+org\s+research`))
+				Expect(testUI.Out).ToNot(Say("acme / dev"))
+				Expect(testUI.Err).To(Say("Stopped after 1 of 3 changes."))
+			})
+		})
+	})
+
+	Context("when the changes delete resources and -f is not provided", func() {
+		It("lists the resources to delete and asks for confirmation", func() {
+			Expect(testUI.Out).To(Say("The following will be deleted:"))
+			Expect(testUI.Out).To(Say(`-\s+space role\s+mallory\s+developers in org acme / space dev`))
+			Expect(testUI.Out).To(Say(`Really delete the 1 resources listed above\?`))
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("makes every change", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(3))
+				Expect(testUI.Out).To(Say("Made 3 changes."))
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("makes no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(0))
+				Expect(testUI.Out).To(Say("Apply cancelled, no changes were made."))
+			})
+		})
+
+		Context("when the user presses enter", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("makes no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
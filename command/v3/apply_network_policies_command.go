@@ -0,0 +1,138 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyNetworkPoliciesActor
+
+type ApplyNetworkPoliciesActor interface {
+	ApplyNetworkPolicies(spaceGUID string, policies []cfnetworkingaction.Policy, prune bool) ([]cfnetworkingaction.Policy, []cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	ReadNetworkPolicyFile(path string) ([]cfnetworkingaction.Policy, error)
+}
+
+type ApplyNetworkPoliciesCommand struct {
+	PolicyFile flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a YAML file of network policies keyed by source app name"`
+	Prune      bool                        `long:"prune" description:"Remove policies between apps in the space that are not in the file"`
+
+	usage           interface{} `usage:"CF_NAME apply-network-policies -f FILE [--prune]\n\n   The file lists the policies of each source app, for example:\n\n   frontend:\n   - destination: backend\n     protocol: tcp\n     ports: 8080-8090\n\n   The protocol defaults to tcp and the ports default to 8080.\n\nEXAMPLES:\n   CF_NAME network-policies --export policies.yml\n   CF_NAME apply-network-policies -f policies.yml --prune"`
+	relatedCommands interface{} `related_commands:"add-network-policy, network-policies, remove-network-policy"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyNetworkPoliciesActor
+}
+
+func (cmd *ApplyNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	client, uaa, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.CFNetworkingEndpointNotFoundError{}
+		}
+
+		return err
+	}
+
+	v3Actor := v3action.NewActor(client, config, nil, nil)
+	networkingClient, err := shared.NewNetworkingClient(client.NetworkPolicyV1(), config, uaa, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	policies, err := cmd.Actor.ReadNetworkPolicyFile(string(cmd.PolicyFile))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying network policies from {{.Path}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"Path":  cmd.PolicyFile,
+		"Org":   cmd.Config.TargetedOrganization().Name,
+		"Space": cmd.Config.TargetedSpace().Name,
+		"User":  user.Name,
+	})
+
+	added, removed, warnings, err := cmd.Actor.ApplyNetworkPolicies(cmd.Config.TargetedSpace().GUID, policies, cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(added) == 0 && len(removed) == 0 {
+		cmd.UI.DisplayText("Network policies are up to date.")
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("source"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("ports"),
+		},
+	}
+	for _, policy := range added {
+		table = append(table, networkPolicyChangeRow("+", policy))
+	}
+	for _, policy := range removed {
+		table = append(table, networkPolicyChangeRow("-", policy))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Added {{.Added}} and removed {{.Removed}} network policies.", map[string]interface{}{
+		"Added":   len(added),
+		"Removed": len(removed),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func networkPolicyChangeRow(change string, policy cfnetworkingaction.Policy) []string {
+	return []string{change, policy.SourceName, policy.DestinationName, policy.Protocol, networkPolicyPorts(policy.StartPort, policy.EndPort)}
+}
+
+func networkPolicyPorts(startPort int, endPort int) string {
+	if startPort == endPort {
+		return strconv.Itoa(startPort)
+	}
+	return fmt.Sprintf("%d-%d", startPort, endPort)
+}
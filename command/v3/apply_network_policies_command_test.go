@@ -0,0 +1,167 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-network-policies Command", func() {
+	var (
+		cmd             ApplyNetworkPoliciesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyNetworkPoliciesActor
+		binaryName      string
+		executeErr      error
+		policies        []cfnetworkingaction.Policy
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyNetworkPoliciesActor)
+
+		cmd = ApplyNetworkPoliciesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			PolicyFile:  "some-file.yml",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		policies = []cfnetworkingaction.Policy{
+			{SourceName: "app1", DestinationName: "app2", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+		}
+		fakeActor.ReadNetworkPolicyFileReturns(policies, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		})
+
+		Context("when fetching the user fails", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+
+		Context("when reading the policy file fails", func() {
+			BeforeEach(func() {
+				fakeActor.ReadNetworkPolicyFileReturns(nil, cfnetworkingaction.InvalidPolicyFileError{Path: "some-file.yml", Message: "some-message"})
+			})
+
+			It("returns an InvalidNetworkPolicyFileError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidNetworkPolicyFileError{Path: "some-file.yml", Message: "some-message"}))
+				Expect(fakeActor.ApplyNetworkPoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when policies are added and removed", func() {
+			BeforeEach(func() {
+				cmd.Prune = true
+				fakeActor.ApplyNetworkPoliciesReturns(
+					[]cfnetworkingaction.Policy{
+						{SourceName: "app1", DestinationName: "app2", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					},
+					[]cfnetworkingaction.Policy{
+						{SourceName: "app2", DestinationName: "app1", Protocol: "udp", StartPort: 1234, EndPort: 2345},
+					},
+					cfnetworkingaction.Warnings{"some-warning-1", "some-warning-2"},
+					nil)
+			})
+
+			It("applies the policies from the file and displays the changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.ReadNetworkPolicyFileCallCount()).To(Equal(1))
+				Expect(fakeActor.ReadNetworkPolicyFileArgsForCall(0)).To(Equal("some-file.yml"))
+
+				Expect(fakeActor.ApplyNetworkPoliciesCallCount()).To(Equal(1))
+				spaceGUID, passedPolicies, prune := fakeActor.ApplyNetworkPoliciesArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(passedPolicies).To(Equal(policies))
+				Expect(prune).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`Applying network policies from some-file\.yml in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`source\s+destination\s+protocol\s+ports`))
+				Expect(testUI.Out).To(Say(`\+\s+app1\s+app2\s+tcp\s+8080[^-]`))
+				Expect(testUI.Out).To(Say(`-\s+app2\s+app1\s+udp\s+1234-2345`))
+				Expect(testUI.Out).To(Say("Added 1 and removed 1 network policies."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("some-warning-1"))
+				Expect(testUI.Err).To(Say("some-warning-2"))
+			})
+		})
+
+		Context("when there are no changes", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyNetworkPoliciesReturns(nil, nil, nil, nil)
+			})
+
+			It("displays that the policies are up to date", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, prune := fakeActor.ApplyNetworkPoliciesArgsForCall(0)
+				Expect(prune).To(BeFalse())
+
+				Expect(testUI.Out).To(Say("Network policies are up to date."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when applying the policies fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyNetworkPoliciesReturns(nil, nil, cfnetworkingaction.Warnings{"some-warning-1"}, v3action.ApplicationNotFoundError{Name: "app3"})
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "app3"}))
+				Expect(testUI.Err).To(Say("some-warning-1"))
+			})
+		})
+	})
+})
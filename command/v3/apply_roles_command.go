@@ -0,0 +1,178 @@
+package v3
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyRolesActor
+
+type ApplyRolesActor interface {
+	ApplyChange(change foundationaction.Change) (foundationaction.Warnings, error)
+	PlanRoles(path string, prune bool, origin string) ([]foundationaction.Change, foundationaction.Warnings, error)
+}
+
+type ApplyRolesCommand struct {
+	RolesFile   flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a YAML or CSV file of org and space roles"`
+	Plan        bool                        `long:"plan" description:"Show the changes that would be made without making them"`
+	Prune       bool                        `long:"prune" description:"Remove roles in the orgs and spaces of the file that are not in the file"`
+	Force       bool                        `long:"force" description:"Force removal of roles without confirmation"`
+	CreateUsers bool                        `long:"create-users" description:"Create the users that are given roles in the identity provider given by --origin"`
+	Origin      string                      `long:"origin" description:"Origin of the users to create, such as ldap or a SAML provider alias"`
+	usage       interface{}                 `usage:"CF_NAME apply-roles -f FILE [--plan] [--prune [--force]] [--create-users --origin ORIGIN]\n\n   A YAML file lists the roles of each org and its spaces, for example:\n\n   - name: acme\n     managers: [alice]\n     spaces:\n     - name: dev\n       developers: [bob, carol]\n\n   A CSV file has rows of org, space, role and username. The space is empty for the\n   OrgManager, BillingManager and OrgAuditor roles; the space roles are SpaceManager,\n   SpaceDeveloper and SpaceAuditor.\n\n   The orgs and spaces must exist. Every change is attempted and reported.
+
+   With --prune, the roles that will be removed are listed and nothing is changed
+   until the removal is confirmed.\n\nEXAMPLES:\n   CF_NAME export-roles acme -f roles.yml\n   CF_NAME apply-roles -f roles.yml --plan\n   CF_NAME apply-roles -f roles.csv --prune --create-users --origin ldap"`
+	relatedCommands interface{} `related_commands:"create-user, export-roles, org-users, set-org-role, set-space-role, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyRolesActor
+}
+
+func (cmd *ApplyRolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	actor, err := newFoundationActor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = actor
+
+	return nil
+}
+
+func (cmd ApplyRolesCommand) Execute(args []string) error {
+	var origin string
+	if cmd.CreateUsers || cmd.Origin != "" {
+		if !cmd.CreateUsers || cmd.Origin == "" || strings.ToLower(cmd.Origin) == "uaa" {
+			return translatableerror.RequiredFlagsError{
+				Arg1: "--create-users",
+				Arg2: "--origin",
+			}
+		}
+		origin = cmd.Origin
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Plan {
+		cmd.UI.DisplayTextWithFlavor("Planning role changes from {{.Path}} as {{.User}}...", map[string]interface{}{
+			"Path": cmd.RolesFile,
+			"User": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Applying roles from {{.Path}} as {{.User}}...", map[string]interface{}{
+			"Path": cmd.RolesFile,
+			"User": user.Name,
+		})
+	}
+
+	changes, warnings, err := cmd.Actor.PlanRoles(string(cmd.RolesFile), cmd.Prune, origin)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Roles are up to date.")
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	header := []string{
+		"",
+		cmd.UI.TranslateText("resource"),
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("details"),
+	}
+
+	if cmd.Plan {
+		table := [][]string{header}
+		for _, change := range changes {
+			table = append(table, foundationChangeRow(cmd.UI, change))
+		}
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("{{.Count}} changes planned. Run without --plan to make them.", map[string]interface{}{
+			"Count": len(changes),
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if deletions := foundationaction.Deletions(changes); len(deletions) > 0 && !cmd.Force {
+		table := [][]string{header}
+		for _, change := range deletions {
+			table = append(table, foundationChangeRow(cmd.UI, change))
+		}
+		cmd.UI.DisplayText("The following roles will be removed:")
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+		cmd.UI.DisplayNewline()
+
+		removeRoles, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really remove the {{.Count}} roles listed above?", map[string]interface{}{
+			"Count": len(deletions),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !removeRoles {
+			cmd.UI.DisplayText("Apply cancelled, no changes were made.")
+			return nil
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	table := [][]string{append(header, cmd.UI.TranslateText("status"))}
+	failed := 0
+	for _, change := range changes {
+		status := cmd.UI.TranslateText("ok")
+		warnings, err = cmd.Actor.ApplyChange(change)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			failed++
+			status = cmd.UI.TranslateText("failed: {{.Error}}", map[string]interface{}{"Error": err.Error()})
+		}
+		table = append(table, append(foundationChangeRow(cmd.UI, change), status))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	if failed > 0 {
+		return translatableerror.RoleChangesFailedError{
+			Failed: failed,
+			Count:  len(changes),
+		}
+	}
+
+	cmd.UI.DisplayText("Made {{.Count}} changes.", map[string]interface{}{
+		"Count": len(changes),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
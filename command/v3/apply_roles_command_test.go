@@ -0,0 +1,177 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-roles Command", func() {
+	var (
+		cmd             ApplyRolesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyRolesActor
+		binaryName      string
+		changes         []foundationaction.Change
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyRolesActor)
+
+		cmd = ApplyRolesCommand{
+			RolesFile:   "roles.yml",
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		changes = []foundationaction.Change{
+			{Type: foundationaction.ChangeCreate, Resource: foundationaction.UserResource, Name: "bob", Origin: "ldap"},
+			{Type: foundationaction.ChangeCreate, Resource: foundationaction.OrganizationRoleResource, Organization: "acme", Role: "managers", Name: "bob"},
+			{Type: foundationaction.ChangeDelete, Resource: foundationaction.SpaceRoleResource, Organization: "acme", Space: "dev", Role: "developers", Name: "mallory"},
+		}
+		fakeActor.PlanRolesReturns(changes, foundationaction.Warnings{"plan-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when --create-users is provided without an external --origin", func() {
+		BeforeEach(func() {
+			cmd.CreateUsers = true
+			cmd.Origin = "uaa"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--create-users", Arg2: "--origin"}))
+			Expect(fakeActor.PlanRolesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --plan is provided", func() {
+		BeforeEach(func() {
+			cmd.Plan = true
+			cmd.Prune = true
+			cmd.CreateUsers = true
+			cmd.Origin = "ldap"
+		})
+
+		It("displays the changes without making them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			path, prune, origin := fakeActor.PlanRolesArgsForCall(0)
+			Expect(path).To(Equal("roles.yml"))
+			Expect(prune).To(BeTrue())
+			Expect(origin).To(Equal("ldap"))
+			Expect(fakeActor.ApplyChangeCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say("Planning role changes from roles.yml as some-user..."))
+			Expect(testUI.Out).To(Say(`\+\s+user\s+bob\s+origin ldap`))
+			Expect(testUI.Out).To(Say(`\+\s+org role\s+bob\s+managers in org acme`))
+			Expect(testUI.Out).To(Say(`-\s+space role\s+mallory\s+developers in org acme / space dev`))
+			Expect(testUI.Out).To(Say("3 changes planned. Run without --plan to make them."))
+			Expect(testUI.Err).To(Say("plan-warning"))
+		})
+	})
+
+	Context("when applying the changes", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			fakeActor.ApplyChangeReturns(foundationaction.Warnings{"apply-warning"}, nil)
+		})
+
+		It("makes every change and reports each of them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, origin := fakeActor.PlanRolesArgsForCall(0)
+			Expect(origin).To(BeEmpty())
+			Expect(fakeActor.ApplyChangeCallCount()).To(Equal(3))
+
+			Expect(testUI.Out).To(Say("Applying roles from roles.yml as some-user..."))
+			Expect(testUI.Out).To(Say(`resource\s+name\s+details\s+status`))
+			Expect(testUI.Out).To(Say(`\+\s+org role\s+bob\s+managers in org acme\s+ok`))
+			Expect(testUI.Out).To(Say("Made 3 changes."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+
+		Context("when a change fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyChangeReturnsOnCall(1, nil, errors.New("apply-error"))
+			})
+
+			It("makes the remaining changes and returns a RoleChangesFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RoleChangesFailedError{Failed: 1, Count: 3}))
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(3))
+
+				Expect(testUI.Out).To(Say(`\+\s+org role\s+bob\s+managers in org acme\s+failed: apply-error`))
+				Expect(testUI.Out).To(Say(`-\s+space role\s+mallory\s+developers in org acme / space dev\s+ok`))
+			})
+		})
+	})
+
+	Context("when roles will be removed and --force is not provided", func() {
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("lists the roles to remove and makes every change", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("The following roles will be removed:"))
+				Expect(testUI.Out).To(Say(`-\s+space role\s+mallory\s+developers in org acme / space dev`))
+				Expect(testUI.Out).To(Say(`Really remove the 1 roles listed above\?`))
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(3))
+				Expect(testUI.Out).To(Say("Made 3 changes."))
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("makes no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Apply cancelled, no changes were made."))
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,176 @@
+package v3
+
+import (
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/cloneaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . CloneSpaceActor
+
+type CloneSpaceActor interface {
+	CloneSpace(orgGUID string, sourceSpaceName string, destinationSpaceName string, hostnameSuffix string, username string) (cloneaction.SpaceClone, cloneaction.Warnings, error)
+}
+
+type CloneSpaceCommand struct {
+	RequiredArgs    flag.CloneSpaceArgs `positional-args:"yes"`
+	HostnameSuffix  string              `long:"hostname-suffix" description:"Suffix appended to the hostname of each cloned route (Default: -DEST_SPACE)"`
+	usage           interface{}         `usage:"CF_NAME clone-space SOURCE_SPACE DEST_SPACE [--hostname-suffix SUFFIX]\n\n   Creates DEST_SPACE in the targeted org and recreates the apps of SOURCE_SPACE in it\n   with their current droplet, environment variables and process scale, along with the\n   user-provided services and bindings, routes and network policies between the apps.\n   Managed service instances are not cloned.\n\nEXAMPLES:\n   CF_NAME clone-space staging review-42\n   CF_NAME clone-space staging review-42 --hostname-suffix -pr42"`
+	relatedCommands interface{}         `related_commands:"create-space, network-policies, services, spaces"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CloneSpaceActor
+}
+
+func (cmd *CloneSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	client, uaa, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	v3Actor := v3action.NewActor(client, config, nil, nil)
+	networkingClient, err := shared.NewNetworkingClient(client.NetworkPolicyV1(), config, uaa, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = cloneaction.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3Actor,
+		cfnetworkingaction.NewActor(networkingClient, v3Actor),
+	)
+
+	return nil
+}
+
+func (cmd CloneSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	hostnameSuffix := cmd.HostnameSuffix
+	if hostnameSuffix == "" {
+		hostnameSuffix = cloneaction.DefaultHostnameSuffix(cmd.RequiredArgs.DestinationSpace)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Cloning space {{.SourceSpace}} to {{.DestinationSpace}} in org {{.Org}} as {{.User}}...", map[string]interface{}{
+		"SourceSpace":      cmd.RequiredArgs.SourceSpace,
+		"DestinationSpace": cmd.RequiredArgs.DestinationSpace,
+		"Org":              cmd.Config.TargetedOrganization().Name,
+		"User":             user.Name,
+	})
+
+	clone, warnings, err := cmd.Actor.CloneSpace(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.SourceSpace, cmd.RequiredArgs.DestinationSpace, hostnameSuffix, user.Name)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if cloneErr, ok := err.(cloneaction.CloneFailedError); ok {
+			cmd.displayCloneFailed(cloneErr)
+			err = cloneErr.Err
+		}
+		if _, ok := err.(v2action.SpaceNotFoundError); ok {
+			return sharedV2.HandleError(err)
+		}
+		return shared.HandleError(err)
+	}
+
+	for _, serviceName := range clone.SkippedServices {
+		cmd.UI.DisplayWarning("Managed service instance {{.ServiceName}} was not cloned.", map[string]interface{}{
+			"ServiceName": serviceName,
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("droplet"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("services"),
+			cmd.UI.TranslateText("routes"),
+		},
+	}
+	for _, app := range clone.Applications {
+		droplet := cmd.UI.TranslateText("none")
+		if app.DropletCopied {
+			droplet = cmd.UI.TranslateText("copied")
+		}
+		state := cmd.UI.TranslateText("stopped")
+		if app.Started {
+			state = cmd.UI.TranslateText("started")
+		}
+
+		table = append(table, []string{
+			app.Name,
+			droplet,
+			state,
+			strings.Join(app.Services, ", "),
+			strings.Join(app.Routes, ", "),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Cloned {{.Apps}} apps, {{.Services}} user-provided services and {{.Policies}} network policies.", map[string]interface{}{
+		"Apps":     len(clone.Applications),
+		"Services": len(clone.UserProvidedServices),
+		"Policies": clone.NetworkPolicies,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// displayCloneFailed tells the user what was left behind by a clone that
+// failed after the destination space was created.
+func (cmd CloneSpaceCommand) displayCloneFailed(cloneErr cloneaction.CloneFailedError) {
+	if cloneErr.RollbackErr == nil {
+		cmd.UI.DisplayWarning("Cloning failed, space {{.Space}} and everything cloned into it were deleted.", map[string]interface{}{
+			"Space": cloneErr.SpaceName,
+		})
+		return
+	}
+
+	cmd.UI.DisplayWarning("Cloning failed and space {{.Space}} could not be deleted: {{.Error}}", map[string]interface{}{
+		"Space": cloneErr.SpaceName,
+		"Error": cloneErr.RollbackErr.Error(),
+	})
+	cmd.UI.DisplayWarning("The space is partially cloned. Delete it with '{{.BinaryName}} delete-space {{.Space}}' before cloning again.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"Space":      cloneErr.SpaceName,
+	})
+}
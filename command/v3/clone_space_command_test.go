@@ -0,0 +1,170 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/cloneaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("clone-space Command", func() {
+	var (
+		cmd             CloneSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeCloneSpaceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeCloneSpaceActor)
+
+		cmd = CloneSpaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.SourceSpace = "staging"
+		cmd.RequiredArgs.DestinationSpace = "review"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the space is cloned", func() {
+		BeforeEach(func() {
+			fakeActor.CloneSpaceReturns(cloneaction.SpaceClone{
+				Applications: []cloneaction.ClonedApplication{
+					{Name: "web-app", DropletCopied: true, Started: true, Services: []string{"ups-1"}, Routes: []string{"web-app-review.example.com"}},
+					{Name: "new-app"},
+				},
+				UserProvidedServices: []string{"ups-1"},
+				SkippedServices:      []string{"some-db"},
+				NetworkPolicies:      2,
+			}, cloneaction.Warnings{"clone-warning"}, nil)
+		})
+
+		It("clones the space with the default hostname suffix and displays the apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			orgGUID, source, destination, suffix, username := fakeActor.CloneSpaceArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(source).To(Equal("staging"))
+			Expect(destination).To(Equal("review"))
+			Expect(suffix).To(Equal("-review"))
+			Expect(username).To(Equal("some-user"))
+
+			Expect(testUI.Out).To(Say("Cloning space staging to review in org some-org as some-user..."))
+			Expect(testUI.Out).To(Say(`name\s+droplet\s+state\s+services\s+routes`))
+			Expect(testUI.Out).To(Say(`web-app\s+copied\s+started\s+ups-1\s+web-app-review.example.com`))
+			Expect(testUI.Out).To(Say(`new-app\s+none\s+stopped`))
+			Expect(testUI.Out).To(Say("Cloned 2 apps, 1 user-provided services and 2 network policies."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("clone-warning"))
+			Expect(testUI.Err).To(Say("Managed service instance some-db was not cloned."))
+		})
+
+		Context("when a hostname suffix is provided", func() {
+			BeforeEach(func() {
+				cmd.HostnameSuffix = "-pr42"
+			})
+
+			It("uses the suffix", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, suffix, _ := fakeActor.CloneSpaceArgsForCall(0)
+				Expect(suffix).To(Equal("-pr42"))
+			})
+		})
+	})
+
+	Context("when the source space does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.CloneSpaceReturns(cloneaction.SpaceClone{}, nil, v2action.SpaceNotFoundError{Name: "staging"})
+		})
+
+		It("returns a SpaceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "staging"}))
+		})
+	})
+
+	Context("when cloning fails", func() {
+		BeforeEach(func() {
+			fakeActor.CloneSpaceReturns(cloneaction.SpaceClone{}, cloneaction.Warnings{"clone-warning"}, errors.New("clone-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("clone-error"))
+			Expect(testUI.Err).To(Say("clone-warning"))
+		})
+	})
+
+	Context("when cloning fails after the destination space was created", func() {
+		Context("when the destination space was deleted", func() {
+			BeforeEach(func() {
+				fakeActor.CloneSpaceReturns(cloneaction.SpaceClone{}, cloneaction.Warnings{"clone-warning"}, cloneaction.CloneFailedError{
+					SpaceName: "dev",
+					Err:       errors.New("clone-error"),
+				})
+			})
+
+			It("reports that the space was deleted and returns the cause", func() {
+				Expect(executeErr).To(MatchError("clone-error"))
+				Expect(testUI.Err).To(Say("clone-warning"))
+				Expect(testUI.Err).To(Say("Cloning failed, space dev and everything cloned into it were deleted."))
+			})
+		})
+
+		Context("when the destination space could not be deleted", func() {
+			BeforeEach(func() {
+				fakeActor.CloneSpaceReturns(cloneaction.SpaceClone{}, nil, cloneaction.CloneFailedError{
+					SpaceName:   "dev",
+					Err:         v2action.SpaceNotFoundError{Name: "staging"},
+					RollbackErr: errors.New("delete-error"),
+				})
+			})
+
+			It("reports the partially cloned space and returns the cause", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "staging"}))
+				Expect(testUI.Err).To(Say("Cloning failed and space dev could not be deleted: delete-error"))
+				Expect(testUI.Err).To(Say("The space is partially cloned. Delete it with 'faceman delete-space dev' before cloning again."))
+			})
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package v3
+
+import (
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . DropletProgressBar
+
+type DropletProgressBar interface {
+	v3action.ProgressBar
+	Ready()
+	Complete()
+}
+
+// readyProgressBar readies the progress bar when the actor starts streaming
+// the droplet, since NewProgressBarWrapper blocks until Ready is called.
+type readyProgressBar struct {
+	DropletProgressBar
+}
+
+func (bar readyProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	go bar.Ready()
+	return bar.DropletProgressBar.NewProgressBarWrapper(reader, sizeOfFile)
+}
+
+//go:generate counterfeiter . DownloadDropletActor
+
+type DownloadDropletActor interface {
+	CloudControllerAPIVersion() string
+	DownloadApplicationDroplet(appName string, spaceGUID string, dropletGUID string, path string, progressBar v3action.ProgressBar) (v3action.Droplet, v3action.Warnings, error)
+}
+
+type DownloadDropletCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	DropletGUID     string       `long:"droplet" description:"The guid of the droplet to download (Default: the current droplet of the app)"`
+	Path            flag.Path    `long:"path" required:"true" description:"File to write the droplet to"`
+	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME [--droplet DROPLET_GUID] --path FILE\n\n   The checksum of the droplet is verified before the file is written.\n\nEXAMPLES:\n   CF_NAME download-droplet my-app --path my-app.tgz"`
+	relatedCommands interface{}  `related_commands:"upload-droplet, v3-droplets, v3-set-droplet"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DownloadDropletActor
+	ProgressBar DropletProgressBar
+}
+
+func (cmd *DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd DownloadDropletCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Downloading droplet of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	droplet, warnings, err := cmd.Actor.DownloadApplicationDroplet(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.DropletGUID, string(cmd.Path), readyProgressBar{cmd.ProgressBar})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v3action.DropletNotFoundError); ok {
+			return translatableerror.DropletNotFoundError{AppName: cmd.RequiredArgs.AppName, DropletGUID: cmd.DropletGUID}
+		}
+		return shared.HandleError(err)
+	}
+	cmd.ProgressBar.Complete()
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Droplet {{.DropletGUID}} written to {{.Path}}", map[string]interface{}{
+		"DropletGUID": droplet.GUID,
+		"Path":        cmd.Path,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package v3_test
+
+import (
+	"errors"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-droplet Command", func() {
+	var (
+		cmd             v3.DownloadDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeDownloadDropletActor
+		fakeProgressBar *v3fakes.FakeDropletProgressBar
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeDownloadDropletActor)
+		fakeProgressBar = new(v3fakes.FakeDropletProgressBar)
+
+		cmd = v3.DownloadDropletCommand{
+			Path:        "my-app.tgz",
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the droplet is downloaded", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "some-droplet-guid"
+			fakeActor.DownloadApplicationDropletStub = func(_ string, _ string, _ string, _ string, progressBar v3action.ProgressBar) (v3action.Droplet, v3action.Warnings, error) {
+				progressBar.NewProgressBarWrapper(strings.NewReader("bits"), 4)
+				return v3action.Droplet{GUID: "some-droplet-guid"}, v3action.Warnings{"download-warning"}, nil
+			}
+		})
+
+		It("downloads the droplet with a progress bar", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID, dropletGUID, path, _ := fakeActor.DownloadApplicationDropletArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(path).To(Equal("my-app.tgz"))
+
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			Eventually(fakeProgressBar.ReadyCallCount).Should(Equal(1))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say("Downloading droplet of app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("Droplet some-droplet-guid written to my-app.tgz"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("download-warning"))
+		})
+	})
+
+	Context("when the app has no current droplet", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadApplicationDropletReturns(v3action.Droplet{}, nil, v3action.DropletNotFoundError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns a DropletNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DropletNotFoundError{AppName: "some-app"}))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the checksum does not match", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadApplicationDropletReturns(v3action.Droplet{}, v3action.Warnings{"download-warning"}, v3action.DropletChecksumMismatchError{DropletGUID: "some-droplet-guid", Expected: "abc", Actual: "def"})
+		})
+
+		It("returns a DropletChecksumMismatchError and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DropletChecksumMismatchError{DropletGUID: "some-droplet-guid", Expected: "abc", Actual: "def"}))
+			Expect(testUI.Err).To(Say("download-warning"))
+		})
+	})
+
+	Context("when the download fails", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadApplicationDropletReturns(v3action.Droplet{}, nil, errors.New("download-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("download-error"))
+		})
+	})
+})
@@ -0,0 +1,280 @@
+package v3
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . EgressCheckActor
+
+type EgressCheckActor interface {
+	NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+//go:generate counterfeiter . EgressCheckActorV2
+
+type EgressCheckActorV2 interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetSecurityGroupRulesBySpace(spaceGUID string) ([]v2action.SecurityGroupRule, v2action.Warnings, error)
+}
+
+type EgressCheckCommand struct {
+	RequiredArgs    flag.EgressCheckArgs `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME egress-check APP_NAME DEST:PORT/PROTO\n\n   When DEST is an IP address, the rules of the running and staging security\n   groups that apply to the app's space are checked. When DEST is the name of an\n   app in the targeted space, or ORG/SPACE/APP for an app in another space, the\n   network policies of the app are checked. Otherwise DEST is taken to be a host\n   name, such as db.example.com, and each of its addresses is checked.\n\n   The command exits with status 2 when the traffic is not allowed.\n\nEXAMPLES:\n   CF_NAME egress-check frontend 10.0.16.4:5432/tcp\n   CF_NAME egress-check frontend backend:8080/tcp"`
+	relatedCommands interface{}          `related_commands:"network-policies, security-groups, space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EgressCheckActor
+	ActorV2     EgressCheckActorV2
+	LookupIP    func(host string) ([]net.IP, error)
+}
+
+func (cmd *EgressCheckCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+	cmd.LookupIP = net.LookupIP
+
+	client, uaa, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.CFNetworkingEndpointNotFoundError{}
+		}
+
+		return err
+	}
+
+	v3Actor := v3action.NewActor(client, config, nil, nil)
+	networkingClient, err := shared.NewNetworkingClient(client.NetworkPolicyV1(), config, uaa, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd EgressCheckCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	destination := cmd.RequiredArgs.Destination
+	cmd.UI.DisplayTextWithFlavor("Checking egress from app {{.AppName}} to {{.Destination}}:{{.Port}}/{{.Protocol}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"Destination": destination.Destination,
+		"Port":        destination.Port,
+		"Protocol":    destination.Protocol,
+		"Org":         cmd.Config.TargetedOrganization().Name,
+		"Space":       cmd.Config.TargetedSpace().Name,
+		"User":        user.Name,
+	})
+
+	_, appWarnings, err := cmd.ActorV2.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(appWarnings)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	var allowed bool
+	if ip := net.ParseIP(destination.Destination); ip != nil {
+		allowed, err = cmd.checkSecurityGroups([]net.IP{ip}, false)
+	} else {
+		var isApp bool
+		isApp, err = cmd.isAppName(destination.Destination)
+		if err != nil {
+			return err
+		}
+
+		if isApp {
+			allowed, err = cmd.checkNetworkPolicies()
+		} else {
+			var ips []net.IP
+			ips, err = cmd.LookupIP(destination.Destination)
+			if err != nil {
+				return translatableerror.EgressDestinationNotResolvedError{Host: destination.Destination, Err: err}
+			}
+			allowed, err = cmd.checkSecurityGroups(ips, true)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return translatableerror.EgressDeniedError{
+			AppName:     cmd.RequiredArgs.AppName,
+			Destination: fmt.Sprintf("%s:%d/%s", destination.Destination, destination.Port, destination.Protocol),
+		}
+	}
+
+	return nil
+}
+
+// checkSecurityGroups displays the security group rules that allow traffic to
+// each of the IPs, and returns whether the running app can reach all of them.
+func (cmd EgressCheckCommand) checkSecurityGroups(ips []net.IP, resolved bool) (bool, error) {
+	rules, warnings, err := cmd.ActorV2.GetSecurityGroupRulesBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, sharedV2.HandleError(err)
+	}
+
+	allowedWhenRunning := true
+	for _, ip := range ips {
+		if resolved {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("{{.Host}} resolves to {{.IP}}", map[string]interface{}{
+				"Host": cmd.RequiredArgs.Destination.Destination,
+				"IP":   ip.String(),
+			})
+		}
+
+		table, allowed := cmd.securityGroupsTable(rules, ip)
+		allowedWhenRunning = allowedWhenRunning && allowed
+		cmd.displayEgressTable(table)
+	}
+
+	return allowedWhenRunning, nil
+}
+
+func (cmd EgressCheckCommand) securityGroupsTable(rules []v2action.SecurityGroupRule, ip net.IP) ([][]string, bool) {
+	allowedWhenRunning := false
+	table := cmd.egressTableHeader()
+	for _, lifecycle := range []ccv2.SecurityGroupLifecycle{ccv2.SecurityGroupLifecycleRunning, ccv2.SecurityGroupLifecycleStaging} {
+		allowed := false
+		for _, rule := range rules {
+			if rule.Lifecycle != lifecycle || !rule.Allows(ip, cmd.RequiredArgs.Destination.Port, cmd.RequiredArgs.Destination.Protocol) {
+				continue
+			}
+
+			allowed = true
+			table = append(table, []string{
+				string(lifecycle),
+				cmd.UI.TranslateText("yes"),
+				cmd.UI.TranslateText("security group {{.Name}} ({{.Rule}})", map[string]interface{}{
+					"Name": rule.Name,
+					"Rule": strings.TrimSpace(fmt.Sprintf("%s %s %s", rule.Protocol, rule.Destination, rule.Ports)),
+				}),
+			})
+		}
+
+		if !allowed {
+			table = append(table, []string{string(lifecycle), cmd.UI.TranslateText("no"), ""})
+		} else if lifecycle == ccv2.SecurityGroupLifecycleRunning {
+			allowedWhenRunning = true
+		}
+	}
+
+	return table, allowedWhenRunning
+}
+
+// checkNetworkPolicies displays the network policies that allow traffic to
+// the destination app, and returns whether there are any.
+func (cmd EgressCheckCommand) checkNetworkPolicies() (bool, error) {
+	policies, warnings, err := cmd.Actor.NetworkPoliciesBySpaceAndAppName(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.AppName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, shared.HandleError(err)
+	}
+
+	destination := cmd.RequiredArgs.Destination
+	running := string(ccv2.SecurityGroupLifecycleRunning)
+
+	table := cmd.egressTableHeader()
+	for _, policy := range policies {
+		destinationName := policy.DestinationName
+		if policy.DestinationSpaceName != "" {
+			destinationName = fmt.Sprintf("%s/%s/%s", policy.DestinationOrgName, policy.DestinationSpaceName, policy.DestinationName)
+		}
+
+		if destinationName != destination.Destination ||
+			policy.Protocol != destination.Protocol ||
+			destination.Port < policy.StartPort || destination.Port > policy.EndPort {
+			continue
+		}
+
+		table = append(table, []string{
+			running,
+			cmd.UI.TranslateText("yes"),
+			cmd.UI.TranslateText("network policy to {{.Destination}} ({{.Protocol}} {{.Ports}})", map[string]interface{}{
+				"Destination": destinationName,
+				"Protocol":    policy.Protocol,
+				"Ports":       networkPolicyPorts(policy.StartPort, policy.EndPort),
+			}),
+		})
+	}
+
+	allowed := len(table) > 1
+	if !allowed {
+		table = append(table, []string{running, cmd.UI.TranslateText("no"), ""})
+	}
+
+	cmd.displayEgressTable(table)
+	return allowed, nil
+}
+
+func (cmd EgressCheckCommand) displayEgressTable(table [][]string) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd EgressCheckCommand) egressTableHeader() [][]string {
+	return [][]string{
+		{
+			cmd.UI.TranslateText("lifecycle"),
+			cmd.UI.TranslateText("allowed"),
+			cmd.UI.TranslateText("allowed by"),
+		},
+	}
+}
+
+// isAppName returns true if the destination is ORG/SPACE/APP or the name of an
+// app in the targeted space, and false if it is to be resolved as a host
+// name.
+func (cmd EgressCheckCommand) isAppName(destination string) (bool, error) {
+	if strings.Contains(destination, "/") {
+		return true, nil
+	}
+
+	_, warnings, err := cmd.ActorV2.GetApplicationByNameAndSpace(destination, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case actionerror.ApplicationNotFoundError:
+		return false, nil
+	default:
+		return false, sharedV2.HandleError(err)
+	}
+}
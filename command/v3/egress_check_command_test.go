@@ -0,0 +1,341 @@
+package v3_test
+
+import (
+	"errors"
+	"net"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("egress-check Command", func() {
+	var (
+		cmd             EgressCheckCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeEgressCheckActor
+		fakeActorV2     *v3fakes.FakeEgressCheckActorV2
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeEgressCheckActor)
+		fakeActorV2 = new(v3fakes.FakeEgressCheckActorV2)
+
+		cmd = EgressCheckCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+			RequiredArgs: flag.EgressCheckArgs{
+				AppName:     "some-app",
+				Destination: flag.EgressDestination{Destination: "10.0.0.5", Port: 443, Protocol: "tcp"},
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		})
+
+		Context("when getting the current user fails", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns an ApplicationNotFoundError and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("app-warning"))
+				Expect(fakeActorV2.GetSecurityGroupRulesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the destination is an IP address", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetSecurityGroupRulesBySpaceReturns(
+					[]v2action.SecurityGroupRule{
+						{Name: "private", Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443,8443", Lifecycle: ccv2.SecurityGroupLifecycleRunning},
+						{Name: "dns", Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53", Lifecycle: ccv2.SecurityGroupLifecycleRunning},
+						{Name: "dns", Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53", Lifecycle: ccv2.SecurityGroupLifecycleStaging},
+					},
+					v2action.Warnings{"rules-warning"},
+					nil)
+			})
+
+			It("reports the security group rules that allow the traffic for each lifecycle", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActorV2.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActorV2.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActorV2.GetSecurityGroupRulesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeActor.NetworkPoliciesBySpaceAndAppNameCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say(`Checking egress from app some-app to 10\.0\.0\.5:443/tcp in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`lifecycle\s+allowed\s+allowed by`))
+				Expect(testUI.Out).To(Say(`running\s+yes\s+security group private \(tcp 10\.0\.0\.0/24 443,8443\)`))
+				Expect(testUI.Out).To(Say(`staging\s+no`))
+				Expect(testUI.Err).To(Say("rules-warning"))
+			})
+
+			Context("when no rule allows the traffic of the running app", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Destination.Port = 5432
+				})
+
+				It("displays the result and returns an EgressDeniedError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.EgressDeniedError{
+						AppName:     "some-app",
+						Destination: "10.0.0.5:5432/tcp",
+					}))
+					Expect(executeErr.(translatableerror.EgressDeniedError).ExitCode()).To(Equal(2))
+					Expect(testUI.Out).To(Say(`running\s+no`))
+				})
+			})
+
+			Context("when getting the security group rules fails", func() {
+				BeforeEach(func() {
+					fakeActorV2.GetSecurityGroupRulesBySpaceReturns(nil, v2action.Warnings{"rules-warning"}, v2action.SpaceNotFoundError{GUID: "some-space-guid"})
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{}))
+					Expect(testUI.Err).To(Say("rules-warning"))
+				})
+			})
+		})
+
+		Context("when the destination is a host name", func() {
+			var lookedUpHost string
+
+			BeforeEach(func() {
+				lookedUpHost = ""
+				cmd.RequiredArgs.Destination = flag.EgressDestination{Destination: "db.example.com", Port: 443, Protocol: "tcp"}
+				fakeActorV2.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+					if name == "some-app" {
+						return v2action.Application{Name: name}, nil, nil
+					}
+					return v2action.Application{}, v2action.Warnings{"destination-app-warning"}, actionerror.ApplicationNotFoundError{Name: name}
+				}
+				cmd.LookupIP = func(host string) ([]net.IP, error) {
+					lookedUpHost = host
+					return []net.IP{net.ParseIP("10.0.0.5"), net.ParseIP("10.0.1.5")}, nil
+				}
+				fakeActorV2.GetSecurityGroupRulesBySpaceReturns(
+					[]v2action.SecurityGroupRule{
+						{Name: "private", Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443", Lifecycle: ccv2.SecurityGroupLifecycleRunning},
+					},
+					nil,
+					nil)
+			})
+
+			It("checks the security group rules for each of the resolved addresses", func() {
+				Expect(fakeActorV2.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+				appName, spaceGUID := fakeActorV2.GetApplicationByNameAndSpaceArgsForCall(1)
+				Expect(appName).To(Equal("db.example.com"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(testUI.Err).To(Say("destination-app-warning"))
+
+				Expect(lookedUpHost).To(Equal("db.example.com"))
+				Expect(fakeActorV2.GetSecurityGroupRulesBySpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.NetworkPoliciesBySpaceAndAppNameCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say(`db\.example\.com resolves to 10\.0\.0\.5`))
+				Expect(testUI.Out).To(Say(`running\s+yes\s+security group private \(tcp 10\.0\.0\.0/24 443\)`))
+				Expect(testUI.Out).To(Say(`db\.example\.com resolves to 10\.0\.1\.5`))
+				Expect(testUI.Out).To(Say(`running\s+no`))
+			})
+
+			It("returns an EgressDeniedError when any of the addresses is not allowed", func() {
+				Expect(executeErr).To(MatchError(translatableerror.EgressDeniedError{
+					AppName:     "some-app",
+					Destination: "db.example.com:443/tcp",
+				}))
+			})
+
+			Context("when the host name has no dots", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Destination.Destination = "db"
+				})
+
+				It("resolves it", func() {
+					Expect(lookedUpHost).To(Equal("db"))
+					Expect(fakeActor.NetworkPoliciesBySpaceAndAppNameCallCount()).To(Equal(0))
+					Expect(testUI.Out).To(Say(`db resolves to 10\.0\.0\.5`))
+				})
+			})
+
+			Context("when looking up the destination app fails", func() {
+				BeforeEach(func() {
+					fakeActorV2.GetApplicationByNameAndSpaceReturnsOnCall(1, v2action.Application{}, nil, errors.New("app-error"))
+					fakeActorV2.GetApplicationByNameAndSpaceStub = nil
+				})
+
+				It("returns the error without resolving the host name", func() {
+					Expect(executeErr).To(MatchError("app-error"))
+					Expect(lookedUpHost).To(BeEmpty())
+				})
+			})
+
+			Context("when the host name cannot be resolved", func() {
+				BeforeEach(func() {
+					cmd.LookupIP = func(string) ([]net.IP, error) {
+						return nil, errors.New("no such host")
+					}
+				})
+
+				It("returns an EgressDestinationNotResolvedError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.EgressDestinationNotResolvedError{
+						Host: "db.example.com",
+						Err:  errors.New("no such host"),
+					}))
+					Expect(fakeActorV2.GetSecurityGroupRulesBySpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the destination is an app name", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Destination = flag.EgressDestination{Destination: "backend", Port: 8085, Protocol: "tcp"}
+				fakeActor.NetworkPoliciesBySpaceAndAppNameReturns(
+					[]cfnetworkingaction.Policy{
+						{SourceName: "some-app", DestinationName: "backend", Protocol: "udp", StartPort: 8085, EndPort: 8085},
+						{SourceName: "some-app", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+						{SourceName: "some-app", DestinationName: "backend", DestinationSpaceName: "other-space", DestinationOrgName: "other-org", Protocol: "tcp", StartPort: 8085, EndPort: 8085},
+					},
+					cfnetworkingaction.Warnings{"policy-warning"},
+					nil)
+			})
+
+			It("reports the network policies that allow the traffic", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.NetworkPoliciesBySpaceAndAppNameCallCount()).To(Equal(1))
+				spaceGUID, appName := fakeActor.NetworkPoliciesBySpaceAndAppNameArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(appName).To(Equal("some-app"))
+				Expect(fakeActorV2.GetSecurityGroupRulesBySpaceCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say(`running\s+yes\s+network policy to backend \(tcp 8080-8090\)`))
+				Expect(testUI.Out).ToNot(Say("other-org"))
+				Expect(testUI.Err).To(Say("policy-warning"))
+			})
+
+			Context("when the app name has a dot", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Destination.Destination = "api.v2"
+					fakeActor.NetworkPoliciesBySpaceAndAppNameReturns(
+						[]cfnetworkingaction.Policy{
+							{SourceName: "some-app", DestinationName: "api.v2", Protocol: "tcp", StartPort: 8085, EndPort: 8085},
+						},
+						nil,
+						nil)
+					cmd.LookupIP = func(string) ([]net.IP, error) {
+						return nil, errors.New("should not resolve")
+					}
+				})
+
+				It("checks the network policies of the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					appName, _ := fakeActorV2.GetApplicationByNameAndSpaceArgsForCall(1)
+					Expect(appName).To(Equal("api.v2"))
+					Expect(testUI.Out).To(Say(`running\s+yes\s+network policy to api\.v2 \(tcp 8085\)`))
+				})
+			})
+
+			Context("when the destination is an app in another space", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Destination.Destination = "other-org/other-space/backend"
+				})
+
+				It("matches the fully qualified destination without looking it up", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActorV2.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					Expect(testUI.Out).To(Say(`running\s+yes\s+network policy to other-org/other-space/backend \(tcp 8085\)`))
+				})
+			})
+
+			Context("when no policy allows the traffic", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Destination.Port = 9000
+				})
+
+				It("reports that the traffic is not allowed", func() {
+					Expect(executeErr).To(MatchError(translatableerror.EgressDeniedError{
+						AppName:     "some-app",
+						Destination: "backend:9000/tcp",
+					}))
+					Expect(testUI.Out).To(Say(`running\s+no`))
+					Expect(testUI.Out).ToNot(Say("network policy to"))
+				})
+			})
+
+			Context("when listing the network policies fails", func() {
+				BeforeEach(func() {
+					fakeActor.NetworkPoliciesBySpaceAndAppNameReturns(nil, cfnetworkingaction.Warnings{"policy-warning"}, errors.New("policy-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("policy-error"))
+					Expect(testUI.Err).To(Say("policy-warning"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ExportFoundationActor
+
+type ExportFoundationActor interface {
+	ExportFoundation(dir string) (int, foundationaction.Warnings, error)
+}
+
+type ExportFoundationCommand struct {
+	RequiredArgs    flag.ExportFoundationArgs `positional-args:"yes"`
+	usage           interface{}               `usage:"CF_NAME export-foundation DIR\n\n   The foundation is written to DIR as:\n\n   organization_quotas.yml  the organization quotas\n   isolation_segments.yml   the isolation segments and the orgs entitled to them\n   orgs/ORG.yml             the quota, roles, space quotas and spaces of each org\n\nEXAMPLES:\n   CF_NAME export-foundation ./foundation"`
+	relatedCommands interface{}               `related_commands:"apply-foundation, isolation-segments, orgs, quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportFoundationActor
+}
+
+func (cmd *ExportFoundationCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	actor, err := newFoundationActor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = actor
+
+	return nil
+}
+
+func (cmd ExportFoundationCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting foundation to {{.Dir}} as {{.User}}...", map[string]interface{}{
+		"Dir":  cmd.RequiredArgs.Directory,
+		"User": user.Name,
+	})
+
+	count, warnings, err := cmd.Actor.ExportFoundation(string(cmd.RequiredArgs.Directory))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Exported {{.Count}} orgs.", map[string]interface{}{
+		"Count": count,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// newFoundationActor returns a foundation actor backed by V2 and V3 actors.
+func newFoundationActor(config command.Config, ui command.UI) (*foundationaction.Actor, error) {
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return nil, translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionIsolationSegmentV3}
+		}
+
+		return nil, err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return foundationaction.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3action.NewActor(client, config, nil, nil),
+	), nil
+}
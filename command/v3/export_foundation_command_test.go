@@ -0,0 +1,93 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-foundation Command", func() {
+	var (
+		cmd             ExportFoundationCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeExportFoundationActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeExportFoundationActor)
+
+		cmd = ExportFoundationCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Directory = "some-dir"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the export succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.ExportFoundationReturns(3, foundationaction.Warnings{"some-warning"}, nil)
+		})
+
+		It("exports the foundation to the directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.ExportFoundationArgsForCall(0)).To(Equal("some-dir"))
+
+			Expect(testUI.Out).To(Say("Exporting foundation to some-dir as some-user..."))
+			Expect(testUI.Out).To(Say("Exported 3 orgs."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when the export fails", func() {
+		BeforeEach(func() {
+			fakeActor.ExportFoundationReturns(0, foundationaction.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
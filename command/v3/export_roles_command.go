@@ -0,0 +1,83 @@
+package v3
+
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ExportRolesActor
+
+type ExportRolesActor interface {
+	ExportRoles(orgName string, path string) (foundationaction.Warnings, error)
+	GetOrganizationRoles(orgName string) (foundationaction.OrganizationRoles, foundationaction.Warnings, error)
+	WriteRoles(writer io.Writer, roles []foundationaction.OrganizationRoles, csvFormat bool) error
+}
+
+type ExportRolesCommand struct {
+	RequiredArgs    flag.Organization `positional-args:"yes"`
+	RolesFile       flag.Path         `short:"f" description:"Write the roles to a file instead of displaying them as YAML, as CSV when the file has a .csv extension"`
+	usage           interface{}       `usage:"CF_NAME export-roles ORG [-f FILE]\n\nEXAMPLES:\n   CF_NAME export-roles acme > roles.yml\n   CF_NAME export-roles acme -f roles.csv"`
+	relatedCommands interface{}       `related_commands:"apply-roles, org-users, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportRolesActor
+}
+
+func (cmd *ExportRolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	actor, err := newFoundationActor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = actor
+
+	return nil
+}
+
+func (cmd ExportRolesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.RolesFile == "" {
+		roles, warnings, err := cmd.Actor.GetOrganizationRoles(cmd.RequiredArgs.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		return cmd.Actor.WriteRoles(cmd.UI.GetOut(), []foundationaction.OrganizationRoles{roles}, false)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting roles of org {{.Org}} to {{.Path}} as {{.User}}...", map[string]interface{}{
+		"Org":  cmd.RequiredArgs.Organization,
+		"Path": cmd.RolesFile,
+		"User": user.Name,
+	})
+
+	warnings, err := cmd.Actor.ExportRoles(cmd.RequiredArgs.Organization, string(cmd.RolesFile))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package v3_test
+
+import (
+	"errors"
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-roles Command", func() {
+	var (
+		cmd             ExportRolesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeExportRolesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeExportRolesActor)
+
+		cmd = ExportRolesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Organization = "acme"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no file is provided", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationRolesReturns(foundationaction.OrganizationRoles{Name: "acme"}, foundationaction.Warnings{"roles-warning"}, nil)
+			fakeActor.WriteRolesStub = func(writer io.Writer, _ []foundationaction.OrganizationRoles, _ bool) error {
+				_, err := writer.Write([]byte("- name: acme\n"))
+				return err
+			}
+		})
+
+		It("displays the roles as YAML", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetOrganizationRolesArgsForCall(0)).To(Equal("acme"))
+
+			_, roles, csvFormat := fakeActor.WriteRolesArgsForCall(0)
+			Expect(roles).To(Equal([]foundationaction.OrganizationRoles{{Name: "acme"}}))
+			Expect(csvFormat).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("- name: acme"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(testUI.Err).To(Say("roles-warning"))
+		})
+	})
+
+	Context("when a file is provided", func() {
+		BeforeEach(func() {
+			cmd.RolesFile = "roles.csv"
+			fakeActor.ExportRolesReturns(foundationaction.Warnings{"export-warning"}, nil)
+		})
+
+		It("writes the roles to the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			orgName, path := fakeActor.ExportRolesArgsForCall(0)
+			Expect(orgName).To(Equal("acme"))
+			Expect(path).To(Equal("roles.csv"))
+
+			Expect(testUI.Out).To(Say("Exporting roles of org acme to roles.csv as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("export-warning"))
+		})
+
+		Context("when exporting fails", func() {
+			BeforeEach(func() {
+				fakeActor.ExportRolesReturns(nil, errors.New("export-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("export-error"))
+			})
+		})
+	})
+})
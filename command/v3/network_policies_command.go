@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -20,13 +21,17 @@ import (
 type NetworkPoliciesActor interface {
 	NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
 	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	WriteNetworkPolicyFile(path string, policies []cfnetworkingaction.Policy) error
 }
 
 type NetworkPoliciesCommand struct {
-	SourceApp string `long:"source" required:"false" description:"Source app to filter results by"`
+	SourceApp        string    `long:"source" required:"false" description:"Source app to filter results by" completion:"apps"`
+	DestinationSpace string    `long:"destination-space" description:"Space of destination apps to filter results by" completion:"spaces"`
+	DestinationOrg   string    `long:"destination-org" description:"Org of the destination space (Default: targeted org)" completion:"orgs"`
+	Export           flag.Path `long:"export" description:"Write the policies to a YAML file keyed by source app name instead of displaying them"`
 
-	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP]"`
-	relatedCommands interface{} `related_commands:"add-network-policy, apps, remove-network-policy"`
+	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP] [--destination-space SPACE [--destination-org ORG]] [--export FILE]\n\n   Destination apps in other spaces are displayed as ORG/SPACE/APP. Policies to apps in other spaces are not exported.\n\nEXAMPLES:\n   CF_NAME network-policies --destination-space services --destination-org platform\n   CF_NAME network-policies --export policies.yml"`
+	relatedCommands interface{} `related_commands:"add-network-policy, apply-network-policies, apps, remove-network-policy"`
 
 	UI          command.UI
 	Config      command.Config
@@ -59,6 +64,10 @@ func (cmd *NetworkPoliciesCommand) Setup(config command.Config, ui command.UI) e
 }
 
 func (cmd NetworkPoliciesCommand) Execute(args []string) error {
+	if cmd.DestinationOrg != "" && cmd.DestinationSpace == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--destination-org", Arg2: "--destination-space"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -94,6 +103,14 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.DestinationSpace != "" {
+		policies = cmd.filterByDestinationSpace(policies)
+	}
+
+	if cmd.Export != "" {
+		return cmd.exportPolicies(policies)
+	}
+
 	cmd.UI.DisplayNewline()
 
 	table := [][]string{
@@ -112,9 +129,13 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		} else {
 			portEntry = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
 		}
+		destination := policy.DestinationName
+		if policy.DestinationSpaceName != "" {
+			destination = fmt.Sprintf("%s/%s/%s", policy.DestinationOrgName, policy.DestinationSpaceName, policy.DestinationName)
+		}
 		table = append(table, []string{
 			policy.SourceName,
-			policy.DestinationName,
+			destination,
 			policy.Protocol,
 			portEntry,
 		})
@@ -124,3 +145,49 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// filterByDestinationSpace returns the policies whose destination app is in
+// the space given by --destination-space and --destination-org.
+func (cmd NetworkPoliciesCommand) filterByDestinationSpace(policies []cfnetworkingaction.Policy) []cfnetworkingaction.Policy {
+	orgName := cmd.DestinationOrg
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	var filtered []cfnetworkingaction.Policy
+	for _, policy := range policies {
+		policyOrgName, policySpaceName := policy.DestinationOrgName, policy.DestinationSpaceName
+		if policySpaceName == "" {
+			policyOrgName, policySpaceName = cmd.Config.TargetedOrganization().Name, cmd.Config.TargetedSpace().Name
+		}
+
+		if policyOrgName == orgName && policySpaceName == cmd.DestinationSpace {
+			filtered = append(filtered, policy)
+		}
+	}
+	return filtered
+}
+
+// exportPolicies writes the policies between apps in the targeted space to
+// the export file, since the file format cannot describe other spaces.
+func (cmd NetworkPoliciesCommand) exportPolicies(policies []cfnetworkingaction.Policy) error {
+	var spacePolicies []cfnetworkingaction.Policy
+	for _, policy := range policies {
+		if policy.DestinationSpaceName == "" {
+			spacePolicies = append(spacePolicies, policy)
+		}
+	}
+
+	err := cmd.Actor.WriteNetworkPolicyFile(string(cmd.Export), spacePolicies)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Exported {{.Count}} network policies to {{.Path}}", map[string]interface{}{
+		"Count": len(spacePolicies),
+		"Path":  cmd.Export,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
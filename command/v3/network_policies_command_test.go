@@ -160,6 +160,127 @@ var _ = Describe("network-policies Command", func() {
 					Expect(testUI.Err).To(Say("some-warning-2"))
 				})
 			})
+
+			Context("when an export file is passed", func() {
+				BeforeEach(func() {
+					cmd.Export = "some-file.yml"
+				})
+
+				It("writes the policies to the file instead of displaying them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.WriteNetworkPolicyFileCallCount()).To(Equal(1))
+					path, policies := fakeActor.WriteNetworkPolicyFileArgsForCall(0)
+					Expect(path).To(Equal("some-file.yml"))
+					Expect(policies).To(HaveLen(2))
+
+					Expect(testUI.Out).To(Say(`Listing network policies in org some-org / space some-space as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`Exported 2 network policies to some-file\.yml`))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).ToNot(Say("source\\s+destination"))
+
+					Expect(testUI.Err).To(Say("some-warning-1"))
+				})
+
+				Context("when writing the file fails", func() {
+					BeforeEach(func() {
+						fakeActor.WriteNetworkPolicyFileReturns(errors.New("write error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("write error"))
+					})
+				})
+			})
+		})
+
+		Context("when policies have destinations in other spaces", func() {
+			BeforeEach(func() {
+				fakeActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+					{
+						SourceName:      "app1",
+						DestinationName: "app2",
+						Protocol:        "tcp",
+						StartPort:       8080,
+						EndPort:         8080,
+					}, {
+						SourceName:           "app1",
+						DestinationName:      "app3",
+						DestinationSpaceName: "other-space",
+						DestinationOrgName:   "other-org",
+						Protocol:             "tcp",
+						StartPort:            9000,
+						EndPort:              9000,
+					},
+				}, nil, nil)
+			})
+
+			It("displays the fully qualified destinations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080"))
+				Expect(testUI.Out).To(Say("app1\\s+other-org/other-space/app3\\s+tcp\\s+9000"))
+			})
+
+			Context("when filtering by a destination space and org", func() {
+				BeforeEach(func() {
+					cmd.DestinationSpace = "other-space"
+					cmd.DestinationOrg = "other-org"
+				})
+
+				It("only displays the policies to apps in that space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("app1\\s+app2\\s+"))
+					Expect(testUI.Out).To(Say("app1\\s+other-org/other-space/app3\\s+tcp\\s+9000"))
+				})
+			})
+
+			Context("when filtering by the targeted space", func() {
+				BeforeEach(func() {
+					cmd.DestinationSpace = "some-space"
+				})
+
+				It("only displays the policies to apps in the targeted space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080"))
+					Expect(testUI.Out).ToNot(Say("other-org/other-space/app3"))
+				})
+			})
+
+			Context("when a destination org is passed without a destination space", func() {
+				BeforeEach(func() {
+					cmd.DestinationOrg = "other-org"
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--destination-org", Arg2: "--destination-space"}))
+					Expect(fakeActor.NetworkPoliciesBySpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when an export file is passed", func() {
+				BeforeEach(func() {
+					cmd.Export = "some-file.yml"
+				})
+
+				It("only exports the policies between apps in the targeted space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					_, policies := fakeActor.WriteNetworkPolicyFileArgsForCall(0)
+					Expect(policies).To(Equal([]cfnetworkingaction.Policy{
+						{
+							SourceName:      "app1",
+							DestinationName: "app2",
+							Protocol:        "tcp",
+							StartPort:       8080,
+							EndPort:         8080,
+						},
+					}))
+					Expect(testUI.Out).To(Say(`Exported 1 network policies to some-file\.yml`))
+				})
+			})
 		})
 
 		Context("when listing the policies is not successful", func() {
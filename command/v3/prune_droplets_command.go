@@ -0,0 +1,264 @@
+package v3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+//go:generate counterfeiter . PruneDropletsActor
+
+type PruneDropletsActor interface {
+	CloudControllerAPIVersion() string
+	PruneApplicationDroplets(appName string, spaceGUID string, keep int, dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error)
+	PruneSpaceDroplets(spaceGUID string, keep int, dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error)
+}
+
+type PruneDropletsCommand struct {
+	OptionalArgs    flag.OptionalAppName `positional-args:"yes"`
+	Keep            int                  `long:"keep" required:"true" description:"Number of most recent droplets to keep for each app"`
+	AllApps         bool                 `long:"all-apps" description:"Prune the droplets of every app in the targeted space"`
+	DryRun          bool                 `long:"dry-run" description:"List the droplets that would be deleted without deleting them"`
+	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}          `usage:"CF_NAME prune-droplets (APP_NAME | --all-apps) --keep N [--dry-run | -f]\n\n   Deletes the failed and expired droplets of the app and all but the N most recent\n   staged droplets. The current droplet and droplets that are still staging or\n   uploading are never deleted. The droplets that will be deleted are listed and\n   nothing is deleted until the deletion is confirmed, unless -f is given.\n\nEXAMPLES:\n   CF_NAME prune-droplets my-app --keep 3\n   CF_NAME prune-droplets --all-apps --keep 1 --dry-run"`
+	relatedCommands interface{}          `related_commands:"prune-packages, v3-droplets"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       PruneDropletsActor
+}
+
+func (cmd *PruneDropletsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd PruneDropletsCommand) Execute(args []string) error {
+	err := validatePruneArgs(cmd.OptionalArgs.AppName, cmd.AllApps, cmd.Keep)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var prune func(dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error)
+	if cmd.AllApps {
+		cmd.UI.DisplayTextWithFlavor("Pruning droplets of all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		prune = func(dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error) {
+			return cmd.Actor.PruneSpaceDroplets(cmd.Config.TargetedSpace().GUID, cmd.Keep, dryRun)
+		}
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Pruning droplets of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.OptionalArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		prune = func(dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error) {
+			return cmd.Actor.PruneApplicationDroplets(cmd.OptionalArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.Keep, dryRun)
+		}
+	}
+
+	return runPrune(cmd.UI, "droplets", cmd.DryRun, cmd.Force, prune)
+}
+
+// runPrune deletes the droplets or packages returned by prune and displays
+// them. Unless dryRun or force is set, the resources that will be deleted are
+// listed with a dry run first and nothing is deleted until the deletion is
+// confirmed.
+func runPrune(commandUI command.UI, resourceType string, dryRun bool, force bool, prune func(dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error)) error {
+	if !dryRun && !force {
+		planned, warnings, err := prune(true)
+		commandUI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		if len(planned) == 0 {
+			displayPrunedResources(commandUI, resourceType, nil, false)
+			return nil
+		}
+
+		commandUI.DisplayNewline()
+		commandUI.DisplayText("The following {{.ResourceType}} will be deleted:", map[string]interface{}{
+			"ResourceType": resourceType,
+		})
+		displayPrunedTable(commandUI, planned)
+		commandUI.DisplayNewline()
+
+		deleteResources, promptErr := commandUI.DisplayBoolPrompt(false, "Really delete the {{.Count}} {{.ResourceType}} listed above?", map[string]interface{}{
+			"Count":        len(planned),
+			"ResourceType": resourceType,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteResources {
+			commandUI.DisplayText("Prune cancelled, nothing was deleted.")
+			return nil
+		}
+	}
+
+	pruned, warnings, err := prune(dryRun)
+	commandUI.DisplayWarnings(warnings)
+	if err != nil {
+		if !dryRun && len(pruned) > 0 {
+			displayPartiallyPrunedResources(commandUI, resourceType, pruned)
+		}
+		return shared.HandleError(err)
+	}
+
+	displayPrunedResources(commandUI, resourceType, pruned, dryRun)
+	return nil
+}
+
+func validatePruneArgs(appName string, allApps bool, keep int) error {
+	switch {
+	case appName != "" && allApps:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"APP_NAME", "--all-apps"},
+		}
+	case appName == "" && !allApps:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case keep < 0:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--keep",
+			ExpectedType: "a non-negative integer",
+		}
+	}
+	return nil
+}
+
+// displayPrunedResources displays the pruned droplets or packages along with
+// the number of bytes reclaimed from the blobstore.
+func displayPrunedResources(commandUI command.UI, resourceType string, pruned []v3action.PrunedResource, dryRun bool) {
+	commandUI.DisplayNewline()
+
+	if len(pruned) == 0 {
+		commandUI.DisplayText("No {{.ResourceType}} to prune.", map[string]interface{}{
+			"ResourceType": resourceType,
+		})
+		commandUI.DisplayOK()
+		return
+	}
+
+	reclaimed, sizeUnknown := displayPrunedTable(commandUI, pruned)
+	commandUI.DisplayNewline()
+
+	var summary string
+	switch {
+	case dryRun && sizeUnknown:
+		summary = "Dry run: {{.Count}} {{.ResourceType}} would be deleted, reclaiming at least {{.Size}}."
+	case dryRun:
+		summary = "Dry run: {{.Count}} {{.ResourceType}} would be deleted, reclaiming {{.Size}}."
+	case sizeUnknown:
+		summary = "Deleted {{.Count}} {{.ResourceType}}, reclaiming at least {{.Size}}."
+	default:
+		summary = "Deleted {{.Count}} {{.ResourceType}}, reclaiming {{.Size}}."
+	}
+	commandUI.DisplayText(summary, map[string]interface{}{
+		"Count":        len(pruned),
+		"ResourceType": resourceType,
+		"Size":         bytefmt.ByteSize(uint64(reclaimed)),
+	})
+	commandUI.DisplayOK()
+}
+
+// displayPartiallyPrunedResources displays the droplets or packages that were
+// deleted before pruning failed.
+func displayPartiallyPrunedResources(commandUI command.UI, resourceType string, pruned []v3action.PrunedResource) {
+	commandUI.DisplayNewline()
+	commandUI.DisplayText("Deleted {{.Count}} {{.ResourceType}} before pruning failed:", map[string]interface{}{
+		"Count":        len(pruned),
+		"ResourceType": resourceType,
+	})
+	displayPrunedTable(commandUI, pruned)
+	commandUI.DisplayNewline()
+}
+
+// displayPrunedTable displays a table of the pruned droplets or packages and
+// returns the total size of the ones whose size is known.
+func displayPrunedTable(commandUI command.UI, pruned []v3action.PrunedResource) (int64, bool) {
+	table := [][]string{
+		{
+			commandUI.TranslateText("app"),
+			commandUI.TranslateText("guid"),
+			commandUI.TranslateText("state"),
+			commandUI.TranslateText("created"),
+			commandUI.TranslateText("size"),
+		},
+	}
+
+	var (
+		reclaimed   int64
+		sizeUnknown bool
+	)
+	for _, resource := range pruned {
+		created := resource.CreatedAt
+		if t, err := time.Parse(time.RFC3339, resource.CreatedAt); err == nil {
+			created = commandUI.UserFriendlyDate(t)
+		}
+
+		size := commandUI.TranslateText("unknown")
+		if resource.SizeInBytes >= 0 {
+			size = bytefmt.ByteSize(uint64(resource.SizeInBytes))
+			reclaimed += resource.SizeInBytes
+		} else {
+			sizeUnknown = true
+		}
+
+		table = append(table, []string{
+			resource.AppName,
+			resource.GUID,
+			commandUI.TranslateText(strings.ToLower(resource.State)),
+			created,
+			size,
+		})
+	}
+
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return reclaimed, sizeUnknown
+}
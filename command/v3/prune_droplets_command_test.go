@@ -0,0 +1,281 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("prune-droplets Command", func() {
+	var (
+		cmd             v3.PruneDropletsCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakePruneDropletsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakePruneDropletsActor)
+
+		cmd = v3.PruneDropletsCommand{
+			Keep:        2,
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Force:       true,
+		}
+		cmd.OptionalArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when neither an app nor --all-apps is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	Context("when both an app and --all-apps are provided", func() {
+		BeforeEach(func() {
+			cmd.AllApps = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"APP_NAME", "--all-apps"},
+			}))
+		})
+	})
+
+	Context("when --keep is negative", func() {
+		BeforeEach(func() {
+			cmd.Keep = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--keep",
+				ExpectedType: "a non-negative integer",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when droplets are pruned", func() {
+		BeforeEach(func() {
+			fakeActor.PruneApplicationDropletsReturns([]v3action.PrunedResource{
+				{AppName: "some-app", GUID: "droplet-1", State: "STAGED", CreatedAt: "2017-01-01T00:00:00Z", SizeInBytes: 2 * 1024 * 1024},
+				{AppName: "some-app", GUID: "droplet-2", State: "FAILED", CreatedAt: "2017-01-02T00:00:00Z"},
+			}, v3action.Warnings{"prune-warning"}, nil)
+		})
+
+		It("displays the deleted droplets and the reclaimed bytes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID, keep, dryRun := fakeActor.PruneApplicationDropletsArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(2))
+			Expect(dryRun).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Pruning droplets of app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`app\s+guid\s+state\s+created\s+size`))
+			Expect(testUI.Out).To(Say(`some-app\s+droplet-1\s+staged\s+.*\s+2M`))
+			Expect(testUI.Out).To(Say(`some-app\s+droplet-2\s+failed`))
+			Expect(testUI.Out).To(Say("Deleted 2 droplets, reclaiming 2M."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("prune-warning"))
+		})
+
+		Context("when it is a dry run", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the droplets that would be deleted", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, dryRun := fakeActor.PruneApplicationDropletsArgsForCall(0)
+				Expect(dryRun).To(BeTrue())
+				Expect(testUI.Out).To(Say("Dry run: 2 droplets would be deleted, reclaiming 2M."))
+			})
+		})
+
+		Context("when -f is not provided", func() {
+			BeforeEach(func() {
+				cmd.Force = false
+			})
+
+			Context("when the deletion is confirmed", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("lists the droplets with a dry run and deletes them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.PruneApplicationDropletsCallCount()).To(Equal(2))
+					_, _, _, dryRun := fakeActor.PruneApplicationDropletsArgsForCall(0)
+					Expect(dryRun).To(BeTrue())
+					_, _, _, dryRun = fakeActor.PruneApplicationDropletsArgsForCall(1)
+					Expect(dryRun).To(BeFalse())
+
+					Expect(testUI.Out).To(Say("The following droplets will be deleted:"))
+					Expect(testUI.Out).To(Say(`some-app\s+droplet-1\s+staged`))
+					Expect(testUI.Out).To(Say(`Really delete the 2 droplets listed above\?`))
+					Expect(testUI.Out).To(Say("Deleted 2 droplets, reclaiming 2M."))
+				})
+			})
+
+			Context("when the deletion is not confirmed", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("deletes nothing", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.PruneApplicationDropletsCallCount()).To(Equal(1))
+					Expect(testUI.Out).To(Say("Prune cancelled, nothing was deleted."))
+				})
+			})
+
+			Context("when there is nothing to prune", func() {
+				BeforeEach(func() {
+					fakeActor.PruneApplicationDropletsReturns(nil, nil, nil)
+				})
+
+				It("does not ask for confirmation", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.PruneApplicationDropletsCallCount()).To(Equal(1))
+					Expect(testUI.Out).ToNot(Say("Really delete"))
+					Expect(testUI.Out).To(Say("No droplets to prune."))
+				})
+			})
+		})
+
+		Context("when the size of a droplet is unknown", func() {
+			BeforeEach(func() {
+				fakeActor.PruneApplicationDropletsReturns([]v3action.PrunedResource{
+					{AppName: "some-app", GUID: "droplet-1", State: "STAGED", CreatedAt: "2017-01-01T00:00:00Z", SizeInBytes: 2 * 1024 * 1024},
+					{AppName: "some-app", GUID: "droplet-2", State: "STAGED", CreatedAt: "2017-01-02T00:00:00Z", SizeInBytes: -1},
+				}, nil, nil)
+			})
+
+			It("displays the size as unknown and the reclaimed bytes as a lower bound", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`some-app\s+droplet-1\s+staged\s+.*\s+2M`))
+				Expect(testUI.Out).To(Say(`some-app\s+droplet-2\s+staged\s+.*\s+unknown`))
+				Expect(testUI.Out).To(Say("Deleted 2 droplets, reclaiming at least 2M."))
+			})
+		})
+	})
+
+	Context("when --all-apps is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = ""
+			cmd.AllApps = true
+		})
+
+		It("prunes the droplets of every app in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.PruneApplicationDropletsCallCount()).To(Equal(0))
+
+			spaceGUID, keep, _ := fakeActor.PruneSpaceDropletsArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(2))
+
+			Expect(testUI.Out).To(Say("Pruning droplets of all apps in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("No droplets to prune."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.PruneApplicationDropletsReturns(nil, v3action.Warnings{"prune-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("prune-warning"))
+		})
+	})
+
+	Context("when pruning fails", func() {
+		BeforeEach(func() {
+			fakeActor.PruneApplicationDropletsReturns(nil, nil, errors.New("prune-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("prune-error"))
+		})
+	})
+
+	Context("when pruning fails after deleting some droplets", func() {
+		BeforeEach(func() {
+			fakeActor.PruneApplicationDropletsReturns([]v3action.PrunedResource{
+				{AppName: "some-app", GUID: "droplet-1", State: "STAGED", CreatedAt: "2017-01-01T00:00:00Z", SizeInBytes: 1024},
+			}, nil, errors.New("prune-error"))
+		})
+
+		It("displays the deleted droplets and returns the error", func() {
+			Expect(executeErr).To(MatchError("prune-error"))
+			Expect(testUI.Out).To(Say("Deleted 1 droplets before pruning failed:"))
+			Expect(testUI.Out).To(Say(`some-app\s+droplet-1\s+staged\s+.*\s+1K`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+
+		Context("when it is a dry run", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("does not display the droplets", func() {
+				Expect(executeErr).To(MatchError("prune-error"))
+				Expect(testUI.Out).ToNot(Say("droplet-1"))
+			})
+		})
+	})
+})
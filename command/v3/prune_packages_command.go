@@ -0,0 +1,101 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . PrunePackagesActor
+
+type PrunePackagesActor interface {
+	CloudControllerAPIVersion() string
+	PruneApplicationPackages(appName string, spaceGUID string, keep int, dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error)
+	PruneSpacePackages(spaceGUID string, keep int, dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error)
+}
+
+type PrunePackagesCommand struct {
+	OptionalArgs    flag.OptionalAppName `positional-args:"yes"`
+	Keep            int                  `long:"keep" required:"true" description:"Number of most recent packages to keep for each app"`
+	AllApps         bool                 `long:"all-apps" description:"Prune the packages of every app in the targeted space"`
+	DryRun          bool                 `long:"dry-run" description:"List the packages that would be deleted without deleting them"`
+	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}          `usage:"CF_NAME prune-packages (APP_NAME | --all-apps) --keep N [--dry-run | -f]\n\n   Deletes the failed and expired packages of the app and all but the N most recent\n   ready packages. Packages that are still uploading or being copied are never\n   deleted. The packages that will be deleted are listed and nothing is deleted\n   until the deletion is confirmed, unless -f is given.\n\nEXAMPLES:\n   CF_NAME prune-packages my-app --keep 3\n   CF_NAME prune-packages --all-apps --keep 1 --dry-run"`
+	relatedCommands interface{}          `related_commands:"prune-droplets, v3-packages"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       PrunePackagesActor
+}
+
+func (cmd *PrunePackagesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd PrunePackagesCommand) Execute(args []string) error {
+	err := validatePruneArgs(cmd.OptionalArgs.AppName, cmd.AllApps, cmd.Keep)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var prune func(dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error)
+	if cmd.AllApps {
+		cmd.UI.DisplayTextWithFlavor("Pruning packages of all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		prune = func(dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error) {
+			return cmd.Actor.PruneSpacePackages(cmd.Config.TargetedSpace().GUID, cmd.Keep, dryRun)
+		}
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Pruning packages of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.OptionalArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		prune = func(dryRun bool) ([]v3action.PrunedResource, v3action.Warnings, error) {
+			return cmd.Actor.PruneApplicationPackages(cmd.OptionalArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.Keep, dryRun)
+		}
+	}
+
+	return runPrune(cmd.UI, "packages", cmd.DryRun, cmd.Force, prune)
+}
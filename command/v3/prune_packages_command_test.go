@@ -0,0 +1,134 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("prune-packages Command", func() {
+	var (
+		cmd             v3.PrunePackagesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakePrunePackagesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakePrunePackagesActor)
+
+		cmd = v3.PrunePackagesCommand{
+			Keep:        1,
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Force:       true,
+		}
+		cmd.OptionalArgs.AppName = "some-app"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when packages are pruned", func() {
+		BeforeEach(func() {
+			fakeActor.PruneApplicationPackagesReturns([]v3action.PrunedResource{
+				{AppName: "some-app", GUID: "package-1", State: "READY", CreatedAt: "2017-01-01T00:00:00Z", SizeInBytes: 512 * 1024},
+			}, v3action.Warnings{"prune-warning"}, nil)
+		})
+
+		It("displays the deleted packages and the reclaimed bytes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID, keep, dryRun := fakeActor.PruneApplicationPackagesArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(1))
+			Expect(dryRun).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Pruning packages of app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`some-app\s+package-1\s+ready\s+.*\s+512K`))
+			Expect(testUI.Out).To(Say("Deleted 1 packages, reclaiming 512K."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("prune-warning"))
+		})
+	})
+
+	Context("when --all-apps is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = ""
+			cmd.AllApps = true
+			cmd.DryRun = true
+		})
+
+		It("prunes the packages of every app in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			spaceGUID, keep, dryRun := fakeActor.PruneSpacePackagesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(1))
+			Expect(dryRun).To(BeTrue())
+			Expect(testUI.Out).To(Say("Pruning packages of all apps in org some-org / space some-space as some-user..."))
+		})
+	})
+
+	Context("when pruning fails", func() {
+		BeforeEach(func() {
+			fakeActor.PruneApplicationPackagesReturns(nil, v3action.Warnings{"prune-warning"}, errors.New("prune-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("prune-error"))
+			Expect(testUI.Err).To(Say("prune-warning"))
+		})
+	})
+
+	Context("when pruning fails after deleting some packages", func() {
+		BeforeEach(func() {
+			fakeActor.PruneApplicationPackagesReturns([]v3action.PrunedResource{
+				{AppName: "some-app", GUID: "package-1", State: "READY", CreatedAt: "2017-01-01T00:00:00Z", SizeInBytes: 1024},
+			}, nil, errors.New("prune-error"))
+		})
+
+		It("displays the deleted packages and returns the error", func() {
+			Expect(executeErr).To(MatchError("prune-error"))
+			Expect(testUI.Out).To(Say("Deleted 1 packages before pruning failed:"))
+			Expect(testUI.Out).To(Say(`some-app\s+package-1\s+ready\s+.*\s+1K`))
+		})
+	})
+})
@@ -23,6 +23,7 @@ var _ = Describe("remove-network-policy Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeRemoveNetworkPolicyActor
+		fakeMembership  *v3fakes.FakeMembershipActor
 		binaryName      string
 		executeErr      error
 		srcApp          string
@@ -35,20 +36,22 @@ var _ = Describe("remove-network-policy Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeRemoveNetworkPolicyActor)
+		fakeMembership = new(v3fakes.FakeMembershipActor)
 
 		srcApp = "some-app"
 		destApp = "some-other-app"
 		protocol = "tcp"
 
 		cmd = RemoveNetworkPolicyCommand{
-			UI:             testUI,
-			Config:         fakeConfig,
-			SharedActor:    fakeSharedActor,
-			Actor:          fakeActor,
-			RequiredArgs:   flag.RemoveNetworkPolicyArgs{SourceApp: srcApp},
-			DestinationApp: destApp,
-			Protocol:       flag.NetworkProtocol{Protocol: protocol},
-			Port:           flag.NetworkPort{StartPort: 8080, EndPort: 8081},
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			MembershipActor: fakeMembership,
+			RequiredArgs:    flag.RemoveNetworkPolicyArgs{SourceApp: srcApp},
+			DestinationApp:  destApp,
+			Protocol:        flag.NetworkProtocol{Protocol: protocol},
+			Port:            flag.NetworkPort{StartPort: 8080, EndPort: 8081},
 		}
 
 		binaryName = "faceman"
@@ -78,7 +81,7 @@ var _ = Describe("remove-network-policy Command", func() {
 		BeforeEach(func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
-			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
 		})
 
 		It("outputs flavor text", func() {
@@ -93,9 +96,10 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
 				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
@@ -116,9 +120,10 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
 				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
@@ -146,5 +151,41 @@ var _ = Describe("remove-network-policy Command", func() {
 				Expect(testUI.Out).ToNot(Say("OK"))
 			})
 		})
+
+		Context("when a destination space and org are specified", func() {
+			BeforeEach(func() {
+				cmd.DestinationSpace = "other-space"
+				cmd.DestinationOrg = "other-org"
+				fakeMembership.GetOrganizationByNameReturns(v3action.Organization{Name: "other-org", GUID: "other-org-guid"}, nil, nil)
+				fakeMembership.GetSpaceByNameAndOrganizationReturns(v3action.Space{Name: "other-space", GUID: "other-space-guid"}, nil, nil)
+			})
+
+			It("removes the policy to the app in the destination space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeMembership.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+				spaceName, orgGUID := fakeMembership.GetSpaceByNameAndOrganizationArgsForCall(0)
+				Expect(spaceName).To(Equal("other-space"))
+				Expect(orgGUID).To(Equal("other-org-guid"))
+
+				passedSpaceGuid, _, passedDestSpaceGuid, _, _, _, _ := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("other-space-guid"))
+
+				Expect(testUI.Out).To(Say(`Removing network policy from app %s in org some-org / space some-space to app %s in org other-org / space other-space as some-user\.\.\.`, srcApp, destApp))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when a destination org is specified without a destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "other-org"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--destination-org", Arg2: "--destination-space"}))
+				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
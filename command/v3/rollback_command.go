@@ -0,0 +1,206 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . RollbackActor
+
+type RollbackActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetRollbackDroplet(appGUID string, dropletGUID string, steps int) (v3action.Droplet, v3action.Warnings, error)
+	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
+	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
+	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Warnings, error)
+}
+
+type RollbackCommand struct {
+	RequiredArgs        flag.AppName `positional-args:"yes"`
+	DropletGUID         string       `long:"to" description:"GUID of the droplet to roll back to"`
+	Steps               int          `long:"steps" default:"1" description:"Number of staged droplets to step back from the current droplet"`
+	usage               interface{}  `usage:"CF_NAME rollback APP_NAME [--to DROPLET_GUID | --steps N]\n\n   Sets the droplet of the app to a previous staged droplet and restarts the app.\n   Droplets that failed staging are never used.\n\nEXAMPLES:\n   CF_NAME rollback my-app\n   CF_NAME rollback my-app --steps 2\n   CF_NAME rollback my-app --to 5b3f9a2e-1c6d-4c3f-8d8a-0e6a7c1f2b44"`
+	relatedCommands     interface{}  `related_commands:"v3-droplets, v3-set-droplet, v3-restart"`
+	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI                  command.UI
+	Config              command.Config
+	SharedActor         command.SharedActor
+	Actor               RollbackActor
+	AppSummaryDisplayer shared.AppSummaryDisplayer
+}
+
+func (cmd *RollbackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	actor := v3action.NewActor(ccClient, config, nil, nil)
+	cmd.Actor = actor
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.AppSummaryDisplayer = shared.AppSummaryDisplayer{
+		UI:              cmd.UI,
+		Config:          cmd.Config,
+		Actor:           actor,
+		V2AppRouteActor: v2action.NewActor(ccClientV2, uaaClientV2, config),
+		AppName:         cmd.RequiredArgs.AppName,
+	}
+
+	return nil
+}
+
+func (cmd RollbackCommand) Execute(args []string) error {
+	switch {
+	case cmd.DropletGUID != "" && cmd.Steps != 1:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--to", "--steps"},
+		}
+	case cmd.Steps < 1:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--steps",
+			ExpectedType: "a positive integer",
+		}
+	}
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	droplet, warnings, err := cmd.Actor.GetRollbackDroplet(app.GUID, cmd.DropletGUID, cmd.Steps)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		switch e := err.(type) {
+		case v3action.DropletNotFoundError:
+			return translatableerror.DropletNotFoundError{AppName: cmd.RequiredArgs.AppName, DropletGUID: e.DropletGUID}
+		case v3action.NoPreviousDropletError:
+			return translatableerror.NoPreviousDropletError{AppName: cmd.RequiredArgs.AppName, Steps: e.Steps}
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rolling back app {{.AppName}} to droplet {{.DropletGUID}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"DropletGUID": droplet.GUID,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	warnings, err = cmd.Actor.SetApplicationDroplet(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, droplet.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+
+	if app.Started() {
+		cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		warnings, err = cmd.Actor.StopApplication(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayOK()
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	_, warnings, err = cmd.Actor.StartApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Actor.PollStart(app.GUID, pollWarnings)
+	done <- true
+
+	if err != nil {
+		if _, ok := err.(v3action.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	return cmd.AppSummaryDisplayer.DisplayAppInfo()
+}
@@ -0,0 +1,219 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rollback Command", func() {
+	var (
+		cmd              v3.RollbackCommand
+		testUI           *ui.UI
+		fakeConfig       *commandfakes.FakeConfig
+		fakeSharedActor  *commandfakes.FakeSharedActor
+		fakeActor        *v3fakes.FakeRollbackActor
+		fakeSummaryActor *sharedfakes.FakeV3AppSummaryActor
+		fakeV2RouteActor *sharedfakes.FakeV2AppRouteActor
+		binaryName       string
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeRollbackActor)
+		fakeSummaryActor = new(sharedfakes.FakeV3AppSummaryActor)
+		fakeV2RouteActor = new(sharedfakes.FakeV2AppRouteActor)
+
+		cmd = v3.RollbackCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Steps:        1,
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			AppSummaryDisplayer: shared.AppSummaryDisplayer{
+				UI:              testUI,
+				Config:          fakeConfig,
+				Actor:           fakeSummaryActor,
+				V2AppRouteActor: fakeV2RouteActor,
+				AppName:         "some-app",
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{Name: "some-app", GUID: "some-app-guid", State: "STARTED"}, v3action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetRollbackDropletReturns(v3action.Droplet{GUID: "previous-droplet-guid"}, v3action.Warnings{"get-droplet-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --to and --steps are provided", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "some-droplet-guid"
+			cmd.Steps = 2
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--to", "--steps"},
+			}))
+		})
+	})
+
+	Context("when --steps is not positive", func() {
+		BeforeEach(func() {
+			cmd.Steps = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--steps",
+				ExpectedType: "a positive integer",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the rollback succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.PollStartStub = func(appGUID string, warnings chan<- v3action.Warnings) error {
+				warnings <- v3action.Warnings{"poll-warning"}
+				return nil
+			}
+			fakeSummaryActor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{
+				Application: v3action.Application{Name: "some-app", State: "STARTED"},
+			}, nil, nil)
+		})
+
+		It("sets the previous droplet, restarts the app and displays the app summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appGUID, dropletGUID, steps := fakeActor.GetRollbackDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(dropletGUID).To(BeEmpty())
+			Expect(steps).To(Equal(1))
+
+			appName, spaceGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(dropletGUID).To(Equal("previous-droplet-guid"))
+
+			Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say("Rolling back app some-app to droplet previous-droplet-guid in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Stopping app some-app"))
+			Expect(testUI.Out).To(Say("Starting app some-app"))
+			Expect(testUI.Out).To(Say("Waiting for app to start..."))
+			Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`name:\s+some-app`))
+
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-droplet-warning"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{Name: "some-app", GUID: "some-app-guid", State: "STOPPED"}, nil, nil)
+			})
+
+			It("starts the app without stopping it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when there is no previous staged droplet", func() {
+		BeforeEach(func() {
+			cmd.Steps = 3
+			fakeActor.GetRollbackDropletReturns(v3action.Droplet{}, nil, v3action.NoPreviousDropletError{AppGUID: "some-app-guid", Steps: 3})
+		})
+
+		It("returns a NoPreviousDropletError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPreviousDropletError{AppName: "some-app", Steps: 3}))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the droplet failed staging", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "failed-droplet-guid"
+			fakeActor.GetRollbackDropletReturns(v3action.Droplet{}, nil, v3action.RollbackDropletNotStagedError{DropletGUID: "failed-droplet-guid", State: v3action.DropletStateFailed})
+		})
+
+		It("returns a RollbackDropletNotStagedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RollbackDropletNotStagedError{DropletGUID: "failed-droplet-guid", State: "FAILED"}))
+		})
+	})
+
+	Context("when the droplet does not exist", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "missing-droplet-guid"
+			fakeActor.GetRollbackDropletReturns(v3action.Droplet{}, nil, v3action.DropletNotFoundError{AppGUID: "some-app-guid", DropletGUID: "missing-droplet-guid"})
+		})
+
+		It("returns a DropletNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DropletNotFoundError{AppName: "some-app", DropletGUID: "missing-droplet-guid"}))
+		})
+	})
+
+	Context("when the app does not start in time", func() {
+		BeforeEach(func() {
+			fakeActor.PollStartReturns(v3action.StartupTimeoutError{})
+		})
+
+		It("returns a StartupTimeoutError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: binaryName}))
+		})
+	})
+
+	Context("when setting the droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, errors.New("set-droplet-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("set-droplet-error"))
+			Expect(testUI.Err).To(Say("set-droplet-warning"))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+		})
+	})
+})
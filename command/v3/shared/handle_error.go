@@ -3,10 +3,13 @@ package shared
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/foundationaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
 func HandleError(err error) error {
@@ -24,6 +27,8 @@ func HandleError(err error) error {
 		}
 	case ccerror.UnverifiedServerError:
 		return translatableerror.InvalidSSLCertError{API: e.URL}
+	case ccerror.ReadOnlyError:
+		return translatableerror.ReadOnlyModeError(e)
 
 	case sharedaction.NotLoggedInError:
 		return translatableerror.NotLoggedInError(e)
@@ -32,12 +37,26 @@ func HandleError(err error) error {
 	case sharedaction.NoSpaceTargetedError:
 		return translatableerror.NoSpaceTargetedError(e)
 
+	case cfnetworkingaction.InvalidPolicyFileError:
+		return translatableerror.InvalidNetworkPolicyFileError(e)
+
+	case foundationaction.InvalidFoundationDirectoryError:
+		return translatableerror.InvalidFoundationDirectoryError(e)
+	case foundationaction.InvalidRolesFileError:
+		return translatableerror.InvalidRolesFileError(e)
+
 	case v3action.ApplicationNotFoundError:
 		return translatableerror.ApplicationNotFoundError(e)
+	case v3action.AppNotFoundInManifestError:
+		return translatableerror.AppNotFoundInManifestError(e)
 	case v3action.AssignDropletError:
 		return translatableerror.AssignDropletError(e)
+	case v3action.DropletChecksumMismatchError:
+		return translatableerror.DropletChecksumMismatchError(e)
 	case sharedaction.EmptyDirectoryError:
 		return translatableerror.EmptyDirectoryError(e)
+	case v3action.HTTPHealthCheckInvalidError:
+		return translatableerror.HTTPHealthCheckInvalidError{}
 	case v3action.IsolationSegmentNotFoundError:
 		return translatableerror.IsolationSegmentNotFoundError(e)
 	case v3action.OrganizationNotFoundError:
@@ -46,10 +65,17 @@ func HandleError(err error) error {
 		return translatableerror.ProcessNotFoundError(e)
 	case v3action.ProcessInstanceNotFoundError:
 		return translatableerror.ProcessInstanceNotFoundError(e)
+	case v3action.RollbackDropletNotStagedError:
+		return translatableerror.RollbackDropletNotStagedError{DropletGUID: e.DropletGUID, State: string(e.State)}
+	case v3action.SpaceNotFoundError:
+		return translatableerror.SpaceNotFoundError(e)
 	case v3action.StagingTimeoutError:
 		return translatableerror.StagingTimeoutError(e)
 	case v3action.TaskWorkersUnavailableError:
 		return translatableerror.RunTaskError{Message: "Task workers are unavailable."}
+
+	case manifest.ProcessTypeMissingError:
+		return translatableerror.ManifestProcessTypeMissingError(e)
 	}
 
 	return err
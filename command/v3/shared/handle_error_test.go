@@ -4,11 +4,14 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/foundationaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -32,6 +35,10 @@ var _ = Describe("HandleError", func() {
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			translatableerror.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("ccerror.ReadOnlyError -> ReadOnlyModeError",
+			ccerror.ReadOnlyError{Method: "DELETE", URL: "some-url"},
+			translatableerror.ReadOnlyModeError{Method: "DELETE", URL: "some-url"}),
+
 		Entry("ccerror.SSLValidationHostnameError -> SSLCertErrorError",
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			translatableerror.SSLCertError{Message: "some-message"}),
@@ -57,10 +64,22 @@ var _ = Describe("HandleError", func() {
 			v3action.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v3action.AppNotFoundInManifestError -> AppNotFoundInManifestError",
+			v3action.AppNotFoundInManifestError{Name: "some-app"},
+			translatableerror.AppNotFoundInManifestError{Name: "some-app"}),
+
+		Entry("v3action.HTTPHealthCheckInvalidError -> HTTPHealthCheckInvalidError",
+			v3action.HTTPHealthCheckInvalidError{},
+			translatableerror.HTTPHealthCheckInvalidError{}),
+
 		Entry("v3action.TaskWorkersUnavailableError -> RunTaskError",
 			v3action.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			translatableerror.RunTaskError{Message: "Task workers are unavailable."}),
 
+		Entry("manifest.ProcessTypeMissingError -> ManifestProcessTypeMissingError",
+			manifest.ProcessTypeMissingError{AppName: "some-app"},
+			translatableerror.ManifestProcessTypeMissingError{AppName: "some-app"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			translatableerror.NotLoggedInError{BinaryName: "faceman"}),
@@ -77,6 +96,10 @@ var _ = Describe("HandleError", func() {
 			v3action.AssignDropletError{Message: "some-message"},
 			translatableerror.AssignDropletError{Message: "some-message"}),
 
+		Entry("v3action.DropletChecksumMismatchError -> DropletChecksumMismatchError",
+			v3action.DropletChecksumMismatchError{DropletGUID: "some-guid", Expected: "abc", Actual: "def"},
+			translatableerror.DropletChecksumMismatchError{DropletGUID: "some-guid", Expected: "abc", Actual: "def"}),
+
 		Entry("v3action.OrganizationNotFoundError -> OrgNotFoundError",
 			v3action.OrganizationNotFoundError{Name: "some-org"},
 			translatableerror.OrganizationNotFoundError{Name: "some-org"}),
@@ -89,6 +112,14 @@ var _ = Describe("HandleError", func() {
 			v3action.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			translatableerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
 
+		Entry("v3action.RollbackDropletNotStagedError -> RollbackDropletNotStagedError",
+			v3action.RollbackDropletNotStagedError{DropletGUID: "some-guid", State: v3action.DropletStateFailed},
+			translatableerror.RollbackDropletNotStagedError{DropletGUID: "some-guid", State: "FAILED"}),
+
+		Entry("v3action.SpaceNotFoundError -> SpaceNotFoundError",
+			v3action.SpaceNotFoundError{Name: "some-space"},
+			translatableerror.SpaceNotFoundError{Name: "some-space"}),
+
 		Entry("v3action.StagingTimeoutError -> StagingTimeoutError",
 			v3action.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond},
 			translatableerror.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond}),
@@ -97,6 +128,18 @@ var _ = Describe("HandleError", func() {
 			sharedaction.EmptyDirectoryError{Path: "some-path"},
 			translatableerror.EmptyDirectoryError{Path: "some-path"}),
 
+		Entry("cfnetworkingaction.InvalidPolicyFileError -> InvalidNetworkPolicyFileError",
+			cfnetworkingaction.InvalidPolicyFileError{Path: "some-path", Message: "some-message"},
+			translatableerror.InvalidNetworkPolicyFileError{Path: "some-path", Message: "some-message"}),
+
+		Entry("foundationaction.InvalidFoundationDirectoryError -> InvalidFoundationDirectoryError",
+			foundationaction.InvalidFoundationDirectoryError{Path: "some-path", Message: "some-message"},
+			translatableerror.InvalidFoundationDirectoryError{Path: "some-path", Message: "some-message"}),
+
+		Entry("foundationaction.InvalidRolesFileError -> InvalidRolesFileError",
+			foundationaction.InvalidRolesFileError{Path: "some-path", Message: "some-message"},
+			translatableerror.InvalidRolesFileError{Path: "some-path", Message: "some-message"}),
+
 		Entry("default case -> original error",
 			err,
 			err),
@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/targetsafety"
+)
+
+// networkingReadOnly rejects requests to the networking API that could modify
+// resources, like the ReadOnly Cloud Controller wrapper.
+type networkingReadOnly struct {
+	connection cfnetworking.Connection
+}
+
+func (readOnly *networkingReadOnly) Wrap(innerconnection cfnetworking.Connection) cfnetworking.Connection {
+	readOnly.connection = innerconnection
+	return readOnly
+}
+
+func (readOnly *networkingReadOnly) Make(request *cfnetworking.Request, passedResponse *cfnetworking.Response) error {
+	if targetsafety.IsMutatingRequest(request.Method, request.URL.Path) {
+		return ccerror.ReadOnlyError{
+			Method: request.Method,
+			URL:    request.URL.String(),
+		}
+	}
+
+	return readOnly.connection.Make(request, passedResponse)
+}
+
+// networkingProtectedTarget asks for confirmation before the first request to
+// the networking API that could modify resources, like the ProtectedTarget
+// Cloud Controller wrapper.
+type networkingProtectedTarget struct {
+	confirm    func() error
+	confirmed  bool
+	connection cfnetworking.Connection
+}
+
+func (protected *networkingProtectedTarget) Wrap(innerconnection cfnetworking.Connection) cfnetworking.Connection {
+	protected.connection = innerconnection
+	return protected
+}
+
+func (protected *networkingProtectedTarget) Make(request *cfnetworking.Request, passedResponse *cfnetworking.Response) error {
+	if !protected.confirmed && targetsafety.IsMutatingRequest(request.Method, request.URL.Path) {
+		err := protected.confirm()
+		if err != nil {
+			return err
+		}
+		protected.confirmed = true
+	}
+
+	return protected.connection.Make(request, passedResponse)
+}
@@ -7,6 +7,7 @@ import (
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 // NewClients creates a new V3 Cloud Controller client and UAA client using the
@@ -14,6 +15,12 @@ import (
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Client, *uaa.Client, error) {
 	ccWrappers := []ccv3.ConnectionWrapper{}
 
+	if replayDir := config.ReplayDirectory(); replayDir != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestReplayer(replayDir))
+	} else if recordDir := config.RecordDirectory(); recordDir != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestRecorder(recordDir))
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -27,6 +34,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 	ccWrappers = append(ccWrappers, authWrapper)
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
 
+	if config.ReadOnly() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewReadOnly())
+	} else if config.ProtectedTarget() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewProtectedTarget(sharedV2.ConfirmProtectedTarget(config, ui)))
+	}
+
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
@@ -67,6 +80,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		SkipSSLValidation: config.SkipSSLValidation(),
 	})
 
+	if replayDir := config.ReplayDirectory(); replayDir != "" {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestReplayer(replayDir))
+	} else if recordDir := config.RecordDirectory(); recordDir != "" {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestRecorder(recordDir))
+	}
+
 	if verbose {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
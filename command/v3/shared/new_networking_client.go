@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 // NewNetworkingClient creates a new cfnetworking client.
@@ -29,6 +30,12 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 
 	wrappers = append(wrappers, wrapper.NewRetryRequest(2))
 
+	if config.ReadOnly() {
+		wrappers = append(wrappers, new(networkingReadOnly))
+	} else if config.ProtectedTarget() {
+		wrappers = append(wrappers, &networkingProtectedTarget{confirm: sharedV2.ConfirmProtectedTarget(config, ui)})
+	}
+
 	return cfnetv1.NewClient(cfnetv1.Config{
 		AppName:           config.BinaryName(),
 		AppVersion:        config.BinaryVersion(),
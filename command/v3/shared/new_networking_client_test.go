@@ -1,7 +1,12 @@
 package shared_test
 
 import (
+	"net/http"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -10,6 +15,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("New Clients", func() {
@@ -40,4 +46,58 @@ var _ = Describe("New Clients", func() {
 			Expect(err).To(MatchError("This command requires Network Policy API V1. Your targeted endpoint does not expose it."))
 		})
 	})
+
+	Describe("target safety", func() {
+		var (
+			server *Server
+			input  *Buffer
+			client *cfnetv1.Client
+		)
+
+		BeforeEach(func() {
+			server = NewServer()
+			server.AllowUnhandledRequests = true
+			server.UnhandledRequestStatusCode = http.StatusOK
+
+			input = NewBuffer()
+			testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+			fakeConfig.TargetReturns("https://api.example.com")
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			client, err = NewNetworkingClient(server.URL(), fakeConfig, fakeUAAClient, testUI)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		Context("when the config is read-only", func() {
+			BeforeEach(func() {
+				fakeConfig.ReadOnlyReturns(true)
+			})
+
+			It("rejects policy changes without sending them", func() {
+				err := client.CreatePolicies([]cfnetv1.Policy{})
+				Expect(err).To(BeAssignableToTypeOf(ccerror.ReadOnlyError{}))
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when the target is protected", func() {
+			BeforeEach(func() {
+				fakeConfig.ProtectedTargetReturns(true)
+				_, err := input.Write([]byte("some-other-target\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not send policy changes that are not confirmed", func() {
+				err := client.CreatePolicies([]cfnetv1.Policy{})
+				Expect(err).To(MatchError(translatableerror.ProtectedTargetNotConfirmedError{}))
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
 })
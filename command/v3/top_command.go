@@ -0,0 +1,334 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+const clearScreen = "\033[H\033[2J"
+
+//go:generate counterfeiter . TopActor
+
+type TopActor interface {
+	CloudControllerAPIVersion() string
+	GetInstanceMetricsBySpace(spaceGUID string, appNames []string) ([]v3action.InstanceMetrics, v3action.Warnings, error)
+}
+
+type TopCommand struct {
+	OptionalArgs    flag.OptionalAppNames `positional-args:"yes"`
+	Interval        int                   `long:"interval" default:"5" description:"Seconds between samples"`
+	Samples         int                   `long:"samples" description:"Number of samples to take before exiting (Default: unlimited)"`
+	SortBy          string                `long:"sort" choice:"cpu" choice:"memory" choice:"disk" choice:"name" default:"cpu" description:"Sort instances by cpu, memory, disk or name"`
+	usage           interface{}           `usage:"CF_NAME top [APP_NAME...] [--interval SECONDS] [--samples N] [--sort (cpu | memory | disk | name)]\n\n   Shows the CPU, memory and disk usage of the process instances of the apps in the\n   targeted space, refreshed every interval. Crashed instances and instances that\n   restarted while sampling are highlighted. When the output is not a terminal one\n   line is printed for every instance in every sample.\n\nEXAMPLES:\n   CF_NAME top\n   CF_NAME top my-app my-worker --sort memory\n   CF_NAME top my-app --interval 1 --samples 600 > metrics.log"`
+	relatedCommands interface{}           `related_commands:"v3-app, v3-scale"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TopActor
+}
+
+func (cmd *TopCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd TopCommand) Execute(args []string) error {
+	switch {
+	case cmd.Interval < 1:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--interval",
+			ExpectedType: "a positive integer",
+		}
+	case cmd.Samples < 0:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--samples",
+			ExpectedType: "a non-negative integer",
+		}
+	}
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	isTTY := cmd.Config.IsTTY()
+	if !isTTY {
+		cmd.displayFlavorText(user.Name)
+	}
+
+	history := instanceHistory{}
+	for sample := 1; cmd.Samples == 0 || sample <= cmd.Samples; sample++ {
+		if sample > 1 {
+			time.Sleep(time.Duration(cmd.Interval) * time.Second)
+		}
+
+		metrics, warnings, err := cmd.Actor.GetInstanceMetricsBySpace(cmd.Config.TargetedSpace().GUID, cmd.OptionalArgs.AppNames)
+		if err != nil {
+			cmd.UI.DisplayWarnings(warnings)
+			return shared.HandleError(err)
+		}
+
+		sortInstanceMetrics(metrics, cmd.SortBy)
+		flapping := history.record(metrics)
+
+		if isTTY {
+			fmt.Fprint(cmd.UI.GetOut(), clearScreen)
+			cmd.displayFlavorText(user.Name)
+			cmd.UI.DisplayWarnings(warnings)
+			cmd.displayDashboard(metrics, flapping)
+		} else {
+			cmd.UI.DisplayWarnings(warnings)
+			cmd.displaySample(time.Now(), metrics, flapping)
+		}
+	}
+
+	return nil
+}
+
+func (cmd TopCommand) displayFlavorText(username string) {
+	cmd.UI.DisplayTextWithFlavor("Showing instance metrics in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+}
+
+func (cmd TopCommand) displayDashboard(metrics []v3action.InstanceMetrics, flapping map[instanceKey]bool) {
+	cmd.UI.DisplayNewline()
+
+	processTable := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("cpu"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("disk"),
+		},
+	}
+	for _, process := range summarizeProcesses(metrics) {
+		processTable = append(processTable, []string{
+			process.AppName,
+			process.ProcessType,
+			fmt.Sprintf("%d/%d", process.running, process.total),
+			fmt.Sprintf("%.1f%%", process.CPU*100),
+			cmd.UI.TranslateText("{{.Usage}} of {{.Quota}}", map[string]interface{}{
+				"Usage": bytefmt.ByteSize(process.MemoryUsage),
+				"Quota": bytefmt.ByteSize(process.MemoryQuota),
+			}),
+			cmd.UI.TranslateText("{{.Usage}} of {{.Quota}}", map[string]interface{}{
+				"Usage": bytefmt.ByteSize(process.DiskUsage),
+				"Quota": bytefmt.ByteSize(process.DiskQuota),
+			}),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", processTable, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	instanceTable := [][]string{
+		{
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("since"),
+			cmd.UI.TranslateText("cpu"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("disk"),
+		},
+	}
+	for _, instance := range metrics {
+		instanceTable = append(instanceTable, []string{
+			fmt.Sprintf("%s %s #%d", instance.AppName, instance.ProcessType, instance.Index),
+			cmd.UI.TranslateText(instanceState(instance, flapping)),
+			cmd.UI.UserFriendlyDate(instance.StartTime()),
+			fmt.Sprintf("%.1f%%", instance.CPU*100),
+			cmd.UI.TranslateText("{{.Usage}} of {{.Quota}}", map[string]interface{}{
+				"Usage": bytefmt.ByteSize(instance.MemoryUsage),
+				"Quota": bytefmt.ByteSize(instance.MemoryQuota),
+			}),
+			cmd.UI.TranslateText("{{.Usage}} of {{.Quota}}", map[string]interface{}{
+				"Usage": bytefmt.ByteSize(instance.DiskUsage),
+				"Quota": bytefmt.ByteSize(instance.DiskQuota),
+			}),
+		})
+	}
+	cmd.UI.DisplayInstancesTableForApp(instanceTable)
+}
+
+// displaySample displays one line for every instance in the sample, with raw
+// byte counts so that the output can be parsed.
+func (cmd TopCommand) displaySample(sampledAt time.Time, metrics []v3action.InstanceMetrics, flapping map[instanceKey]bool) {
+	for _, instance := range metrics {
+		cmd.UI.DisplayText("{{.Time}} app={{.AppName}} process={{.ProcessType}} index={{.Index}} state={{.State}} cpu={{.CPU}} memory={{.MemoryUsage}}/{{.MemoryQuota}} disk={{.DiskUsage}}/{{.DiskQuota}} uptime={{.Uptime}}", map[string]interface{}{
+			"Time":        sampledAt.UTC().Format(time.RFC3339),
+			"AppName":     instance.AppName,
+			"ProcessType": instance.ProcessType,
+			"Index":       instance.Index,
+			"State":       instanceState(instance, flapping),
+			"CPU":         fmt.Sprintf("%.1f%%", instance.CPU*100),
+			"MemoryUsage": instance.MemoryUsage,
+			"MemoryQuota": instance.MemoryQuota,
+			"DiskUsage":   instance.DiskUsage,
+			"DiskQuota":   instance.DiskQuota,
+			"Uptime":      instance.Uptime,
+		})
+	}
+}
+
+func instanceState(instance v3action.InstanceMetrics, flapping map[instanceKey]bool) string {
+	if instance.State != "CRASHED" && flapping[newInstanceKey(instance)] {
+		return "flapping"
+	}
+	return strings.ToLower(instance.State)
+}
+
+func sortInstanceMetrics(metrics []v3action.InstanceMetrics, sortBy string) {
+	sort.SliceStable(metrics, func(i, j int) bool {
+		switch sortBy {
+		case "cpu":
+			if metrics[i].CPU != metrics[j].CPU {
+				return metrics[i].CPU > metrics[j].CPU
+			}
+		case "memory":
+			if metrics[i].MemoryUsage != metrics[j].MemoryUsage {
+				return metrics[i].MemoryUsage > metrics[j].MemoryUsage
+			}
+		case "disk":
+			if metrics[i].DiskUsage != metrics[j].DiskUsage {
+				return metrics[i].DiskUsage > metrics[j].DiskUsage
+			}
+		}
+
+		if metrics[i].AppName != metrics[j].AppName {
+			return metrics[i].AppName < metrics[j].AppName
+		}
+		if metrics[i].ProcessType != metrics[j].ProcessType {
+			return metrics[i].ProcessType < metrics[j].ProcessType
+		}
+		return metrics[i].Index < metrics[j].Index
+	})
+}
+
+type processMetrics struct {
+	v3action.InstanceMetrics
+	running int
+	total   int
+}
+
+// summarizeProcesses adds up the usage of the instances of each process, in
+// order of app name and process type.
+func summarizeProcesses(metrics []v3action.InstanceMetrics) []processMetrics {
+	var processes []processMetrics
+	indexes := map[string]int{}
+	for _, instance := range metrics {
+		key := instance.AppName + "/" + instance.ProcessType
+		i, ok := indexes[key]
+		if !ok {
+			i = len(processes)
+			indexes[key] = i
+			processes = append(processes, processMetrics{InstanceMetrics: v3action.InstanceMetrics{
+				AppName:     instance.AppName,
+				ProcessType: instance.ProcessType,
+			}})
+		}
+
+		process := &processes[i]
+		process.total++
+		if instance.State == "RUNNING" {
+			process.running++
+		}
+		process.CPU += instance.CPU
+		process.MemoryUsage += instance.MemoryUsage
+		process.MemoryQuota += instance.MemoryQuota
+		process.DiskUsage += instance.DiskUsage
+		process.DiskQuota += instance.DiskQuota
+	}
+
+	sort.SliceStable(processes, func(i, j int) bool {
+		if processes[i].AppName != processes[j].AppName {
+			return processes[i].AppName < processes[j].AppName
+		}
+		return processes[i].ProcessType < processes[j].ProcessType
+	})
+
+	return processes
+}
+
+type instanceKey struct {
+	appName     string
+	processType string
+	index       int
+}
+
+func newInstanceKey(instance v3action.InstanceMetrics) instanceKey {
+	return instanceKey{appName: instance.AppName, processType: instance.ProcessType, index: instance.Index}
+}
+
+// instanceHistory remembers the previous sample of every instance so that
+// instances that restart between samples can be flagged as flapping.
+type instanceHistory map[instanceKey]instanceSample
+
+type instanceSample struct {
+	state    string
+	uptime   int
+	restarts int
+}
+
+// record adds the sample to the history and returns the instances that have
+// restarted at least once while sampling.
+func (history instanceHistory) record(metrics []v3action.InstanceMetrics) map[instanceKey]bool {
+	flapping := map[instanceKey]bool{}
+	for _, instance := range metrics {
+		key := newInstanceKey(instance)
+		sample := instanceSample{state: instance.State, uptime: instance.Uptime}
+
+		if previous, ok := history[key]; ok {
+			sample.restarts = previous.restarts
+			if instance.Uptime < previous.uptime ||
+				previous.state == "CRASHED" && instance.State != "CRASHED" {
+				sample.restarts++
+			}
+		}
+
+		history[key] = sample
+		flapping[key] = sample.restarts > 0
+	}
+	return flapping
+}
@@ -0,0 +1,179 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("top Command", func() {
+	var (
+		cmd             v3.TopCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeTopActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeTopActor)
+
+		cmd = v3.TopCommand{
+			Interval:    1,
+			Samples:     1,
+			SortBy:      "cpu",
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+
+		fakeActor.GetInstanceMetricsBySpaceReturns([]v3action.InstanceMetrics{
+			{AppName: "app-1", ProcessType: "web", Instance: v3action.Instance{Index: 0, State: "RUNNING", CPU: 0.1, MemoryUsage: 64 * 1024 * 1024, MemoryQuota: 256 * 1024 * 1024, DiskUsage: 1024 * 1024, DiskQuota: 1024 * 1024 * 1024, Uptime: 100}},
+			{AppName: "app-1", ProcessType: "web", Instance: v3action.Instance{Index: 1, State: "CRASHED", MemoryQuota: 256 * 1024 * 1024, DiskQuota: 1024 * 1024 * 1024}},
+			{AppName: "app-2", ProcessType: "worker", Instance: v3action.Instance{Index: 0, State: "RUNNING", CPU: 0.5, MemoryUsage: 32 * 1024 * 1024, MemoryQuota: 128 * 1024 * 1024, DiskUsage: 2 * 1024 * 1024, DiskQuota: 1024 * 1024 * 1024, Uptime: 50}},
+		}, v3action.Warnings{"metrics-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the interval is not positive", func() {
+		BeforeEach(func() {
+			cmd.Interval = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--interval",
+				ExpectedType: "a positive integer",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the output is a terminal", func() {
+		BeforeEach(func() {
+			fakeConfig.IsTTYReturns(true)
+			cmd.OptionalArgs.AppNames = []string{"app-1", "app-2"}
+		})
+
+		It("displays the process and instance usage sorted by cpu", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			spaceGUID, appNames := fakeActor.GetInstanceMetricsBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(appNames).To(Equal([]string{"app-1", "app-2"}))
+
+			Expect(testUI.Out).To(Say("Showing instance metrics in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`app\s+process\s+instances\s+cpu\s+memory\s+disk`))
+			Expect(testUI.Out).To(Say(`app-1\s+web\s+1/2\s+10.0%\s+64M of 512M\s+1M of 2G`))
+			Expect(testUI.Out).To(Say(`app-2\s+worker\s+1/1\s+50.0%\s+32M of 128M\s+2M of 1G`))
+			Expect(testUI.Out).To(Say(`instance\s+state\s+since\s+cpu\s+memory\s+disk`))
+			Expect(testUI.Out).To(Say(`app-2 worker #0\s+running\s+.*50.0%`))
+			Expect(testUI.Out).To(Say(`app-1 web #0\s+running\s+.*10.0%`))
+			Expect(testUI.Out).To(Say(`app-1 web #1\s+crashed`))
+			Expect(testUI.Err).To(Say("metrics-warning"))
+		})
+
+		Context("when sorting by name", func() {
+			BeforeEach(func() {
+				cmd.SortBy = "name"
+			})
+
+			It("sorts the instances by app, process and index", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`app-1 web #0`))
+				Expect(testUI.Out).To(Say(`app-1 web #1`))
+				Expect(testUI.Out).To(Say(`app-2 worker #0`))
+			})
+		})
+	})
+
+	Context("when the output is not a terminal", func() {
+		BeforeEach(func() {
+			fakeConfig.IsTTYReturns(false)
+		})
+
+		It("displays one line for every instance in the sample", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Showing instance metrics in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`\S+ app=app-2 process=worker index=0 state=running cpu=50.0% memory=33554432/134217728 disk=2097152/1073741824 uptime=50`))
+			Expect(testUI.Out).To(Say(`\S+ app=app-1 process=web index=0 state=running cpu=10.0%`))
+			Expect(testUI.Out).To(Say(`\S+ app=app-1 process=web index=1 state=crashed`))
+		})
+
+		Context("when an instance restarts between samples", func() {
+			BeforeEach(func() {
+				cmd.Samples = 2
+				fakeActor.GetInstanceMetricsBySpaceReturnsOnCall(0, []v3action.InstanceMetrics{
+					{AppName: "app-1", ProcessType: "web", Instance: v3action.Instance{Index: 0, State: "RUNNING", Uptime: 100}},
+				}, nil, nil)
+				fakeActor.GetInstanceMetricsBySpaceReturnsOnCall(1, []v3action.InstanceMetrics{
+					{AppName: "app-1", ProcessType: "web", Instance: v3action.Instance{Index: 0, State: "RUNNING", Uptime: 2}},
+				}, nil, nil)
+			})
+
+			It("flags the instance as flapping", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetInstanceMetricsBySpaceCallCount()).To(Equal(2))
+				Expect(testUI.Out).To(Say(`app=app-1 process=web index=0 state=running`))
+				Expect(testUI.Out).To(Say(`app=app-1 process=web index=0 state=flapping`))
+			})
+		})
+	})
+
+	Context("when an app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetInstanceMetricsBySpaceReturns(nil, v3action.Warnings{"metrics-warning"}, v3action.ApplicationNotFoundError{Name: "app-3"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "app-3"}))
+			Expect(testUI.Err).To(Say("metrics-warning"))
+		})
+	})
+
+	Context("when getting the metrics fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetInstanceMetricsBySpaceReturns(nil, nil, errors.New("metrics-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("metrics-error"))
+		})
+	})
+})
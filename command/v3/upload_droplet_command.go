@@ -0,0 +1,114 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . UploadDropletActor
+
+type UploadDropletActor interface {
+	CloudControllerAPIVersion() string
+	UploadApplicationDroplet(appName string, spaceGUID string, path string, progressBar v3action.ProgressBar) (v3action.Droplet, v3action.Warnings, error)
+}
+
+type UploadDropletCommand struct {
+	RequiredArgs    flag.UploadDropletArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME upload-droplet APP_NAME FILE\n\n   Creates a droplet for the app from a file written by download-droplet and verifies\n   its checksum. Use v3-set-droplet to run the app with the new droplet.\n\nEXAMPLES:\n   CF_NAME upload-droplet my-app my-app.tgz"`
+	relatedCommands interface{}            `related_commands:"download-droplet, v3-droplets, v3-set-droplet"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UploadDropletActor
+	ProgressBar DropletProgressBar
+}
+
+func (cmd *UploadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd UploadDropletCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Uploading droplet for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	droplet, warnings, err := cmd.Actor.UploadApplicationDroplet(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, string(cmd.RequiredArgs.Path), readyProgressBar{cmd.ProgressBar})
+	cmd.UI.DisplayWarnings(warnings)
+	if uploadErr, ok := err.(v3action.DropletUploadFailedError); ok {
+		cmd.displayUploadFailed(uploadErr)
+		err = uploadErr.Err
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.ProgressBar.Complete()
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Droplet {{.DropletGUID}} uploaded", map[string]interface{}{
+		"DropletGUID": droplet.GUID,
+	})
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use 'cf v3-set-droplet {{.AppName}} -d {{.DropletGUID}}' to run the app with this droplet.", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"DropletGUID": droplet.GUID,
+	})
+
+	return nil
+}
+
+// displayUploadFailed tells the user about the empty droplet left behind by
+// an upload that failed.
+func (cmd UploadDropletCommand) displayUploadFailed(uploadErr v3action.DropletUploadFailedError) {
+	cmd.UI.DisplayWarning("Upload failed and droplet {{.DropletGUID}} could not be deleted: {{.Error}}", map[string]interface{}{
+		"DropletGUID": uploadErr.DropletGUID,
+		"Error":       uploadErr.CleanupErr.Error(),
+	})
+	cmd.UI.DisplayWarning("Delete it with '{{.BinaryName}} curl -X DELETE /v3/droplets/{{.DropletGUID}}'.", map[string]interface{}{
+		"BinaryName":  cmd.Config.BinaryName(),
+		"DropletGUID": uploadErr.DropletGUID,
+	})
+}
@@ -0,0 +1,125 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("upload-droplet Command", func() {
+	var (
+		cmd             v3.UploadDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUploadDropletActor
+		fakeProgressBar *v3fakes.FakeDropletProgressBar
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUploadDropletActor)
+		fakeProgressBar = new(v3fakes.FakeDropletProgressBar)
+
+		cmd = v3.UploadDropletCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.Path = "my-app.tgz"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.UploadApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the droplet is uploaded", func() {
+		BeforeEach(func() {
+			fakeActor.UploadApplicationDropletReturns(v3action.Droplet{GUID: "some-droplet-guid"}, v3action.Warnings{"upload-warning"}, nil)
+		})
+
+		It("uploads the droplet and displays how to use it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID, path, _ := fakeActor.UploadApplicationDropletArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(path).To(Equal("my-app.tgz"))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say("Uploading droplet for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("Droplet some-droplet-guid uploaded"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: Use 'cf v3-set-droplet some-app -d some-droplet-guid' to run the app with this droplet."))
+			Expect(testUI.Err).To(Say("upload-warning"))
+		})
+	})
+
+	Context("when the upload fails", func() {
+		BeforeEach(func() {
+			fakeActor.UploadApplicationDropletReturns(v3action.Droplet{}, v3action.Warnings{"upload-warning"}, errors.New("upload-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("upload-error"))
+			Expect(testUI.Err).To(Say("upload-warning"))
+		})
+	})
+
+	Context("when the upload fails and the droplet cannot be deleted", func() {
+		BeforeEach(func() {
+			fakeActor.UploadApplicationDropletReturns(
+				v3action.Droplet{},
+				v3action.Warnings{"upload-warning"},
+				v3action.DropletUploadFailedError{
+					DropletGUID: "some-droplet-guid",
+					Err:         errors.New("upload-error"),
+					CleanupErr:  errors.New("delete-error"),
+				},
+			)
+		})
+
+		It("warns about the droplet left behind and returns the upload error", func() {
+			Expect(executeErr).To(MatchError("upload-error"))
+			Expect(testUI.Err).To(Say("upload-warning"))
+			Expect(testUI.Err).To(Say("Upload failed and droplet some-droplet-guid could not be deleted: delete-error"))
+			Expect(testUI.Err).To(Say(`Delete it with '%s curl -X DELETE /v3/droplets/some-droplet-guid'\.`, binaryName))
+		})
+	})
+})
@@ -0,0 +1,245 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . UsageActor
+
+type UsageActor interface {
+	CloudControllerAPIVersion() string
+	GetRunningTasksCountByOrganization(orgGUID string) (int, v3action.Warnings, error)
+	GetRunningTasksCountBySpace(spaceGUID string) (int, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . UsageActorV2
+
+type UsageActorV2 interface {
+	GetOrganizationUsageByName(orgName string) (v2action.OrganizationUsage, v2action.Warnings, error)
+}
+
+type UsageCommand struct {
+	Organization    string      `short:"o" long:"org" description:"Org to report on, defaults to the targeted org"`
+	Space           string      `short:"s" long:"space" description:"Only report on this space of the org"`
+	Threshold       int         `long:"threshold" default:"80" description:"Flag the org and spaces using at least this percentage of a quota limit"`
+	usage           interface{} `usage:"CF_NAME usage [--org ORG] [--space SPACE] [--threshold PERCENT]\n\n   Memory and instances are counted for started apps. Service instances are counted\n   for managed service instances. App tasks are counted for running tasks.\n\nEXAMPLES:\n   CF_NAME usage\n   CF_NAME usage --org acme --threshold 90"`
+	relatedCommands interface{} `related_commands:"org, quota, space, space-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UsageActor
+	ActorV2     UsageActorV2
+}
+
+func (cmd *UsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionRunTaskV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd UsageCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRunTaskV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Organization == "", false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.Organization
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting usage for org {{.Org}} as {{.User}}...", map[string]interface{}{
+		"Org":  orgName,
+		"User": user.Name,
+	})
+
+	orgUsage, warnings, err := cmd.ActorV2.GetOrganizationUsageByName(orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	spaces := orgUsage.Spaces
+	if cmd.Space != "" {
+		spaces = nil
+		for _, spaceUsage := range orgUsage.Spaces {
+			if spaceUsage.Space.Name == cmd.Space {
+				spaces = append(spaces, spaceUsage)
+			}
+		}
+		if len(spaces) == 0 {
+			return sharedV2.HandleError(v2action.SpaceNotFoundError{Name: cmd.Space})
+		}
+	}
+
+	orgTasks, v3Warnings, err := cmd.Actor.GetRunningTasksCountByOrganization(orgUsage.Organization.GUID)
+	cmd.UI.DisplayWarnings(v3Warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	spaceTasks := map[string]int{}
+	for _, spaceUsage := range spaces {
+		count, v3Warnings, err := cmd.Actor.GetRunningTasksCountBySpace(spaceUsage.Space.GUID)
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		spaceTasks[spaceUsage.Space.GUID] = count
+	}
+
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("quota"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("routes"),
+			cmd.UI.TranslateText("service instances"),
+			cmd.UI.TranslateText("app tasks"),
+			"",
+		},
+	}
+
+	quota := orgUsage.Quota
+	table = append(table, cmd.usageRow(
+		cmd.UI.TranslateText("org {{.Org}}", map[string]interface{}{"Org": orgUsage.Organization.Name}),
+		quota.Name,
+		orgUsage.Usage,
+		orgTasks,
+		&usageLimits{quota.MemoryLimit, quota.AppInstanceLimit, quota.TotalRoutes, quota.TotalServices, quota.AppTaskLimit},
+	))
+
+	for _, spaceUsage := range spaces {
+		var limits *usageLimits
+		spaceQuota := spaceUsage.Quota
+		if spaceQuota.GUID != "" {
+			limits = &usageLimits{spaceQuota.MemoryLimit, spaceQuota.AppInstanceLimit, spaceQuota.TotalRoutes, spaceQuota.TotalServices, spaceQuota.AppTaskLimit}
+		}
+		table = append(table, cmd.usageRow(
+			cmd.UI.TranslateText("space {{.Space}}", map[string]interface{}{"Space": spaceUsage.Space.Name}),
+			spaceQuota.Name,
+			spaceUsage.Usage,
+			spaceTasks[spaceUsage.Space.GUID],
+			limits,
+		))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// usageLimits are the limits of a quota in the order of the usage columns.
+// Limits of -1 are unlimited.
+type usageLimits struct {
+	Memory           int
+	Instances        int
+	Routes           int
+	ServiceInstances int
+	AppTasks         int
+}
+
+// usageRow returns a table row of the usage. When limits is nil, only the
+// usage is displayed.
+func (cmd UsageCommand) usageRow(name string, quotaName string, usage v2action.Usage, tasks int, limits *usageLimits) []string {
+	if limits == nil {
+		return []string{
+			name,
+			"",
+			sharedV2.MegabytesToString(uint64(usage.Memory)),
+			strconv.Itoa(usage.Instances),
+			strconv.Itoa(usage.Routes),
+			strconv.Itoa(usage.ServiceInstances),
+			strconv.Itoa(tasks),
+			"",
+		}
+	}
+
+	var overThreshold []string
+	cell := func(resource string, used int, limit int, format func(int) string) string {
+		if limit < 0 {
+			return cmd.UI.TranslateText("{{.Used}} of unlimited", map[string]interface{}{"Used": format(used)})
+		}
+
+		percent := 100
+		if limit > 0 {
+			percent = used * 100 / limit
+		}
+		if percent >= cmd.Threshold && (used > 0 || limit == 0) {
+			overThreshold = append(overThreshold, cmd.UI.TranslateText(resource))
+		}
+
+		return cmd.UI.TranslateText("{{.Used}} of {{.Limit}} ({{.Percent}}%)", map[string]interface{}{
+			"Used":    format(used),
+			"Limit":   format(limit),
+			"Percent": percent,
+		})
+	}
+	megabytes := func(value int) string { return sharedV2.MegabytesToString(uint64(value)) }
+
+	row := []string{
+		name,
+		quotaName,
+		cell("memory", usage.Memory, limits.Memory, megabytes),
+		cell("instances", usage.Instances, limits.Instances, strconv.Itoa),
+		cell("routes", usage.Routes, limits.Routes, strconv.Itoa),
+		cell("service instances", usage.ServiceInstances, limits.ServiceInstances, strconv.Itoa),
+		cell("app tasks", tasks, limits.AppTasks, strconv.Itoa),
+		"",
+	}
+
+	if len(overThreshold) > 0 {
+		row[len(row)-1] = cmd.UI.TranslateText("at or above {{.Threshold}}%: {{.Resources}}", map[string]interface{}{
+			"Threshold": fmt.Sprint(cmd.Threshold),
+			"Resources": strings.Join(overThreshold, ", "),
+		})
+	}
+
+	return row
+}
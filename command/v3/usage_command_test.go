@@ -0,0 +1,216 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("usage Command", func() {
+	var (
+		cmd             UsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUsageActor
+		fakeActorV2     *v3fakes.FakeUsageActorV2
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUsageActor)
+		fakeActorV2 = new(v3fakes.FakeUsageActorV2)
+
+		cmd = UsageCommand{
+			Threshold:   80,
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionRunTaskV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API is below the minimum version", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionRunTaskV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the org is provided", func() {
+		BeforeEach(func() {
+			cmd.Organization = "some-org"
+			fakeActorV2.GetOrganizationUsageByNameReturns(v2action.OrganizationUsage{}, nil, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("does not require a targeted org and gets the usage of the provided org", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(fakeActorV2.GetOrganizationUsageByNameArgsForCall(0)).To(Equal("some-org"))
+		})
+	})
+
+	Context("when getting the usage succeeds", func() {
+		BeforeEach(func() {
+			fakeActorV2.GetOrganizationUsageByNameReturns(
+				v2action.OrganizationUsage{
+					Organization: v2action.Organization{GUID: "targeted-org-guid", Name: "targeted-org"},
+					Quota: v2action.OrganizationQuota{
+						Name:             "small",
+						MemoryLimit:      2048,
+						AppInstanceLimit: -1,
+						TotalRoutes:      10,
+						TotalServices:    4,
+						AppTaskLimit:     -1,
+					},
+					Usage: v2action.Usage{Memory: 1792, Instances: 3, Routes: 2, ServiceInstances: 4},
+					Spaces: []v2action.SpaceUsage{
+						{
+							Space: v2action.Space{GUID: "dev-guid", Name: "dev"},
+							Quota: v2action.SpaceQuota{
+								GUID:             "dev-quota-guid",
+								Name:             "dev-quota",
+								MemoryLimit:      1024,
+								AppInstanceLimit: 5,
+								TotalRoutes:      -1,
+								TotalServices:    -1,
+								AppTaskLimit:     2,
+							},
+							Usage: v2action.Usage{Memory: 256, Instances: 1, Routes: 1},
+						},
+						{
+							Space: v2action.Space{GUID: "prod-guid", Name: "prod"},
+							Usage: v2action.Usage{Memory: 1536, Instances: 2, Routes: 1, ServiceInstances: 4},
+						},
+					},
+				},
+				v2action.Warnings{"usage-warning"},
+				nil)
+			fakeActor.GetRunningTasksCountByOrganizationReturns(3, v3action.Warnings{"org-tasks-warning"}, nil)
+			fakeActor.GetRunningTasksCountBySpaceStub = func(spaceGUID string) (int, v3action.Warnings, error) {
+				if spaceGUID == "dev-guid" {
+					return 2, v3action.Warnings{"tasks-warning"}, nil
+				}
+				return 1, nil, nil
+			}
+		})
+
+		It("displays the usage of the targeted org and its spaces against their quotas", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActorV2.GetOrganizationUsageByNameArgsForCall(0)).To(Equal("targeted-org"))
+
+			Expect(testUI.Out).To(Say("Getting usage for org targeted-org as some-user..."))
+			Expect(testUI.Out).To(Say(`quota\s+memory\s+instances\s+routes\s+service instances\s+app tasks`))
+			Expect(testUI.Out).To(Say(`org targeted-org\s+small\s+1.8G of 2G \(87%\)\s+3 of unlimited\s+2 of 10 \(20%\)\s+4 of 4 \(100%\)\s+3 of unlimited\s+at or above 80%: memory, service instances`))
+			Expect(testUI.Out).To(Say(`space dev\s+dev-quota\s+256M of 1G \(25%\)\s+1 of 5 \(20%\)\s+1 of unlimited\s+0 of unlimited\s+2 of 2 \(100%\)\s+at or above 80%: app tasks`))
+			Expect(testUI.Out).To(Say(`space prod\s+1.5G\s+2\s+1\s+4\s+1\s*\n`))
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(testUI.Err).To(Say("org-tasks-warning"))
+			Expect(testUI.Err).To(Say("tasks-warning"))
+
+			Expect(fakeActor.GetRunningTasksCountByOrganizationArgsForCall(0)).To(Equal("targeted-org-guid"))
+		})
+
+		Context("when the space is provided", func() {
+			BeforeEach(func() {
+				cmd.Space = "prod"
+			})
+
+			It("displays the org and only the provided space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`org targeted-org\s+small`))
+				Expect(testUI.Out).ToNot(Say("space dev"))
+				Expect(testUI.Out).To(Say(`space prod\s+1.5G\s+2\s+1\s+4\s+1\s*\n`))
+
+				Expect(fakeActor.GetRunningTasksCountByOrganizationCallCount()).To(Equal(1))
+				Expect(fakeActor.GetRunningTasksCountBySpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetRunningTasksCountBySpaceArgsForCall(0)).To(Equal("prod-guid"))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				cmd.Space = "missing"
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "missing"}))
+			})
+		})
+
+		Context("when getting the running tasks of the org fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRunningTasksCountByOrganizationReturns(0, v3action.Warnings{"org-tasks-warning"}, errors.New("org-tasks-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("org-tasks-error"))
+				Expect(testUI.Err).To(Say("usage-warning"))
+				Expect(testUI.Err).To(Say("org-tasks-warning"))
+				Expect(fakeActor.GetRunningTasksCountBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the running tasks of a space fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRunningTasksCountBySpaceStub = nil
+				fakeActor.GetRunningTasksCountBySpaceReturns(0, v3action.Warnings{"tasks-warning"}, errors.New("tasks-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("tasks-error"))
+				Expect(testUI.Err).To(Say("usage-warning"))
+				Expect(testUI.Err).To(Say("tasks-warning"))
+			})
+		})
+	})
+})
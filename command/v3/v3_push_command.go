@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
 //go:generate counterfeiter . V2PushActor
@@ -33,11 +34,13 @@ type V3PushActor interface {
 	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
 	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
+	ReadManifestProcesses(pathToManifest string, appName string) ([]manifest.Process, error)
 	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
 	StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 	UpdateApplication(app v3action.Application) (v3action.Application, v3action.Warnings, error)
+	UpdateApplicationProcesses(appGUID string, processes []manifest.Process) (v3action.Warnings, error)
 }
 
 type V3PushCommand struct {
@@ -46,10 +49,11 @@ type V3PushCommand struct {
 	DockerImage    flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	NoRoute        bool                        `long:"no-route" description:"Do not map a route to this app"`
-	AppPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	AppPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip, jar, war, tar or tgz archive of the contents of the app directory"`
+	PathToManifest flag.PathWithExistenceCheck `short:"f" description:"Path to manifest declaring the processes of the app"`
 	dockerPassword interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
-	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route]"`
+	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [-f MANIFEST_PATH] [--no-route]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [-f MANIFEST_PATH] [--no-route]\n\n   The processes section of the app in the manifest sets the command, instances, memory,\n   disk and health check of process types such as worker or clock. Process types that\n   are not in the Procfile of the app are created."`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -76,7 +80,8 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 
 		return err
 	}
-	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, nil)
+	v3Actor := v3action.NewActor(ccClient, config, sharedActor, nil)
+	cmd.Actor = v3Actor
 
 	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
 	if err != nil {
@@ -86,7 +91,7 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
 
 	cmd.SharedActor = sharedActor
-	cmd.V2PushActor = pushaction.NewActor(v2Actor, sharedActor)
+	cmd.V2PushActor = pushaction.NewActor(v2Actor, v3Actor, sharedActor)
 
 	v2AppActor := v2action.NewActor(ccClientV2, uaaClientV2, config)
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
@@ -131,6 +136,14 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return translatableerror.ConflictingBuildpacksError{}
 	}
 
+	var processes []manifest.Process
+	if cmd.PathToManifest != "" {
+		processes, err = cmd.Actor.ReadManifestProcesses(string(cmd.PathToManifest), cmd.RequiredArgs.AppName)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	var app v3action.Application
 	app, err = cmd.getApplication()
 	if _, ok := err.(v3action.ApplicationNotFoundError); ok {
@@ -169,6 +182,13 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if len(processes) > 0 {
+		err = cmd.updateApplicationProcesses(app.GUID, processes, user.Name)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	if !cmd.NoRoute {
 		err = cmd.createAndMapRoutes(app)
 		if err != nil {
@@ -412,6 +432,25 @@ func (cmd V3PushCommand) setApplicationDroplet(dropletGUID string, userName stri
 	return nil
 }
 
+func (cmd V3PushCommand) updateApplicationProcesses(appGUID string, processes []manifest.Process, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Updating processes of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	warnings, err := cmd.Actor.UpdateApplicationProcesses(appGUID, processes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd V3PushCommand) startApplication(appGUID string, userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
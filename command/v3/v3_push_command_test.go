@@ -19,6 +19,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -490,6 +491,64 @@ var _ = Describe("v3-push Command", func() {
 									Expect(dropletGUID).To(Equal("some-droplet-guid"))
 								})
 
+								It("does not update any processes", func() {
+									Expect(fakeActor.ReadManifestProcessesCallCount()).To(Equal(0))
+									Expect(fakeActor.UpdateApplicationProcessesCallCount()).To(Equal(0))
+								})
+
+								Context("when a manifest declares processes", func() {
+									var processes []manifest.Process
+
+									BeforeEach(func() {
+										cmd.PathToManifest = "some-manifest-path"
+										processes = []manifest.Process{
+											{Type: "worker", Instances: types.NullInt{Value: 2, IsSet: true}},
+										}
+										fakeActor.ReadManifestProcessesReturns(processes, nil)
+										fakeActor.UpdateApplicationProcessesReturns(v3action.Warnings{"process-warning"}, nil)
+									})
+
+									It("updates the processes after setting the droplet", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+
+										Expect(testUI.Out).To(Say("Setting app some-app to droplet some-droplet-guid"))
+										Expect(testUI.Out).To(Say("Updating processes of app some-app in org some-org / space some-space as banana..."))
+										Expect(testUI.Err).To(Say("process-warning"))
+										Expect(testUI.Out).To(Say("OK"))
+
+										pathToManifest, appName := fakeActor.ReadManifestProcessesArgsForCall(0)
+										Expect(pathToManifest).To(Equal("some-manifest-path"))
+										Expect(appName).To(Equal("some-app"))
+
+										appGUID, updatedProcesses := fakeActor.UpdateApplicationProcessesArgsForCall(0)
+										Expect(appGUID).To(Equal("some-app-guid"))
+										Expect(updatedProcesses).To(Equal(processes))
+									})
+
+									Context("when updating the processes fails", func() {
+										BeforeEach(func() {
+											fakeActor.UpdateApplicationProcessesReturns(v3action.Warnings{"process-warning"}, v3action.ProcessNotFoundError{ProcessType: "worker"})
+										})
+
+										It("returns the error and does not start the app", func() {
+											Expect(executeErr).To(MatchError(translatableerror.ProcessNotFoundError{ProcessType: "worker"}))
+											Expect(testUI.Err).To(Say("process-warning"))
+											Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+										})
+									})
+
+									Context("when the app is not in the manifest", func() {
+										BeforeEach(func() {
+											fakeActor.ReadManifestProcessesReturns(nil, v3action.AppNotFoundInManifestError{Name: "some-app"})
+										})
+
+										It("returns an AppNotFoundInManifestError before pushing", func() {
+											Expect(executeErr).To(MatchError(translatableerror.AppNotFoundInManifestError{Name: "some-app"}))
+											Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+										})
+									})
+								})
+
 								Context("when --no-route flag is set to true", func() {
 									BeforeEach(func() {
 										cmd.NoRoute = true
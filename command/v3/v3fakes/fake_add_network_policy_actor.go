@@ -9,15 +9,16 @@ import (
 )
 
 type FakeAddNetworkPolicyActor struct {
-	AddNetworkPolicyStub        func(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	AddNetworkPolicyStub        func(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 	addNetworkPolicyMutex       sync.RWMutex
 	addNetworkPolicyArgsForCall []struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
 	}
 	addNetworkPolicyReturns struct {
 		result1 cfnetworkingaction.Warnings
@@ -31,21 +32,22 @@ type FakeAddNetworkPolicyActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
+func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
 	fake.addNetworkPolicyMutex.Lock()
 	ret, specificReturn := fake.addNetworkPolicyReturnsOnCall[len(fake.addNetworkPolicyArgsForCall)]
 	fake.addNetworkPolicyArgsForCall = append(fake.addNetworkPolicyArgsForCall, struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
-	}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
-	fake.recordInvocation("AddNetworkPolicy", []interface{}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
+	}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
+	fake.recordInvocation("AddNetworkPolicy", []interface{}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
 	fake.addNetworkPolicyMutex.Unlock()
 	if fake.AddNetworkPolicyStub != nil {
-		return fake.AddNetworkPolicyStub(spaceGUID, srcAppName, destAppName, protocol, startPort, endPort)
+		return fake.AddNetworkPolicyStub(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -59,10 +61,10 @@ func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyCallCount() int {
 	return len(fake.addNetworkPolicyArgsForCall)
 }
 
-func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyArgsForCall(i int) (string, string, string, string, int, int) {
+func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyArgsForCall(i int) (string, string, string, string, string, int, int) {
 	fake.addNetworkPolicyMutex.RLock()
 	defer fake.addNetworkPolicyMutex.RUnlock()
-	return fake.addNetworkPolicyArgsForCall[i].spaceGUID, fake.addNetworkPolicyArgsForCall[i].srcAppName, fake.addNetworkPolicyArgsForCall[i].destAppName, fake.addNetworkPolicyArgsForCall[i].protocol, fake.addNetworkPolicyArgsForCall[i].startPort, fake.addNetworkPolicyArgsForCall[i].endPort
+	return fake.addNetworkPolicyArgsForCall[i].srcSpaceGUID, fake.addNetworkPolicyArgsForCall[i].srcAppName, fake.addNetworkPolicyArgsForCall[i].destSpaceGUID, fake.addNetworkPolicyArgsForCall[i].destAppName, fake.addNetworkPolicyArgsForCall[i].protocol, fake.addNetworkPolicyArgsForCall[i].startPort, fake.addNetworkPolicyArgsForCall[i].endPort
 }
 
 func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyReturns(result1 cfnetworkingaction.Warnings, result2 error) {
@@ -40,6 +40,18 @@ type FakeNetworkPoliciesActor struct {
 		result2 cfnetworkingaction.Warnings
 		result3 error
 	}
+	WriteNetworkPolicyFileStub        func(path string, policies []cfnetworkingaction.Policy) error
+	writeNetworkPolicyFileMutex       sync.RWMutex
+	writeNetworkPolicyFileArgsForCall []struct {
+		path     string
+		policies []cfnetworkingaction.Policy
+	}
+	writeNetworkPolicyFileReturns struct {
+		result1 error
+	}
+	writeNetworkPolicyFileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -153,6 +165,60 @@ func (fake *FakeNetworkPoliciesActor) NetworkPoliciesBySpaceReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeNetworkPoliciesActor) WriteNetworkPolicyFile(path string, policies []cfnetworkingaction.Policy) error {
+	var policiesCopy []cfnetworkingaction.Policy
+	if policies != nil {
+		policiesCopy = make([]cfnetworkingaction.Policy, len(policies))
+		copy(policiesCopy, policies)
+	}
+	fake.writeNetworkPolicyFileMutex.Lock()
+	ret, specificReturn := fake.writeNetworkPolicyFileReturnsOnCall[len(fake.writeNetworkPolicyFileArgsForCall)]
+	fake.writeNetworkPolicyFileArgsForCall = append(fake.writeNetworkPolicyFileArgsForCall, struct {
+		path     string
+		policies []cfnetworkingaction.Policy
+	}{path, policiesCopy})
+	fake.recordInvocation("WriteNetworkPolicyFile", []interface{}{path, policiesCopy})
+	fake.writeNetworkPolicyFileMutex.Unlock()
+	if fake.WriteNetworkPolicyFileStub != nil {
+		return fake.WriteNetworkPolicyFileStub(path, policies)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.writeNetworkPolicyFileReturns.result1
+}
+
+func (fake *FakeNetworkPoliciesActor) WriteNetworkPolicyFileCallCount() int {
+	fake.writeNetworkPolicyFileMutex.RLock()
+	defer fake.writeNetworkPolicyFileMutex.RUnlock()
+	return len(fake.writeNetworkPolicyFileArgsForCall)
+}
+
+func (fake *FakeNetworkPoliciesActor) WriteNetworkPolicyFileArgsForCall(i int) (string, []cfnetworkingaction.Policy) {
+	fake.writeNetworkPolicyFileMutex.RLock()
+	defer fake.writeNetworkPolicyFileMutex.RUnlock()
+	return fake.writeNetworkPolicyFileArgsForCall[i].path, fake.writeNetworkPolicyFileArgsForCall[i].policies
+}
+
+func (fake *FakeNetworkPoliciesActor) WriteNetworkPolicyFileReturns(result1 error) {
+	fake.WriteNetworkPolicyFileStub = nil
+	fake.writeNetworkPolicyFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworkPoliciesActor) WriteNetworkPolicyFileReturnsOnCall(i int, result1 error) {
+	fake.WriteNetworkPolicyFileStub = nil
+	if fake.writeNetworkPolicyFileReturnsOnCall == nil {
+		fake.writeNetworkPolicyFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeNetworkPolicyFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetworkPoliciesActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -160,6 +226,8 @@ func (fake *FakeNetworkPoliciesActor) Invocations() map[string][][]interface{} {
 	defer fake.networkPoliciesBySpaceAndAppNameMutex.RUnlock()
 	fake.networkPoliciesBySpaceMutex.RLock()
 	defer fake.networkPoliciesBySpaceMutex.RUnlock()
+	fake.writeNetworkPolicyFileMutex.RLock()
+	defer fake.writeNetworkPolicyFileMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
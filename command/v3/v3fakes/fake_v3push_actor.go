@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
 type FakeV3PushActor struct {
@@ -205,6 +206,34 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	ReadManifestProcessesStub        func(pathToManifest string, appName string) ([]manifest.Process, error)
+	readManifestProcessesMutex       sync.RWMutex
+	readManifestProcessesArgsForCall []struct {
+		pathToManifest string
+		appName        string
+	}
+	readManifestProcessesReturns struct {
+		result1 []manifest.Process
+		result2 error
+	}
+	readManifestProcessesReturnsOnCall map[int]struct {
+		result1 []manifest.Process
+		result2 error
+	}
+	UpdateApplicationProcessesStub        func(appGUID string, processes []manifest.Process) (v3action.Warnings, error)
+	updateApplicationProcessesMutex       sync.RWMutex
+	updateApplicationProcessesArgsForCall []struct {
+		appGUID   string
+		processes []manifest.Process
+	}
+	updateApplicationProcessesReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	updateApplicationProcessesReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -901,6 +930,115 @@ func (fake *FakeV3PushActor) UpdateApplicationReturnsOnCall(i int, result1 v3act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) ReadManifestProcesses(pathToManifest string, appName string) ([]manifest.Process, error) {
+	fake.readManifestProcessesMutex.Lock()
+	ret, specificReturn := fake.readManifestProcessesReturnsOnCall[len(fake.readManifestProcessesArgsForCall)]
+	fake.readManifestProcessesArgsForCall = append(fake.readManifestProcessesArgsForCall, struct {
+		pathToManifest string
+		appName        string
+	}{pathToManifest, appName})
+	fake.recordInvocation("ReadManifestProcesses", []interface{}{pathToManifest, appName})
+	fake.readManifestProcessesMutex.Unlock()
+	if fake.ReadManifestProcessesStub != nil {
+		return fake.ReadManifestProcessesStub(pathToManifest, appName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readManifestProcessesReturns.result1, fake.readManifestProcessesReturns.result2
+}
+
+func (fake *FakeV3PushActor) ReadManifestProcessesCallCount() int {
+	fake.readManifestProcessesMutex.RLock()
+	defer fake.readManifestProcessesMutex.RUnlock()
+	return len(fake.readManifestProcessesArgsForCall)
+}
+
+func (fake *FakeV3PushActor) ReadManifestProcessesArgsForCall(i int) (string, string) {
+	fake.readManifestProcessesMutex.RLock()
+	defer fake.readManifestProcessesMutex.RUnlock()
+	return fake.readManifestProcessesArgsForCall[i].pathToManifest, fake.readManifestProcessesArgsForCall[i].appName
+}
+
+func (fake *FakeV3PushActor) ReadManifestProcessesReturns(result1 []manifest.Process, result2 error) {
+	fake.ReadManifestProcessesStub = nil
+	fake.readManifestProcessesReturns = struct {
+		result1 []manifest.Process
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) ReadManifestProcessesReturnsOnCall(i int, result1 []manifest.Process, result2 error) {
+	fake.ReadManifestProcessesStub = nil
+	if fake.readManifestProcessesReturnsOnCall == nil {
+		fake.readManifestProcessesReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Process
+			result2 error
+		})
+	}
+	fake.readManifestProcessesReturnsOnCall[i] = struct {
+		result1 []manifest.Process
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) UpdateApplicationProcesses(appGUID string, processes []manifest.Process) (v3action.Warnings, error) {
+	var processesCopy []manifest.Process
+	if processes != nil {
+		processesCopy = make([]manifest.Process, len(processes))
+		copy(processesCopy, processes)
+	}
+	fake.updateApplicationProcessesMutex.Lock()
+	ret, specificReturn := fake.updateApplicationProcessesReturnsOnCall[len(fake.updateApplicationProcessesArgsForCall)]
+	fake.updateApplicationProcessesArgsForCall = append(fake.updateApplicationProcessesArgsForCall, struct {
+		appGUID   string
+		processes []manifest.Process
+	}{appGUID, processesCopy})
+	fake.recordInvocation("UpdateApplicationProcesses", []interface{}{appGUID, processesCopy})
+	fake.updateApplicationProcessesMutex.Unlock()
+	if fake.UpdateApplicationProcessesStub != nil {
+		return fake.UpdateApplicationProcessesStub(appGUID, processes)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateApplicationProcessesReturns.result1, fake.updateApplicationProcessesReturns.result2
+}
+
+func (fake *FakeV3PushActor) UpdateApplicationProcessesCallCount() int {
+	fake.updateApplicationProcessesMutex.RLock()
+	defer fake.updateApplicationProcessesMutex.RUnlock()
+	return len(fake.updateApplicationProcessesArgsForCall)
+}
+
+func (fake *FakeV3PushActor) UpdateApplicationProcessesArgsForCall(i int) (string, []manifest.Process) {
+	fake.updateApplicationProcessesMutex.RLock()
+	defer fake.updateApplicationProcessesMutex.RUnlock()
+	return fake.updateApplicationProcessesArgsForCall[i].appGUID, fake.updateApplicationProcessesArgsForCall[i].processes
+}
+
+func (fake *FakeV3PushActor) UpdateApplicationProcessesReturns(result1 v3action.Warnings, result2 error) {
+	fake.UpdateApplicationProcessesStub = nil
+	fake.updateApplicationProcessesReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) UpdateApplicationProcessesReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.UpdateApplicationProcessesStub = nil
+	if fake.updateApplicationProcessesReturnsOnCall == nil {
+		fake.updateApplicationProcessesReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationProcessesReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -930,6 +1068,10 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.readManifestProcessesMutex.RLock()
+	defer fake.readManifestProcessesMutex.RUnlock()
+	fake.updateApplicationProcessesMutex.RLock()
+	defer fake.updateApplicationProcessesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
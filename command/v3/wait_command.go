@@ -0,0 +1,228 @@
+package v3
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . WaitActor
+
+type WaitActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	PollApplicationInstancesRunning(appGUID string, instances int, timeout time.Duration) (v3action.Warnings, error)
+	PollTaskDone(appGUID string, sequenceID int, timeout time.Duration) (v3action.Task, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . WaitServiceActor
+
+type WaitServiceActor interface {
+	PollServiceInstanceReady(name string, spaceGUID string, timeout time.Duration) (v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+type WaitCommand struct {
+	RequiredArgs    flag.WaitArgs `positional-args:"yes"`
+	Instances       int           `long:"instances" description:"Number of running web instances to wait for with app-running (Default: all instances)"`
+	Timeout         int           `long:"timeout" default:"300" description:"Maximum time in seconds to wait for the condition"`
+	usage           interface{}   `usage:"CF_NAME wait app-running APP_NAME [--instances N] [--timeout SECONDS]\n   CF_NAME wait task-done APP_NAME TASK_ID [--timeout SECONDS]\n   CF_NAME wait service-ready SERVICE_INSTANCE [--timeout SECONDS]\n\n   Waits until the condition is met. Exits with status 2 if the timeout is reached\n   first, and with status 3 if the task or the service instance operation failed.\n\nEXAMPLES:\n   CF_NAME wait app-running my-app --instances 3\n   CF_NAME wait task-done my-app 4 --timeout 600\n   CF_NAME wait service-ready my-db"`
+	relatedCommands interface{}   `related_commands:"create-service, run-task, tasks, v3-app"`
+
+	UI           command.UI
+	Config       command.Config
+	SharedActor  command.SharedActor
+	Actor        WaitActor
+	ServiceActor WaitServiceActor
+}
+
+func (cmd *WaitCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ServiceActor = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd WaitCommand) Execute(args []string) error {
+	sequenceID, err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	timeout := time.Duration(cmd.Timeout) * time.Second
+
+	switch cmd.RequiredArgs.Condition {
+	case flag.WaitConditionAppRunning:
+		err = cmd.waitForApplicationRunning(user.Name, timeout)
+	case flag.WaitConditionTaskDone:
+		err = cmd.waitForTaskDone(user.Name, sequenceID, timeout)
+	case flag.WaitConditionServiceReady:
+		err = cmd.waitForServiceReady(user.Name, timeout)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd WaitCommand) validateArgs() (int, error) {
+	condition := string(cmd.RequiredArgs.Condition)
+
+	switch {
+	case cmd.RequiredArgs.Condition == flag.WaitConditionTaskDone && cmd.RequiredArgs.SequenceID == "":
+		return 0, translatableerror.RequiredArgumentError{ArgumentName: "TASK_ID"}
+	case cmd.RequiredArgs.Condition != flag.WaitConditionTaskDone && cmd.RequiredArgs.SequenceID != "":
+		return 0, translatableerror.ArgumentCombinationError{Args: []string{condition, "TASK_ID"}}
+	case cmd.RequiredArgs.Condition != flag.WaitConditionAppRunning && cmd.Instances != 0:
+		return 0, translatableerror.ArgumentCombinationError{Args: []string{condition, "--instances"}}
+	case cmd.Instances < 0:
+		return 0, translatableerror.ParseArgumentError{
+			ArgumentName: "--instances",
+			ExpectedType: "a non-negative integer",
+		}
+	case cmd.Timeout < 0:
+		return 0, translatableerror.ParseArgumentError{
+			ArgumentName: "--timeout",
+			ExpectedType: "a non-negative integer",
+		}
+	}
+
+	if cmd.RequiredArgs.Condition != flag.WaitConditionTaskDone {
+		return 0, nil
+	}
+
+	sequenceID, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	if err != nil {
+		return 0, translatableerror.ParseArgumentError{
+			ArgumentName: "TASK_ID",
+			ExpectedType: "integer",
+		}
+	}
+	return sequenceID, nil
+}
+
+func (cmd WaitCommand) waitForApplicationRunning(userName string, timeout time.Duration) error {
+	condition := cmd.UI.TranslateText("app {{.AppName}} to be running", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.Name,
+	})
+	if cmd.Instances > 0 {
+		condition = cmd.UI.TranslateText("app {{.AppName}} to have {{.Instances}} running instances", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.Name,
+			"Instances": cmd.Instances,
+		})
+	}
+	cmd.displayFlavorText(condition, userName)
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.Name, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	warnings, err = cmd.Actor.PollApplicationInstancesRunning(app.GUID, cmd.Instances, timeout)
+	cmd.UI.DisplayWarnings(warnings)
+	return cmd.handleWaitError(err, condition)
+}
+
+func (cmd WaitCommand) waitForTaskDone(userName string, sequenceID int, timeout time.Duration) error {
+	condition := cmd.UI.TranslateText("task {{.SequenceID}} of app {{.AppName}} to finish", map[string]interface{}{
+		"SequenceID": sequenceID,
+		"AppName":    cmd.RequiredArgs.Name,
+	})
+	cmd.displayFlavorText(condition, userName)
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.Name, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	_, warnings, err = cmd.Actor.PollTaskDone(app.GUID, sequenceID, timeout)
+	cmd.UI.DisplayWarnings(warnings)
+	if e, ok := err.(v3action.TaskFailedError); ok {
+		return translatableerror.TaskFailedError{SequenceID: e.SequenceID, AppName: cmd.RequiredArgs.Name}
+	}
+	return cmd.handleWaitError(err, condition)
+}
+
+func (cmd WaitCommand) waitForServiceReady(userName string, timeout time.Duration) error {
+	condition := cmd.UI.TranslateText("service instance {{.ServiceInstance}} to be ready", map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.Name,
+	})
+	cmd.displayFlavorText(condition, userName)
+
+	_, warnings, err := cmd.ServiceActor.PollServiceInstanceReady(cmd.RequiredArgs.Name, cmd.Config.TargetedSpace().GUID, timeout)
+	cmd.UI.DisplayWarnings(warnings)
+	switch e := err.(type) {
+	case v2action.ServiceInstanceOperationFailedError:
+		return translatableerror.ServiceInstanceOperationFailedError(e)
+	case v2action.ServiceInstanceNotFoundError:
+		return sharedV2.HandleError(err)
+	}
+	return cmd.handleWaitError(err, condition)
+}
+
+func (cmd WaitCommand) handleWaitError(err error, condition string) error {
+	if err == nil {
+		return nil
+	}
+
+	if e, ok := err.(actionerror.WaitTimeoutError); ok {
+		return translatableerror.WaitTimeoutError{Condition: condition, Timeout: e.Timeout}
+	}
+	return shared.HandleError(err)
+}
+
+func (cmd WaitCommand) displayFlavorText(condition string, userName string) {
+	cmd.UI.DisplayTextWithFlavor("Waiting for {{.Condition}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Condition": condition,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+}
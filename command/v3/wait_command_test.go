@@ -0,0 +1,292 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("wait Command", func() {
+	var (
+		cmd              WaitCommand
+		testUI           *ui.UI
+		fakeConfig       *commandfakes.FakeConfig
+		fakeSharedActor  *commandfakes.FakeSharedActor
+		fakeActor        *v3fakes.FakeWaitActor
+		fakeServiceActor *v3fakes.FakeWaitServiceActor
+		binaryName       string
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeWaitActor)
+		fakeServiceActor = new(v3fakes.FakeWaitServiceActor)
+
+		cmd = WaitCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			ServiceActor: fakeServiceActor,
+			Timeout:      300,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{Name: "some-app", GUID: "some-app-guid"}, v3action.Warnings{"get-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when waiting for an app to be running", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.WaitArgs{Condition: flag.WaitConditionAppRunning, Name: "some-app"}
+			fakeActor.PollApplicationInstancesRunningReturns(v3action.Warnings{"poll-warning"}, nil)
+		})
+
+		It("polls the instances of the app until they are all running", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Waiting for app some-app to be running in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			appGUID, instances, timeout := fakeActor.PollApplicationInstancesRunningArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(instances).To(Equal(0))
+			Expect(timeout).To(Equal(300 * time.Second))
+		})
+
+		Context("when a number of instances is given", func() {
+			BeforeEach(func() {
+				cmd.Instances = 3
+			})
+
+			It("waits for that many instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Waiting for app some-app to have 3 running instances"))
+
+				_, instances, _ := fakeActor.PollApplicationInstancesRunningArgsForCall(0)
+				Expect(instances).To(Equal(3))
+			})
+		})
+
+		Context("when the timeout is reached", func() {
+			BeforeEach(func() {
+				cmd.Timeout = 10
+				fakeActor.PollApplicationInstancesRunningReturns(v3action.Warnings{"poll-warning"}, actionerror.WaitTimeoutError{Timeout: 10 * time.Second})
+			})
+
+			It("returns a WaitTimeoutError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.WaitTimeoutError{
+					Condition: "app some-app to be running",
+					Timeout:   10 * time.Second,
+				}))
+				Expect(executeErr.(translatableerror.WaitTimeoutError).ExitCode()).To(Equal(2))
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeActor.PollApplicationInstancesRunningCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a TASK_ID is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.SequenceID = "3"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"app-running", "TASK_ID"}}))
+			})
+		})
+
+		Context("when the number of instances is negative", func() {
+			BeforeEach(func() {
+				cmd.Instances = -1
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "--instances",
+					ExpectedType: "a non-negative integer",
+				}))
+			})
+		})
+	})
+
+	Context("when waiting for a task to be done", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.WaitArgs{Condition: flag.WaitConditionTaskDone, Name: "some-app", SequenceID: "3"}
+			fakeActor.PollTaskDoneReturns(v3action.Task{SequenceID: 3, State: "SUCCEEDED"}, v3action.Warnings{"poll-warning"}, nil)
+		})
+
+		It("polls the task until it is done", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Waiting for task 3 of app some-app to finish in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			appGUID, sequenceID, timeout := fakeActor.PollTaskDoneArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(sequenceID).To(Equal(3))
+			Expect(timeout).To(Equal(300 * time.Second))
+		})
+
+		Context("when the task failed", func() {
+			BeforeEach(func() {
+				fakeActor.PollTaskDoneReturns(v3action.Task{SequenceID: 3, State: "FAILED"}, nil, v3action.TaskFailedError{SequenceID: 3})
+			})
+
+			It("returns a TaskFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.TaskFailedError{SequenceID: 3, AppName: "some-app"}))
+				Expect(executeErr.(translatableerror.TaskFailedError).ExitCode()).To(Equal(3))
+			})
+		})
+
+		Context("when the TASK_ID is missing", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.SequenceID = ""
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "TASK_ID"}))
+			})
+		})
+
+		Context("when the TASK_ID is not an integer", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.SequenceID = "three"
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "TASK_ID",
+					ExpectedType: "integer",
+				}))
+			})
+		})
+
+		Context("when --instances is provided", func() {
+			BeforeEach(func() {
+				cmd.Instances = 2
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"task-done", "--instances"}}))
+			})
+		})
+	})
+
+	Context("when waiting for a service instance to be ready", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.WaitArgs{Condition: flag.WaitConditionServiceReady, Name: "some-db"}
+			fakeServiceActor.PollServiceInstanceReadyReturns(v2action.ServiceInstance{}, v2action.Warnings{"poll-warning"}, nil)
+		})
+
+		It("polls the service instance until it is ready", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Waiting for service instance some-db to be ready in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			name, spaceGUID, timeout := fakeServiceActor.PollServiceInstanceReadyArgsForCall(0)
+			Expect(name).To(Equal("some-db"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(timeout).To(Equal(300 * time.Second))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+
+		Context("when the service instance operation failed", func() {
+			BeforeEach(func() {
+				fakeServiceActor.PollServiceInstanceReadyReturns(v2action.ServiceInstance{}, nil, v2action.ServiceInstanceOperationFailedError{
+					Name:        "some-db",
+					Operation:   "create",
+					Description: "broker error",
+				})
+			})
+
+			It("returns a ServiceInstanceOperationFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationFailedError{
+					Name:        "some-db",
+					Operation:   "create",
+					Description: "broker error",
+				}))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeServiceActor.PollServiceInstanceReadyReturns(v2action.ServiceInstance{}, nil, v2action.ServiceInstanceNotFoundError{Name: "some-db"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-db"}))
+			})
+		})
+
+		Context("when polling fails", func() {
+			BeforeEach(func() {
+				fakeServiceActor.PollServiceInstanceReadyReturns(v2action.ServiceInstance{}, v2action.Warnings{"poll-warning"}, errors.New("poll-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("poll-error"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.WaitArgs{Condition: flag.WaitConditionServiceReady, Name: "some-db"}
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+})
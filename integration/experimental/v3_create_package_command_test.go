@@ -36,7 +36,7 @@ var _ = Describe("v3-create-package command", func() {
 				Eventually(session).Should(Say("cf v3-create-package APP_NAME \\[-p APP_PATH \\| --docker-image \\[REGISTRY_HOST:PORT/\\]IMAGE\\[:TAG\\]\\]"))
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say("--docker-image, -o\\s+Docker image to use \\(e\\.g\\. user/docker-image-name\\)"))
-				Eventually(session).Should(Say("-p\\s+Path to app directory or to a zip file of the contents of the app directory"))
+				Eventually(session).Should(Say("-p\\s+Path to app directory or to a zip, jar, war, tar or tgz archive of the contents of the app directory"))
 				Eventually(session).Should(Exit(0))
 			})
 		})
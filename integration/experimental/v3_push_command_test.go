@@ -45,7 +45,7 @@ var _ = Describe("v3-push command", func() {
 				Eventually(session.Out).Should(Say("--docker-image, -o\\s+Docker image to use \\(e\\.g\\. user/docker-image-name\\)"))
 				Eventually(session.Out).Should(Say("--docker-username\\s+Repository username; used with password from environment variable CF_DOCKER_PASSWORD"))
 				Eventually(session.Out).Should(Say("--no-route\\s+Do not map a route to this app"))
-				Eventually(session.Out).Should(Say("-p\\s+Path to app directory or to a zip file of the contents of the app directory"))
+				Eventually(session.Out).Should(Say("-p\\s+Path to app directory or to a zip, jar, war, tar or tgz archive of the contents of the app directory"))
 				Eventually(session.Out).Should(Say("ENVIRONMENT:"))
 				Eventually(session.Out).Should(Say("CF_DOCKER_PASSWORD=\\s+Password used for private docker repository"))
 				Eventually(session.Out).Should(Say("CF_STAGING_TIMEOUT=15\\s+Max wait time for buildpack staging, in minutes"))
@@ -7,9 +7,11 @@ import (
 	"reflect"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
+	sharedPlugin "code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -28,12 +30,54 @@ type DisplayUsage interface {
 	DisplayUsage()
 }
 
+// ExitCoder is an error that requires the CLI to exit with a status other
+// than 1.
+type ExitCoder interface {
+	ExitCode() int
+}
+
 var ErrFailed = errors.New("command failed")
 var ParseErr = errors.New("incorrect type for arg")
 
+// ExitStatusError is returned in place of ErrFailed when the command failed
+// with an ExitCoder.
+type ExitStatusError int
+
+func (e ExitStatusError) Error() string {
+	return fmt.Sprintf("command failed with exit status %d", int(e))
+}
+
 func main() {
 	defer panichandler.HandlePanic()
-	parse(os.Args[1:])
+
+	args := expandAlias(os.Args[1:])
+	// legacy commands read their arguments from os.Args
+	os.Args = append([]string{os.Args[0]}, args...)
+
+	parse(args)
+}
+
+// expandAlias replaces a user defined alias at the start of args with the
+// command it stands for. Aliases are expanded before parsing so that the
+// expanded command is parsed, validated and dispatched like any other.
+func expandAlias(args []string) []string {
+	cfConfig, err := configv3.LoadConfig()
+	if err != nil {
+		return args
+	}
+
+	expandedArgs, err := pluginaction.NewActor(cfConfig, nil).ExpandAlias(common.Commands, args)
+	if err != nil {
+		commandUI, uiErr := ui.NewUI(cfConfig)
+		if uiErr != nil {
+			fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
+			os.Exit(1)
+		}
+		commandUI.DisplayError(sharedPlugin.HandleError(err))
+		os.Exit(1)
+	}
+
+	return expandedArgs
 }
 
 func parse(args []string) {
@@ -117,6 +161,8 @@ func parse(args []string) {
 		}
 	} else if err == ErrFailed {
 		os.Exit(1)
+	} else if exitStatus, ok := err.(ExitStatusError); ok {
+		os.Exit(int(exitStatus))
 	} else if err == ParseErr {
 		fmt.Println()
 		parse([]string{"help", args[0]})
@@ -212,5 +258,9 @@ func handleError(err error, commandUI UI) error {
 		return ParseErr
 	}
 
+	if exitCoder, ok := err.(ExitCoder); ok {
+		return ExitStatusError(exitCoder.ExitCode())
+	}
+
 	return ErrFailed
 }
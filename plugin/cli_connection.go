@@ -109,6 +109,46 @@ func (c *cliConnection) callCliCommand(silently bool, args ...string) ([]string,
 	return cmdOutput, nil
 }
 
+func (c *cliConnection) CliCommandWithStreamingOutput(handler func(plugin_models.CommandOutput), args ...string) (plugin_models.CommandResult, error) {
+	var result plugin_models.CommandResult
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		var success bool
+
+		err := client.Call("CliRpcCmd.DisableTerminalOutput", true, &success)
+		if err != nil {
+			return err
+		}
+
+		var streamID int
+		err = client.Call("CliRpcCmd.StartCoreCommand", args, &streamID)
+		if err != nil {
+			return err
+		}
+
+		for {
+			var batch plugin_models.CommandOutputBatch
+			err = client.Call("CliRpcCmd.GetStreamingOutput", streamID, &batch)
+			if err != nil {
+				return err
+			}
+
+			if handler != nil {
+				for _, output := range batch.Output {
+					handler(output)
+				}
+			}
+
+			if batch.Done {
+				result = batch.Result
+				return nil
+			}
+		}
+	})
+
+	return result, err
+}
+
 func (c *cliConnection) pingCLI() {
 	//call back to cf saying we have been setup
 	var connErr error
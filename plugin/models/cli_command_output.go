@@ -0,0 +1,40 @@
+package plugin_models
+
+type CommandOutputStream string
+
+const (
+	Stdout CommandOutputStream = "stdout"
+	Stderr CommandOutputStream = "stderr"
+)
+
+// CommandOutput is a single chunk of output written by a cf command, in the
+// order it was written.
+type CommandOutput struct {
+	Stream CommandOutputStream
+	Data   string
+}
+
+type CommandErrorType string
+
+const (
+	CommandErrorNone           CommandErrorType = ""
+	CommandErrorUnknownCommand CommandErrorType = "UnknownCommand"
+	CommandErrorFailed         CommandErrorType = "CommandFailed"
+	CommandErrorPanic          CommandErrorType = "CommandPanic"
+)
+
+// CommandResult describes how a cf command finished. ExitCode is the code the
+// cf binary would have exited with had the command been run directly.
+type CommandResult struct {
+	ExitCode  int
+	ErrorType CommandErrorType
+	Error     string
+}
+
+// CommandOutputBatch is the output collected since the previous batch. Result
+// is only set once Done is true.
+type CommandOutputBatch struct {
+	Output []CommandOutput
+	Done   bool
+	Result CommandResult
+}
@@ -17,6 +17,11 @@ type Plugin interface {
 type CliConnection interface {
 	CliCommandWithoutTerminalOutput(args ...string) ([]string, error)
 	CliCommand(args ...string) ([]string, error)
+	// CliCommandWithStreamingOutput runs a cf command and passes each chunk of
+	// its stdout and stderr to handler as soon as it is written, instead of
+	// returning all of the output once the command has finished. The result
+	// contains the command's exit code and the type of error, if any.
+	CliCommandWithStreamingOutput(handler func(plugin_models.CommandOutput), args ...string) (plugin_models.CommandResult, error)
 	GetCurrentOrg() (plugin_models.Organization, error)
 	GetCurrentSpace() (plugin_models.Space, error)
 	Username() (string, error)
@@ -27,6 +27,16 @@ type FakeCliConnection struct {
 		result1 []string
 		result2 error
 	}
+	CliCommandWithStreamingOutputStub        func(handler func(plugin_models.CommandOutput), args ...string) (plugin_models.CommandResult, error)
+	cliCommandWithStreamingOutputMutex       sync.RWMutex
+	cliCommandWithStreamingOutputArgsForCall []struct {
+		handler func(plugin_models.CommandOutput)
+		args    []string
+	}
+	cliCommandWithStreamingOutputReturns struct {
+		result1 plugin_models.CommandResult
+		result2 error
+	}
 	GetCurrentOrgStub        func() (plugin_models.Organization, error)
 	getCurrentOrgMutex       sync.RWMutex
 	getCurrentOrgArgsForCall []struct{}
@@ -288,6 +298,41 @@ func (fake *FakeCliConnection) CliCommandReturns(result1 []string, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) CliCommandWithStreamingOutput(handler func(plugin_models.CommandOutput), args ...string) (plugin_models.CommandResult, error) {
+	fake.cliCommandWithStreamingOutputMutex.Lock()
+	fake.cliCommandWithStreamingOutputArgsForCall = append(fake.cliCommandWithStreamingOutputArgsForCall, struct {
+		handler func(plugin_models.CommandOutput)
+		args    []string
+	}{handler, args})
+	fake.recordInvocation("CliCommandWithStreamingOutput", []interface{}{handler, args})
+	fake.cliCommandWithStreamingOutputMutex.Unlock()
+	if fake.CliCommandWithStreamingOutputStub != nil {
+		return fake.CliCommandWithStreamingOutputStub(handler, args...)
+	} else {
+		return fake.cliCommandWithStreamingOutputReturns.result1, fake.cliCommandWithStreamingOutputReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) CliCommandWithStreamingOutputCallCount() int {
+	fake.cliCommandWithStreamingOutputMutex.RLock()
+	defer fake.cliCommandWithStreamingOutputMutex.RUnlock()
+	return len(fake.cliCommandWithStreamingOutputArgsForCall)
+}
+
+func (fake *FakeCliConnection) CliCommandWithStreamingOutputArgsForCall(i int) (func(plugin_models.CommandOutput), []string) {
+	fake.cliCommandWithStreamingOutputMutex.RLock()
+	defer fake.cliCommandWithStreamingOutputMutex.RUnlock()
+	return fake.cliCommandWithStreamingOutputArgsForCall[i].handler, fake.cliCommandWithStreamingOutputArgsForCall[i].args
+}
+
+func (fake *FakeCliConnection) CliCommandWithStreamingOutputReturns(result1 plugin_models.CommandResult, result2 error) {
+	fake.CliCommandWithStreamingOutputStub = nil
+	fake.cliCommandWithStreamingOutputReturns = struct {
+		result1 plugin_models.CommandResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetCurrentOrg() (plugin_models.Organization, error) {
 	fake.getCurrentOrgMutex.Lock()
 	fake.getCurrentOrgArgsForCall = append(fake.getCurrentOrgArgsForCall, struct{}{})
@@ -995,6 +1040,8 @@ func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
 	defer fake.cliCommandWithoutTerminalOutputMutex.RUnlock()
 	fake.cliCommandMutex.RLock()
 	defer fake.cliCommandMutex.RUnlock()
+	fake.cliCommandWithStreamingOutputMutex.RLock()
+	defer fake.cliCommandWithStreamingOutputMutex.RUnlock()
 	fake.getCurrentOrgMutex.RLock()
 	defer fake.getCurrentOrgMutex.RUnlock()
 	fake.getCurrentSpaceMutex.RLock()
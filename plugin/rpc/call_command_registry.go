@@ -14,6 +14,22 @@ type CommandRunner interface {
 	Command([]string, commandregistry.Dependency, bool) error
 }
 
+// ExitCoder is an error from a command that finished with an exit code other
+// than 1.
+type ExitCoder interface {
+	ExitCode() int
+}
+
+// CommandPanicError is returned when a command panics instead of returning an
+// error.
+type CommandPanicError struct {
+	Reason interface{}
+}
+
+func (e CommandPanicError) Error() string {
+	return fmt.Sprintf("command panic: %v", e.Reason)
+}
+
 type commandRunner struct{}
 
 func NewCommandRunner() CommandRunner {
@@ -46,7 +62,7 @@ func (c *commandRunner) Command(args []string, deps commandregistry.Dependency,
 
 		defer func() {
 			if r := recover(); r != nil {
-				err = fmt.Errorf("command panic: %v", r)
+				err = CommandPanicError{Reason: r}
 			}
 		}()
 
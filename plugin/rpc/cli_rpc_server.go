@@ -45,8 +45,14 @@ type CliRpcCmd struct {
 	repoLocator          api.RepositoryLocator
 	newCmdRunner         CommandRunner
 	outputBucket         *bytes.Buffer
+	outputStreams        map[int]*commandOutputStream
+	lastOutputStreamID   int
+	outputStreamMutex    sync.Mutex
+	commandMutex         sync.Mutex
+	outputDisabled       bool
 	logger               trace.Printer
 	stdout               io.Writer
+	stderr               io.Writer
 }
 
 //go:generate counterfeiter . TerminalOutputSwitch
@@ -84,6 +90,7 @@ func NewRpcService(
 			logger:               logger,
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
+			stderr:               os.Stderr,
 		},
 	}
 
@@ -165,30 +172,19 @@ func (cmd *CliRpcCmd) SetPluginMetadata(pluginMetadata plugin.PluginMetadata, re
 
 func (cmd *CliRpcCmd) DisableTerminalOutput(disable bool, retVal *bool) error {
 	cmd.terminalOutputSwitch.DisableTerminalOutput(disable)
+	cmd.outputDisabled = disable
 	*retVal = true
 	return nil
 }
 
 func (cmd *CliRpcCmd) CallCoreCommand(args []string, retVal *bool) error {
-	var err error
-	cmdRegistry := commandregistry.Commands
+	cmd.commandMutex.Lock()
+	defer cmd.commandMutex.Unlock()
 
 	cmd.outputBucket = &bytes.Buffer{}
-	cmd.outputCapture.SetOutputBucket(cmd.outputBucket)
-
-	if cmdRegistry.CommandExists(args[0]) {
-		deps := commandregistry.NewDependency(cmd.stdout, cmd.logger, dialTimeout)
 
-		//set deps objs to be the one used by all other commands
-		//once all commands are converted, we can make fresh deps for each command run
-		deps.Config = cmd.cliConfig
-		deps.RepoLocator = cmd.repoLocator
-
-		//set command ui's TeePrinter to be the one used by RpcService, for output to be captured
-		deps.UI = terminal.NewUI(os.Stdin, cmd.stdout, cmd.outputCapture.(*terminal.TeePrinter), cmd.logger)
-
-		err = cmd.newCmdRunner.Command(args, deps, false)
-	} else {
+	exists, err := cmd.runCoreCommand(args, cmd.outputBucket, nil)
+	if !exists {
 		*retVal = false
 		return nil
 	}
@@ -202,6 +198,107 @@ func (cmd *CliRpcCmd) CallCoreCommand(args []string, retVal *bool) error {
 	return nil
 }
 
+// StartCoreCommand runs a core command in the background and returns the ID
+// of its output stream. The output can be read as it is written by passing
+// the ID to GetStreamingOutput, so that concurrent commands do not read each
+// other's output.
+func (cmd *CliRpcCmd) StartCoreCommand(args []string, retVal *int) error {
+	stream := newCommandOutputStream()
+
+	cmd.outputStreamMutex.Lock()
+	if cmd.outputStreams == nil {
+		cmd.outputStreams = map[int]*commandOutputStream{}
+	}
+	cmd.lastOutputStreamID++
+	streamID := cmd.lastOutputStreamID
+	cmd.outputStreams[streamID] = stream
+	cmd.outputStreamMutex.Unlock()
+
+	go func() {
+		cmd.commandMutex.Lock()
+		defer cmd.commandMutex.Unlock()
+
+		result := plugin_models.CommandResult{}
+
+		exists, err := cmd.runCoreCommand(args, stream.Stdout(), stream.Stderr())
+		switch {
+		case !exists:
+			result.ExitCode = 1
+			result.ErrorType = plugin_models.CommandErrorUnknownCommand
+			result.Error = fmt.Sprintf("'%s' is not a registered command. See 'cf help -a'", args[0])
+		case err != nil:
+			result.ExitCode = 1
+			if exitCoder, ok := err.(ExitCoder); ok {
+				result.ExitCode = exitCoder.ExitCode()
+			}
+			result.ErrorType = plugin_models.CommandErrorFailed
+			if _, ok := err.(CommandPanicError); ok {
+				result.ErrorType = plugin_models.CommandErrorPanic
+			}
+			result.Error = err.Error()
+		}
+
+		stream.finish(result)
+	}()
+
+	*retVal = streamID
+	return nil
+}
+
+// GetStreamingOutput blocks until the command started by StartCoreCommand
+// with the provided stream ID writes more output or finishes. The stream is
+// discarded once the batch with the result has been returned.
+func (cmd *CliRpcCmd) GetStreamingOutput(streamID int, retVal *plugin_models.CommandOutputBatch) error {
+	cmd.outputStreamMutex.Lock()
+	stream, ok := cmd.outputStreams[streamID]
+	cmd.outputStreamMutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no command has been started with stream ID %d", streamID)
+	}
+
+	*retVal = stream.next()
+
+	if retVal.Done {
+		cmd.outputStreamMutex.Lock()
+		delete(cmd.outputStreams, streamID)
+		cmd.outputStreamMutex.Unlock()
+	}
+	return nil
+}
+
+// runCoreCommand runs a core command with its output captured in
+// outputBucket. When errorBucket is set, warnings and failures are written to
+// stderr and captured in errorBucket instead. Callers must hold commandMutex.
+func (cmd *CliRpcCmd) runCoreCommand(args []string, outputBucket io.Writer, errorBucket io.Writer) (bool, error) {
+	cmdRegistry := commandregistry.Commands
+
+	cmd.outputCapture.SetOutputBucket(outputBucket)
+
+	if !cmdRegistry.CommandExists(args[0]) {
+		return false, nil
+	}
+
+	deps := commandregistry.NewDependency(cmd.stdout, cmd.logger, dialTimeout)
+
+	//set deps objs to be the one used by all other commands
+	//once all commands are converted, we can make fresh deps for each command run
+	deps.Config = cmd.cliConfig
+	deps.RepoLocator = cmd.repoLocator
+
+	//set command ui's TeePrinter to be the one used by RpcService, for output to be captured
+	deps.UI = terminal.NewUI(os.Stdin, cmd.stdout, cmd.outputCapture.(*terminal.TeePrinter), cmd.logger)
+
+	if errorBucket != nil {
+		errorPrinter := terminal.NewTeePrinter(cmd.stderr)
+		errorPrinter.SetOutputBucket(errorBucket)
+		errorPrinter.DisableTerminalOutput(cmd.outputDisabled)
+		deps.UI = newStderrUI(deps.UI, errorPrinter)
+	}
+
+	return true, cmd.newCmdRunner.Command(args, deps, false)
+}
+
 func (cmd *CliRpcCmd) GetOutputAndReset(args bool, retVal *[]string) error {
 	v := strings.TrimSuffix(cmd.outputBucket.String(), "\n")
 	*retVal = strings.Split(v, "\n")
@@ -2,6 +2,7 @@ package rpc_test
 
 import (
 	"errors"
+	"fmt"
 	"net"
 	"net/rpc"
 	"os"
@@ -246,6 +247,169 @@ var _ = Describe("Server", func() {
 		})
 	})
 
+	Describe(".StartCoreCommand", func() {
+		BeforeEach(func() {
+			outputCapture := terminal.NewTeePrinter(os.Stdout)
+			outputCapture.DisableTerminalOutput(true)
+			rpcService, err = NewRpcService(outputCapture, nil, nil, api.RepositoryLocator{}, cmdRunner.NewCommandRunner(), nil, nil, rpc.DefaultServer)
+			Expect(err).ToNot(HaveOccurred())
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		readAll := func(streamID int) ([]plugin_models.CommandOutput, plugin_models.CommandResult) {
+			var output []plugin_models.CommandOutput
+			for {
+				var batch plugin_models.CommandOutputBatch
+				err := client.Call("CliRpcCmd.GetStreamingOutput", streamID, &batch)
+				Expect(err).ToNot(HaveOccurred())
+
+				output = append(output, batch.Output...)
+				if batch.Done {
+					return output, batch.Result
+				}
+			}
+		}
+
+		It("streams the command output and a successful result", func() {
+			var streamID int
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"fake-command"}, &streamID)
+			Expect(err).ToNot(HaveOccurred())
+
+			output, result := readAll(streamID)
+			Expect(output).To(ConsistOf(
+				plugin_models.CommandOutput{Stream: plugin_models.Stdout, Data: "Requirement executed\n"},
+				plugin_models.CommandOutput{Stream: plugin_models.Stdout, Data: "Command Executed\n"},
+			))
+			Expect(result).To(Equal(plugin_models.CommandResult{}))
+		})
+
+		It("reports an unknown command on stderr", func() {
+			var streamID int
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"not_a_cmd"}, &streamID)
+			Expect(err).ToNot(HaveOccurred())
+
+			output, result := readAll(streamID)
+			Expect(output).To(ConsistOf(
+				plugin_models.CommandOutput{Stream: plugin_models.Stderr, Data: "'not_a_cmd' is not a registered command. See 'cf help -a'\n"},
+			))
+			Expect(result.ExitCode).To(Equal(1))
+			Expect(result.ErrorType).To(Equal(plugin_models.CommandErrorUnknownCommand))
+		})
+
+		It("reports a failed command", func() {
+			var streamID int
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"fake-command", "-invalid_flag"}, &streamID)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, result := readAll(streamID)
+			Expect(result.ExitCode).To(Equal(1))
+			Expect(result.ErrorType).To(Equal(plugin_models.CommandErrorFailed))
+			Expect(result.Error).ToNot(BeEmpty())
+		})
+
+		It("streams warnings on stderr and passes through the command's exit code", func() {
+			var streamID int
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"fake-command5"}, &streamID)
+			Expect(err).ToNot(HaveOccurred())
+
+			output, result := readAll(streamID)
+			Expect(output).To(ContainElement(
+				plugin_models.CommandOutput{Stream: plugin_models.Stdout, Data: "Command Executed\n"},
+			))
+			Expect(output).To(ContainElement(
+				plugin_models.CommandOutput{Stream: plugin_models.Stderr, Data: "some warning\n"},
+			))
+			Expect(result.ExitCode).To(Equal(3))
+			Expect(result.ErrorType).To(Equal(plugin_models.CommandErrorFailed))
+		})
+
+		It("reports a command that panics", func() {
+			var streamID int
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"fake-command3"}, &streamID)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, result := readAll(streamID)
+			Expect(result.ExitCode).To(Equal(1))
+			Expect(result.ErrorType).To(Equal(plugin_models.CommandErrorPanic))
+			Expect(result.Error).To(ContainSubstring("command panic"))
+		})
+
+		It("keeps the output of concurrent commands apart", func() {
+			var firstStreamID, secondStreamID int
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"fake-command"}, &firstStreamID)
+			Expect(err).ToNot(HaveOccurred())
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"not_a_cmd"}, &secondStreamID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondStreamID).ToNot(Equal(firstStreamID))
+
+			output, result := readAll(secondStreamID)
+			Expect(output).To(ConsistOf(
+				plugin_models.CommandOutput{Stream: plugin_models.Stderr, Data: "'not_a_cmd' is not a registered command. See 'cf help -a'\n"},
+			))
+			Expect(result.ErrorType).To(Equal(plugin_models.CommandErrorUnknownCommand))
+
+			output, result = readAll(firstStreamID)
+			Expect(output).To(ConsistOf(
+				plugin_models.CommandOutput{Stream: plugin_models.Stdout, Data: "Requirement executed\n"},
+				plugin_models.CommandOutput{Stream: plugin_models.Stdout, Data: "Command Executed\n"},
+			))
+			Expect(result).To(Equal(plugin_models.CommandResult{}))
+		})
+
+		It("discards the stream once the result has been read", func() {
+			var streamID int
+			err = client.Call("CliRpcCmd.StartCoreCommand", []string{"fake-command"}, &streamID)
+			Expect(err).ToNot(HaveOccurred())
+			readAll(streamID)
+
+			var batch plugin_models.CommandOutputBatch
+			err = client.Call("CliRpcCmd.GetStreamingOutput", streamID, &batch)
+			Expect(err).To(MatchError(fmt.Sprintf("no command has been started with stream ID %d", streamID)))
+		})
+	})
+
+	Describe(".GetStreamingOutput", func() {
+		BeforeEach(func() {
+			rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+			Expect(err).ToNot(HaveOccurred())
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		It("returns an error when no command has been started", func() {
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+
+			var batch plugin_models.CommandOutputBatch
+			err = client.Call("CliRpcCmd.GetStreamingOutput", 1, &batch)
+			Expect(err).To(MatchError("no command has been started with stream ID 1"))
+		})
+	})
+
 	Describe("disabling terminal output", func() {
 		var terminalOutputSwitch *rpcfakes.FakeTerminalOutputSwitch
 
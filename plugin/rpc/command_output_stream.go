@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/plugin/models"
+)
+
+// commandOutputStream collects the output of a core command that is running
+// in the background so that it can be handed to a plugin in batches.
+type commandOutputStream struct {
+	mutex  sync.Mutex
+	cond   *sync.Cond
+	output []plugin_models.CommandOutput
+	done   bool
+	result plugin_models.CommandResult
+}
+
+func newCommandOutputStream() *commandOutputStream {
+	stream := &commandOutputStream{}
+	stream.cond = sync.NewCond(&stream.mutex)
+	return stream
+}
+
+// Stdout returns a writer that records what is written to it as stdout. It is
+// used as the output bucket of the OutputCapture while the command runs.
+func (stream *commandOutputStream) Stdout() io.Writer {
+	return streamWriter{stream: stream, source: plugin_models.Stdout}
+}
+
+// Stderr returns a writer that records what is written to it as stderr.
+func (stream *commandOutputStream) Stderr() io.Writer {
+	return streamWriter{stream: stream, source: plugin_models.Stderr}
+}
+
+func (stream *commandOutputStream) append(source plugin_models.CommandOutputStream, data string) {
+	if data == "" {
+		return
+	}
+
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+
+	stream.output = append(stream.output, plugin_models.CommandOutput{Stream: source, Data: data})
+	stream.cond.Broadcast()
+}
+
+// finish marks the command as complete. Any error message is written to
+// stderr before the stream is closed.
+func (stream *commandOutputStream) finish(result plugin_models.CommandResult) {
+	if result.Error != "" {
+		stream.append(plugin_models.Stderr, terminal.Decolorize(result.Error)+"\n")
+	}
+
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+
+	stream.done = true
+	stream.result = result
+	stream.cond.Broadcast()
+}
+
+// next blocks until there is unread output or the command has finished, and
+// returns everything collected since the previous call.
+func (stream *commandOutputStream) next() plugin_models.CommandOutputBatch {
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+
+	for len(stream.output) == 0 && !stream.done {
+		stream.cond.Wait()
+	}
+
+	batch := plugin_models.CommandOutputBatch{
+		Output: stream.output,
+		Done:   stream.done,
+	}
+	if stream.done {
+		batch.Result = stream.result
+	}
+	stream.output = nil
+
+	return batch
+}
+
+type streamWriter struct {
+	stream *commandOutputStream
+	source plugin_models.CommandOutputStream
+}
+
+func (writer streamWriter) Write(p []byte) (int, error) {
+	writer.stream.append(writer.source, string(p))
+	return len(p), nil
+}
@@ -0,0 +1,52 @@
+package fakecommand
+
+import (
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type FakeCommand5 struct {
+	ui terminal.UI
+}
+
+// ExitStatusError is returned by fake-command5 to test exit codes.
+type ExitStatusError struct{}
+
+func (ExitStatusError) Error() string {
+	return "exited with status 3"
+}
+
+func (ExitStatusError) ExitCode() int {
+	return 3
+}
+
+func init() {
+	commandregistry.Register(FakeCommand5{})
+}
+
+func (cmd FakeCommand5) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "fake-command5",
+		Description: "Description for fake-command5",
+		Usage: []string{
+			"Usage of fake-command5",
+		},
+	}
+}
+
+func (cmd FakeCommand5) Requirements(_ requirements.Factory, _ flags.FlagContext) ([]requirements.Requirement, error) {
+	return []requirements.Requirement{}, nil
+}
+
+func (cmd FakeCommand5) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	return cmd
+}
+
+func (cmd FakeCommand5) Execute(c flags.FlagContext) error {
+	cmd.ui.Say("Command Executed")
+	cmd.ui.Warn("some warning")
+	return ExitStatusError{}
+}
@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+// stderrUI is the UI of a command started with StartCoreCommand. It writes
+// warnings and failures to stderr, so that a plugin can tell them apart from
+// the rest of the output.
+type stderrUI struct {
+	terminal.UI
+	stderr terminal.Printer
+}
+
+func newStderrUI(ui terminal.UI, stderr terminal.Printer) terminal.UI {
+	return stderrUI{UI: ui, stderr: stderr}
+}
+
+func (ui stderrUI) Warn(message string, args ...interface{}) {
+	_, _ = ui.stderr.Printf("%s\n", terminal.WarningColor(fmt.Sprintf(message, args...)))
+}
+
+func (ui stderrUI) Failed(message string, args ...interface{}) {
+	failed := "FAILED"
+	if i18n.T != nil {
+		failed = i18n.T("FAILED")
+	}
+
+	_, _ = ui.stderr.Printf("%s\n", terminal.FailureColor(failed))
+	_, _ = ui.stderr.Printf("%s\n", fmt.Sprintf(message, args...))
+}
@@ -0,0 +1,235 @@
+// Package cassette records the requests the CLI makes and the responses it
+// receives to files, called cassettes, and replays them later without
+// contacting the server.
+//
+// A cassette stores one JSON encoded Interaction per line. Authorization and
+// cookie headers, and token, password, secret and credentials values in JSON
+// and form bodies are redacted before they are written.
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// RedactedValue replaces the values that are redacted from a cassette.
+const RedactedValue = "[PRIVATE DATA HIDDEN]"
+
+// redactedSignature replaces the signature of JSON Web Tokens, so that their
+// claims can still be decoded during replay but the token cannot be used.
+const redactedSignature = "REDACTED"
+
+var (
+	keysToRedact    = regexp.MustCompile("(?i).*(?:token|password|secret|credentials).*")
+	keysToKeep      = map[string]bool{"token_endpoint": true, "token_type": true}
+	headersToRedact = []string{"Authorization", "Cookie", "Set-Cookie"}
+)
+
+// Interaction is a request and the response that the server sent back.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. Requests are matched during replay by their
+// method, URL and body. Only JSON and form bodies are recorded.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response. Bodies that are not valid UTF-8 are stored
+// base64 encoded in BodyBase64.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// NewInteraction returns the redacted interaction for the provided request
+// and response.
+func NewInteraction(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte) Interaction {
+	recorded := Response{
+		StatusCode: response.StatusCode,
+		Header:     redactHeader(response.Header),
+	}
+
+	if isJSON(response.Header.Get("Content-Type")) {
+		responseBody = redactJSON(responseBody, redactSignature)
+	}
+	if utf8.Valid(responseBody) {
+		recorded.Body = string(responseBody)
+	} else {
+		recorded.BodyBase64 = base64.StdEncoding.EncodeToString(responseBody)
+	}
+
+	return Interaction{
+		Request:  NewRequest(request, requestBody),
+		Response: recorded,
+	}
+}
+
+// NewRequest returns the redacted request, in the form that is used to match
+// it against the recorded requests.
+func NewRequest(request *http.Request, body []byte) Request {
+	recorded := Request{
+		Method: request.Method,
+		URL:    normalizeURL(request.URL),
+		Header: redactHeader(request.Header),
+	}
+
+	contentType := request.Header.Get("Content-Type")
+	switch {
+	case isJSON(contentType):
+		recorded.Body = string(redactJSON(body, redactValue))
+	case isForm(contentType):
+		recorded.Body = redactForm(body)
+	}
+
+	return recorded
+}
+
+// HasMatchedBody returns true when the body of requests with the provided
+// content type is recorded and used to match them.
+func HasMatchedBody(contentType string) bool {
+	return isJSON(contentType) || isForm(contentType)
+}
+
+// Matches returns true if both requests have the same method, URL and body.
+func (request Request) Matches(other Request) bool {
+	return request.Method == other.Method &&
+		request.URL == other.URL &&
+		request.Body == other.Body
+}
+
+// RawBody returns the response body as it was sent by the server, apart from
+// redacted values.
+func (response Response) RawBody() ([]byte, error) {
+	if response.BodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(response.BodyBase64)
+	}
+	return []byte(response.Body), nil
+}
+
+func isJSON(contentType string) bool {
+	return strings.Contains(contentType, "json")
+}
+
+func isForm(contentType string) bool {
+	return strings.Contains(contentType, "x-www-form-urlencoded")
+}
+
+// normalizeURL sorts the query parameters so that their order does not
+// affect matching.
+func normalizeURL(original *url.URL) string {
+	normalized := *original
+	normalized.RawQuery = original.Query().Encode()
+	return normalized.String()
+}
+
+func redactHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	redacted := http.Header{}
+	for key, values := range header {
+		redacted[key] = values
+	}
+	for _, key := range headersToRedact {
+		if _, ok := redacted[key]; ok {
+			redacted.Set(key, RedactedValue)
+		}
+	}
+	return redacted
+}
+
+// redactJSON redacts the values of token, password, secret and credentials
+// keys, including objects and arrays, and returns the body in a canonical
+// form. Bodies that are not valid JSON are returned as is.
+func redactJSON(body []byte, redact func(string) string) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactJSONValue(value, redact)); err != nil {
+		return body
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n"))
+}
+
+func redactJSONValue(value interface{}, redact func(string) string) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typed {
+			if !keysToRedact.MatchString(key) || keysToKeep[key] {
+				typed[key] = redactJSONValue(item, redact)
+				continue
+			}
+
+			switch redacted := item.(type) {
+			case string:
+				typed[key] = redact(redacted)
+			case nil:
+			default:
+				typed[key] = RedactedValue
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactJSONValue(item, redact)
+		}
+	}
+	return value
+}
+
+func redactForm(body []byte) string {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return string(body)
+	}
+
+	for key, items := range values {
+		if keysToRedact.MatchString(key) && !keysToKeep[key] {
+			for i := range items {
+				items[i] = RedactedValue
+			}
+		}
+	}
+	return values.Encode()
+}
+
+// redactValue redacts values in requests completely, so that requests match
+// regardless of the tokens they were sent with.
+func redactValue(string) string {
+	return RedactedValue
+}
+
+// redactSignature redacts the signature of JSON Web Tokens in responses and
+// any other value completely.
+func redactSignature(value string) string {
+	parts := strings.Split(value, ".")
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" {
+		return parts[0] + "." + parts[1] + "." + redactedSignature
+	}
+	return RedactedValue
+}
@@ -0,0 +1,110 @@
+package cassette_test
+
+import (
+	"net/http"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/cassette"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette", func() {
+	Describe("NewInteraction", func() {
+		var (
+			request      *http.Request
+			requestBody  []byte
+			response     *http.Response
+			responseBody []byte
+
+			interaction Interaction
+		)
+
+		BeforeEach(func() {
+			requestBody = []byte("client_id=cf&client_secret=some-secret&grant_type=password&password=some-password&username=some-user")
+
+			var err error
+			request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token?b=2&a=1", strings.NewReader(string(requestBody)))
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Set("Authorization", "Basic some-credentials")
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			responseBody = []byte(`{"access_token":"some-header.some-claims.some-signature","refresh_token":"some-refresh-token","token_type":"bearer","nested":[{"password":"some-password","credentials":{"uri":"postgres://some-user:some-password@db"},"client_secret":"some-secret"}]}`)
+			response = &http.Response{
+				StatusCode: http.StatusOK,
+				Header: http.Header{
+					"Content-Type": {"application/json"},
+					"Set-Cookie":   {"some-cookie"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			interaction = NewInteraction(request, requestBody, response, responseBody)
+		})
+
+		It("records the request with sorted query parameters and redacted credentials", func() {
+			Expect(interaction.Request.Method).To(Equal(http.MethodPost))
+			Expect(interaction.Request.URL).To(Equal("https://uaa.example.com/oauth/token?a=1&b=2"))
+			Expect(interaction.Request.Header.Get("Authorization")).To(Equal(RedactedValue))
+			Expect(interaction.Request.Body).To(Equal("client_id=cf&client_secret=%5BPRIVATE+DATA+HIDDEN%5D&grant_type=password&password=%5BPRIVATE+DATA+HIDDEN%5D&username=some-user"))
+		})
+
+		It("records the response with redacted tokens", func() {
+			Expect(interaction.Response.StatusCode).To(Equal(http.StatusOK))
+			Expect(interaction.Response.Header.Get("Set-Cookie")).To(Equal(RedactedValue))
+			Expect(interaction.Response.Body).To(Equal(`{"access_token":"some-header.some-claims.REDACTED","nested":[{"client_secret":"[PRIVATE DATA HIDDEN]","credentials":"[PRIVATE DATA HIDDEN]","password":"[PRIVATE DATA HIDDEN]"}],"refresh_token":"[PRIVATE DATA HIDDEN]","token_type":"bearer"}`))
+		})
+
+		It("does not modify the live request", func() {
+			Expect(request.Header.Get("Authorization")).To(Equal("Basic some-credentials"))
+		})
+
+		Context("when the response body is binary", func() {
+			BeforeEach(func() {
+				response.Header.Set("Content-Type", "application/octet-stream")
+				responseBody = []byte{0xff, 0xfe, 0x00}
+			})
+
+			It("stores it base64 encoded", func() {
+				Expect(interaction.Response.Body).To(BeEmpty())
+				Expect(interaction.Response.BodyBase64).To(Equal("//4A"))
+
+				body, err := interaction.Response.RawBody()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(body).To(Equal(responseBody))
+			})
+		})
+	})
+
+	Describe("Request.Matches", func() {
+		var request *http.Request
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodPut, "https://api.example.com/v2/apps/some-guid", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+		})
+
+		It("matches JSON bodies regardless of key order and token values", func() {
+			recorded := NewRequest(request, []byte(`{"name":"some-app","token":"some-token"}`))
+			live := NewRequest(request, []byte(`{"token":"some-other-token", "name":"some-app"}`))
+			Expect(recorded.Matches(live)).To(BeTrue())
+		})
+
+		It("does not match different bodies", func() {
+			recorded := NewRequest(request, []byte(`{"name":"some-app"}`))
+			live := NewRequest(request, []byte(`{"name":"some-other-app"}`))
+			Expect(recorded.Matches(live)).To(BeFalse())
+		})
+
+		It("ignores bodies that are not JSON or form encoded", func() {
+			request.Header.Set("Content-Type", "multipart/form-data; boundary=some-boundary")
+			recorded := NewRequest(request, []byte("some-bits"))
+			live := NewRequest(request, []byte("some-other-bits"))
+			Expect(recorded.Matches(live)).To(BeTrue())
+		})
+	})
+})
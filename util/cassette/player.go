@@ -0,0 +1,184 @@
+package cassette
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// UnmatchedRequestError is returned when a cassette has no interaction left
+// that matches a request.
+type UnmatchedRequestError struct {
+	Cassette string
+	Method   string
+	URL      string
+}
+
+func (e UnmatchedRequestError) Error() string {
+	return fmt.Sprintf("no recorded response in %s for %s %s", e.Cassette, e.Method, e.URL)
+}
+
+// CassetteNotFoundError is returned when the cassette to replay does not
+// exist.
+type CassetteNotFoundError struct {
+	Cassette string
+}
+
+func (e CassetteNotFoundError) Error() string {
+	return fmt.Sprintf("cassette not found: %s", e.Cassette)
+}
+
+// Player replays the interactions of a cassette. A request is answered with
+// the first interaction that has the same method, URL and body, and that has
+// not been replayed yet. Responses are served by a local HTTP server, so that
+// they go through the same response and error handling as live responses.
+type Player struct {
+	path string
+
+	mutex        sync.Mutex
+	loaded       bool
+	interactions []Interaction
+	replayed     []bool
+	serverURL    *url.URL
+}
+
+var (
+	playersMutex sync.Mutex
+	players      = map[string]*Player{}
+)
+
+// NewPlayer returns the Player for the cassette at the provided path. Players
+// are shared within the process, so an interaction that one client replayed
+// is not replayed again by another client of the same API.
+func NewPlayer(path string) *Player {
+	playersMutex.Lock()
+	defer playersMutex.Unlock()
+
+	if player, ok := players[path]; ok {
+		return player
+	}
+
+	player := &Player{path: path}
+	players[path] = player
+	return player
+}
+
+// Replay returns the URL at which the recorded response to the request is
+// served. The body is only used for JSON and form requests.
+func (player *Player) Replay(request *http.Request, body []byte) (*url.URL, error) {
+	player.mutex.Lock()
+	defer player.mutex.Unlock()
+
+	err := player.load()
+	if err != nil {
+		return nil, err
+	}
+
+	live := NewRequest(request, body)
+	for i, interaction := range player.interactions {
+		if player.replayed[i] || !interaction.Request.Matches(live) {
+			continue
+		}
+
+		err = player.startServer()
+		if err != nil {
+			return nil, err
+		}
+
+		player.replayed[i] = true
+		replayURL := *player.serverURL
+		replayURL.Path = "/interactions/" + strconv.Itoa(i)
+		return &replayURL, nil
+	}
+
+	return nil, UnmatchedRequestError{
+		Cassette: player.path,
+		Method:   live.Method,
+		URL:      live.URL,
+	}
+}
+
+func (player *Player) load() error {
+	if player.loaded {
+		return nil
+	}
+
+	cassette, err := os.Open(player.path)
+	if os.IsNotExist(err) {
+		return CassetteNotFoundError{Cassette: player.path}
+	}
+	if err != nil {
+		return err
+	}
+	defer cassette.Close()
+
+	decoder := json.NewDecoder(cassette)
+	for {
+		var interaction Interaction
+		err = decoder.Decode(&interaction)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		player.interactions = append(player.interactions, interaction)
+	}
+
+	player.replayed = make([]bool, len(player.interactions))
+	player.loaded = true
+	return nil
+}
+
+// startServer starts the local server that serves the recorded responses, if
+// it is not running yet. It runs until the process exits.
+func (player *Player) startServer() error {
+	if player.serverURL != nil {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	go http.Serve(listener, http.HandlerFunc(player.serveInteraction))
+
+	player.serverURL = &url.URL{Scheme: "http", Host: listener.Addr().String()}
+	return nil
+}
+
+func (player *Player) serveInteraction(writer http.ResponseWriter, request *http.Request) {
+	// Request bodies, such as uploaded application bits, are not recorded.
+	_, _ = io.Copy(ioutil.Discard, request.Body)
+
+	index, err := strconv.Atoi(strings.TrimPrefix(request.URL.Path, "/interactions/"))
+	if err != nil || index < 0 || index >= len(player.interactions) {
+		http.NotFound(writer, request)
+		return
+	}
+
+	response := player.interactions[index].Response
+	body, err := response.RawBody()
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for key, values := range response.Header {
+		if key == "Content-Length" || key == "Transfer-Encoding" {
+			continue
+		}
+		writer.Header()[key] = values
+	}
+	writer.WriteHeader(response.StatusCode)
+	_, _ = writer.Write(body)
+}
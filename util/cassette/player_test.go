@@ -0,0 +1,101 @@
+package cassette_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/cassette"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder and Player", func() {
+	var (
+		cassetteDir  string
+		cassettePath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "cassette-test")
+		Expect(err).ToNot(HaveOccurred())
+		cassettePath = filepath.Join(cassetteDir, "some-dir", "some-cassette.jsonl")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	newRequest := func(path string) *http.Request {
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com"+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return request
+	}
+
+	record := func(path string, statusCode int, body string) {
+		response := &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{"X-Some-Header": {"some-value"}},
+		}
+		Expect(NewRecorder(cassettePath).Record(NewInteraction(newRequest(path), nil, response, []byte(body)))).To(Succeed())
+	}
+
+	get := func(player *Player, path string) (*http.Response, string) {
+		replayURL, err := player.Replay(newRequest(path), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err := http.Get(replayURL.String())
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response, string(body)
+	}
+
+	It("replays the recorded responses in the order they were recorded", func() {
+		record("/v2/jobs/some-job", http.StatusOK, "queued")
+		record("/v2/jobs/some-job", http.StatusOK, "finished")
+		record("/v2/apps/some-guid", http.StatusNotFound, "not found")
+
+		player := NewPlayer(cassettePath)
+
+		response, body := get(player, "/v2/apps/some-guid")
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(response.Header.Get("X-Some-Header")).To(Equal("some-value"))
+		Expect(body).To(Equal("not found"))
+
+		_, body = get(player, "/v2/jobs/some-job")
+		Expect(body).To(Equal("queued"))
+		_, body = get(player, "/v2/jobs/some-job")
+		Expect(body).To(Equal("finished"))
+
+		_, err := player.Replay(newRequest("/v2/jobs/some-job"), nil)
+		Expect(err).To(MatchError(UnmatchedRequestError{
+			Cassette: cassettePath,
+			Method:   http.MethodGet,
+			URL:      "https://api.example.com/v2/jobs/some-job",
+		}))
+	})
+
+	It("shares the replayed interactions between players of the same cassette", func() {
+		record("/v2/info", http.StatusOK, "{}")
+
+		_, err := NewPlayer(cassettePath).Replay(newRequest("/v2/info"), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = NewPlayer(cassettePath).Replay(newRequest("/v2/info"), nil)
+		Expect(err).To(BeAssignableToTypeOf(UnmatchedRequestError{}))
+	})
+
+	Context("when the cassette does not exist", func() {
+		It("returns a CassetteNotFoundError", func() {
+			_, err := NewPlayer(cassettePath).Replay(newRequest("/v2/info"), nil)
+			Expect(err).To(MatchError(CassetteNotFoundError{Cassette: cassettePath}))
+			Expect(err).To(MatchError("cassette not found: " + cassettePath))
+		})
+	})
+})
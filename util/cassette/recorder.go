@@ -0,0 +1,47 @@
+package cassette
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Recorder appends interactions to a cassette. Each interaction is written
+// as soon as it is recorded, so several connections and CLI invocations can
+// record to the same cassette.
+type Recorder struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewRecorder returns a Recorder for the cassette at the provided path. The
+// cassette and its directory are created on the first recorded interaction.
+func NewRecorder(path string) *Recorder {
+	return &Recorder{path: path}
+}
+
+// Record appends the interaction to the cassette.
+func (recorder *Recorder) Record(interaction Interaction) error {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	raw, err := json.Marshal(interaction)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(recorder.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	cassette, err := os.OpenFile(recorder.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer cassette.Close()
+
+	_, err = cassette.Write(append(raw, '\n'))
+	return err
+}
@@ -0,0 +1,31 @@
+package configv3
+
+// Aliases returns the user defined command aliases from the .cf/config.json,
+// keyed by alias name.
+func (config *Config) Aliases() map[string]string {
+	aliases := map[string]string{}
+	for name, command := range config.ConfigFile.Aliases {
+		aliases[name] = command
+	}
+	return aliases
+}
+
+// GetAlias returns the command the alias expands to, and false if the alias
+// does not exist.
+func (config *Config) GetAlias(name string) (string, bool) {
+	command, found := config.ConfigFile.Aliases[name]
+	return command, found
+}
+
+// SetAlias adds or replaces the alias with the given command.
+func (config *Config) SetAlias(name string, command string) {
+	if config.ConfigFile.Aliases == nil {
+		config.ConfigFile.Aliases = map[string]string{}
+	}
+	config.ConfigFile.Aliases[name] = command
+}
+
+// RemoveAlias removes the alias. It does nothing if the alias does not exist.
+func (config *Config) RemoveAlias(name string) {
+	delete(config.ConfigFile.Aliases, name)
+}
@@ -0,0 +1,64 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Alias", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	Describe("SetAlias", func() {
+		It("adds the alias", func() {
+			config.SetAlias("sw", "v3-scale $1 --process worker")
+
+			command, found := config.GetAlias("sw")
+			Expect(found).To(BeTrue())
+			Expect(command).To(Equal("v3-scale $1 --process worker"))
+		})
+
+		It("replaces an existing alias", func() {
+			config.SetAlias("sw", "v3-scale $1")
+			config.SetAlias("sw", "v3-scale $1 --process worker")
+
+			Expect(config.Aliases()).To(Equal(map[string]string{"sw": "v3-scale $1 --process worker"}))
+		})
+	})
+
+	Describe("GetAlias", func() {
+		It("returns false when the alias does not exist", func() {
+			_, found := config.GetAlias("sw")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("Aliases", func() {
+		It("returns a copy of the aliases", func() {
+			config.SetAlias("sw", "v3-scale $1")
+
+			config.Aliases()["other"] = "apps"
+			Expect(config.Aliases()).To(Equal(map[string]string{"sw": "v3-scale $1"}))
+		})
+	})
+
+	Describe("RemoveAlias", func() {
+		It("removes the alias", func() {
+			config.SetAlias("sw", "v3-scale $1")
+			config.RemoveAlias("sw")
+
+			_, found := config.GetAlias("sw")
+			Expect(found).To(BeFalse())
+		})
+
+		It("does nothing when the alias does not exist", func() {
+			config.RemoveAlias("sw")
+			Expect(config.Aliases()).To(BeEmpty())
+		})
+	})
+})
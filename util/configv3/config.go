@@ -127,6 +127,9 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
 		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
 		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
+		CFReadOnly:       os.Getenv("CF_READ_ONLY"),
+		CFRecord:         os.Getenv("CF_RECORD"),
+		CFReplay:         os.Getenv("CF_REPLAY"),
 		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
 		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
 		CFTrace:          os.Getenv("CF_TRACE"),
@@ -300,6 +303,8 @@ type CFConfig struct {
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	Aliases                  map[string]string  `json:"Aliases,omitempty"`
+	TargetSafety             map[string]string  `json:"TargetSafety,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -337,6 +342,9 @@ type EnvOverride struct {
 	CFHome           string
 	CFLogLevel       string
 	CFPluginHome     string
+	CFReadOnly       string
+	CFRecord         string
+	CFReplay         string
 	CFStagingTimeout string
 	CFStartupTimeout string
 	CFTrace          string
@@ -537,6 +545,30 @@ func (config *Config) Verbose() (bool, []string) {
 	return verbose, filePath
 }
 
+// RecordDirectory returns the directory that API requests and responses are
+// recorded to, based off of the $CF_RECORD environment variable. It is empty
+// when $CF_REPLAY is set, as replayed responses are not recorded again.
+func (config *Config) RecordDirectory() string {
+	if config.ENV.CFReplay != "" {
+		return ""
+	}
+	return config.absolutePath(config.ENV.CFRecord)
+}
+
+// ReplayDirectory returns the directory that API responses are replayed from
+// instead of contacting the API, based off of the $CF_REPLAY environment
+// variable.
+func (config *Config) ReplayDirectory() string {
+	return config.absolutePath(config.ENV.CFReplay)
+}
+
+func (config *Config) absolutePath(path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(config.detectedSettings.currentDirectory, path)
+}
+
 // IsTTY returns true based off of:
 //   - The $FORCE_TTY is set to true/t/1
 //   - Detected from the STDOUT stream
@@ -621,6 +621,43 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("RecordDirectory and ReplayDirectory", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				config = &Config{}
+			})
+
+			Context("when neither $CF_RECORD nor $CF_REPLAY are set", func() {
+				It("returns empty directories", func() {
+					Expect(config.RecordDirectory()).To(BeEmpty())
+					Expect(config.ReplayDirectory()).To(BeEmpty())
+				})
+			})
+
+			Context("when $CF_RECORD is set", func() {
+				BeforeEach(func() {
+					config.ENV.CFRecord = "/some/cassettes"
+				})
+
+				It("returns the record directory", func() {
+					Expect(config.RecordDirectory()).To(Equal("/some/cassettes"))
+					Expect(config.ReplayDirectory()).To(BeEmpty())
+				})
+
+				Context("when $CF_REPLAY is also set", func() {
+					BeforeEach(func() {
+						config.ENV.CFReplay = "/some/other/cassettes"
+					})
+
+					It("only replays", func() {
+						Expect(config.RecordDirectory()).To(BeEmpty())
+						Expect(config.ReplayDirectory()).To(Equal("/some/other/cassettes"))
+					})
+				})
+			})
+		})
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -12,6 +12,12 @@ func ConfigFilePath() string {
 	return filepath.Join(homeDirectory(), ".cf", "config.json")
 }
 
+// CompletionCacheFilePath returns the location of the file used to cache
+// resource names for shell completion.
+func CompletionCacheFilePath() string {
+	return filepath.Join(configDirectory(), "completion_cache.json")
+}
+
 func configDirectory() string {
 	return filepath.Join(homeDirectory(), ".cf")
 }
@@ -0,0 +1,64 @@
+package configv3
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/targetsafety"
+)
+
+// TargetSafety is the protection against changes that is applied to a
+// targeted API.
+type TargetSafety string
+
+const (
+	// TargetSafetyNone allows any request to be sent to the API.
+	TargetSafetyNone TargetSafety = ""
+	// TargetSafetyReadOnly rejects requests that could modify resources before
+	// they are sent to the API.
+	TargetSafetyReadOnly TargetSafety = targetsafety.ReadOnlySafety
+	// TargetSafetyProtected requires the user to type the targeted org name
+	// before the first request that could modify resources is sent.
+	TargetSafetyProtected TargetSafety = targetsafety.ProtectedSafety
+)
+
+// TargetSafety returns the safety of the currently targeted API from the
+// .cf/config.json.
+func (config *Config) TargetSafety() TargetSafety {
+	return TargetSafety(config.ConfigFile.TargetSafety[targetSafetyKey(config.Target())])
+}
+
+// SetTargetSafety sets the safety of the currently targeted API. It does
+// nothing if no API is targeted.
+func (config *Config) SetTargetSafety(safety TargetSafety) {
+	target := targetSafetyKey(config.Target())
+	if target == "" {
+		return
+	}
+
+	if safety == TargetSafetyNone {
+		delete(config.ConfigFile.TargetSafety, target)
+		return
+	}
+
+	if config.ConfigFile.TargetSafety == nil {
+		config.ConfigFile.TargetSafety = map[string]string{}
+	}
+	config.ConfigFile.TargetSafety[target] = string(safety)
+}
+
+// ReadOnly returns true if requests that could modify resources must not be
+// sent, based off of:
+//   - The $CF_READ_ONLY environment variable set to true/t/1
+//   - The currently targeted API being read-only
+func (config *Config) ReadOnly() bool {
+	return targetsafety.ReadOnly(config.ENV.CFReadOnly, string(config.TargetSafety()))
+}
+
+// ProtectedTarget returns true if the currently targeted API is protected.
+func (config *Config) ProtectedTarget() bool {
+	return config.TargetSafety() == TargetSafetyProtected
+}
+
+func targetSafetyKey(target string) string {
+	return strings.TrimSuffix(target, "/")
+}
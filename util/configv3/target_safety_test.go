@@ -0,0 +1,85 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Target Safety", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+		config.ConfigFile.Target = "https://api.prod.example.com"
+	})
+
+	Describe("SetTargetSafety", func() {
+		It("sets the safety of the current target", func() {
+			config.SetTargetSafety(TargetSafetyProtected)
+
+			Expect(config.TargetSafety()).To(Equal(TargetSafetyProtected))
+			Expect(config.ConfigFile.TargetSafety).To(Equal(map[string]string{
+				"https://api.prod.example.com": "protected",
+			}))
+		})
+
+		It("ignores a trailing slash on the target", func() {
+			config.SetTargetSafety(TargetSafetyReadOnly)
+			config.ConfigFile.Target = "https://api.prod.example.com/"
+
+			Expect(config.TargetSafety()).To(Equal(TargetSafetyReadOnly))
+		})
+
+		It("does not apply to other targets", func() {
+			config.SetTargetSafety(TargetSafetyReadOnly)
+			config.ConfigFile.Target = "https://api.dev.example.com"
+
+			Expect(config.TargetSafety()).To(Equal(TargetSafetyNone))
+		})
+
+		It("removes the safety when set to none", func() {
+			config.SetTargetSafety(TargetSafetyReadOnly)
+			config.SetTargetSafety(TargetSafetyNone)
+
+			Expect(config.ConfigFile.TargetSafety).To(BeEmpty())
+		})
+
+		Context("when no API is targeted", func() {
+			BeforeEach(func() {
+				config.ConfigFile.Target = ""
+			})
+
+			It("does nothing", func() {
+				config.SetTargetSafety(TargetSafetyReadOnly)
+				Expect(config.ConfigFile.TargetSafety).To(BeEmpty())
+			})
+		})
+	})
+
+	DescribeTable("ReadOnly",
+		func(envVal string, safety TargetSafety, expected bool) {
+			config.ENV.CFReadOnly = envVal
+			config.SetTargetSafety(safety)
+
+			Expect(config.ReadOnly()).To(Equal(expected))
+		},
+
+		Entry("CF_READ_ONLY is unset and the target is not read-only", "", TargetSafetyNone, false),
+		Entry("CF_READ_ONLY is true", "1", TargetSafetyNone, true),
+		Entry("CF_READ_ONLY is false and the target is not read-only", "false", TargetSafetyProtected, false),
+		Entry("CF_READ_ONLY is false and the target is read-only", "false", TargetSafetyReadOnly, true),
+		Entry("CF_READ_ONLY is invalid and the target is read-only", "maybe", TargetSafetyReadOnly, true),
+	)
+
+	Describe("ProtectedTarget", func() {
+		It("returns true when the target is protected", func() {
+			Expect(config.ProtectedTarget()).To(BeFalse())
+
+			config.SetTargetSafety(TargetSafetyProtected)
+			Expect(config.ProtectedTarget()).To(BeTrue())
+		})
+	})
+})
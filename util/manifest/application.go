@@ -9,12 +9,18 @@ import (
 
 type Application struct {
 	Buildpack types.FilteredString
-	Command   types.FilteredString
+	// Buildpacks are the buildpacks the application is staged with, in order.
+	// They cannot be combined with Buildpack.
+	Buildpacks []string
+	Command    types.FilteredString
 	// DiskQuota is the disk size in megabytes.
 	DiskQuota      types.NullByteSizeInMb
 	DockerImage    string
 	DockerUsername string
 	DockerPassword string
+	// Domains are the domains of the routes generated for the application
+	// when no routes are provided.
+	Domains []string
 	// EnvironmentVariables can be any valid json type (ie, strings not
 	// guaranteed, although CLI only ships strings).
 	EnvironmentVariables    map[string]string
@@ -23,13 +29,23 @@ type Application struct {
 	// for starting an application.
 	HealthCheckTimeout int
 	HealthCheckType    string
-	Instances          types.NullInt
+	// Hosts are the hostnames of the routes generated for the application
+	// when no routes are provided.
+	Hosts     []string
+	Instances types.NullInt
 	// Memory is the amount of memory in megabytes.
-	Memory    types.NullByteSizeInMb
-	Name      string
-	Domain    string
-	NoRoute   bool
-	Path      string
+	Memory     types.NullByteSizeInMb
+	Name       string
+	NoHostname bool
+	NoRoute    bool
+	Path       string
+	// Processes are the process types of the application other than the web
+	// process, which is described by the fields above.
+	Processes   []Process
+	RandomRoute bool
+	// RoutePath is the path of the generated routes. It can only be provided
+	// on the command line.
+	RoutePath string
 	Routes    []string
 	Services  []string
 	StackName string
@@ -37,10 +53,11 @@ type Application struct {
 
 func (app Application) String() string {
 	return fmt.Sprintf(
-		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Command IsSet: %t, Command: '%s', Disk Quota: '%s', Docker Image: '%s', Health Check HTTP Endpoint: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%s', No-route: %t, Path: '%s', Routes: [%s], Services: [%s], Stack Name: '%s'",
+		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Buildpacks: [%s], Command IsSet: %t, Command: '%s', Disk Quota: '%s', Docker Image: '%s', Health Check HTTP Endpoint: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%s', No-route: %t, Path: '%s', Routes: [%s], Services: [%s], Stack Name: '%s'",
 		app.Name,
 		app.Buildpack.IsSet,
 		app.Buildpack.Value,
+		strings.Join(app.Buildpacks, ", "),
 		app.Command.IsSet,
 		app.Command.Value,
 		app.DiskQuota,
@@ -62,14 +79,19 @@ func (app Application) String() string {
 func (app Application) MarshalYAML() (interface{}, error) {
 	var m = rawManifestApplication{
 		Buildpack:               app.Buildpack.Value,
+		Buildpacks:              app.Buildpacks,
 		Command:                 app.Command.Value,
 		Docker:                  rawDockerInfo{Image: app.DockerImage, Username: app.DockerUsername},
+		Domains:                 app.Domains,
 		EnvironmentVariables:    app.EnvironmentVariables,
 		HealthCheckHTTPEndpoint: app.HealthCheckHTTPEndpoint,
 		HealthCheckType:         app.HealthCheckType,
+		Hosts:                   app.Hosts,
 		Name:                    app.Name,
+		NoHostname:              app.NoHostname,
 		NoRoute:                 app.NoRoute,
 		Path:                    app.Path,
+		RandomRoute:             app.RandomRoute,
 		Services:                app.Services,
 		StackName:               app.StackName,
 		Timeout:                 app.HealthCheckTimeout,
@@ -81,6 +103,10 @@ func (app Application) MarshalYAML() (interface{}, error) {
 		m.Instances = &app.Instances.Value
 	}
 
+	for _, process := range app.Processes {
+		m.Processes = append(m.Processes, process.toRaw())
+	}
+
 	for _, route := range app.Routes {
 		m.Routes = append(m.Routes, rawManifestRoute{Route: route})
 	}
@@ -96,13 +122,18 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 		return err
 	}
 
+	app.Buildpacks = m.Buildpacks
 	app.DockerImage = m.Docker.Image
 	app.DockerUsername = m.Docker.Username
+	app.Domains = m.Domains
 	app.HealthCheckHTTPEndpoint = m.HealthCheckHTTPEndpoint
 	app.HealthCheckType = m.HealthCheckType
+	app.Hosts = m.Hosts
 	app.Name = m.Name
+	app.NoHostname = m.NoHostname
 	app.NoRoute = m.NoRoute
 	app.Path = m.Path
+	app.RandomRoute = m.RandomRoute
 	app.Services = m.Services
 	app.StackName = m.StackName
 	app.HealthCheckTimeout = m.Timeout
@@ -118,6 +149,18 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 		return fmtErr
 	}
 
+	for _, rawProcess := range m.Processes {
+		if rawProcess.Type == "" {
+			return ProcessTypeMissingError{AppName: m.Name}
+		}
+
+		var process Process
+		if fmtErr := process.fromRaw(rawProcess); fmtErr != nil {
+			return fmtErr
+		}
+		app.Processes = append(app.Processes, process)
+	}
+
 	for _, route := range m.Routes {
 		app.Routes = append(app.Routes, route.Route)
 	}
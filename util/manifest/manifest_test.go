@@ -68,6 +68,29 @@ applications:
 - name: "app-4"
   buildpack: null
   command: null
+- name: "app-5"
+  processes:
+  - type: worker
+    command: "some-worker-command"
+    instances: 2
+    memory: 512M
+    disk_quota: 1G
+    health-check-type: process
+  - type: clock
+    health-check-type: http
+    health-check-http-endpoint: /health
+- name: "app-6"
+  hosts:
+  - host-1
+  - host-2
+  domains:
+  - example.com
+  no-hostname: true
+  random-route: true
+- name: "app-7"
+  buildpacks:
+  - apm_buildpack
+  - ruby_buildpack
 `
 				tempFile, err := ioutil.TempFile("", "manifest-test-")
 				Expect(err).ToNot(HaveOccurred())
@@ -156,6 +179,47 @@ applications:
 							Value: "",
 						},
 					},
+					Application{
+						Name: "app-5",
+						Processes: []Process{
+							{
+								Type: "worker",
+								Command: types.FilteredString{
+									IsSet: true,
+									Value: "some-worker-command",
+								},
+								Instances: types.NullInt{
+									Value: 2,
+									IsSet: true,
+								},
+								Memory: types.NullByteSizeInMb{
+									Value: 512,
+									IsSet: true,
+								},
+								DiskQuota: types.NullByteSizeInMb{
+									Value: 1024,
+									IsSet: true,
+								},
+								HealthCheckType: "process",
+							},
+							{
+								Type:                    "clock",
+								HealthCheckType:         "http",
+								HealthCheckHTTPEndpoint: "/health",
+							},
+						},
+					},
+					Application{
+						Name:        "app-6",
+						Hosts:       []string{"host-1", "host-2"},
+						Domains:     []string{"example.com"},
+						NoHostname:  true,
+						RandomRoute: true,
+					},
+					Application{
+						Name:       "app-7",
+						Buildpacks: []string{"apm_buildpack", "ruby_buildpack"},
+					},
 				))
 			})
 		})
@@ -208,6 +272,29 @@ applications:
 				Entry("inheritance", "inherit", 1),
 			)
 		})
+
+		Context("when a process has no type", func() {
+			var pathToManifest string
+
+			BeforeEach(func() {
+				tempFile, err := ioutil.TempFile("", "manifest-test-")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFile.Close()).ToNot(HaveOccurred())
+				pathToManifest = tempFile.Name()
+
+				manifest := "---\napplications:\n- name: some-app\n  processes:\n  - command: some-command\n"
+				Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(pathToManifest)).ToNot(HaveOccurred())
+			})
+
+			It("returns a ProcessTypeMissingError", func() {
+				_, err := ReadAndMergeManifests(pathToManifest)
+				Expect(err).To(MatchError(ProcessTypeMissingError{AppName: "some-app"}))
+			})
+		})
 	})
 
 	Describe("WriteApplicationManifest", func() {
@@ -328,6 +415,45 @@ applications:
 			})
 		})
 
+		Context("when the app has processes", func() {
+			BeforeEach(func() {
+				application = Application{
+					Name: "app-1",
+					Processes: []Process{
+						{
+							Type: "worker",
+							Command: types.FilteredString{
+								IsSet: true,
+								Value: "some-worker-command",
+							},
+							Instances: types.NullInt{
+								Value: 0,
+								IsSet: true,
+							},
+							Memory: types.NullByteSizeInMb{
+								Value: 512,
+								IsSet: true,
+							},
+						},
+					},
+				}
+			})
+
+			It("saves the processes in the manifest", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				manifestBytes, err := ioutil.ReadFile(filePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  processes:
+  - type: worker
+    command: some-worker-command
+    instances: 0
+    memory: 512M
+`))
+			})
+		})
+
 		Context("when the file is a relative path", func() {
 			var pwd string
 
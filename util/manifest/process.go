@@ -0,0 +1,49 @@
+package manifest
+
+import "code.cloudfoundry.org/cli/types"
+
+// Process is a process type of an application, such as a worker or a clock,
+// declared in the processes section of the application.
+type Process struct {
+	Type    string
+	Command types.FilteredString
+	// DiskQuota is the disk size in megabytes.
+	DiskQuota               types.NullByteSizeInMb
+	HealthCheckHTTPEndpoint string
+	HealthCheckType         string
+	Instances               types.NullInt
+	// Memory is the amount of memory in megabytes.
+	Memory types.NullByteSizeInMb
+}
+
+func (process Process) toRaw() rawManifestProcess {
+	m := rawManifestProcess{
+		Type:                    process.Type,
+		Command:                 process.Command.Value,
+		HealthCheckHTTPEndpoint: process.HealthCheckHTTPEndpoint,
+		HealthCheckType:         process.HealthCheckType,
+	}
+	m.DiskQuota = process.DiskQuota.String()
+	m.Memory = process.Memory.String()
+
+	if process.Instances.IsSet {
+		m.Instances = &process.Instances.Value
+	}
+
+	return m
+}
+
+func (process *Process) fromRaw(m rawManifestProcess) error {
+	process.Type = m.Type
+	process.Command.ParseValue(m.Command)
+	process.HealthCheckHTTPEndpoint = m.HealthCheckHTTPEndpoint
+	process.HealthCheckType = m.HealthCheckType
+
+	process.Instances.ParseIntValue(m.Instances)
+
+	if fmtErr := process.DiskQuota.ParseStringValue(m.DiskQuota); fmtErr != nil {
+		return fmtErr
+	}
+
+	return process.Memory.ParseStringValue(m.Memory)
+}
@@ -0,0 +1,13 @@
+package manifest
+
+import "fmt"
+
+// ProcessTypeMissingError is returned when a process in the processes section
+// of an application has no type.
+type ProcessTypeMissingError struct {
+	AppName string
+}
+
+func (e ProcessTypeMissingError) Error() string {
+	return fmt.Sprintf("Process type missing for a process of application %s", e.AppName)
+}
@@ -1,22 +1,38 @@
 package manifest
 
 type rawManifestApplication struct {
-	Name                    string             `yaml:"name,omitempty"`
-	Buildpack               string             `yaml:"buildpack,omitempty"`
-	Command                 string             `yaml:"command,omitempty"`
-	DiskQuota               string             `yaml:"disk_quota,omitempty"`
-	Docker                  rawDockerInfo      `yaml:"docker,omitempty"`
-	EnvironmentVariables    map[string]string  `yaml:"env,omitempty"`
-	HealthCheckHTTPEndpoint string             `yaml:"health-check-http-endpoint,omitempty"`
-	HealthCheckType         string             `yaml:"health-check-type,omitempty"`
-	Instances               *int               `yaml:"instances,omitempty"`
-	Memory                  string             `yaml:"memory,omitempty"`
-	NoRoute                 bool               `yaml:"no-route,omitempty"`
-	Path                    string             `yaml:"path,omitempty"`
-	Routes                  []rawManifestRoute `yaml:"routes,omitempty"`
-	Services                []string           `yaml:"services,omitempty"`
-	StackName               string             `yaml:"stack,omitempty"`
-	Timeout                 int                `yaml:"timeout,omitempty"`
+	Name                    string               `yaml:"name,omitempty"`
+	Buildpack               string               `yaml:"buildpack,omitempty"`
+	Buildpacks              []string             `yaml:"buildpacks,omitempty"`
+	Command                 string               `yaml:"command,omitempty"`
+	DiskQuota               string               `yaml:"disk_quota,omitempty" manifest:"bytesize"`
+	Docker                  rawDockerInfo        `yaml:"docker,omitempty"`
+	Domains                 []string             `yaml:"domains,omitempty"`
+	EnvironmentVariables    map[string]string    `yaml:"env,omitempty"`
+	HealthCheckHTTPEndpoint string               `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckType         string               `yaml:"health-check-type,omitempty"`
+	Hosts                   []string             `yaml:"hosts,omitempty"`
+	Instances               *int                 `yaml:"instances,omitempty"`
+	Memory                  string               `yaml:"memory,omitempty" manifest:"bytesize"`
+	NoHostname              bool                 `yaml:"no-hostname,omitempty"`
+	NoRoute                 bool                 `yaml:"no-route,omitempty"`
+	Path                    string               `yaml:"path,omitempty"`
+	Processes               []rawManifestProcess `yaml:"processes,omitempty"`
+	RandomRoute             bool                 `yaml:"random-route,omitempty"`
+	Routes                  []rawManifestRoute   `yaml:"routes,omitempty"`
+	Services                []string             `yaml:"services,omitempty"`
+	StackName               string               `yaml:"stack,omitempty"`
+	Timeout                 int                  `yaml:"timeout,omitempty"`
+}
+
+type rawManifestProcess struct {
+	Type                    string `yaml:"type"`
+	Command                 string `yaml:"command,omitempty"`
+	DiskQuota               string `yaml:"disk_quota,omitempty" manifest:"bytesize"`
+	HealthCheckHTTPEndpoint string `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckType         string `yaml:"health-check-type,omitempty"`
+	Instances               *int   `yaml:"instances,omitempty"`
+	Memory                  string `yaml:"memory,omitempty" manifest:"bytesize"`
 }
 
 type rawManifestRoute struct {
@@ -0,0 +1,94 @@
+package manifest
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+const (
+	jsonSchemaVersion = "http://json-schema.org/draft-04/schema#"
+	byteSizePattern   = `^\s*[0-9]+(\.[0-9]+)?([KkMmGgTt][Bb]?|[Bb])\s*$`
+)
+
+// Schema is a JSON Schema describing the structure of a manifest. It is
+// generated from the YAML tags of the manifest application so that the
+// validator and editors agree with what push reads.
+type Schema struct {
+	SchemaVersion        string             `json:"$schema,omitempty"`
+	Type                 string             `json:"type"`
+	Description          string             `json:"description,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+
+	byteSize bool
+}
+
+// ManifestSchema returns the schema of a manifest file.
+func ManifestSchema() *Schema {
+	application := schemaForType(reflect.TypeOf(rawManifestApplication{}))
+	application.Required = append([]string{"name"}, application.Required...)
+
+	return &Schema{
+		SchemaVersion: jsonSchemaVersion,
+		Type:          "object",
+		Properties: map[string]*Schema{
+			"applications": {Type: "array", Items: application},
+		},
+		AdditionalProperties: false,
+	}
+}
+
+// JSONSchema returns the schema of a manifest file encoded as JSON, for use
+// with editors that support JSON Schema.
+func JSONSchema() ([]byte, error) {
+	return json.MarshalIndent(ManifestSchema(), "", "  ")
+}
+
+func schemaForType(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		schema := &Schema{
+			Type:                 "object",
+			Properties:           map[string]*Schema{},
+			AdditionalProperties: false,
+		}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := strings.Split(field.Tag.Get("yaml"), ",")
+			if tag[0] == "" || tag[0] == "-" {
+				continue
+			}
+
+			property := schemaForType(field.Type)
+			if field.Tag.Get("manifest") == "bytesize" {
+				property.Pattern = byteSizePattern
+				property.Description = "A size with a unit, such as 256M or 1G"
+				property.byteSize = true
+			}
+			schema.Properties[tag[0]] = property
+
+			if len(tag) == 1 {
+				schema.Required = append(schema.Required, tag[0])
+			}
+		}
+		return schema
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: schemaForType(t.Elem())}
+	case reflect.Slice:
+		return &Schema{Type: "array", Items: schemaForType(t.Elem())}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
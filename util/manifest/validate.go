@@ -0,0 +1,413 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry/bytefmt"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Diagnostic is a problem found in a manifest, located by its line and column
+// in the manifest file.
+type Diagnostic struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d:%d: %s", d.Line, d.Column, d.Message)
+}
+
+var yamlErrorLineRegexp = regexp.MustCompile(`^yaml: (?:line (\d+): )?`)
+
+// ValidateManifest checks the manifest at the provided path against the
+// manifest schema and returns the problems found, ordered by their position
+// in the file. Unknown properties, type mismatches, invalid byte sizes and
+// properties that cannot be used together are reported. An error is only
+// returned when the manifest cannot be read.
+func ValidateManifest(pathToManifest string) ([]Diagnostic, error) {
+	raw, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var document interface{}
+	err = yaml.Unmarshal(raw, &document)
+	if err != nil {
+		// yaml.v2 reports the zero based line of syntax errors and no column.
+		line := 1
+		if matches := yamlErrorLineRegexp.FindStringSubmatch(err.Error()); matches != nil && matches[1] != "" {
+			line, _ = strconv.Atoi(matches[1])
+			line++
+		}
+		return []Diagnostic{{Line: line, Column: 1, Message: yamlErrorLineRegexp.ReplaceAllString(err.Error(), "")}}, nil
+	}
+
+	validator := manifestValidator{positions: indexPositions(raw)}
+	if document != nil {
+		validator.validateTopLevel(document)
+	}
+
+	sort.SliceStable(validator.diagnostics, func(i int, j int) bool {
+		if validator.diagnostics[i].Line != validator.diagnostics[j].Line {
+			return validator.diagnostics[i].Line < validator.diagnostics[j].Line
+		}
+		return validator.diagnostics[i].Column < validator.diagnostics[j].Column
+	})
+	return validator.diagnostics, nil
+}
+
+type position struct {
+	line   int
+	column int
+}
+
+type manifestValidator struct {
+	positions   map[string]position
+	diagnostics []Diagnostic
+}
+
+func (v *manifestValidator) report(path string, format string, args ...interface{}) {
+	pos := v.position(path)
+	v.diagnostics = append(v.diagnostics, Diagnostic{
+		Line:    pos.line,
+		Column:  pos.column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// position returns the position of the path in the manifest, falling back to
+// the closest ancestor when the path could not be located, for example inside
+// flow style collections.
+func (v *manifestValidator) position(path string) position {
+	for path != "" {
+		if pos, ok := v.positions[path]; ok {
+			return pos
+		}
+		path = parentPath(path)
+	}
+	return position{line: 1, column: 1}
+}
+
+func (v *manifestValidator) validateTopLevel(document interface{}) {
+	topLevel, ok := document.(map[interface{}]interface{})
+	if !ok {
+		v.report("", "manifest must be a map with an applications list")
+		return
+	}
+
+	deprecatedFields := yamlKeys(reflect.TypeOf(rawManifest{}))
+	schema := ManifestSchema()
+	for _, key := range sortedKeys(topLevel) {
+		switch {
+		case schema.Properties[key] != nil:
+			v.validate(key, topLevel[key], schema.Properties[key])
+		case deprecatedFields[key]:
+			v.report(key, "global property %q is not supported, set it on each application instead", key)
+		default:
+			v.report(key, "unknown property %q", key)
+		}
+	}
+
+	applications, ok := topLevel["applications"].([]interface{})
+	if !ok {
+		return
+	}
+
+	seenNames := map[string]bool{}
+	for i, application := range applications {
+		path := fmt.Sprintf("applications[%d]", i)
+		properties, ok := application.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		v.checkConflicts(path, properties)
+
+		if name, ok := properties["name"]; ok && name != nil {
+			nameString := fmt.Sprint(name)
+			if seenNames[nameString] {
+				v.report(path+".name", "application %q is declared more than once", nameString)
+			}
+			seenNames[nameString] = true
+		}
+	}
+}
+
+func (v *manifestValidator) validate(path string, value interface{}, schema *Schema) {
+	if value == nil {
+		return
+	}
+
+	switch schema.Type {
+	case "object":
+		properties, ok := value.(map[interface{}]interface{})
+		if !ok {
+			v.report(path, "property %q must be a map", lastPathElement(path))
+			return
+		}
+
+		for _, key := range sortedKeys(properties) {
+			keyPath := path + "." + key
+			if property, ok := schema.Properties[key]; ok {
+				v.validate(keyPath, properties[key], property)
+			} else if additional, ok := schema.AdditionalProperties.(*Schema); ok {
+				v.validate(keyPath, properties[key], additional)
+			} else {
+				v.report(keyPath, "unknown property %q", key)
+			}
+		}
+
+		for _, required := range schema.Required {
+			if properties[required] == nil {
+				v.report(path, "missing required property %q", required)
+			}
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			v.report(path, "property %q must be a list", lastPathElement(path))
+			return
+		}
+
+		for i, item := range items {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), item, schema.Items)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.report(path, "property %q must be true or false", lastPathElement(path))
+		}
+	case "integer":
+		if !isInteger(value) {
+			v.report(path, "property %q must be an integer", lastPathElement(path))
+		}
+	case "string":
+		// Push reads any scalar as a string, so numbers and booleans are
+		// accepted here as well.
+		switch value.(type) {
+		case map[interface{}]interface{}, []interface{}:
+			v.report(path, "property %q must be a string", lastPathElement(path))
+			return
+		}
+
+		if schema.byteSize {
+			if _, err := bytefmt.ToMegabytes(fmt.Sprint(value)); err != nil {
+				v.report(path, "invalid byte size %q for property %q, use a number followed by a unit such as 256M or 1G", fmt.Sprint(value), lastPathElement(path))
+			}
+		}
+	}
+}
+
+func (v *manifestValidator) checkConflicts(path string, application map[interface{}]interface{}) {
+	var dockerImage, dockerUsername interface{}
+	if docker, ok := application["docker"].(map[interface{}]interface{}); ok {
+		dockerImage = docker["image"]
+		dockerUsername = docker["username"]
+	}
+
+	if dockerImage != nil {
+		if application["buildpack"] != nil {
+			v.reportCombination(path+".docker", "docker", "buildpack")
+		}
+		if application["buildpacks"] != nil {
+			v.reportCombination(path+".docker", "docker", "buildpacks")
+		}
+		if application["path"] != nil {
+			v.reportCombination(path+".docker", "docker", "path")
+		}
+	} else if dockerUsername != nil {
+		v.report(path+".docker.username", "property \"docker.username\" requires \"docker.image\"")
+	}
+
+	if application["buildpack"] != nil && application["buildpacks"] != nil {
+		v.reportCombination(path+".buildpacks", "buildpack", "buildpacks")
+	}
+
+	if noRoute, _ := application["no-route"].(bool); noRoute && application["routes"] != nil {
+		v.reportCombination(path+".no-route", "no-route", "routes")
+	}
+
+	if application["routes"] != nil {
+		for _, property := range []string{"domains", "hosts", "no-hostname", "random-route"} {
+			if application[property] != nil {
+				v.reportCombination(path+"."+property, "routes", property)
+			}
+		}
+	}
+
+	if randomRoute, _ := application["random-route"].(bool); randomRoute {
+		for _, property := range []string{"hosts", "no-hostname"} {
+			if value := application[property]; value != nil && value != false {
+				v.reportCombination(path+"."+property, "random-route", property)
+			}
+		}
+	}
+
+	healthCheckType, hasType := application["health-check-type"].(string)
+	endpoint, hasEndpoint := application["health-check-http-endpoint"].(string)
+	if hasType && hasEndpoint && healthCheckType != "http" && endpoint != "" && endpoint != "/" {
+		v.report(path+".health-check-http-endpoint", "property \"health-check-http-endpoint\" can only be used with the http health check type")
+	}
+}
+
+func (v *manifestValidator) reportCombination(path string, properties ...string) {
+	v.report(path, "Cannot use the following properties together: %s", strings.Join(properties, ", "))
+}
+
+func isInteger(value interface{}) bool {
+	switch number := value.(type) {
+	case int, int64, uint64:
+		return true
+	case float64:
+		return number == math.Trunc(number)
+	default:
+		return false
+	}
+}
+
+func yamlKeys(t reflect.Type) map[string]bool {
+	keys := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+	return keys
+}
+
+func sortedKeys(m map[interface{}]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, fmt.Sprint(key))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func parentPath(path string) string {
+	index := strings.LastIndexAny(path, ".[")
+	if index < 0 {
+		return ""
+	}
+	return path[:index]
+}
+
+func lastPathElement(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+type indexEntry struct {
+	column    int
+	path      string
+	isItem    bool
+	nextIndex int
+}
+
+// indexPositions maps the path of every block style key and list item in the
+// YAML document to its position. yaml.v2 does not expose node positions, so
+// the document is scanned by indentation. Paths look like
+// "applications[0].routes[1].route".
+func indexPositions(raw []byte) map[string]position {
+	positions := map[string]position{}
+	var stack []*indexEntry
+	blockScalarColumn := -1
+
+	for lineIndex, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		content := strings.TrimLeft(line, " ")
+		column := len(line) - len(content)
+
+		if blockScalarColumn >= 0 {
+			if content == "" || column > blockScalarColumn {
+				continue
+			}
+			blockScalarColumn = -1
+		}
+
+		if content == "" || strings.HasPrefix(content, "#") || strings.HasPrefix(content, "---") || strings.HasPrefix(content, "...") {
+			continue
+		}
+
+		for content != "" {
+			if content == "-" || strings.HasPrefix(content, "- ") {
+				for len(stack) > 0 {
+					top := stack[len(stack)-1]
+					if top.column < column || (top.column == column && !top.isItem) {
+						break
+					}
+					stack = stack[:len(stack)-1]
+				}
+
+				path := "[0]"
+				if len(stack) > 0 {
+					parent := stack[len(stack)-1]
+					path = fmt.Sprintf("%s[%d]", parent.path, parent.nextIndex)
+					parent.nextIndex++
+				}
+				positions[path] = position{line: lineIndex + 1, column: column + 1}
+				stack = append(stack, &indexEntry{column: column, path: path, isItem: true})
+
+				rest := strings.TrimLeft(content[1:], " ")
+				column += len(content) - len(rest)
+				content = rest
+				continue
+			}
+
+			key, value, ok := splitKey(content)
+			if !ok {
+				break
+			}
+
+			for len(stack) > 0 && stack[len(stack)-1].column >= column {
+				stack = stack[:len(stack)-1]
+			}
+
+			path := key
+			if len(stack) > 0 {
+				path = stack[len(stack)-1].path + "." + key
+			}
+			positions[path] = position{line: lineIndex + 1, column: column + 1}
+			stack = append(stack, &indexEntry{column: column, path: path})
+
+			if strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+				blockScalarColumn = column
+			}
+			break
+		}
+	}
+
+	return positions
+}
+
+// splitKey splits a "key: value" line into its key and value.
+func splitKey(content string) (string, string, bool) {
+	if strings.HasPrefix(content, `"`) || strings.HasPrefix(content, "'") {
+		end := strings.Index(content[1:], content[:1])
+		if end < 0 {
+			return "", "", false
+		}
+		rest := content[end+2:]
+		if rest != ":" && !strings.HasPrefix(rest, ": ") {
+			return "", "", false
+		}
+		return content[1 : end+1], strings.TrimSpace(rest[1:]), true
+	}
+
+	if strings.HasSuffix(content, ":") && !strings.Contains(content, ": ") {
+		return content[:len(content)-1], "", true
+	}
+
+	index := strings.Index(content, ": ")
+	if index < 0 {
+		return "", "", false
+	}
+	return content[:index], strings.TrimSpace(content[index+2:]), true
+}
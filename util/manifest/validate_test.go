@@ -0,0 +1,286 @@
+package manifest_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	Describe("ValidateManifest", func() {
+		var (
+			pathToManifest string
+			manifest       string
+			diagnostics    []Diagnostic
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			tempFile, err := ioutil.TempFile("", "manifest-test-")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tempFile.Close()).To(Succeed())
+			pathToManifest = tempFile.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(pathToManifest)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)).To(Succeed())
+			diagnostics, executeErr = ValidateManifest(pathToManifest)
+		})
+
+		Context("when the manifest is valid", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  memory: 256M
+  disk_quota: 1G
+  instances: 2
+  command: |
+    bundle exec: rackup
+  env:
+    SOME_NUMBER: 1
+  routes:
+  - route: example.com
+  processes:
+  - type: worker
+    memory: 128M
+- name: app-2
+  docker:
+    image: some-image
+    username: some-user
+  no-route: true
+`
+			})
+
+			It("returns no diagnostics", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diagnostics).To(BeEmpty())
+			})
+		})
+
+		Context("when the manifest has unknown properties and type mismatches", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  memroy: 256M
+  instances: two
+  routes: example.com
+  processes:
+  - type: worker
+    memory: lots
+  env:
+    SOME_LIST: [a, b]
+- name: app-2
+  no-route: "yes please"
+`
+			})
+
+			It("returns a diagnostic for each problem with its position", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 4, Column: 3, Message: `unknown property "memroy"`},
+					{Line: 5, Column: 3, Message: `property "instances" must be an integer`},
+					{Line: 6, Column: 3, Message: `property "routes" must be a list`},
+					{Line: 9, Column: 5, Message: `invalid byte size "lots" for property "memory", use a number followed by a unit such as 256M or 1G`},
+					{Line: 11, Column: 5, Message: `property "SOME_LIST" must be a string`},
+					{Line: 13, Column: 3, Message: `property "no-route" must be true or false`},
+				}))
+			})
+		})
+
+		Context("when required properties are missing", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- memory: 256M
+  processes:
+  - instances: 2
+`
+			})
+
+			It("reports them at the position of the enclosing item", func() {
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 3, Column: 1, Message: `missing required property "name"`},
+					{Line: 5, Column: 3, Message: `missing required property "type"`},
+				}))
+			})
+		})
+
+		Context("when properties conflict", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  buildpack: ruby_buildpack
+  docker:
+    image: some-image
+- name: app-2
+  no-route: true
+  routes:
+  - route: example.com
+- name: app-2
+  health-check-type: port
+  health-check-http-endpoint: /health
+  docker:
+    username: some-user
+`
+			})
+
+			It("reports each conflict", func() {
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 5, Column: 3, Message: "Cannot use the following properties together: docker, buildpack"},
+					{Line: 8, Column: 3, Message: "Cannot use the following properties together: no-route, routes"},
+					{Line: 11, Column: 3, Message: `application "app-2" is declared more than once`},
+					{Line: 13, Column: 3, Message: `property "health-check-http-endpoint" can only be used with the http health check type`},
+					{Line: 15, Column: 5, Message: `property "docker.username" requires "docker.image"`},
+				}))
+			})
+		})
+
+		Context("when routes are combined with route generation properties", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  routes:
+  - route: example.com
+  hosts:
+  - some-host
+  random-route: true
+`
+			})
+
+			It("reports each combination", func() {
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 6, Column: 3, Message: "Cannot use the following properties together: routes, hosts"},
+					{Line: 6, Column: 3, Message: "Cannot use the following properties together: random-route, hosts"},
+					{Line: 8, Column: 3, Message: "Cannot use the following properties together: routes, random-route"},
+				}))
+			})
+		})
+
+		Context("when random-route is combined with host properties", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  random-route: true
+  hosts:
+  - some-host
+- name: app-2
+  random-route: true
+  no-hostname: true
+- name: app-3
+  random-route: true
+  no-hostname: false
+- name: app-4
+  random-route: false
+  hosts:
+  - some-host
+`
+			})
+
+			It("reports each combination", func() {
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 5, Column: 3, Message: "Cannot use the following properties together: random-route, hosts"},
+					{Line: 9, Column: 3, Message: "Cannot use the following properties together: random-route, no-hostname"},
+				}))
+			})
+		})
+
+		Context("when buildpack and buildpacks are both provided", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  buildpack: ruby_buildpack
+  buildpacks:
+  - apm_buildpack
+  - ruby_buildpack
+`
+			})
+
+			It("reports the combination", func() {
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 5, Column: 3, Message: "Cannot use the following properties together: buildpack, buildpacks"},
+				}))
+			})
+		})
+
+		Context("when the manifest uses global properties", func() {
+			BeforeEach(func() {
+				manifest = `---
+memory: 256M
+applications:
+- name: app-1
+`
+			})
+
+			It("reports that they are not supported", func() {
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 2, Column: 1, Message: `global property "memory" is not supported, set it on each application instead`},
+				}))
+			})
+		})
+
+		Context("when the manifest is not valid YAML", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+   memory: 256M
+`
+			})
+
+			It("returns the parse error with its line", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diagnostics).To(Equal([]Diagnostic{
+					{Line: 4, Column: 1, Message: "mapping values are not allowed in this context"},
+				}))
+			})
+		})
+
+		Context("when the manifest does not exist", func() {
+			JustBeforeEach(func() {
+				diagnostics, executeErr = ValidateManifest("/does/not/exist/manifest.yml")
+			})
+
+			It("returns an error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("JSONSchema", func() {
+		It("describes the manifest application properties", func() {
+			rawSchema, err := JSONSchema()
+			Expect(err).ToNot(HaveOccurred())
+
+			var schema map[string]interface{}
+			Expect(json.Unmarshal(rawSchema, &schema)).To(Succeed())
+			Expect(schema["$schema"]).To(Equal("http://json-schema.org/draft-04/schema#"))
+			Expect(schema["additionalProperties"]).To(BeFalse())
+
+			application := schema["properties"].(map[string]interface{})["applications"].(map[string]interface{})["items"].(map[string]interface{})
+			Expect(application["required"]).To(ConsistOf("name"))
+			Expect(application["additionalProperties"]).To(BeFalse())
+
+			properties := application["properties"].(map[string]interface{})
+			Expect(properties["instances"]).To(HaveKeyWithValue("type", "integer"))
+			Expect(properties["no-route"]).To(HaveKeyWithValue("type", "boolean"))
+			Expect(properties["memory"]).To(HaveKey("pattern"))
+			Expect(properties["env"]).To(HaveKeyWithValue("additionalProperties", HaveKeyWithValue("type", "string")))
+			Expect(properties["routes"]).To(HaveKeyWithValue("items", HaveKeyWithValue("required", ConsistOf("route"))))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// Package targetsafety holds the checks shared by the read-only and protected
+// target safety settings of both the cf and the command clients.
+package targetsafety
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ReadOnlySafety is the target safety setting that rejects requests that
+	// could modify resources.
+	ReadOnlySafety = "read-only"
+	// ProtectedSafety is the target safety setting that asks for confirmation
+	// before the first request that could modify resources.
+	ProtectedSafety = "protected"
+
+	// ConfirmPrompt asks the user to type the Expected org name before
+	// changing the protected Target.
+	ConfirmPrompt = "{{.Target}} is a protected target. Type {{.Expected}} to confirm the change"
+)
+
+// nonMutatingRequests are the Cloud Controller endpoints that use a mutating
+// method to send a query, keyed by path.
+var nonMutatingRequests = map[string]string{
+	"/v2/resource_match":   http.MethodPut,
+	"/v3/resource_matches": http.MethodPost,
+}
+
+// IsMutatingRequest returns true for POST, PUT, PATCH and DELETE requests,
+// unless the endpoint only queries the Cloud Controller.
+func IsMutatingRequest(method string, path string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return nonMutatingRequests[strings.TrimSuffix(path, "/")] != method
+	}
+	return false
+}
+
+// ReadOnly returns true if requests that could modify resources must not be
+// sent, based off of:
+//   - The $CF_READ_ONLY environment variable set to true/t/1
+//   - The target safety setting being read-only
+func ReadOnly(envReadOnly string, safety string) bool {
+	if envReadOnly != "" {
+		envVal, err := strconv.ParseBool(envReadOnly)
+		if err == nil && envVal {
+			return true
+		}
+	}
+
+	return safety == ReadOnlySafety
+}
@@ -0,0 +1,13 @@
+package targetsafety_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTargetSafety(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Target Safety Suite")
+}
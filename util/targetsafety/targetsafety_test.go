@@ -0,0 +1,43 @@
+package targetsafety_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/targetsafety"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Target Safety", func() {
+	DescribeTable("IsMutatingRequest",
+		func(method string, path string, expected bool) {
+			Expect(IsMutatingRequest(method, path)).To(Equal(expected))
+		},
+
+		Entry("GET", "GET", "/v2/apps", false),
+		Entry("HEAD", "HEAD", "/v3/droplets/some-guid/download", false),
+		Entry("POST", "POST", "/v2/apps", true),
+		Entry("PUT", "PUT", "/v2/apps/some-guid", true),
+		Entry("PATCH", "PATCH", "/v3/apps/some-guid", true),
+		Entry("DELETE", "DELETE", "/v2/apps/some-guid", true),
+		Entry("V2 resource matching", "PUT", "/v2/resource_match", false),
+		Entry("V2 resource matching with a trailing slash", "PUT", "/v2/resource_match/", false),
+		Entry("V3 resource matching", "POST", "/v3/resource_matches", false),
+		Entry("resource matching with another method", "DELETE", "/v2/resource_match", true),
+	)
+
+	DescribeTable("ReadOnly",
+		func(envReadOnly string, safety string, expected bool) {
+			Expect(ReadOnly(envReadOnly, safety)).To(Equal(expected))
+		},
+
+		Entry("nothing set", "", "", false),
+		Entry("env true", "true", "", true),
+		Entry("env 1", "1", "protected", true),
+		Entry("env false", "false", "", false),
+		Entry("env invalid", "banana", "", false),
+		Entry("read-only target", "", "read-only", true),
+		Entry("env false and read-only target", "false", "read-only", true),
+		Entry("protected target", "", "protected", false),
+	)
+})
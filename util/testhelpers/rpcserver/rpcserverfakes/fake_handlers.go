@@ -70,6 +70,30 @@ type FakeHandlers struct {
 	getOutputAndResetReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StartCoreCommandStub        func(args []string, retVal *int) error
+	startCoreCommandMutex       sync.RWMutex
+	startCoreCommandArgsForCall []struct {
+		args   []string
+		retVal *int
+	}
+	startCoreCommandReturns struct {
+		result1 error
+	}
+	startCoreCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStreamingOutputStub        func(args int, retVal *plugin_models.CommandOutputBatch) error
+	getStreamingOutputMutex       sync.RWMutex
+	getStreamingOutputArgsForCall []struct {
+		args   int
+		retVal *plugin_models.CommandOutputBatch
+	}
+	getStreamingOutputReturns struct {
+		result1 error
+	}
+	getStreamingOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetCurrentOrgStub        func(args string, retVal *plugin_models.Organization) error
 	getCurrentOrgMutex       sync.RWMutex
 	getCurrentOrgArgsForCall []struct {
@@ -624,6 +648,109 @@ func (fake *FakeHandlers) GetOutputAndResetReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) StartCoreCommand(args []string, retVal *int) error {
+	var argsCopy []string
+	if args != nil {
+		argsCopy = make([]string, len(args))
+		copy(argsCopy, args)
+	}
+	fake.startCoreCommandMutex.Lock()
+	ret, specificReturn := fake.startCoreCommandReturnsOnCall[len(fake.startCoreCommandArgsForCall)]
+	fake.startCoreCommandArgsForCall = append(fake.startCoreCommandArgsForCall, struct {
+		args   []string
+		retVal *int
+	}{argsCopy, retVal})
+	fake.recordInvocation("StartCoreCommand", []interface{}{argsCopy, retVal})
+	fake.startCoreCommandMutex.Unlock()
+	if fake.StartCoreCommandStub != nil {
+		return fake.StartCoreCommandStub(args, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.startCoreCommandReturns.result1
+}
+
+func (fake *FakeHandlers) StartCoreCommandCallCount() int {
+	fake.startCoreCommandMutex.RLock()
+	defer fake.startCoreCommandMutex.RUnlock()
+	return len(fake.startCoreCommandArgsForCall)
+}
+
+func (fake *FakeHandlers) StartCoreCommandArgsForCall(i int) ([]string, *int) {
+	fake.startCoreCommandMutex.RLock()
+	defer fake.startCoreCommandMutex.RUnlock()
+	return fake.startCoreCommandArgsForCall[i].args, fake.startCoreCommandArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) StartCoreCommandReturns(result1 error) {
+	fake.StartCoreCommandStub = nil
+	fake.startCoreCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) StartCoreCommandReturnsOnCall(i int, result1 error) {
+	fake.StartCoreCommandStub = nil
+	if fake.startCoreCommandReturnsOnCall == nil {
+		fake.startCoreCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startCoreCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetStreamingOutput(args int, retVal *plugin_models.CommandOutputBatch) error {
+	fake.getStreamingOutputMutex.Lock()
+	ret, specificReturn := fake.getStreamingOutputReturnsOnCall[len(fake.getStreamingOutputArgsForCall)]
+	fake.getStreamingOutputArgsForCall = append(fake.getStreamingOutputArgsForCall, struct {
+		args   int
+		retVal *plugin_models.CommandOutputBatch
+	}{args, retVal})
+	fake.recordInvocation("GetStreamingOutput", []interface{}{args, retVal})
+	fake.getStreamingOutputMutex.Unlock()
+	if fake.GetStreamingOutputStub != nil {
+		return fake.GetStreamingOutputStub(args, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getStreamingOutputReturns.result1
+}
+
+func (fake *FakeHandlers) GetStreamingOutputCallCount() int {
+	fake.getStreamingOutputMutex.RLock()
+	defer fake.getStreamingOutputMutex.RUnlock()
+	return len(fake.getStreamingOutputArgsForCall)
+}
+
+func (fake *FakeHandlers) GetStreamingOutputArgsForCall(i int) (int, *plugin_models.CommandOutputBatch) {
+	fake.getStreamingOutputMutex.RLock()
+	defer fake.getStreamingOutputMutex.RUnlock()
+	return fake.getStreamingOutputArgsForCall[i].args, fake.getStreamingOutputArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetStreamingOutputReturns(result1 error) {
+	fake.GetStreamingOutputStub = nil
+	fake.getStreamingOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetStreamingOutputReturnsOnCall(i int, result1 error) {
+	fake.GetStreamingOutputStub = nil
+	if fake.getStreamingOutputReturnsOnCall == nil {
+		fake.getStreamingOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getStreamingOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetCurrentOrg(args string, retVal *plugin_models.Organization) error {
 	fake.getCurrentOrgMutex.Lock()
 	ret, specificReturn := fake.getCurrentOrgReturnsOnCall[len(fake.getCurrentOrgArgsForCall)]
@@ -1872,6 +1999,10 @@ func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	defer fake.callCoreCommandMutex.RUnlock()
 	fake.getOutputAndResetMutex.RLock()
 	defer fake.getOutputAndResetMutex.RUnlock()
+	fake.startCoreCommandMutex.RLock()
+	defer fake.startCoreCommandMutex.RUnlock()
+	fake.getStreamingOutputMutex.RLock()
+	defer fake.getStreamingOutputMutex.RUnlock()
 	fake.getCurrentOrgMutex.RLock()
 	defer fake.getCurrentOrgMutex.RUnlock()
 	fake.getCurrentSpaceMutex.RLock()
@@ -21,6 +21,8 @@ type Handlers interface {
 	DisableTerminalOutput(disable bool, retVal *bool) error
 	CallCoreCommand(args []string, retVal *bool) error
 	GetOutputAndReset(args bool, retVal *[]string) error
+	StartCoreCommand(args []string, retVal *int) error
+	GetStreamingOutput(args int, retVal *plugin_models.CommandOutputBatch) error
 	GetCurrentOrg(args string, retVal *plugin_models.Organization) error
 	GetCurrentSpace(args string, retVal *plugin_models.Space) error
 	Username(args string, retVal *string) error
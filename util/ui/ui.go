@@ -159,6 +159,20 @@ func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[strin
 	return string(password), err
 }
 
+// DisplayTextPrompt outputs the prompt and waits for user input. It requires a
+// non-empty response.
+func (ui *UI) DisplayTextPrompt(template string, templateValues ...map[string]interface{}) (string, error) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	var response string
+	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
+	interactivePrompt.Input = ui.In
+	interactivePrompt.Output = ui.Out
+	err := interactivePrompt.Resolve(interact.Required(&response))
+	return response, err
+}
+
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
 // to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
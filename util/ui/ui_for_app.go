@@ -8,10 +8,10 @@ import (
 
 func (ui *UI) DisplayInstancesTableForApp(table [][]string) {
 	redColor := color.New(color.FgRed, color.Bold)
-	trDown, trCrashed := ui.TranslateText("down"), ui.TranslateText("crashed")
+	trDown, trCrashed, trFlapping := ui.TranslateText("down"), ui.TranslateText("crashed"), ui.TranslateText("flapping")
 
 	for i, row := range table {
-		if row[1] == trDown || row[1] == trCrashed {
+		if row[1] == trDown || row[1] == trCrashed || row[1] == trFlapping {
 			table[i][1] = ui.modifyColor(row[1], redColor)
 		}
 	}
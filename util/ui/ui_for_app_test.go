@@ -82,18 +82,20 @@ var _ = Describe("UI", func() {
 
 	Describe("DisplayInstancesTableForApp", func() {
 		Context("in english", func() {
-			It("displays a table with red coloring for down and crashed", func() {
+			It("displays a table with red coloring for down, crashed and flapping", func() {
 				ui.DisplayInstancesTableForApp([][]string{
 					{"", "header1", "header2", "header3"},
 					{"#0", "starting", "val1", "val2"},
 					{"#1", "down", "val1", "val2"},
 					{"#2", "crashed", "val1", "val2"},
+					{"#3", "flapping", "val1", "val2"},
 				})
 
 				Expect(ui.Out).To(Say("\x1b\\[1mheader1\x1b\\[0m\\s+\x1b\\[1mheader2\x1b\\[0m\\s+\x1b\\[1mheader3\x1b\\[0m")) // Makes sure empty values are not bolded
 				Expect(ui.Out).To(Say("#0\\s+starting\\s+val1\\s+val2"))
 				Expect(ui.Out).To(Say("#1\\s+\x1b\\[31;1mdown\x1b\\[0m\\s+val1\\s+val2"))
 				Expect(ui.Out).To(Say("#2\\s+\x1b\\[31;1mcrashed\x1b\\[0m\\s+val1\\s+val2"))
+				Expect(ui.Out).To(Say("#3\\s+\x1b\\[31;1mflapping\x1b\\[0m\\s+val1\\s+val2"))
 			})
 		})
 
@@ -85,6 +85,26 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayTextPrompt", func() {
+		var inBuffer *Buffer
+
+		BeforeEach(func() {
+			inBuffer = NewBuffer()
+			ui.In = inBuffer
+			inBuffer.Write([]byte("some-input\n"))
+		})
+
+		It("displays the prompt and returns the user input", func() {
+			userInput, err := ui.DisplayTextPrompt("Type {{.OrgName}} to confirm", map[string]interface{}{
+				"OrgName": "some-org",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(userInput).To(Equal("some-input"))
+			Expect(ui.Out).To(Say("Type some-org to confirm"))
+		})
+	})
+
 	Describe("DisplayBoolPrompt", func() {
 		var inBuffer *Buffer
 